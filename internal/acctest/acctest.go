@@ -12,6 +12,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -33,6 +34,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	tfsts "github.com/hashicorp/terraform-provider-aws/internal/service/sts"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 )
 
 const (
@@ -190,6 +192,13 @@ func FactoriesMultipleRegion(providers *[]*schema.Provider, regions int) map[str
 // These verifications and configuration are preferred at this level to prevent
 // provider developers from experiencing less clear errors for every test.
 func PreCheck(t *testing.T) {
+	// Replaying requires no credentials, so placeholder static credentials are set.
+	if vcr.Replaying() {
+		os.Setenv(conns.EnvVarAccessKeyId, vcr.ScrubbedValue)
+		os.Setenv(conns.EnvVarSecretAccessKey, vcr.ScrubbedValue)
+		os.Unsetenv(conns.EnvVarProfile)
+	}
+
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
@@ -209,11 +218,93 @@ func PreCheck(t *testing.T) {
 		region := Region()
 		os.Setenv(conns.EnvVarDefaultRegion, region)
 
+		// The provider is shared by all tests, so the AWS API interactions made while
+		// configuring it are recorded into, and replayed from, every test's cassette.
+		if vcr.Enabled() {
+			if err := vcr.StartSetup(t.Name(), vcrStartTimeout(t)); err != nil {
+				t.Fatal(err)
+			}
+
+			defer vcr.StopSetup()
+		}
+
 		err := Provider.Configure(context.Background(), terraform.NewResourceConfigRaw(nil))
 		if err != nil {
 			t.Fatal(err)
 		}
 	})
+
+	if vcr.Enabled() {
+		vcrPreCheck(t)
+	}
+}
+
+// vcrPreCheck starts recording or replaying the AWS API interactions of the test.
+func vcrPreCheck(t *testing.T) {
+	if err := vcr.Start(t.Name(), vcrStartTimeout(t)); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := vcr.Stop(t.Name()); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+// vcrStartTimeout returns the maximum time to wait for another test's cassette to be stopped.
+func vcrStartTimeout(t *testing.T) time.Duration {
+	timeout := vcr.DefaultStartTimeout
+
+	// Fail before the test binary times out, which would hide the reason.
+	if deadline, ok := t.Deadline(); ok {
+		if v := time.Until(deadline) - time.Minute; v < timeout {
+			timeout = v
+		}
+	}
+
+	return timeout
+}
+
+// Character sets for RandStringFromCharSet.
+const (
+	CharSetAlpha    = sdkacctest.CharSetAlpha
+	CharSetAlphaNum = sdkacctest.CharSetAlphaNum
+)
+
+// RandomWithPrefix is used to generate a unique name with a prefix. Unlike
+// sdkacctest.RandomWithPrefix, the names generated are deterministic when recording
+// or replaying acceptance tests, allowing tests using them to be replayed.
+// The same holds for the other Rand functions in this package.
+func RandomWithPrefix(name string) string {
+	return fmt.Sprintf("%s-%d", name, RandInt())
+}
+
+// RandInt generates a random integer.
+func RandInt() int {
+	return vcr.RandInt()
+}
+
+// RandIntRange generates a random integer in [min, max).
+func RandIntRange(min int, max int) int {
+	return min + vcr.RandIntn(max-min)
+}
+
+// RandString generates a random alphanumeric string of the length specified.
+func RandString(strlen int) string {
+	return RandStringFromCharSet(strlen, CharSetAlphaNum)
+}
+
+// RandStringFromCharSet generates a random string by selecting characters from
+// the charset provided.
+func RandStringFromCharSet(strlen int, charSet string) string {
+	result := make([]byte, strlen)
+
+	for i := 0; i < strlen; i++ {
+		result[i] = charSet[vcr.RandIntn(len(charSet))]
+	}
+
+	return string(result)
 }
 
 // ProviderAccountID returns the account ID of an AWS provider
@@ -1910,7 +2001,7 @@ func (d domainName) Subdomain(name string) domainName {
 }
 
 func (d domainName) RandomSubdomain() domainName {
-	return d.Subdomain(sdkacctest.RandString(8))
+	return d.Subdomain(RandString(8))
 }
 
 func (d domainName) FQDN() domainName {
@@ -1932,7 +2023,7 @@ const DefaultEmailAddress = "no-reply@hashicorp.com"
 // RandomEmailAddress generates a random email address in the form
 // "tf-acc-test-<random>@<domain>"
 func RandomEmailAddress(domainName string) string {
-	return fmt.Sprintf("%s@%s", sdkacctest.RandomWithPrefix(ResourcePrefix), domainName)
+	return fmt.Sprintf("%s@%s", RandomWithPrefix(ResourcePrefix), domainName)
 }
 
 func PreCheckOutpostsOutposts(t *testing.T) {
@@ -1987,7 +2078,7 @@ func ACMCertificateRandomSubDomain(rootDomain string) string {
 	// Subtract "tf-acc-" prefix (7)
 	// Subtract "." between prefix and root domain (1)
 	// Subtract length of root domain
-	return fmt.Sprintf("tf-acc-%s.%s", sdkacctest.RandString(55-len(rootDomain)), rootDomain)
+	return fmt.Sprintf("tf-acc-%s.%s", RandString(55-len(rootDomain)), rootDomain)
 }
 
 func CheckACMPCACertificateAuthorityActivateCA(certificateAuthority *acmpca.CertificateAuthority) resource.TestCheckFunc {
@@ -6,6 +6,8 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
@@ -178,6 +180,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
 
@@ -189,6 +192,7 @@ type Config struct {
 	Token         string
 	Region        string
 	MaxRetries    int
+	RetryMode     string
 
 	AssumeRoleARN               string
 	AssumeRoleDurationSeconds   int
@@ -199,14 +203,29 @@ type Config struct {
 	AssumeRoleTags              map[string]string
 	AssumeRoleTransitiveTagKeys []string
 
+	AssumeRoleWithWebIdentityARN             string
+	AssumeRoleWithWebIdentityDurationSeconds int
+	AssumeRoleWithWebIdentityPolicy          string
+	AssumeRoleWithWebIdentityPolicyARNs      []string
+	AssumeRoleWithWebIdentitySessionName     string
+	AssumeRoleWithWebIdentityToken           string
+	AssumeRoleWithWebIdentityTokenFile       string
+
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
+	AllowedRegions      []string
+	ForbiddenRegions    []string
+
+	// DefaultTagsSSMParameterPath is an SSM Parameter path from which additional default tags are read.
+	DefaultTagsSSMParameterPath string
 
 	DefaultTagsConfig *tftags.DefaultConfig
 	Endpoints         map[string]string
 	IgnoreTagsConfig  *tftags.IgnoreConfig
 	Insecure          bool
 	HTTPProxy         string
+	NoProxy           []string
+	CustomCABundle    string
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
@@ -215,6 +234,9 @@ type Config struct {
 	SkipMetadataApiCheck    bool
 	S3ForcePathStyle        bool
 
+	// RequestHandlers, if set, adds handlers to the requests of all service clients.
+	RequestHandlers func(*request.Handlers)
+
 	TerraformVersion string
 }
 
@@ -223,6 +245,7 @@ type AWSClient struct {
 	AccountID                        string
 	ACMConn                          *acm.ACM
 	ACMPCAConn                       *acmpca.ACMPCA
+	AllowedRegions                   []string
 	AmplifyConn                      *amplify.Amplify
 	APIGatewayConn                   *apigateway.APIGateway
 	APIGatewayV2Conn                 *apigatewayv2.ApiGatewayV2
@@ -262,6 +285,7 @@ type AWSClient struct {
 	CognitoIDPConn                   *cognitoidentityprovider.CognitoIdentityProvider
 	ConfigConn                       *configservice.ConfigService
 	ConnectConn                      *connect.Connect
+	CredentialsInfo                  *CredentialsInfo
 	CURConn                          *costandusagereportservice.CostandUsageReportService
 	DataExchangeConn                 *dataexchange.DataExchange
 	DataPipelineConn                 *datapipeline.DataPipeline
@@ -293,6 +317,7 @@ type AWSClient struct {
 	ElasticSearchConn                *elasticsearch.ElasticsearchService
 	FirehoseConn                     *firehose.Firehose
 	FMSConn                          *fms.FMS
+	ForbiddenRegions                 []string
 	ForecastConn                     *forecastservice.ForecastService
 	FSxConn                          *fsx.FSx
 	GameLiftConn                     *gamelift.GameLift
@@ -363,6 +388,7 @@ type AWSClient struct {
 	S3Conn                           *s3.S3
 	S3ConnURICleaningDisabled        *s3.S3
 	S3ControlConn                    *s3control.S3Control
+	S3ControlMultiRegionConn         *s3control.S3Control
 	S3OutpostsConn                   *s3outposts.S3Outposts
 	SageMakerConn                    *sagemaker.SageMaker
 	ServiceCatalogConn               *servicecatalog.ServiceCatalog
@@ -422,32 +448,28 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
+	if err := c.resolveEndpoints(); err != nil {
+		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+	}
+
 	awsbaseConfig := &awsbase.Config{
-		AccessKey:                   c.AccessKey,
-		AssumeRoleARN:               c.AssumeRoleARN,
-		AssumeRoleDurationSeconds:   c.AssumeRoleDurationSeconds,
-		AssumeRoleExternalID:        c.AssumeRoleExternalID,
-		AssumeRolePolicy:            c.AssumeRolePolicy,
-		AssumeRolePolicyARNs:        c.AssumeRolePolicyARNs,
-		AssumeRoleSessionName:       c.AssumeRoleSessionName,
-		AssumeRoleTags:              c.AssumeRoleTags,
-		AssumeRoleTransitiveTagKeys: c.AssumeRoleTransitiveTagKeys,
-		CallerDocumentationURL:      "https://registry.terraform.io/providers/hashicorp/aws",
-		CallerName:                  "Terraform AWS Provider",
-		CredsFilename:               c.CredsFilename,
-		DebugLogging:                logging.IsDebugOrHigher(),
-		IamEndpoint:                 c.Endpoints["iam"],
-		Insecure:                    c.Insecure,
-		HTTPProxy:                   c.HTTPProxy,
-		MaxRetries:                  c.MaxRetries,
-		Profile:                     c.Profile,
-		Region:                      c.Region,
-		SecretKey:                   c.SecretKey,
-		SkipCredsValidation:         c.SkipCredsValidation,
-		SkipMetadataApiCheck:        c.SkipMetadataApiCheck,
-		SkipRequestingAccountId:     c.SkipRequestingAccountId,
-		StsEndpoint:                 c.Endpoints["sts"],
-		Token:                       c.Token,
+		AccessKey:               c.AccessKey,
+		CallerDocumentationURL:  "https://registry.terraform.io/providers/hashicorp/aws",
+		CallerName:              "Terraform AWS Provider",
+		CredsFilename:           c.CredsFilename,
+		DebugLogging:            logging.LogLevel() == "TRACE",
+		IamEndpoint:             c.Endpoints["iam"],
+		Insecure:                c.Insecure,
+		HTTPProxy:               c.HTTPProxy,
+		MaxRetries:              c.MaxRetries,
+		Profile:                 c.Profile,
+		Region:                  c.Region,
+		SecretKey:               c.SecretKey,
+		SkipCredsValidation:     c.SkipCredsValidation,
+		SkipMetadataApiCheck:    c.SkipMetadataApiCheck,
+		SkipRequestingAccountId: c.SkipRequestingAccountId,
+		StsEndpoint:             c.Endpoints["sts"],
+		Token:                   c.Token,
 		UserAgentProducts: []*awsbase.UserAgentProduct{
 			{Name: "APN", Version: "1.0"},
 			{Name: "HashiCorp", Version: "1.0"},
@@ -456,11 +478,118 @@ func (c *Config) Client() (interface{}, error) {
 		},
 	}
 
+	httpClient, err := c.httpClient()
+
+	if err != nil {
+		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+	}
+
+	if vcr.Enabled() {
+		httpClient.Transport = vcr.Transport(httpClient.Transport)
+		awsbaseConfig.SkipMetadataApiCheck = true
+	}
+
+	// awsbase makes its calls (credential validation and account ID lookup) with HTTP clients
+	// of its own, which don't trust the custom CA bundle and bypass the recording of acceptance
+	// tests. In those cases, and when authenticating via web identity, whose credentials awsbase
+	// is only handed a point-in-time copy of, the account ID is instead requested below, using
+	// the provider HTTP client.
+	useProviderHTTPClient := vcr.Enabled() || c.CustomCABundle != "" || c.AssumeRoleWithWebIdentityARN != ""
+
+	// awsbase isn't configured to assume the role, which is instead assumed below on top of the
+	// source credentials it returns, so that those are known to the provider credentials information.
+	if useProviderHTTPClient || c.AssumeRoleARN != "" {
+		awsbaseConfig.SkipCredsValidation = true
+		awsbaseConfig.SkipRequestingAccountId = true
+	}
+
+	// The web identity credentials become the source credentials for the session,
+	// on top of which any assume_role configuration is chained.
+	var webIdentityCreds *credentials.Credentials
+
+	if c.AssumeRoleWithWebIdentityARN != "" {
+		log.Printf("[INFO] Attempting to AssumeRoleWithWebIdentity %s (SessionName: %q)", c.AssumeRoleWithWebIdentityARN, c.AssumeRoleWithWebIdentitySessionName)
+
+		creds, err := c.webIdentityCredentials(httpClient)
+
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+
+		v, err := creds.Get()
+
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+
+		webIdentityCreds = creds
+		awsbaseConfig.AccessKey = v.AccessKeyID
+		awsbaseConfig.SecretKey = v.SecretAccessKey
+		awsbaseConfig.Token = v.SessionToken
+	}
+
 	sess, accountID, Partition, err := awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
 	}
 
+	// All service clients share the HTTP client honoring the provider TLS and proxy settings.
+	sess.Config.HTTPClient = httpClient
+
+	// Raw HTTP request and response bodies, which may contain secrets, are only logged at TRACE level.
+	if logging.IsDebugOrHigher() {
+		addWireLoggingHandlers(&sess.Handlers)
+	}
+
+	// Service clients inherit the handlers, so each service is rate limited independently.
+	if c.RetryMode == RetryModeAdaptive {
+		addAdaptiveRateLimitHandlers(&sess.Handlers)
+	}
+
+	if c.RequestHandlers != nil {
+		c.RequestHandlers(&sess.Handlers)
+	}
+
+	// Replace the point-in-time web identity credentials handed to awsbase
+	// with credentials that refresh for the lifetime of the provider.
+	if webIdentityCreds != nil {
+		sess.Config.Credentials = webIdentityCreds
+	}
+
+	sourceCreds := sess.Config.Credentials
+
+	if c.AssumeRoleARN != "" {
+		log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q)", c.AssumeRoleARN, c.AssumeRoleSessionName, c.AssumeRoleExternalID)
+
+		creds := c.assumeRoleCredentials(sess, sess.Config.Credentials)
+
+		if _, err := creds.Get(); err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: IAM Role (%s) cannot be assumed: %w", c.AssumeRoleARN, err)
+		}
+
+		sess.Config.Credentials = creds
+	}
+
+	// As with awsbase, validating the credentials also requests the account ID.
+	if (useProviderHTTPClient || c.AssumeRoleARN != "") && !c.SkipCredsValidation || useProviderHTTPClient && !c.SkipRequestingAccountId {
+		stsconn := sts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sts"])}))
+
+		if accountID, Partition, err = awsbase.GetAccountIDAndPartitionFromSTSGetCallerIdentity(stsconn); err != nil {
+			if !c.SkipCredsValidation {
+				return nil, fmt.Errorf("error configuring Terraform AWS Provider: error validating provider credentials: %w", err)
+			}
+
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+	}
+
+	// As with awsbase, the account ID of an assumed role is otherwise taken from its ARN.
+	if accountID == "" && c.AssumeRoleARN != "" {
+		if v, err := arn.Parse(c.AssumeRoleARN); err == nil {
+			accountID, Partition = v.AccountID, v.Partition
+		}
+	}
+
 	if accountID == "" {
 		log.Printf("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
@@ -479,6 +608,7 @@ func (c *Config) Client() (interface{}, error) {
 		AccountID:                        accountID,
 		ACMConn:                          acm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["acm"])})),
 		ACMPCAConn:                       acmpca.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["acmpca"])})),
+		AllowedRegions:                   c.AllowedRegions,
 		AmplifyConn:                      amplify.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["amplify"])})),
 		APIGatewayConn:                   apigateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["apigateway"])})),
 		APIGatewayV2Conn:                 apigatewayv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["apigateway"])})),
@@ -518,6 +648,7 @@ func (c *Config) Client() (interface{}, error) {
 		CognitoIDPConn:                   cognitoidentityprovider.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cognitoidp"])})),
 		ConfigConn:                       configservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["configservice"])})),
 		ConnectConn:                      connect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["connect"])})),
+		CredentialsInfo:                  c.credentialsInfo(sourceCreds, sess.Config.Credentials),
 		CURConn:                          costandusagereportservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cur"])})),
 		DataExchangeConn:                 dataexchange.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dataexchange"])})),
 		DataPipelineConn:                 datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datapipeline"])})),
@@ -549,6 +680,7 @@ func (c *Config) Client() (interface{}, error) {
 		ElasticSearchConn:                elasticsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["es"])})),
 		FirehoseConn:                     firehose.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["firehose"])})),
 		FMSConn:                          fms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["fms"])})),
+		ForbiddenRegions:                 c.ForbiddenRegions,
 		ForecastConn:                     forecastservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["forecast"])})),
 		FSxConn:                          fsx.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["fsx"])})),
 		GameLiftConn:                     gamelift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["gamelift"])})),
@@ -675,6 +807,11 @@ func (c *Config) Client() (interface{}, error) {
 	s3Config.DisableRestProtocolURICleaning = aws.Bool(true)
 	client.S3ConnURICleaningDisabled = s3.New(sess.Copy(s3Config))
 
+	// Multi-Region Access Point control plane requests must be routed to a single Region
+	s3ControlMultiRegionConfig := &aws.Config{
+		Endpoint: aws.String(c.Endpoints["s3control"]),
+	}
+
 	// Force "global" services to correct regions
 	switch Partition {
 	case endpoints.AwsPartitionID:
@@ -682,6 +819,7 @@ func (c *Config) Client() (interface{}, error) {
 		route53Config.Region = aws.String(endpoints.UsEast1RegionID)
 		route53RecoveryControlConfigConfig.Region = aws.String(endpoints.UsWest2RegionID)
 		route53RecoveryReadinessConfig.Region = aws.String(endpoints.UsWest2RegionID)
+		s3ControlMultiRegionConfig.Region = aws.String(endpoints.UsWest2RegionID)
 		shieldConfig.Region = aws.String(endpoints.UsEast1RegionID)
 	case endpoints.AwsCnPartitionID:
 		// The AWS Go SDK is missing endpoint information for Route 53 in the AWS China partition.
@@ -698,8 +836,19 @@ func (c *Config) Client() (interface{}, error) {
 	client.Route53Conn = route53.New(sess.Copy(route53Config))
 	client.Route53RecoveryControlConfigConn = route53recoverycontrolconfig.New(sess.Copy(route53RecoveryControlConfigConfig))
 	client.Route53RecoveryReadinessConn = route53recoveryreadiness.New(sess.Copy(route53RecoveryReadinessConfig))
+	client.S3ControlMultiRegionConn = s3control.New(sess.Copy(s3ControlMultiRegionConfig))
 	client.ShieldConn = shield.New(sess.Copy(shieldConfig))
 
+	if c.DefaultTagsSSMParameterPath != "" {
+		defaultTagsConfig, err := defaultTagsFromSSMParameterPath(client.SSMConn, c.DefaultTagsSSMParameterPath, c.DefaultTagsConfig)
+
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+
+		client.DefaultTagsConfig = defaultTagsConfig
+	}
+
 	client.APIGatewayConn.Handlers.Retry.PushBack(func(r *request.Request) {
 		// Many operations can return an error such as:
 		//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
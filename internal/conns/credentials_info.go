@@ -0,0 +1,126 @@
+package conns
+
+import (
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/endpointcreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/ssocreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+)
+
+// Credential sources, in terms of the provider configuration.
+const (
+	CredentialsSourceAssumeRole            = "assume_role"
+	CredentialsSourceContainer             = "container"
+	CredentialsSourceEnvironment           = "environment"
+	CredentialsSourceIMDS                  = "imds"
+	CredentialsSourceSharedCredentialsFile = "shared_credentials_file"
+	CredentialsSourceSSO                   = "sso"
+	CredentialsSourceStatic                = "static"
+	CredentialsSourceUnknown               = "unknown"
+	CredentialsSourceWebIdentity           = "web_identity"
+)
+
+// CredentialsInfo describes the credentials used by the provider. It never contains secret material.
+type CredentialsInfo struct {
+	// ProviderName is the name of the AWS SDK credentials provider which supplied the source credentials,
+	// on top of which the roles in AssumedRoleChain are assumed.
+	ProviderName string
+	// Source is the source credentials' source in terms of the provider configuration, e.g. "static" or "imds".
+	Source string
+	// AssumedRoleChain is the ARNs of the roles assumed, in order, on top of the source credentials.
+	AssumedRoleChain []string
+
+	credentials *credentials.Credentials
+}
+
+// Expiration returns when the credentials currently in use expire. Zero if they don't expire.
+// Credentials which have expired are refreshed first, so it is never a past time.
+func (info *CredentialsInfo) Expiration() time.Time {
+	if info.credentials == nil {
+		return time.Time{}
+	}
+
+	if _, err := info.credentials.Get(); err != nil {
+		return time.Time{}
+	}
+
+	v, err := info.credentials.ExpiresAt()
+
+	if err != nil {
+		return time.Time{}
+	}
+
+	return v
+}
+
+// credentialsInfo captures metadata about the source credentials and those in use, which are
+// the same unless roles are assumed.
+// Failure to retrieve the credentials isn't an error here, the service clients report it on first use.
+func (c *Config) credentialsInfo(sourceCreds, creds *credentials.Credentials) *CredentialsInfo {
+	info := &CredentialsInfo{
+		AssumedRoleChain: c.assumedRoleChain(),
+		credentials:      creds,
+	}
+
+	if sourceCreds == nil {
+		info.Source = CredentialsSourceUnknown
+
+		return info
+	}
+
+	v, err := sourceCreds.Get()
+
+	if err != nil {
+		log.Printf("[WARN] Unable to retrieve AWS credentials metadata: %s", err)
+		info.Source = CredentialsSourceUnknown
+
+		return info
+	}
+
+	info.ProviderName = v.ProviderName
+	info.Source = credentialsSource(v.ProviderName)
+
+	return info
+}
+
+// assumedRoleChain returns the ARNs of the roles assumed by the provider configuration, in order.
+func (c *Config) assumedRoleChain() []string {
+	var chain []string
+
+	if c.AssumeRoleWithWebIdentityARN != "" {
+		chain = append(chain, c.AssumeRoleWithWebIdentityARN)
+	}
+
+	if c.AssumeRoleARN != "" {
+		chain = append(chain, c.AssumeRoleARN)
+	}
+
+	return chain
+}
+
+func credentialsSource(providerName string) string {
+	switch providerName {
+	case credentials.StaticProviderName:
+		return CredentialsSourceStatic
+	case credentials.EnvProviderName:
+		return CredentialsSourceEnvironment
+	case credentials.SharedCredsProviderName:
+		return CredentialsSourceSharedCredentialsFile
+	case endpointcreds.ProviderName:
+		return CredentialsSourceContainer
+	case ec2rolecreds.ProviderName:
+		return CredentialsSourceIMDS
+	case ssocreds.ProviderName:
+		return CredentialsSourceSSO
+	case stscreds.ProviderName:
+		return CredentialsSourceAssumeRole
+	case stscreds.WebIdentityProviderName, WebIdentityProviderName:
+		return CredentialsSourceWebIdentity
+	}
+
+	return CredentialsSourceUnknown
+}
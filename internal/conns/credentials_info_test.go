@@ -0,0 +1,161 @@
+package conns
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/endpointcreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+)
+
+type testExpiringProvider struct {
+	credentials.Expiry
+
+	expiration time.Time
+}
+
+func (p *testExpiringProvider) Retrieve() (credentials.Value, error) {
+	p.SetExpiration(p.expiration, 0)
+
+	return credentials.Value{
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "SECRET",
+		SessionToken:    "TOKEN",
+		ProviderName:    stscreds.ProviderName,
+	}, nil
+}
+
+type testErrorProvider struct{}
+
+func (testErrorProvider) Retrieve() (credentials.Value, error) {
+	return credentials.Value{}, errors.New("no valid providers in chain")
+}
+
+func (testErrorProvider) IsExpired() bool {
+	return true
+}
+
+func TestCredentialsSource(t *testing.T) {
+	testCases := []struct {
+		ProviderName string
+		Expected     string
+	}{
+		{ProviderName: credentials.StaticProviderName, Expected: CredentialsSourceStatic},
+		{ProviderName: credentials.EnvProviderName, Expected: CredentialsSourceEnvironment},
+		{ProviderName: credentials.SharedCredsProviderName, Expected: CredentialsSourceSharedCredentialsFile},
+		{ProviderName: endpointcreds.ProviderName, Expected: CredentialsSourceContainer},
+		{ProviderName: ec2rolecreds.ProviderName, Expected: CredentialsSourceIMDS},
+		{ProviderName: stscreds.ProviderName, Expected: CredentialsSourceAssumeRole},
+		{ProviderName: WebIdentityProviderName, Expected: CredentialsSourceWebIdentity},
+		{ProviderName: "", Expected: CredentialsSourceUnknown},
+		{ProviderName: "SomeOtherProvider", Expected: CredentialsSourceUnknown},
+	}
+
+	for _, testCase := range testCases {
+		if got := credentialsSource(testCase.ProviderName); got != testCase.Expected {
+			t.Errorf("credentialsSource(%q) = %q, expected %q", testCase.ProviderName, got, testCase.Expected)
+		}
+	}
+}
+
+func TestConfigCredentialsInfo(t *testing.T) {
+	expiration := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	testCases := []struct {
+		Name               string
+		Config             *Config
+		SourceCreds        *credentials.Credentials
+		Creds              *credentials.Credentials
+		ExpectedInfo       *CredentialsInfo
+		ExpectedExpiration time.Time
+	}{
+		{
+			Name:        "static",
+			Config:      &Config{},
+			SourceCreds: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+			Creds:       credentials.NewStaticCredentials("AKID", "SECRET", ""),
+			ExpectedInfo: &CredentialsInfo{
+				ProviderName: credentials.StaticProviderName,
+				Source:       CredentialsSourceStatic,
+			},
+		},
+		{
+			Name: "assume role chain",
+			Config: &Config{
+				AssumeRoleARN:                "arn:aws:iam::123456789012:role/second",
+				AssumeRoleWithWebIdentityARN: "arn:aws:iam::123456789012:role/first",
+			},
+			SourceCreds: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+			Creds:       credentials.NewCredentials(&testExpiringProvider{expiration: expiration}),
+			ExpectedInfo: &CredentialsInfo{
+				ProviderName: credentials.StaticProviderName,
+				Source:       CredentialsSourceStatic,
+				AssumedRoleChain: []string{
+					"arn:aws:iam::123456789012:role/first",
+					"arn:aws:iam::123456789012:role/second",
+				},
+			},
+			ExpectedExpiration: expiration,
+		},
+		{
+			Name:        "retrieval error",
+			Config:      &Config{},
+			SourceCreds: credentials.NewCredentials(testErrorProvider{}),
+			Creds:       credentials.NewCredentials(testErrorProvider{}),
+			ExpectedInfo: &CredentialsInfo{
+				Source: CredentialsSourceUnknown,
+			},
+		},
+		{
+			Name:   "no credentials",
+			Config: &Config{},
+			ExpectedInfo: &CredentialsInfo{
+				Source: CredentialsSourceUnknown,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := testCase.Config.credentialsInfo(testCase.SourceCreds, testCase.Creds)
+
+			if got.ProviderName != testCase.ExpectedInfo.ProviderName {
+				t.Errorf("got ProviderName %q, expected %q", got.ProviderName, testCase.ExpectedInfo.ProviderName)
+			}
+
+			if got.Source != testCase.ExpectedInfo.Source {
+				t.Errorf("got Source %q, expected %q", got.Source, testCase.ExpectedInfo.Source)
+			}
+
+			if !reflect.DeepEqual(got.AssumedRoleChain, testCase.ExpectedInfo.AssumedRoleChain) {
+				t.Errorf("got AssumedRoleChain %v, expected %v", got.AssumedRoleChain, testCase.ExpectedInfo.AssumedRoleChain)
+			}
+
+			if v := got.Expiration(); !v.Equal(testCase.ExpectedExpiration) {
+				t.Errorf("got Expiration %s, expected %s", v, testCase.ExpectedExpiration)
+			}
+		})
+	}
+}
+
+func TestCredentialsInfoExpirationRefreshed(t *testing.T) {
+	provider := &testExpiringProvider{expiration: time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)}
+	creds := credentials.NewCredentials(provider)
+	info := (&Config{}).credentialsInfo(creds, creds)
+
+	if got, expected := info.Expiration(), provider.expiration; !got.Equal(expected) {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	// The credentials are refreshed after the provider is configured.
+	provider.expiration = time.Date(2030, 1, 2, 4, 4, 5, 0, time.UTC)
+	creds.Expire()
+
+	if got, expected := info.Expiration(), provider.expiration; !got.Equal(expected) {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
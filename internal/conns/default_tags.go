@@ -0,0 +1,61 @@
+package conns
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// defaultTagsFromSSMParameterPath returns the default tags configuration with the tags stored
+// as SSM Parameters under the path merged in. Tags configured directly take precedence.
+func defaultTagsFromSSMParameterPath(conn ssmiface.SSMAPI, path string, defaultTagsConfig *tftags.DefaultConfig) (*tftags.DefaultConfig, error) {
+	tags, err := ssmParameterPathTags(conn, path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading default tags from SSM Parameter path (%s): %w", path, err)
+	}
+
+	return &tftags.DefaultConfig{
+		Tags: tags.Merge(defaultTagsConfig.GetTags()),
+	}, nil
+}
+
+// ssmParameterPathTags returns the SSM Parameters under the path, recursively, as tags.
+// The tag keys are the parameter names relative to the path, e.g. the parameter
+// "/tags/cost-center" under the path "/tags" becomes the "cost-center" tag.
+func ssmParameterPathTags(conn ssmiface.SSMAPI, path string) (tftags.KeyValueTags, error) {
+	prefix := strings.TrimSuffix(path, "/") + "/"
+	tags := make(map[string]string)
+
+	input := &ssm.GetParametersByPathInput{
+		Path:           aws.String(path),
+		Recursive:      aws.Bool(true),
+		WithDecryption: aws.Bool(true),
+	}
+
+	err := conn.GetParametersByPathPages(input, func(page *ssm.GetParametersByPathOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, parameter := range page.Parameters {
+			if parameter == nil {
+				continue
+			}
+
+			tags[strings.TrimPrefix(aws.StringValue(parameter.Name), prefix)] = aws.StringValue(parameter.Value)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tftags.New(tags), nil
+}
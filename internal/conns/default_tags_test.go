@@ -0,0 +1,75 @@
+package conns
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+type testSSMClient struct {
+	ssmiface.SSMAPI
+
+	pages []*ssm.GetParametersByPathOutput
+	input *ssm.GetParametersByPathInput
+}
+
+func (c *testSSMClient) GetParametersByPathPages(input *ssm.GetParametersByPathInput, fn func(*ssm.GetParametersByPathOutput, bool) bool) error {
+	c.input = input
+
+	for i, page := range c.pages {
+		if !fn(page, i == len(c.pages)-1) {
+			break
+		}
+	}
+
+	return nil
+}
+
+func TestDefaultTagsFromSSMParameterPath(t *testing.T) {
+	conn := &testSSMClient{
+		pages: []*ssm.GetParametersByPathOutput{
+			{
+				Parameters: []*ssm.Parameter{
+					{Name: aws.String("/org/tags/cost-center"), Value: aws.String("1234")},
+					{Name: aws.String("/org/tags/owner"), Value: aws.String("platform")},
+				},
+			},
+			{
+				Parameters: []*ssm.Parameter{
+					{Name: aws.String("/org/tags/team/name"), Value: aws.String("storage")},
+				},
+			},
+		},
+	}
+
+	defaultTagsConfig := &tftags.DefaultConfig{
+		Tags: tftags.New(map[string]interface{}{
+			"owner":       "override",
+			"environment": "test",
+		}),
+	}
+
+	got, err := defaultTagsFromSSMParameterPath(conn, "/org/tags", defaultTagsConfig)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !aws.BoolValue(conn.input.Recursive) || !aws.BoolValue(conn.input.WithDecryption) {
+		t.Errorf("expected recursive, decrypted parameters to be requested")
+	}
+
+	expected := map[string]string{
+		"cost-center": "1234",
+		"environment": "test",
+		"owner":       "override",
+		"team/name":   "storage",
+	}
+
+	if !got.Tags.Equal(tftags.New(expected)) {
+		t.Errorf("got tags %v, expected %v", got.Tags.Map(), expected)
+	}
+}
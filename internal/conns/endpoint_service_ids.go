@@ -0,0 +1,337 @@
+package conns
+
+import (
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/applicationinsights"
+	"github.com/aws/aws-sdk-go/service/appmesh"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscalingplans"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/aws/aws-sdk-go/service/chime"
+	"github.com/aws/aws-sdk-go/service/cloud9"
+	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go/service/cloudsearch"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/codeartifact"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/aws-sdk-go/service/codestarconnections"
+	"github.com/aws/aws-sdk-go/service/codestarnotifications"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/aws/aws-sdk-go/service/costandusagereportservice"
+	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go/service/dataexchange"
+	"github.com/aws/aws-sdk-go/service/datapipeline"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/aws/aws-sdk-go/service/devicefarm"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/aws/aws-sdk-go/service/dlm"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecrpublic"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/aws/aws-sdk-go/service/forecastservice"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/aws/aws-sdk-go/service/greengrass"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/aws/aws-sdk-go/service/inspector"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kinesisanalytics"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/aws/aws-sdk-go/service/macie"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/aws/aws-sdk-go/service/marketplacecatalog"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/aws/aws-sdk-go/service/mediastoredata"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/aws/aws-sdk-go/service/mwaa"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/outposts"
+	"github.com/aws/aws-sdk-go/service/personalize"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/aws/aws-sdk-go/service/prometheusservice"
+	"github.com/aws/aws-sdk-go/service/qldb"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/s3outposts"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/schemas"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/aws/aws-sdk-go/service/signer"
+	"github.com/aws/aws-sdk-go/service/simpledb"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/aws-sdk-go/service/worklink"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/aws/aws-sdk-go/service/xray"
+)
+
+// endpointServiceIDs maps the service names of the provider endpoints configuration to the AWS SDK service IDs,
+// from which the names of the service specific endpoint environment variables and shared config file keys are derived.
+var endpointServiceIDs = map[string]string{
+	"accessanalyzer":               accessanalyzer.ServiceID,
+	"acm":                          acm.ServiceID,
+	"acmpca":                       acmpca.ServiceID,
+	"amplify":                      amplify.ServiceID,
+	"apigateway":                   apigateway.ServiceID,
+	"appconfig":                    appconfig.ServiceID,
+	"applicationautoscaling":       applicationautoscaling.ServiceID,
+	"applicationinsights":          applicationinsights.ServiceID,
+	"appmesh":                      appmesh.ServiceID,
+	"apprunner":                    apprunner.ServiceID,
+	"appstream":                    appstream.ServiceID,
+	"appsync":                      appsync.ServiceID,
+	"athena":                       athena.ServiceID,
+	"auditmanager":                 auditmanager.ServiceID,
+	"autoscaling":                  autoscaling.ServiceID,
+	"autoscalingplans":             autoscalingplans.ServiceID,
+	"backup":                       backup.ServiceID,
+	"batch":                        batch.ServiceID,
+	"budgets":                      budgets.ServiceID,
+	"chime":                        chime.ServiceID,
+	"cloud9":                       cloud9.ServiceID,
+	"cloudcontrolapi":              cloudcontrolapi.ServiceID,
+	"cloudformation":               cloudformation.ServiceID,
+	"cloudfront":                   cloudfront.ServiceID,
+	"cloudhsm":                     cloudhsmv2.ServiceID,
+	"cloudsearch":                  cloudsearch.ServiceID,
+	"cloudtrail":                   cloudtrail.ServiceID,
+	"cloudwatch":                   cloudwatch.ServiceID,
+	"cloudwatchevents":             cloudwatchevents.ServiceID,
+	"cloudwatchlogs":               cloudwatchlogs.ServiceID,
+	"codeartifact":                 codeartifact.ServiceID,
+	"codebuild":                    codebuild.ServiceID,
+	"codecommit":                   codecommit.ServiceID,
+	"codedeploy":                   codedeploy.ServiceID,
+	"codepipeline":                 codepipeline.ServiceID,
+	"codestarconnections":          codestarconnections.ServiceID,
+	"codestarnotifications":        codestarnotifications.ServiceID,
+	"cognitoidentity":              cognitoidentity.ServiceID,
+	"cognitoidp":                   cognitoidentityprovider.ServiceID,
+	"configservice":                configservice.ServiceID,
+	"connect":                      connect.ServiceID,
+	"cur":                          costandusagereportservice.ServiceID,
+	"dataexchange":                 dataexchange.ServiceID,
+	"datapipeline":                 datapipeline.ServiceID,
+	"datasync":                     datasync.ServiceID,
+	"dax":                          dax.ServiceID,
+	"detective":                    detective.ServiceID,
+	"devicefarm":                   devicefarm.ServiceID,
+	"directconnect":                directconnect.ServiceID,
+	"dlm":                          dlm.ServiceID,
+	"dms":                          databasemigrationservice.ServiceID,
+	"docdb":                        docdb.ServiceID,
+	"ds":                           directoryservice.ServiceID,
+	"dynamodb":                     dynamodb.ServiceID,
+	"ec2":                          ec2.ServiceID,
+	"ecr":                          ecr.ServiceID,
+	"ecrpublic":                    ecrpublic.ServiceID,
+	"ecs":                          ecs.ServiceID,
+	"efs":                          efs.ServiceID,
+	"eks":                          eks.ServiceID,
+	"elasticache":                  elasticache.ServiceID,
+	"elasticbeanstalk":             elasticbeanstalk.ServiceID,
+	"elastictranscoder":            elastictranscoder.ServiceID,
+	"elb":                          elb.ServiceID,
+	"emr":                          emr.ServiceID,
+	"emrcontainers":                emrcontainers.ServiceID,
+	"es":                           elasticsearch.ServiceID,
+	"firehose":                     firehose.ServiceID,
+	"fms":                          fms.ServiceID,
+	"forecast":                     forecastservice.ServiceID,
+	"fsx":                          fsx.ServiceID,
+	"gamelift":                     gamelift.ServiceID,
+	"glacier":                      glacier.ServiceID,
+	"globalaccelerator":            globalaccelerator.ServiceID,
+	"glue":                         glue.ServiceID,
+	"greengrass":                   greengrass.ServiceID,
+	"guardduty":                    guardduty.ServiceID,
+	"iam":                          iam.ServiceID,
+	"identitystore":                identitystore.ServiceID,
+	"imagebuilder":                 imagebuilder.ServiceID,
+	"inspector":                    inspector.ServiceID,
+	"iot":                          iot.ServiceID,
+	"iotanalytics":                 iotanalytics.ServiceID,
+	"iotevents":                    iotevents.ServiceID,
+	"kafka":                        kafka.ServiceID,
+	"kinesis":                      kinesis.ServiceID,
+	"kinesisanalytics":             kinesisanalytics.ServiceID,
+	"kinesisanalyticsv2":           kinesisanalyticsv2.ServiceID,
+	"kinesisvideo":                 kinesisvideo.ServiceID,
+	"kms":                          kms.ServiceID,
+	"lakeformation":                lakeformation.ServiceID,
+	"lambda":                       lambda.ServiceID,
+	"lexmodels":                    lexmodelbuildingservice.ServiceID,
+	"licensemanager":               licensemanager.ServiceID,
+	"lightsail":                    lightsail.ServiceID,
+	"location":                     locationservice.ServiceID,
+	"macie":                        macie.ServiceID,
+	"macie2":                       macie2.ServiceID,
+	"managedblockchain":            managedblockchain.ServiceID,
+	"marketplacecatalog":           marketplacecatalog.ServiceID,
+	"mediaconnect":                 mediaconnect.ServiceID,
+	"mediaconvert":                 mediaconvert.ServiceID,
+	"medialive":                    medialive.ServiceID,
+	"mediapackage":                 mediapackage.ServiceID,
+	"mediastore":                   mediastore.ServiceID,
+	"mediastoredata":               mediastoredata.ServiceID,
+	"memorydb":                     memorydb.ServiceID,
+	"mq":                           mq.ServiceID,
+	"mwaa":                         mwaa.ServiceID,
+	"neptune":                      neptune.ServiceID,
+	"networkfirewall":              networkfirewall.ServiceID,
+	"networkmanager":               networkmanager.ServiceID,
+	"opsworks":                     opsworks.ServiceID,
+	"organizations":                organizations.ServiceID,
+	"outposts":                     outposts.ServiceID,
+	"personalize":                  personalize.ServiceID,
+	"pinpoint":                     pinpoint.ServiceID,
+	"pricing":                      pricing.ServiceID,
+	"prometheusservice":            prometheusservice.ServiceID,
+	"qldb":                         qldb.ServiceID,
+	"quicksight":                   quicksight.ServiceID,
+	"ram":                          ram.ServiceID,
+	"rds":                          rds.ServiceID,
+	"redshift":                     redshift.ServiceID,
+	"resourcegroups":               resourcegroups.ServiceID,
+	"resourcegroupstaggingapi":     resourcegroupstaggingapi.ServiceID,
+	"route53":                      route53.ServiceID,
+	"route53domains":               route53domains.ServiceID,
+	"route53recoverycontrolconfig": route53recoverycontrolconfig.ServiceID,
+	"route53recoveryreadiness":     route53recoveryreadiness.ServiceID,
+	"route53resolver":              route53resolver.ServiceID,
+	"s3":                           s3.ServiceID,
+	"s3control":                    s3control.ServiceID,
+	"s3outposts":                   s3outposts.ServiceID,
+	"sagemaker":                    sagemaker.ServiceID,
+	"schemas":                      schemas.ServiceID,
+	"sdb":                          simpledb.ServiceID,
+	"secretsmanager":               secretsmanager.ServiceID,
+	"securityhub":                  securityhub.ServiceID,
+	"serverlessrepo":               serverlessapplicationrepository.ServiceID,
+	"servicecatalog":               servicecatalog.ServiceID,
+	"servicediscovery":             servicediscovery.ServiceID,
+	"servicequotas":                servicequotas.ServiceID,
+	"ses":                          ses.ServiceID,
+	"shield":                       shield.ServiceID,
+	"signer":                       signer.ServiceID,
+	"sns":                          sns.ServiceID,
+	"sqs":                          sqs.ServiceID,
+	"ssm":                          ssm.ServiceID,
+	"ssoadmin":                     ssoadmin.ServiceID,
+	"stepfunctions":                sfn.ServiceID,
+	"storagegateway":               storagegateway.ServiceID,
+	"sts":                          sts.ServiceID,
+	"swf":                          swf.ServiceID,
+	"synthetics":                   synthetics.ServiceID,
+	"timestreamwrite":              timestreamwrite.ServiceID,
+	"transfer":                     transfer.ServiceID,
+	"waf":                          waf.ServiceID,
+	"wafregional":                  wafregional.ServiceID,
+	"wafv2":                        wafv2.ServiceID,
+	"worklink":                     worklink.ServiceID,
+	"workmail":                     workmail.ServiceID,
+	"workspaces":                   workspaces.ServiceID,
+	"xray":                         xray.ServiceID,
+}
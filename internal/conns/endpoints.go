@@ -0,0 +1,244 @@
+package conns
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	// EndpointURLEnvVar is the environment variable used to override the endpoint of all services.
+	EndpointURLEnvVar = "AWS_ENDPOINT_URL"
+
+	// EndpointURLServiceEnvVarPrefix prefixes the environment variables used to override the endpoint
+	// of a single service, e.g. AWS_ENDPOINT_URL_DYNAMODB.
+	EndpointURLServiceEnvVarPrefix = EndpointURLEnvVar + "_"
+
+	sharedConfigEndpointURLKey = "endpoint_url"
+	sharedConfigServicesKey    = "services"
+)
+
+// EndpointURLServiceEnvVar returns the name of the environment variable used to
+// override the endpoint of the specified service, which is derived from the AWS SDK
+// service ID, e.g. "AWS_ENDPOINT_URL_CLOUDWATCH_LOGS" for "cloudwatchlogs".
+func EndpointURLServiceEnvVar(serviceName string) string {
+	return EndpointURLServiceEnvVarPrefix + strings.ToUpper(serviceIDKey(serviceName))
+}
+
+// sharedConfigServiceKey returns the key of the specified service in a shared config
+// file services section, which is derived from the AWS SDK service ID, e.g.
+// "cloudwatch_logs" for "cloudwatchlogs".
+func sharedConfigServiceKey(serviceName string) string {
+	return strings.ToLower(serviceIDKey(serviceName))
+}
+
+// serviceIDKey returns the AWS SDK service ID of the specified service with spaces
+// replaced by underscores. Services without a known service ID use their own name.
+func serviceIDKey(serviceName string) string {
+	serviceID, ok := endpointServiceIDs[serviceName]
+
+	if !ok {
+		serviceID = serviceName
+	}
+
+	return strings.ReplaceAll(serviceID, " ", "_")
+}
+
+// resolveEndpoints fills in the endpoint of every service in c.Endpoints that is not
+// configured in the provider endpoints block. The sources are, in order of precedence:
+//   - the AWS_ENDPOINT_URL_<SERVICE> environment variable
+//   - the AWS_ENDPOINT_URL environment variable
+//   - the service's endpoint_url in the services section referenced by the shared config file profile
+//   - the profile's endpoint_url in the shared config file
+func (c *Config) resolveEndpoints() error {
+	if c.Endpoints == nil {
+		c.Endpoints = make(map[string]string)
+	}
+
+	globalEnvEndpoint := os.Getenv(EndpointURLEnvVar)
+	globalConfigEndpoint, serviceConfigEndpoints, err := sharedConfigEndpoints(sharedConfigFilename(), c.sharedConfigProfile())
+
+	if err != nil {
+		return err
+	}
+
+	for serviceName, endpoint := range c.Endpoints {
+		source := "provider configuration"
+
+		if endpoint == "" {
+			if v := os.Getenv(EndpointURLServiceEnvVar(serviceName)); v != "" {
+				endpoint, source = v, EndpointURLServiceEnvVar(serviceName)+" environment variable"
+			} else if globalEnvEndpoint != "" {
+				endpoint, source = globalEnvEndpoint, EndpointURLEnvVar+" environment variable"
+			} else if v := serviceConfigEndpoints[sharedConfigServiceKey(serviceName)]; v != "" {
+				endpoint, source = v, "shared config file services section"
+			} else if globalConfigEndpoint != "" {
+				endpoint, source = globalConfigEndpoint, "shared config file profile"
+			}
+		}
+
+		if endpoint == "" {
+			continue
+		}
+
+		log.Printf("[DEBUG] Using endpoint %q for service %q (source: %s)", endpoint, serviceName, source)
+		c.Endpoints[serviceName] = endpoint
+	}
+
+	return nil
+}
+
+func (c *Config) sharedConfigProfile() string {
+	if c.Profile != "" {
+		return c.Profile
+	}
+
+	if v := os.Getenv("AWS_PROFILE"); v != "" {
+		return v
+	}
+
+	return "default"
+}
+
+func sharedConfigFilename() string {
+	if v := os.Getenv("AWS_CONFIG_FILE"); v != "" {
+		return v
+	}
+
+	return "~/.aws/config"
+}
+
+// sharedConfigEndpoints returns the global endpoint_url of the specified profile and the
+// per-service endpoint_url values, keyed by lower-cased service key, of the services section
+// referenced by the profile, e.g.
+//
+//	[profile local]
+//	services = local-services
+//
+//	[services local-services]
+//	dynamodb =
+//	  endpoint_url = http://localhost:8000
+//	cloudwatch_logs =
+//	  endpoint_url = http://localhost:4566
+//
+// A missing shared config file or profile is not an error.
+func sharedConfigEndpoints(filename, profile string) (string, map[string]string, error) {
+	filename, err := homedir.Expand(filename)
+
+	if err != nil {
+		return "", nil, fmt.Errorf("error expanding shared config filename: %w", err)
+	}
+
+	f, err := os.Open(filename)
+
+	if os.IsNotExist(err) {
+		return "", nil, nil
+	}
+
+	if err != nil {
+		return "", nil, fmt.Errorf("error opening shared config file (%s): %w", filename, err)
+	}
+
+	defer f.Close()
+
+	sections, err := parseSharedConfig(bufio.NewScanner(f))
+
+	if err != nil {
+		return "", nil, fmt.Errorf("error reading shared config file (%s): %w", filename, err)
+	}
+
+	profileSection, ok := sections["profile "+profile]
+
+	if !ok && profile == "default" {
+		profileSection, ok = sections["default"]
+	}
+
+	if !ok {
+		return "", nil, nil
+	}
+
+	services := make(map[string]string)
+
+	if v := profileSection.values[sharedConfigServicesKey]; v != "" {
+		if servicesSection, ok := sections["services "+v]; ok {
+			for serviceName, properties := range servicesSection.subsections {
+				if endpoint := properties[sharedConfigEndpointURLKey]; endpoint != "" {
+					services[strings.ToLower(serviceName)] = endpoint
+				}
+			}
+		}
+	}
+
+	return profileSection.values[sharedConfigEndpointURLKey], services, nil
+}
+
+type sharedConfigSection struct {
+	values      map[string]string
+	subsections map[string]map[string]string
+}
+
+// parseSharedConfig parses the INI formatted shared config file.
+// Only the subset of the format needed to read endpoint configuration is supported:
+// sections, "key = value" properties and indented sub-properties following a "key =" line.
+func parseSharedConfig(scanner *bufio.Scanner) (map[string]*sharedConfigSection, error) {
+	sections := make(map[string]*sharedConfigSection)
+
+	var section *sharedConfigSection
+	var subsection map[string]string
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(trimmed, "[") && strings.HasSuffix(trimmed, "]") {
+			name := strings.Join(strings.Fields(strings.Trim(trimmed, "[]")), " ")
+			section = &sharedConfigSection{
+				values:      make(map[string]string),
+				subsections: make(map[string]map[string]string),
+			}
+			sections[name] = section
+			subsection = nil
+
+			continue
+		}
+
+		if section == nil {
+			continue
+		}
+
+		parts := strings.SplitN(trimmed, "=", 2)
+
+		if len(parts) != 2 {
+			continue
+		}
+
+		key, value := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+
+		// Indented properties belong to the preceding "key =" sub-section.
+		if indented := line != strings.TrimLeft(line, " \t"); indented && subsection != nil {
+			subsection[key] = value
+
+			continue
+		}
+
+		if value == "" {
+			subsection = make(map[string]string)
+			section.subsections[key] = subsection
+
+			continue
+		}
+
+		subsection = nil
+		section.values[key] = value
+	}
+
+	return sections, scanner.Err()
+}
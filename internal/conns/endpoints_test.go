@@ -0,0 +1,221 @@
+package conns
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testSharedConfig = `
+[default]
+region = us-west-2
+
+[profile emulator]
+region = us-east-1
+endpoint_url = http://localhost:4566
+services = emulator-services
+
+[profile dynamodb]
+services = emulator-services
+
+[services emulator-services]
+dynamodb =
+  endpoint_url = http://localhost:8000
+s3 =
+  endpoint_url = http://localhost:9000
+  # other settings are ignored
+  addressing_style = path
+cloudwatch_logs =
+  endpoint_url = http://localhost:4567
+`
+
+func TestConfigResolveEndpoints(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config")
+
+	if err := ioutil.WriteFile(configFile, []byte(testSharedConfig), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name      string
+		Profile   string
+		Endpoints map[string]string
+		EnvVars   map[string]string
+		Expected  map[string]string
+	}{
+		{
+			Name:      "no overrides",
+			Endpoints: map[string]string{"dynamodb": "", "ec2": ""},
+			Expected:  map[string]string{"dynamodb": "", "ec2": ""},
+		},
+		{
+			Name:      "provider configuration",
+			Endpoints: map[string]string{"dynamodb": "http://config:8000", "ec2": ""},
+			EnvVars: map[string]string{
+				"AWS_ENDPOINT_URL_DYNAMODB": "http://env:8000",
+			},
+			Expected: map[string]string{"dynamodb": "http://config:8000", "ec2": ""},
+		},
+		{
+			Name:      "service environment variable",
+			Endpoints: map[string]string{"dynamodb": "", "ec2": ""},
+			EnvVars: map[string]string{
+				"AWS_ENDPOINT_URL":          "http://env",
+				"AWS_ENDPOINT_URL_DYNAMODB": "http://env:8000",
+			},
+			Expected: map[string]string{"dynamodb": "http://env:8000", "ec2": "http://env"},
+		},
+		{
+			Name:      "shared config file",
+			Profile:   "emulator",
+			Endpoints: map[string]string{"cloudwatchlogs": "", "dynamodb": "", "ec2": "", "s3": ""},
+			Expected:  map[string]string{"cloudwatchlogs": "http://localhost:4567", "dynamodb": "http://localhost:8000", "ec2": "http://localhost:4566", "s3": "http://localhost:9000"},
+		},
+		{
+			Name:      "service ID environment variable",
+			Endpoints: map[string]string{"cloudwatchlogs": "", "ec2": ""},
+			EnvVars: map[string]string{
+				"AWS_ENDPOINT_URL_CLOUDWATCH_LOGS": "http://env:4567",
+			},
+			Expected: map[string]string{"cloudwatchlogs": "http://env:4567", "ec2": ""},
+		},
+		{
+			Name:      "shared config file services only",
+			Profile:   "dynamodb",
+			Endpoints: map[string]string{"dynamodb": "", "ec2": ""},
+			Expected:  map[string]string{"dynamodb": "http://localhost:8000", "ec2": ""},
+		},
+		{
+			Name:      "environment variable overrides shared config file",
+			Profile:   "emulator",
+			Endpoints: map[string]string{"dynamodb": "", "ec2": ""},
+			EnvVars: map[string]string{
+				"AWS_ENDPOINT_URL_EC2": "http://env:4566",
+			},
+			Expected: map[string]string{"dynamodb": "http://localhost:8000", "ec2": "http://env:4566"},
+		},
+		{
+			Name:      "profile environment variable",
+			Endpoints: map[string]string{"dynamodb": "", "ec2": ""},
+			EnvVars: map[string]string{
+				"AWS_PROFILE": "dynamodb",
+			},
+			Expected: map[string]string{"dynamodb": "http://localhost:8000", "ec2": ""},
+		},
+		{
+			Name:      "missing profile",
+			Profile:   "missing",
+			Endpoints: map[string]string{"dynamodb": ""},
+			Expected:  map[string]string{"dynamodb": ""},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			envVars := map[string]string{"AWS_CONFIG_FILE": configFile}
+
+			for k, v := range testCase.EnvVars {
+				envVars[k] = v
+			}
+
+			defer testSetEnvVars(t, envVars)()
+
+			config := &Config{
+				Endpoints: testCase.Endpoints,
+				Profile:   testCase.Profile,
+			}
+
+			if err := config.resolveEndpoints(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for serviceName, expected := range testCase.Expected {
+				if got := config.Endpoints[serviceName]; got != expected {
+					t.Errorf("service %s: got endpoint %q, expected %q", serviceName, got, expected)
+				}
+			}
+		})
+	}
+}
+
+func TestEndpointURLServiceEnvVar(t *testing.T) {
+	testCases := []struct {
+		ServiceName string
+		Expected    string
+	}{
+		{
+			ServiceName: "dynamodb",
+			Expected:    "AWS_ENDPOINT_URL_DYNAMODB",
+		},
+		{
+			ServiceName: "cloudwatchlogs",
+			Expected:    "AWS_ENDPOINT_URL_CLOUDWATCH_LOGS",
+		},
+		{
+			ServiceName: "apigateway",
+			Expected:    "AWS_ENDPOINT_URL_API_GATEWAY",
+		},
+		{
+			ServiceName: "configservice",
+			Expected:    "AWS_ENDPOINT_URL_CONFIG_SERVICE",
+		},
+		{
+			ServiceName: "unknown",
+			Expected:    "AWS_ENDPOINT_URL_UNKNOWN",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.ServiceName, func(t *testing.T) {
+			if got := EndpointURLServiceEnvVar(testCase.ServiceName); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestSharedConfigEndpointsMissingFile(t *testing.T) {
+	global, services, err := sharedConfigEndpoints(filepath.Join(t.TempDir(), "missing"), "default")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if global != "" || len(services) != 0 {
+		t.Errorf("expected no endpoints, got %q and %v", global, services)
+	}
+}
+
+// testSetEnvVars clears the endpoint related environment variables, sets the specified ones
+// and returns a function restoring the original environment.
+func testSetEnvVars(t *testing.T, envVars map[string]string) func() {
+	names := []string{"AWS_CONFIG_FILE", "AWS_PROFILE", EndpointURLEnvVar, EndpointURLServiceEnvVar("cloudwatchlogs"), EndpointURLServiceEnvVar("dynamodb"), EndpointURLServiceEnvVar("ec2"), EndpointURLServiceEnvVar("s3")}
+	original := make(map[string]*string)
+
+	for _, name := range names {
+		if v, ok := os.LookupEnv(name); ok {
+			original[name] = &v
+		} else {
+			original[name] = nil
+		}
+
+		os.Unsetenv(name)
+	}
+
+	for k, v := range envVars {
+		if err := os.Setenv(k, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return func() {
+		for name, v := range original {
+			if v == nil {
+				os.Unsetenv(name)
+			} else {
+				os.Setenv(name, *v)
+			}
+		}
+	}
+}
@@ -72,6 +72,15 @@ const (
 	EnvVarAssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to restrict the regions resource sweepers run in
+const (
+	// Comma-separated list of regions sweepers may run in
+	EnvVarAllowedRegions = "TF_AWS_ALLOWED_REGIONS"
+
+	// Comma-separated list of regions sweepers must not run in
+	EnvVarForbiddenRegions = "TF_AWS_FORBIDDEN_REGIONS"
+)
+
 // GetEnvVarWithDefault gets an environment variable value if non-empty or returns the default.
 func GetEnvVarWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -0,0 +1,140 @@
+package conns
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-cleanhttp"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// httpClient returns the HTTP client used for all AWS API calls.
+// It honors the insecure, http_proxy, no_proxy and custom_ca_bundle settings.
+func (c *Config) httpClient() (*http.Client, error) {
+	httpClient := cleanhttp.DefaultClient()
+	transport := httpClient.Transport.(*http.Transport)
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: c.Insecure,
+	}
+
+	if c.CustomCABundle != "" {
+		pem, err := c.customCABundlePEM()
+
+		if err != nil {
+			return nil, err
+		}
+
+		certPool := x509.NewCertPool()
+
+		if !certPool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("error loading custom CA bundle: no valid PEM encoded certificates found")
+		}
+
+		tlsConfig.RootCAs = certPool
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	if c.HTTPProxy != "" {
+		proxyURL, err := url.Parse(c.HTTPProxy)
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing HTTP proxy URL: %w", err)
+		}
+
+		noProxy := c.NoProxy
+
+		transport.Proxy = func(r *http.Request) (*url.URL, error) {
+			if matchesNoProxy(r.URL.Hostname(), noProxy) {
+				return nil, nil
+			}
+
+			return proxyURL, nil
+		}
+	}
+
+	return httpClient, nil
+}
+
+// customCABundlePEM returns the PEM encoded certificates of the custom CA bundle.
+// The custom_ca_bundle value is either the path to a PEM file or the PEM content itself.
+func (c *Config) customCABundlePEM() ([]byte, error) {
+	if isPEM(c.CustomCABundle) {
+		return []byte(c.CustomCABundle), nil
+	}
+
+	path, err := homedir.Expand(c.CustomCABundle)
+
+	if err != nil {
+		return nil, fmt.Errorf("error expanding custom CA bundle path: %w", err)
+	}
+
+	pem, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading custom CA bundle (%s): %w", path, err)
+	}
+
+	return pem, nil
+}
+
+func isPEM(s string) bool {
+	return strings.Contains(s, "-----BEGIN ")
+}
+
+// matchesNoProxy returns whether the specified host should bypass the proxy.
+// Each no_proxy entry may be "*", an IP address, a CIDR block, a hostname
+// or a domain suffix (with or without a leading "."), which matches the domain
+// and all of its subdomains.
+func matchesNoProxy(host string, noProxy []string) bool {
+	host = strings.ToLower(host)
+	ip := net.ParseIP(host)
+
+	for _, entry := range noProxy {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+
+		if entry == "" {
+			continue
+		}
+
+		if entry == "*" {
+			return true
+		}
+
+		if ip != nil {
+			if _, ipNet, err := net.ParseCIDR(entry); err == nil {
+				if ipNet.Contains(ip) {
+					return true
+				}
+
+				continue
+			}
+
+			if entryIP := net.ParseIP(entry); entryIP != nil && entryIP.Equal(ip) {
+				return true
+			}
+
+			continue
+		}
+
+		// Strip any port from the entry.
+		if h, _, err := net.SplitHostPort(entry); err == nil {
+			entry = h
+		}
+
+		domain := strings.TrimPrefix(entry, ".")
+
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+
+	return false
+}
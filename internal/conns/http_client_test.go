@@ -0,0 +1,349 @@
+package conns
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+func TestMatchesNoProxy(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Host     string
+		NoProxy  []string
+		Expected bool
+	}{
+		{
+			Name:     "empty",
+			Host:     "ec2.us-west-2.amazonaws.com", //lintignore:AWSAT003
+			Expected: false,
+		},
+		{
+			Name:     "wildcard",
+			Host:     "ec2.us-west-2.amazonaws.com", //lintignore:AWSAT003
+			NoProxy:  []string{"*"},
+			Expected: true,
+		},
+		{
+			Name:     "exact host",
+			Host:     "localhost",
+			NoProxy:  []string{"localhost"},
+			Expected: true,
+		},
+		{
+			Name:     "domain suffix",
+			Host:     "ec2.us-west-2.amazonaws.com", //lintignore:AWSAT003
+			NoProxy:  []string{"amazonaws.com"},
+			Expected: true,
+		},
+		{
+			Name:     "domain suffix with leading dot",
+			Host:     "ec2.us-west-2.amazonaws.com", //lintignore:AWSAT003
+			NoProxy:  []string{".amazonaws.com"},
+			Expected: true,
+		},
+		{
+			Name:     "partial label",
+			Host:     "notamazonaws.com",
+			NoProxy:  []string{"amazonaws.com"},
+			Expected: false,
+		},
+		{
+			Name:     "host with port",
+			Host:     "localhost",
+			NoProxy:  []string{"localhost:4566"},
+			Expected: true,
+		},
+		{
+			Name:     "IP address",
+			Host:     "10.0.0.1",
+			NoProxy:  []string{"10.0.0.1"},
+			Expected: true,
+		},
+		{
+			Name:     "CIDR block",
+			Host:     "10.0.0.1",
+			NoProxy:  []string{"10.0.0.0/8"},
+			Expected: true,
+		},
+		{
+			Name:     "CIDR block no match",
+			Host:     "192.168.0.1",
+			NoProxy:  []string{"10.0.0.0/8"},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := matchesNoProxy(testCase.Host, testCase.NoProxy)
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestConfigHTTPClient(t *testing.T) {
+	caPEM := testCertificatePEM(t)
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+
+	if err := ioutil.WriteFile(caFile, caPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name          string
+		Config        *Config
+		ExpectError   bool
+		ExpectRootCAs bool
+	}{
+		{
+			Name:   "defaults",
+			Config: &Config{},
+		},
+		{
+			Name:          "custom CA bundle file",
+			Config:        &Config{CustomCABundle: caFile},
+			ExpectRootCAs: true,
+		},
+		{
+			Name:          "custom CA bundle content",
+			Config:        &Config{CustomCABundle: string(caPEM)},
+			ExpectRootCAs: true,
+		},
+		{
+			Name:        "missing custom CA bundle file",
+			Config:      &Config{CustomCABundle: filepath.Join(t.TempDir(), "missing.pem")},
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid custom CA bundle content",
+			Config:      &Config{CustomCABundle: "-----BEGIN CERTIFICATE-----\ninvalid\n-----END CERTIFICATE-----\n"},
+			ExpectError: true,
+		},
+		{
+			Name:        "invalid HTTP proxy",
+			Config:      &Config{HTTPProxy: "://invalid"},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			client, err := testCase.Config.httpClient()
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			transport := client.Transport.(*http.Transport)
+
+			if got := transport.TLSClientConfig.RootCAs != nil; got != testCase.ExpectRootCAs {
+				t.Errorf("got RootCAs set %t, expected %t", got, testCase.ExpectRootCAs)
+			}
+		})
+	}
+}
+
+func TestConfigClientCustomCABundle(t *testing.T) {
+	mockServer := awsbase.MockAwsApiServer("STS", []*awsbase.MockEndpoint{
+		awsbase.MockStsAssumeRoleValidEndpoint,
+		awsbase.MockStsGetCallerIdentityValidEndpoint,
+	})
+	mockServer.Close()
+
+	ts := httptest.NewTLSServer(mockServer.Config.Handler)
+	defer ts.Close()
+
+	defer testSetEnvVars(t, map[string]string{"AWS_CONFIG_FILE": filepath.Join(t.TempDir(), "missing")})()
+
+	caBundleEnv := os.Getenv("AWS_CA_BUNDLE")
+
+	config := &Config{
+		AccessKey:             awsbase.MockStaticAccessKey,
+		AssumeRoleARN:         awsbase.MockStsAssumeRoleArn,
+		AssumeRoleSessionName: awsbase.MockStsAssumeRoleSessionName,
+		CustomCABundle:        string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})),
+		Endpoints:             map[string]string{"ec2": ts.URL, "sts": ts.URL},
+		Region:                "us-east-1", //lintignore:AWSAT003
+		SecretKey:             awsbase.MockStaticSecretKey,
+		SkipMetadataApiCheck:  true,
+	}
+
+	raw, err := config.Client()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := raw.(*AWSClient)
+
+	if got, expected := client.AccountID, awsbase.MockStsGetCallerIdentityAccountID; got != expected {
+		t.Errorf("got account ID %q, expected %q", got, expected)
+	}
+
+	if got := os.Getenv("AWS_CA_BUNDLE"); got != caBundleEnv {
+		t.Errorf("got AWS_CA_BUNDLE environment variable %q, expected %q", got, caBundleEnv)
+	}
+
+	if got, expected := client.CredentialsInfo.Source, CredentialsSourceStatic; got != expected {
+		t.Errorf("got credentials source %q, expected %q", got, expected)
+	}
+
+	if got, expected := client.CredentialsInfo.AssumedRoleChain, []string{awsbase.MockStsAssumeRoleArn}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got assumed role chain %v, expected %v", got, expected)
+	}
+
+	if client.CredentialsInfo.Expiration().IsZero() {
+		t.Error("got no expiration of the assumed role credentials")
+	}
+}
+
+func TestConfigClientCustomCABundleCredentialsValidation(t *testing.T) {
+	mockServer := awsbase.MockAwsApiServer("STS", []*awsbase.MockEndpoint{
+		awsbase.MockStsGetCallerIdentityInvalidEndpointAccessDenied,
+	})
+	mockServer.Close()
+
+	ts := httptest.NewTLSServer(mockServer.Config.Handler)
+	defer ts.Close()
+
+	defer testSetEnvVars(t, map[string]string{"AWS_CONFIG_FILE": filepath.Join(t.TempDir(), "missing")})()
+
+	testCases := []struct {
+		Name                string
+		SkipCredsValidation bool
+		ExpectError         bool
+	}{
+		{
+			Name:        "validated",
+			ExpectError: true,
+		},
+		{
+			Name:                "skip_credentials_validation",
+			SkipCredsValidation: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			config := &Config{
+				AccessKey:               awsbase.MockStaticAccessKey,
+				CustomCABundle:          string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})),
+				Endpoints:               map[string]string{"ec2": ts.URL, "sts": ts.URL},
+				Region:                  "us-east-1", //lintignore:AWSAT003
+				SecretKey:               awsbase.MockStaticSecretKey,
+				SkipCredsValidation:     testCase.SkipCredsValidation,
+				SkipMetadataApiCheck:    true,
+				SkipRequestingAccountId: true,
+			}
+
+			_, err := config.Client()
+
+			if testCase.ExpectError {
+				if err == nil || !strings.Contains(err.Error(), "error validating provider credentials") {
+					t.Errorf("expected credentials validation error, got: %v", err)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestConfigHTTPClientNoProxy(t *testing.T) {
+	config := &Config{
+		HTTPProxy: "http://proxy.example.com:3128",
+		NoProxy:   []string{"localhost", ".internal.example.com"},
+	}
+
+	client, err := config.httpClient()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	transport := client.Transport.(*http.Transport)
+
+	testCases := map[string]string{
+		"https://localhost:4566/":              "",
+		"https://s3.internal.example.com/":     "",
+		"https://ec2.us-west-2.amazonaws.com/": "http://proxy.example.com:3128", //lintignore:AWSAT003
+		"https://sts.amazonaws.com/":           "http://proxy.example.com:3128",
+	}
+
+	for rawURL, expected := range testCases {
+		u, err := url.Parse(rawURL)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		proxyURL, err := transport.Proxy(&http.Request{URL: u})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		got := ""
+
+		if proxyURL != nil {
+			got = proxyURL.String()
+		}
+
+		if got != expected {
+			t.Errorf("%s: got proxy %q, expected %q", rawURL, got, expected)
+		}
+	}
+}
+
+func testCertificatePEM(t *testing.T) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
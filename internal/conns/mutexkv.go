@@ -1,48 +1,160 @@
 package conns
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"runtime"
+	"strings"
 	"sync"
+	"time"
 )
 
+// mutexKVWaitWarningInterval is how often a warning is logged while waiting for a lock.
+var mutexKVWaitWarningInterval = 1 * time.Minute
+
 // MutexKV is a simple key/value store for arbitrary mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
 type MutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*mutexKVEntry
+}
+
+// mutexKVEntry is a lock for a single key. The lock is held while sem contains a value.
+type mutexKVEntry struct {
+	sem chan struct{}
+
+	holderLock sync.Mutex
+	holder     string
+	since      time.Time
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *MutexKV) Lock(key string) {
+	// context.Background() is never done, so no error is returned.
+	_ = m.lockContext(context.Background(), key, lockHolder(context.Background()))
+}
+
+// LockContext locks the mutex for the given key, waiting until the lock is acquired or the context is done.
+// The holder of the lock, taken from the Terraform resource carried by the context (see NewResourceContext)
+// or otherwise the calling function, is recorded and reported by warnings logged during long waits and by
+// the error returned if the context is done first. Caller is responsible for calling Unlock for the same key
+// if, and only if, no error is returned.
+func (m *MutexKV) LockContext(ctx context.Context, key string) error {
+	return m.lockContext(ctx, key, lockHolder(ctx))
+}
+
+func (m *MutexKV) lockContext(ctx context.Context, key, holder string) error {
 	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
-	log.Printf("[DEBUG] Locked %q", key)
+
+	entry := m.get(key)
+	start := time.Now()
+
+	// A context which is already done doesn't race with a free lock.
+	if err := ctx.Err(); err != nil {
+		return entry.lockError(key, start, err)
+	}
+
+	ticker := time.NewTicker(mutexKVWaitWarningInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case entry.sem <- struct{}{}:
+			entry.setHolder(holder)
+			log.Printf("[DEBUG] Locked %q", key)
+
+			return nil
+		case <-ticker.C:
+			currentHolder, heldFor := entry.currentHolder()
+			log.Printf("[WARN] %s has been waiting %s to lock %q, held by %s for %s", holder, time.Since(start).Round(time.Second), key, currentHolder, heldFor.Round(time.Second))
+		case <-ctx.Done():
+			return entry.lockError(key, start, ctx.Err())
+		}
+	}
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *MutexKV) Unlock(key string) {
 	log.Printf("[DEBUG] Unlocking %q", key)
-	m.get(key).Unlock()
+
+	entry := m.get(key)
+	entry.setHolder("")
+
+	select {
+	case <-entry.sem:
+	default:
+		panic(fmt.Sprintf("unlock of unlocked mutex %q", key))
+	}
+
 	log.Printf("[DEBUG] Unlocked %q", key)
 }
 
 // Returns a mutex for the given key, no guarantee of its lock status
-func (m *MutexKV) get(key string) *sync.Mutex {
+func (m *MutexKV) get(key string) *mutexKVEntry {
 	m.lock.Lock()
 	defer m.lock.Unlock()
-	mutex, ok := m.store[key]
+	entry, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
-		m.store[key] = mutex
+		entry = &mutexKVEntry{sem: make(chan struct{}, 1)}
+		m.store[key] = entry
 	}
-	return mutex
+	return entry
+}
+
+func (e *mutexKVEntry) setHolder(holder string) {
+	e.holderLock.Lock()
+	defer e.holderLock.Unlock()
+
+	e.holder = holder
+	e.since = time.Now()
+}
+
+func (e *mutexKVEntry) currentHolder() (string, time.Duration) {
+	e.holderLock.Lock()
+	defer e.holderLock.Unlock()
+
+	if e.holder == "" {
+		return "unknown", 0
+	}
+
+	return e.holder, time.Since(e.since)
+}
+
+func (e *mutexKVEntry) lockError(key string, start time.Time, err error) error {
+	currentHolder, heldFor := e.currentHolder()
+
+	return fmt.Errorf("lock %q not acquired after %s, held by %s for %s: %w", key, time.Since(start).Round(time.Second), currentHolder, heldFor.Round(time.Second), err)
+}
+
+// lockHolder describes the caller of Lock or LockContext.
+func lockHolder(ctx context.Context) string {
+	if v, ok := ResourceFromContext(ctx); ok {
+		return v.Identifier
+	}
+
+	// Skip lockHolder and Lock or LockContext.
+	if pc, _, _, ok := runtime.Caller(2); ok {
+		if f := runtime.FuncForPC(pc); f != nil {
+			name := f.Name()
+
+			// Trim the package path, leaving e.g. "ec2.resourceSecurityGroupRuleCreate".
+			if i := strings.LastIndex(name, "/"); i >= 0 {
+				name = name[i+1:]
+			}
+
+			return name
+		}
+	}
+
+	return "unknown"
 }
 
 // Returns a properly initialized MutexKV
 func NewMutexKV() *MutexKV {
 	return &MutexKV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*mutexKVEntry),
 	}
 }
@@ -1,6 +1,9 @@
 package conns
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 )
@@ -65,3 +68,67 @@ func TestMutexKVDifferentKeys(t *testing.T) {
 		t.Fatal("Second lock on a different key blocked. This shouldn't happen.")
 	}
 }
+
+func TestMutexKVLockContext(t *testing.T) {
+	mkv := NewMutexKV()
+
+	ctx := NewResourceContext(context.Background(), ResourceIdentifier("aws_security_group_rule", "sgrule-1234"))
+
+	if err := mkv.LockContext(ctx, "foo"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := mkv.LockContext(ctx, "foo")
+
+	if err == nil {
+		t.Fatal("Second lock was able to be taken. This shouldn't happen.")
+	}
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got: %s", err)
+	}
+
+	if !strings.Contains(err.Error(), `aws_security_group_rule["sgrule-1234"]`) {
+		t.Errorf("expected lock holder in error, got: %s", err)
+	}
+
+	mkv.Unlock("foo")
+
+	if err := mkv.LockContext(context.Background(), "foo"); err != nil {
+		t.Fatalf("lock after unlock: unexpected error: %s", err)
+	}
+}
+
+func TestMutexKVLockContextCallerHolder(t *testing.T) {
+	mkv := NewMutexKV()
+
+	mkv.Lock("foo")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := mkv.LockContext(ctx, "foo")
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got: %v", err)
+	}
+
+	if !strings.Contains(err.Error(), "conns.TestMutexKVLockContextCallerHolder") {
+		t.Errorf("expected calling function in error, got: %s", err)
+	}
+}
+
+func TestMutexKVUnlockUnlocked(t *testing.T) {
+	mkv := NewMutexKV()
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("Unlock of an unlocked key didn't panic. This shouldn't happen.")
+		}
+	}()
+
+	mkv.Unlock("foo")
+}
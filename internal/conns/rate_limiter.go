@@ -0,0 +1,257 @@
+package conns
+
+import (
+	"context"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	// RetryModeStandard retries throttled and transient errors with exponential backoff.
+	RetryModeStandard = "standard"
+	// RetryModeAdaptive additionally rate limits requests client-side, per service,
+	// reducing the send rate whenever requests are throttled.
+	RetryModeAdaptive = "adaptive"
+)
+
+func RetryMode_Values() []string {
+	return []string{
+		RetryModeStandard,
+		RetryModeAdaptive,
+	}
+}
+
+const (
+	// adaptiveMinFillRate is the lowest send rate (requests per second) the limiter throttles down to.
+	adaptiveMinFillRate = 0.5
+	// adaptiveThrottleBeta is the factor the send rate is multiplied by when a request is throttled.
+	adaptiveThrottleBeta = 0.7
+	// adaptiveSuccessIncrement is the send rate increase (requests per second) for every successful request.
+	adaptiveSuccessIncrement = 0.5
+	// adaptiveMeasurementInterval is the bucket size used to measure the actual send rate.
+	adaptiveMeasurementInterval = 500 * time.Millisecond
+	// adaptiveMeasurementSmoothing weighs the newest send rate measurement.
+	adaptiveMeasurementSmoothing = 0.8
+	// adaptiveMinWait is the shortest time waited for a token.
+	adaptiveMinWait = time.Millisecond
+)
+
+// adaptiveRateLimiter is a client-side token bucket whose fill rate adapts to
+// API throttling: the rate is reduced multiplicatively when a request is throttled
+// and increased additively, up to twice the measured send rate, on success.
+// The limiter does not restrict requests until the first throttling error is seen.
+type adaptiveRateLimiter struct {
+	mu sync.Mutex
+
+	enabled    bool
+	fillRate   float64
+	tokens     float64
+	lastRefill time.Time
+
+	measuredRate      float64
+	measurementBucket time.Time
+	measurementCount  int
+
+	now   func() time.Time
+	sleep func(context.Context, time.Duration) error
+}
+
+func newAdaptiveRateLimiter() *adaptiveRateLimiter {
+	return &adaptiveRateLimiter{
+		now:   time.Now,
+		sleep: sleepContext,
+	}
+}
+
+// Acquire blocks until a token is available or the context is done.
+func (l *adaptiveRateLimiter) Acquire(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+
+		if !l.enabled {
+			l.mu.Unlock()
+			return nil
+		}
+
+		l.refill()
+
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+
+		wait := time.Duration(math.Ceil((1 - l.tokens) / l.fillRate * float64(time.Second)))
+
+		if wait < adaptiveMinWait {
+			wait = adaptiveMinWait
+		}
+
+		l.mu.Unlock()
+
+		if err := l.sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// OnThrottle reduces the send rate after a request was throttled.
+func (l *adaptiveRateLimiter) OnThrottle() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.measure()
+
+	rate := l.measuredRate
+
+	if l.enabled {
+		rate = math.Min(rate, l.fillRate)
+	} else {
+		l.enabled = true
+		l.lastRefill = l.now()
+	}
+
+	l.refill()
+	l.fillRate = math.Max(adaptiveMinFillRate, rate*adaptiveThrottleBeta)
+	l.tokens = math.Min(l.tokens, l.capacity())
+}
+
+// OnSuccess increases the send rate after a request succeeded.
+func (l *adaptiveRateLimiter) OnSuccess() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.measure()
+
+	if !l.enabled {
+		return
+	}
+
+	l.refill()
+	l.fillRate = math.Min(l.fillRate+adaptiveSuccessIncrement, math.Max(adaptiveMinFillRate, 2*l.measuredRate))
+}
+
+// FillRate returns the current send rate in requests per second, or 0 if the limiter is not yet enabled.
+func (l *adaptiveRateLimiter) FillRate() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.enabled {
+		return 0
+	}
+
+	return l.fillRate
+}
+
+func (l *adaptiveRateLimiter) capacity() float64 {
+	return math.Max(1, l.fillRate)
+}
+
+// refill adds the tokens accumulated since the last refill. The caller must hold the lock.
+func (l *adaptiveRateLimiter) refill() {
+	now := l.now()
+
+	if l.fillRate > 0 {
+		l.tokens = math.Min(l.capacity(), l.tokens+now.Sub(l.lastRefill).Seconds()*l.fillRate)
+	}
+
+	l.lastRefill = now
+}
+
+// measure records a completed request and updates the smoothed send rate. The caller must hold the lock.
+func (l *adaptiveRateLimiter) measure() {
+	now := l.now()
+	bucket := now.Truncate(adaptiveMeasurementInterval)
+
+	l.measurementCount++
+
+	if bucket.After(l.measurementBucket) {
+		rate := float64(l.measurementCount) / bucket.Sub(l.measurementBucket).Seconds()
+
+		if l.measurementBucket.IsZero() {
+			rate = float64(l.measurementCount) / adaptiveMeasurementInterval.Seconds()
+		}
+
+		l.measuredRate = adaptiveMeasurementSmoothing*rate + (1-adaptiveMeasurementSmoothing)*l.measuredRate
+		l.measurementCount = 0
+		l.measurementBucket = bucket
+	}
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// adaptiveRateLimiters holds one adaptiveRateLimiter per service so that
+// the requests of all resources using a service back off together.
+type adaptiveRateLimiters struct {
+	mu       sync.Mutex
+	limiters map[string]*adaptiveRateLimiter
+}
+
+func (ls *adaptiveRateLimiters) get(serviceName string) *adaptiveRateLimiter {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+
+	if ls.limiters == nil {
+		ls.limiters = make(map[string]*adaptiveRateLimiter)
+	}
+
+	limiter, ok := ls.limiters[serviceName]
+
+	if !ok {
+		limiter = newAdaptiveRateLimiter()
+		ls.limiters[serviceName] = limiter
+	}
+
+	return limiter
+}
+
+// addAdaptiveRateLimitHandlers installs the client-side rate limiting request handlers.
+// Tokens are acquired before every attempt is signed and sent, including retries, and the outcome
+// of every attempt adjusts the send rate of the request's service.
+func addAdaptiveRateLimitHandlers(handlers *request.Handlers) *adaptiveRateLimiters {
+	limiters := &adaptiveRateLimiters{}
+
+	handlers.Sign.PushFrontNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.AdaptiveRateLimitAcquire",
+		Fn: func(r *request.Request) {
+			if err := limiters.get(r.ClientInfo.ServiceName).Acquire(r.Context()); err != nil {
+				r.Error = awserr.New(request.CanceledErrorCode, "request context canceled while waiting for rate limiter", err)
+			}
+		},
+	})
+
+	handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.AdaptiveRateLimitUpdate",
+		Fn: func(r *request.Request) {
+			limiter := limiters.get(r.ClientInfo.ServiceName)
+
+			if request.IsErrorThrottle(r.Error) {
+				limiter.OnThrottle()
+				log.Printf("[DEBUG] %s/%s request throttled, reducing send rate to %.2f requests per second", r.ClientInfo.ServiceName, r.Operation.Name, limiter.FillRate())
+
+				return
+			}
+
+			if r.Error == nil {
+				limiter.OnSuccess()
+			}
+		},
+	})
+
+	return limiters
+}
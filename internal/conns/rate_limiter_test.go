@@ -0,0 +1,186 @@
+package conns
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func (c *testClock) Sleep(_ context.Context, d time.Duration) error {
+	c.now = c.now.Add(d)
+	return nil
+}
+
+func testAdaptiveRateLimiter() (*adaptiveRateLimiter, *testClock) {
+	clock := &testClock{now: time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)}
+	limiter := newAdaptiveRateLimiter()
+	limiter.now = clock.Now
+	limiter.sleep = clock.Sleep
+
+	return limiter, clock
+}
+
+func TestAdaptiveRateLimiterDisabledUntilThrottled(t *testing.T) {
+	limiter, clock := testAdaptiveRateLimiter()
+	start := clock.now
+
+	for i := 0; i < 100; i++ {
+		if err := limiter.Acquire(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		limiter.OnSuccess()
+	}
+
+	if got := clock.now.Sub(start); got != 0 {
+		t.Errorf("expected no wait, waited %s", got)
+	}
+
+	if got := limiter.FillRate(); got != 0 {
+		t.Errorf("expected limiter to be disabled, got fill rate %f", got)
+	}
+}
+
+func TestAdaptiveRateLimiterThrottle(t *testing.T) {
+	limiter, clock := testAdaptiveRateLimiter()
+
+	// Send 20 requests per second for a few seconds.
+	for i := 0; i < 60; i++ {
+		limiter.OnSuccess()
+		clock.now = clock.now.Add(50 * time.Millisecond)
+	}
+
+	limiter.OnThrottle()
+	throttledRate := limiter.FillRate()
+
+	if throttledRate <= adaptiveMinFillRate || throttledRate >= 20 {
+		t.Fatalf("expected fill rate between %f and 20 after throttle, got %f", adaptiveMinFillRate, throttledRate)
+	}
+
+	limiter.OnThrottle()
+
+	if got := limiter.FillRate(); got >= throttledRate {
+		t.Errorf("expected fill rate to decrease below %f after repeated throttle, got %f", throttledRate, got)
+	}
+
+	for i := 0; i < 100; i++ {
+		limiter.OnThrottle()
+	}
+
+	if got := limiter.FillRate(); got != adaptiveMinFillRate {
+		t.Errorf("expected fill rate to bottom out at %f, got %f", adaptiveMinFillRate, got)
+	}
+
+	// At the minimum fill rate, acquiring 5 tokens takes about 10 seconds.
+	start := clock.now
+
+	for i := 0; i < 5; i++ {
+		if err := limiter.Acquire(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if got := clock.now.Sub(start); got < 8*time.Second || got > 10*time.Second {
+		t.Errorf("expected to wait about 10s, waited %s", got)
+	}
+}
+
+func TestAdaptiveRateLimiterRecovers(t *testing.T) {
+	limiter, clock := testAdaptiveRateLimiter()
+
+	for i := 0; i < 60; i++ {
+		limiter.OnSuccess()
+		clock.now = clock.now.Add(50 * time.Millisecond)
+	}
+
+	limiter.OnThrottle()
+	throttledRate := limiter.FillRate()
+
+	for i := 0; i < 20; i++ {
+		if err := limiter.Acquire(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		limiter.OnSuccess()
+	}
+
+	if got := limiter.FillRate(); got <= throttledRate {
+		t.Errorf("expected fill rate to increase above %f after successes, got %f", throttledRate, got)
+	}
+}
+
+func TestAdaptiveRateLimiterAcquireContextCanceled(t *testing.T) {
+	limiter := newAdaptiveRateLimiter()
+	limiter.OnThrottle()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Drain the bucket; the next acquire must wait and observe the canceled context.
+	for {
+		if err := limiter.Acquire(ctx); err != nil {
+			return
+		}
+	}
+}
+
+func TestAddAdaptiveRateLimitHandlers(t *testing.T) {
+	ts := awsbase.MockAwsApiServer("STS", []*awsbase.MockEndpoint{
+		{
+			Request: &awsbase.MockRequest{
+				Method: http.MethodPost,
+				Uri:    "/",
+				Body:   "Action=GetCallerIdentity&Version=2011-06-15",
+			},
+			Response: &awsbase.MockResponse{
+				StatusCode:  http.StatusBadRequest,
+				Body:        `<ErrorResponse><Error><Type>Sender</Type><Code>Throttling</Code><Message>Rate exceeded</Message></Error><RequestId>1</RequestId></ErrorResponse>`,
+				ContentType: "text/xml",
+			},
+		},
+	})
+	defer ts.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accessKey", "secretKey", ""),
+		Endpoint:    aws.String(ts.URL),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String("us-east-1"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	limiters := addAdaptiveRateLimitHandlers(&sess.Handlers)
+
+	_, err = sts.New(sess).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+
+	if !request.IsErrorThrottle(err) {
+		t.Fatalf("expected throttling error, got %v", err)
+	}
+
+	if got := limiters.get(sts.ServiceName).FillRate(); got == 0 {
+		t.Errorf("expected %s rate limiter to be enabled", sts.ServiceName)
+	}
+
+	if got := limiters.get("ec2").FillRate(); got != 0 {
+		t.Errorf("expected ec2 rate limiter to be disabled, got fill rate %f", got)
+	}
+}
@@ -0,0 +1,35 @@
+package conns
+
+import (
+	"fmt"
+)
+
+// ValidateRegionAllowed returns an error if the region is not in the allowed regions, if any, or is a forbidden region.
+func ValidateRegionAllowed(region string, allowedRegions, forbiddenRegions []string) error {
+	if len(forbiddenRegions) > 0 {
+		for _, forbiddenRegion := range forbiddenRegions {
+			if region == forbiddenRegion {
+				return fmt.Errorf("Forbidden AWS Region: %s", region)
+			}
+		}
+	}
+
+	if len(allowedRegions) > 0 {
+		for _, allowedRegion := range allowedRegions {
+			if region == allowedRegion {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("AWS Region not allowed: %s", region)
+	}
+
+	return nil
+}
+
+// ValidateRegion returns an error if the provider's allowed_regions and forbidden_regions configuration
+// does not permit managing resources in the region. Resources whose arguments place infrastructure
+// in a region other than the provider's must call it for each such region.
+func (client *AWSClient) ValidateRegion(region string) error {
+	return ValidateRegionAllowed(region, client.AllowedRegions, client.ForbiddenRegions)
+}
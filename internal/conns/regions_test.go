@@ -0,0 +1,56 @@
+package conns
+
+import (
+	"testing"
+)
+
+func TestValidateRegionAllowed(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Region           string
+		AllowedRegions   []string
+		ForbiddenRegions []string
+		ExpectError      bool
+	}{
+		{
+			Name:   "no restrictions",
+			Region: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name:           "allowed",
+			Region:         "us-west-2",                        //lintignore:AWSAT003
+			AllowedRegions: []string{"us-east-1", "us-west-2"}, //lintignore:AWSAT003
+		},
+		{
+			Name:           "not allowed",
+			Region:         "eu-west-1",                        //lintignore:AWSAT003
+			AllowedRegions: []string{"us-east-1", "us-west-2"}, //lintignore:AWSAT003
+			ExpectError:    true,
+		},
+		{
+			Name:             "forbidden",
+			Region:           "us-west-2",           //lintignore:AWSAT003
+			ForbiddenRegions: []string{"us-west-2"}, //lintignore:AWSAT003
+			ExpectError:      true,
+		},
+		{
+			Name:             "not forbidden",
+			Region:           "us-east-1",           //lintignore:AWSAT003
+			ForbiddenRegions: []string{"us-west-2"}, //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := ValidateRegionAllowed(testCase.Region, testCase.AllowedRegions, testCase.ForbiddenRegions)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatal("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
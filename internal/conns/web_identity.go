@@ -0,0 +1,191 @@
+package conns
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+)
+
+const (
+	// WebIdentityProviderName is the credentials provider name reported for
+	// credentials retrieved via the assume_role_with_web_identity configuration.
+	WebIdentityProviderName = "AssumeRoleWithWebIdentityProvider"
+
+	// webIdentityExpiryWindow is how long before expiry the credentials are refreshed.
+	webIdentityExpiryWindow = 5 * time.Minute
+)
+
+// webIdentityRoleProvider retrieves credentials by calling STS AssumeRoleWithWebIdentity
+// with an OIDC token supplied either inline or via a file.
+// The token file is re-read on every refresh so that tokens rotated by the
+// platform (e.g. Kubernetes projected service account tokens) are picked up.
+type webIdentityRoleProvider struct {
+	credentials.Expiry
+
+	client          stsiface.STSAPI
+	DurationSeconds int
+	Policy          string
+	PolicyARNs      []string
+	RoleARN         string
+	SessionName     string
+	Token           string
+	TokenFile       string
+}
+
+func (p *webIdentityRoleProvider) Retrieve() (credentials.Value, error) {
+	token, err := p.token()
+
+	if err != nil {
+		return credentials.Value{}, err
+	}
+
+	sessionName := p.SessionName
+
+	if sessionName == "" {
+		sessionName = strconv.FormatInt(time.Now().UnixNano(), 10)
+	}
+
+	input := &sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          aws.String(p.RoleARN),
+		RoleSessionName:  aws.String(sessionName),
+		WebIdentityToken: aws.String(token),
+	}
+
+	if p.DurationSeconds > 0 {
+		input.DurationSeconds = aws.Int64(int64(p.DurationSeconds))
+	}
+
+	if p.Policy != "" {
+		input.Policy = aws.String(p.Policy)
+	}
+
+	for _, policyARN := range p.PolicyARNs {
+		input.PolicyArns = append(input.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	log.Printf("[DEBUG] Assuming IAM Role (%s) with web identity (SessionName: %q)", p.RoleARN, sessionName)
+	output, err := p.client.AssumeRoleWithWebIdentity(input)
+
+	if err != nil {
+		return credentials.Value{}, fmt.Errorf("error assuming IAM Role (%s) with web identity: %w", p.RoleARN, err)
+	}
+
+	if output == nil || output.Credentials == nil {
+		return credentials.Value{}, fmt.Errorf("error assuming IAM Role (%s) with web identity: empty response", p.RoleARN)
+	}
+
+	p.SetExpiration(aws.TimeValue(output.Credentials.Expiration), webIdentityExpiryWindow)
+
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(output.Credentials.AccessKeyId),
+		SecretAccessKey: aws.StringValue(output.Credentials.SecretAccessKey),
+		SessionToken:    aws.StringValue(output.Credentials.SessionToken),
+		ProviderName:    WebIdentityProviderName,
+	}, nil
+}
+
+// token returns the OIDC token, preferring the inline value over the token file.
+// If neither is configured the AWS_WEB_IDENTITY_TOKEN_FILE environment variable is consulted.
+func (p *webIdentityRoleProvider) token() (string, error) {
+	if p.Token != "" {
+		return p.Token, nil
+	}
+
+	tokenFile := p.TokenFile
+
+	if tokenFile == "" {
+		tokenFile = os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE")
+	}
+
+	if tokenFile == "" {
+		return "", fmt.Errorf("one of web_identity_token or web_identity_token_file must be set to assume IAM Role (%s) with web identity", p.RoleARN)
+	}
+
+	b, err := ioutil.ReadFile(tokenFile)
+
+	if err != nil {
+		return "", fmt.Errorf("error reading web identity token file (%s): %w", tokenFile, err)
+	}
+
+	return string(b), nil
+}
+
+// webIdentityCredentials returns refreshable credentials for the configured
+// assume_role_with_web_identity settings.
+// The STS client uses anonymous credentials as AssumeRoleWithWebIdentity is an unsigned call.
+func (c *Config) webIdentityCredentials(httpClient *http.Client) (*credentials.Credentials, error) {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+		Endpoint:    aws.String(c.Endpoints["sts"]),
+		HTTPClient:  httpClient,
+		MaxRetries:  aws.Int(c.MaxRetries),
+		Region:      aws.String(c.Region),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating web identity session: %w", err)
+	}
+
+	return credentials.NewCredentials(&webIdentityRoleProvider{
+		client:          sts.New(sess),
+		DurationSeconds: c.AssumeRoleWithWebIdentityDurationSeconds,
+		Policy:          c.AssumeRoleWithWebIdentityPolicy,
+		PolicyARNs:      c.AssumeRoleWithWebIdentityPolicyARNs,
+		RoleARN:         c.AssumeRoleWithWebIdentityARN,
+		SessionName:     c.AssumeRoleWithWebIdentitySessionName,
+		Token:           c.AssumeRoleWithWebIdentityToken,
+		TokenFile:       c.AssumeRoleWithWebIdentityTokenFile,
+	}), nil
+}
+
+// assumeRoleCredentials returns refreshable credentials for the configured
+// assume_role settings, using the specified source credentials.
+func (c *Config) assumeRoleCredentials(sess *session.Session, sourceCreds *credentials.Credentials) *credentials.Credentials {
+	return stscreds.NewCredentials(sess.Copy(&aws.Config{Credentials: sourceCreds, Endpoint: aws.String(c.Endpoints["sts"])}), c.AssumeRoleARN, func(p *stscreds.AssumeRoleProvider) {
+		if c.AssumeRoleDurationSeconds > 0 {
+			p.Duration = time.Duration(c.AssumeRoleDurationSeconds) * time.Second
+		}
+
+		if c.AssumeRoleExternalID != "" {
+			p.ExternalID = aws.String(c.AssumeRoleExternalID)
+		}
+
+		if c.AssumeRolePolicy != "" {
+			p.Policy = aws.String(c.AssumeRolePolicy)
+		}
+
+		for _, policyARN := range c.AssumeRolePolicyARNs {
+			p.PolicyArns = append(p.PolicyArns, &sts.PolicyDescriptorType{
+				Arn: aws.String(policyARN),
+			})
+		}
+
+		if c.AssumeRoleSessionName != "" {
+			p.RoleSessionName = c.AssumeRoleSessionName
+		}
+
+		for k, v := range c.AssumeRoleTags {
+			p.Tags = append(p.Tags, &sts.Tag{
+				Key:   aws.String(k),
+				Value: aws.String(v),
+			})
+		}
+
+		if len(c.AssumeRoleTransitiveTagKeys) > 0 {
+			p.TransitiveTagKeys = aws.StringSlice(c.AssumeRoleTransitiveTagKeys)
+		}
+	})
+}
@@ -0,0 +1,234 @@
+package conns
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+const testAssumeRoleWithWebIdentityResponseBody = `<AssumeRoleWithWebIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleWithWebIdentityResult>
+    <SubjectFromWebIdentityToken>amzn1.account.AF6RHO7KZU5XRVQJGXK6HB56KR2A</SubjectFromWebIdentityToken>
+    <Audience>client.5498841531868486423.1548@apps.example.com</Audience>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::555555555555:assumed-role/role/SessionName</Arn>
+      <AssumedRoleId>ARO123EXAMPLE123:SessionName</AssumedRoleId>
+    </AssumedRoleUser>
+    <Credentials>
+      <SessionToken>WebIdentitySessionToken</SessionToken>
+      <SecretAccessKey>WebIdentitySecretAccessKey</SecretAccessKey>
+      <Expiration>2099-12-31T23:59:59Z</Expiration>
+      <AccessKeyId>WebIdentityAccessKeyId</AccessKeyId>
+    </Credentials>
+    <Provider>www.amazon.com</Provider>
+  </AssumeRoleWithWebIdentityResult>
+  <ResponseMetadata>
+    <RequestId>ad4156e9-bce1-11e2-82e6-6b6efEXAMPLE</RequestId>
+  </ResponseMetadata>
+</AssumeRoleWithWebIdentityResponse>`
+
+func testAssumeRoleWithWebIdentityEndpoint(token string) *awsbase.MockEndpoint {
+	return &awsbase.MockEndpoint{
+		Request: &awsbase.MockRequest{
+			Method: http.MethodPost,
+			Uri:    "/",
+			Body: url.Values{
+				"Action":           []string{"AssumeRoleWithWebIdentity"},
+				"DurationSeconds":  []string{"3600"},
+				"RoleArn":          []string{"arn:aws:iam::555555555555:role/role"},
+				"RoleSessionName":  []string{"SessionName"},
+				"Version":          []string{"2011-06-15"},
+				"WebIdentityToken": []string{token},
+			}.Encode(),
+		},
+		Response: &awsbase.MockResponse{
+			StatusCode:  http.StatusOK,
+			Body:        testAssumeRoleWithWebIdentityResponseBody,
+			ContentType: "text/xml",
+		},
+	}
+}
+
+func TestWebIdentityRoleProvider(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+
+	if err := ioutil.WriteFile(tokenFile, []byte("file-token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name        string
+		Token       string
+		TokenFile   string
+		ExpectError bool
+	}{
+		{
+			Name:  "inline token",
+			Token: "inline-token",
+		},
+		{
+			Name:      "token file",
+			TokenFile: tokenFile,
+		},
+		{
+			Name:        "missing token file",
+			TokenFile:   filepath.Join(t.TempDir(), "missing"),
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			ts := awsbase.MockAwsApiServer("STS", []*awsbase.MockEndpoint{
+				testAssumeRoleWithWebIdentityEndpoint("inline-token"),
+				testAssumeRoleWithWebIdentityEndpoint("file-token"),
+			})
+			defer ts.Close()
+
+			sess, err := session.NewSession(&aws.Config{
+				Credentials: credentials.AnonymousCredentials,
+				Endpoint:    aws.String(ts.URL),
+				Region:      aws.String("us-east-1"), //lintignore:AWSAT003
+			})
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			creds := credentials.NewCredentials(&webIdentityRoleProvider{
+				client:          sts.New(sess),
+				DurationSeconds: 3600,
+				RoleARN:         "arn:aws:iam::555555555555:role/role", //lintignore:AWSAT005
+				SessionName:     "SessionName",
+				Token:           testCase.Token,
+				TokenFile:       testCase.TokenFile,
+			})
+
+			v, err := creds.Get()
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := v.AccessKeyID, "WebIdentityAccessKeyId"; got != expected {
+				t.Errorf("got access key ID %s, expected %s", got, expected)
+			}
+
+			if got, expected := v.SessionToken, "WebIdentitySessionToken"; got != expected {
+				t.Errorf("got session token %s, expected %s", got, expected)
+			}
+
+			if got, expected := v.ProviderName, WebIdentityProviderName; got != expected {
+				t.Errorf("got provider name %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestWebIdentityRoleProviderTokenFileEnvVar(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+
+	if err := ioutil.WriteFile(tokenFile, []byte("env-token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	oldValue, ok := os.LookupEnv("AWS_WEB_IDENTITY_TOKEN_FILE")
+	os.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", tokenFile)
+	defer func() {
+		if ok {
+			os.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", oldValue)
+		} else {
+			os.Unsetenv("AWS_WEB_IDENTITY_TOKEN_FILE")
+		}
+	}()
+
+	p := &webIdentityRoleProvider{}
+
+	got, err := p.token()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := "env-token"; got != expected {
+		t.Errorf("got token %s, expected %s", got, expected)
+	}
+}
+
+func TestConfigClientWebIdentityAssumeRole(t *testing.T) {
+	mockServer := awsbase.MockAwsApiServer("STS", []*awsbase.MockEndpoint{
+		testAssumeRoleWithWebIdentityEndpoint("inline-token"),
+		awsbase.MockStsAssumeRoleValidEndpoint,
+		awsbase.MockStsGetCallerIdentityValidEndpoint,
+	})
+	defer mockServer.Close()
+
+	actions := make(map[string]int)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		values, _ := url.ParseQuery(string(b))
+		actions[values.Get("Action")]++
+		r.Body = ioutil.NopCloser(bytes.NewReader(b))
+		mockServer.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+
+	defer testSetEnvVars(t, map[string]string{"AWS_CONFIG_FILE": filepath.Join(t.TempDir(), "missing")})()
+
+	config := &Config{
+		AssumeRoleARN:                            awsbase.MockStsAssumeRoleArn,
+		AssumeRoleSessionName:                    awsbase.MockStsAssumeRoleSessionName,
+		AssumeRoleWithWebIdentityARN:             "arn:aws:iam::555555555555:role/role", //lintignore:AWSAT005
+		AssumeRoleWithWebIdentityDurationSeconds: 3600,
+		AssumeRoleWithWebIdentitySessionName:     "SessionName",
+		AssumeRoleWithWebIdentityToken:           "inline-token",
+		Endpoints:                                map[string]string{"ec2": ts.URL, "sts": ts.URL},
+		Region:                                   "us-east-1", //lintignore:AWSAT003
+		SkipMetadataApiCheck:                     true,
+	}
+
+	raw, err := config.Client()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := raw.(*AWSClient)
+
+	if got, expected := client.AccountID, awsbase.MockStsGetCallerIdentityAccountID; got != expected {
+		t.Errorf("got account ID %q, expected %q", got, expected)
+	}
+
+	if got := actions["AssumeRole"]; got != 1 {
+		t.Errorf("got %d AssumeRole calls, expected 1", got)
+	}
+
+	v, err := client.STSConn.Config.Credentials.Get()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := v.AccessKeyID, awsbase.MockStsAssumeRoleAccessKey; got != expected {
+		t.Errorf("got access key ID %q, expected %q", got, expected)
+	}
+}
@@ -0,0 +1,353 @@
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsclient "github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// WireLogRedacted replaces the values of sensitive fields in wire log records.
+	WireLogRedacted = "***REDACTED***"
+
+	// wireLogMaxValueLength is the length string values, e.g. base64 encoded blobs, are truncated to in wire log records.
+	wireLogMaxValueLength = 1024
+)
+
+// wireLogSensitiveFields are redacted in the parameters and results of every operation.
+var wireLogSensitiveFields = []string{
+	"AuthToken",
+	"MasterUserPassword",
+	"NewPassword",
+	"OldPassword",
+	"Password",
+	"PrivateKey",
+	"SecretAccessKey",
+	"SecretBinary",
+	"SecretString",
+	"SessionToken",
+	"WebIdentityToken",
+}
+
+// wireLogSensitiveOperationFields are redacted in the parameters and results of specific operations,
+// for fields whose names are too generic to redact everywhere. Keyed by service and operation name.
+var wireLogSensitiveOperationFields = map[string]map[string][]string{
+	kms.ServiceName: {
+		"Decrypt":             {"Plaintext"},
+		"Encrypt":             {"Plaintext"},
+		"GenerateDataKey":     {"Plaintext"},
+		"GenerateDataKeyPair": {"PrivateKeyPlaintext"},
+		"GenerateRandom":      {"Plaintext"},
+	},
+	ssm.ServiceName: {
+		"GetParameter":        {"Value"},
+		"GetParameterHistory": {"Value"},
+		"GetParameters":       {"Value"},
+		"GetParametersByPath": {"Value"},
+		"PutParameter":        {"Value"},
+	},
+}
+
+type resourceContextKey struct{}
+
+// WireLogResource identifies the Terraform resource whose operation made an AWS API call.
+type WireLogResource struct {
+	Identifier string
+}
+
+// ResourceIdentifier returns the identifier of an instance of the specified resource type
+// (prefixed by "data." for data sources) in logs, e.g. `aws_vpc["vpc-12345678"]`.
+// It isn't the resource's Terraform address: Terraform doesn't send providers the names
+// given to resources in configuration, so the resource ID, once known, is used as the key.
+func ResourceIdentifier(resourceType, id string) string {
+	if id == "" {
+		return resourceType
+	}
+
+	return fmt.Sprintf("%s[%q]", resourceType, id)
+}
+
+// NewResourceContext returns a context carrying the Terraform resource identifier,
+// which is included in the wire log records of AWS API calls made with the context.
+func NewResourceContext(ctx context.Context, identifier string) context.Context {
+	return newResourceContext(ctx, func() string { return identifier })
+}
+
+// newResourceContext returns a context carrying a function returning the Terraform resource identifier,
+// evaluated when the identifier is used so that it reflects a resource ID set after the context is created.
+func newResourceContext(ctx context.Context, identifier func() string) context.Context {
+	return context.WithValue(ctx, resourceContextKey{}, identifier)
+}
+
+// ResourceFromContext returns the Terraform resource carried by the context, if any.
+func ResourceFromContext(ctx context.Context) (WireLogResource, bool) {
+	identifier, ok := ctx.Value(resourceContextKey{}).(func() string)
+
+	if !ok {
+		return WireLogResource{}, false
+	}
+
+	return WireLogResource{Identifier: identifier()}, true
+}
+
+// WithResourceContext wraps the CRUD functions of a resource or data source so that the AWS API calls
+// made during them are logged with the Terraform resource identifier, falling back to the resource type
+// until the resource ID is set. Context-aware functions are passed a context carrying the identifier.
+// When debug logging is enabled, all functions are also passed an AWSClient whose service clients add
+// the identifier to requests made without it, e.g. by functions which aren't context-aware.
+func WithResourceContext(resourceType string, r *schema.Resource) {
+	identifier := func(d *schema.ResourceData) func() string {
+		return func() string {
+			return ResourceIdentifier(resourceType, d.Id())
+		}
+	}
+
+	resourceMeta := func(meta interface{}, identifier func() string) interface{} {
+		if !logging.IsDebugOrHigher() {
+			return meta
+		}
+
+		if client, ok := meta.(*AWSClient); ok && client != nil {
+			return client.withResourceIdentifier(identifier)
+		}
+
+		return meta
+	}
+
+	wrapContext := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			identifier := identifier(d)
+
+			return f(newResourceContext(ctx, identifier), d, resourceMeta(meta, identifier))
+		}
+	}
+
+	wrap := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+
+		return func(d *schema.ResourceData, meta interface{}) error {
+			return f(d, resourceMeta(meta, identifier(d)))
+		}
+	}
+
+	r.CreateContext = wrapContext(r.CreateContext)
+	r.ReadContext = wrapContext(r.ReadContext)
+	r.UpdateContext = wrapContext(r.UpdateContext)
+	r.DeleteContext = wrapContext(r.DeleteContext)
+	r.CreateWithoutTimeout = wrapContext(r.CreateWithoutTimeout)
+	r.ReadWithoutTimeout = wrapContext(r.ReadWithoutTimeout)
+	r.UpdateWithoutTimeout = wrapContext(r.UpdateWithoutTimeout)
+	r.DeleteWithoutTimeout = wrapContext(r.DeleteWithoutTimeout)
+	r.Create = wrap(r.Create)
+	r.Read = wrap(r.Read)
+	r.Update = wrap(r.Update)
+	r.Delete = wrap(r.Delete)
+}
+
+// serviceClientFields are the indices of the AWSClient fields holding service clients, which embed *client.Client.
+var serviceClientFields = func() []int {
+	var indices []int
+	t := reflect.TypeOf(AWSClient{})
+	clientType := reflect.TypeOf((*awsclient.Client)(nil))
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		if field.Type.Kind() != reflect.Ptr || field.Type.Elem().Kind() != reflect.Struct {
+			continue
+		}
+
+		if embedded, ok := field.Type.Elem().FieldByName("Client"); ok && embedded.Anonymous && embedded.Type == clientType {
+			indices = append(indices, i)
+		}
+	}
+
+	return indices
+}()
+
+// withResourceIdentifier returns a copy of the client whose service clients add the Terraform resource
+// identifier to the context of requests which don't carry one.
+func (client *AWSClient) withResourceIdentifier(identifier func() string) *AWSClient {
+	handler := request.NamedHandler{
+		Name: "terraform-provider-aws.ResourceContext",
+		Fn: func(r *request.Request) {
+			if _, ok := ResourceFromContext(r.Context()); !ok {
+				r.SetContext(newResourceContext(r.Context(), identifier))
+			}
+		},
+	}
+
+	c := *client
+	v := reflect.ValueOf(&c).Elem()
+
+	for _, i := range serviceClientFields {
+		field := v.Field(i)
+
+		if field.IsNil() {
+			continue
+		}
+
+		embedded := field.Elem().FieldByName("Client")
+
+		if embedded.IsNil() {
+			continue
+		}
+
+		sdkClient := *embedded.Interface().(*awsclient.Client)
+		sdkClient.Handlers = sdkClient.Handlers.Copy()
+		sdkClient.Handlers.Validate.PushFrontNamed(handler)
+
+		svc := reflect.New(field.Elem().Type())
+		svc.Elem().Set(field.Elem())
+		svc.Elem().FieldByName("Client").Set(reflect.ValueOf(&sdkClient))
+		field.Set(svc)
+	}
+
+	return &c
+}
+
+// wireLogRecord is logged as JSON once per AWS API call, after any retries.
+type wireLogRecord struct {
+	Service        string      `json:"service"`
+	Operation      string      `json:"operation"`
+	Region         string      `json:"region"`
+	DurationMillis int64       `json:"duration_ms"`
+	RetryCount     int         `json:"retry_count"`
+	HTTPStatus     int         `json:"http_status,omitempty"`
+	RequestID      string      `json:"request_id,omitempty"`
+	ErrorCode      string      `json:"error_code,omitempty"`
+	Resource       string      `json:"tf_resource,omitempty"`
+	Params         interface{} `json:"params,omitempty"`
+	Result         interface{} `json:"result,omitempty"`
+}
+
+// addWireLoggingHandlers installs a request handler logging a structured, redacted record of every AWS API call.
+func addWireLoggingHandlers(handlers *request.Handlers) {
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.WireLogging",
+		Fn: func(r *request.Request) {
+			b, err := json.Marshal(newWireLogRecord(r))
+
+			if err != nil {
+				log.Printf("[WARN] Unable to encode %s/%s wire log record: %s", r.ClientInfo.ServiceName, r.Operation.Name, err)
+
+				return
+			}
+
+			log.Printf("[DEBUG] [aws-sdk-go] %s", b)
+		},
+	})
+}
+
+func newWireLogRecord(r *request.Request) *wireLogRecord {
+	record := &wireLogRecord{
+		Service:        r.ClientInfo.ServiceName,
+		Operation:      r.Operation.Name,
+		Region:         aws.StringValue(r.Config.Region),
+		DurationMillis: time.Since(r.Time).Milliseconds(),
+		RetryCount:     r.RetryCount,
+		RequestID:      r.RequestID,
+	}
+
+	if r.HTTPResponse != nil {
+		record.HTTPStatus = r.HTTPResponse.StatusCode
+	}
+
+	if err, ok := r.Error.(awserr.Error); ok {
+		record.ErrorCode = err.Code()
+	}
+
+	if v, ok := ResourceFromContext(r.Context()); ok {
+		record.Resource = v.Identifier
+	}
+
+	fields := wireLogSensitiveOperationFields[r.ClientInfo.ServiceName][r.Operation.Name]
+	record.Params = redactWireLogValue(r.Params, fields)
+
+	if r.Error == nil {
+		record.Result = redactWireLogValue(r.Data, fields)
+	}
+
+	return record
+}
+
+// redactWireLogValue converts an API parameter or result structure to its generic JSON representation
+// with the values of sensitive fields, at any depth, redacted and long values truncated.
+func redactWireLogValue(v interface{}, fields []string) interface{} {
+	if v == nil {
+		return nil
+	}
+
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return nil
+	}
+
+	var generic interface{}
+
+	if err := json.Unmarshal(b, &generic); err != nil {
+		return nil
+	}
+
+	return redactWireLogFields(generic, fields)
+}
+
+func redactWireLogFields(v interface{}, fields []string) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isWireLogSensitiveField(key, fields) {
+				v[key] = WireLogRedacted
+			} else {
+				v[key] = redactWireLogFields(value, fields)
+			}
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactWireLogFields(value, fields)
+		}
+	case string:
+		if len(v) > wireLogMaxValueLength {
+			return fmt.Sprintf("%s...(%d bytes truncated)", v[:wireLogMaxValueLength], len(v)-wireLogMaxValueLength)
+		}
+	}
+
+	return v
+}
+
+func isWireLogSensitiveField(key string, fields []string) bool {
+	for _, field := range wireLogSensitiveFields {
+		if strings.EqualFold(key, field) {
+			return true
+		}
+	}
+
+	for _, field := range fields {
+		if strings.EqualFold(key, field) {
+			return true
+		}
+	}
+
+	return false
+}
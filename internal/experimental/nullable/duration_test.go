@@ -0,0 +1,149 @@
+package nullable
+
+import (
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestNullableDuration(t *testing.T) {
+	cases := []struct {
+		val           string
+		expectNull    bool
+		expectedValue time.Duration
+		expectErr     bool
+	}{
+		{
+			val:           "90s",
+			expectNull:    false,
+			expectedValue: 90 * time.Second,
+		},
+		{
+			val:           "0s",
+			expectNull:    false,
+			expectedValue: 0,
+		},
+		{
+			val:           "",
+			expectNull:    true,
+			expectedValue: 0,
+		},
+		{
+			val:           "A",
+			expectNull:    false,
+			expectedValue: 0,
+			expectErr:     true,
+		},
+	}
+
+	for i, tc := range cases {
+		v := Duration(tc.val)
+
+		if null := v.IsNull(); null != tc.expectNull {
+			t.Fatalf("expected test case %d IsNull to return %t, got %t", i, null, tc.expectNull)
+		}
+
+		value, null, err := v.Value()
+		if value != tc.expectedValue {
+			t.Fatalf("expected test case %d Value to be %s, got %s", i, tc.expectedValue, value)
+		}
+		if null != tc.expectNull {
+			t.Fatalf("expected test case %d Value null flag to be %t, got %t", i, tc.expectNull, null)
+		}
+		if !tc.expectErr && err != nil {
+			t.Fatalf("expected test case %d to succeed, got error %s", i, err)
+		}
+		if tc.expectErr && err == nil {
+			t.Fatalf("expected test case %d to fail", i)
+		}
+	}
+}
+
+func TestNewDuration(t *testing.T) {
+	if v, expected := NewDuration(90*time.Second), Duration("1m30s"); v != expected {
+		t.Fatalf("expected %s, got %s", expected, v)
+	}
+}
+
+func TestValidationDuration(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "90s",
+			f:   ValidateTypeStringNullableDuration,
+		},
+		{
+			val:         "A",
+			f:           ValidateTypeStringNullableDuration,
+			expectedErr: regexp.MustCompile(`[\w]+: cannot parse 'A' as duration: .*`),
+		},
+		{
+			val:         1,
+			f:           ValidateTypeStringNullableDuration,
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
+
+func TestValidationDurationAtLeast(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "1m",
+			f:   ValidateTypeStringNullableDurationAtLeast(time.Minute),
+		},
+		{
+			val: "0s",
+			f:   ValidateTypeStringNullableDurationAtLeast(0),
+		},
+		{
+			val:         "30s",
+			f:           ValidateTypeStringNullableDurationAtLeast(time.Minute),
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be at least \(1m0s\), got 30s`),
+		},
+		{
+			val:         1,
+			f:           ValidateTypeStringNullableDurationAtLeast(time.Minute),
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
+
+func TestValidationDurationBetween(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "10s",
+			f:   ValidateTypeStringNullableDurationBetween(10*time.Second, time.Minute),
+		},
+		{
+			val: "",
+			f:   ValidateTypeStringNullableDurationBetween(10*time.Second, time.Minute),
+		},
+		{
+			val:         "2m",
+			f:           ValidateTypeStringNullableDurationBetween(10*time.Second, time.Minute),
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be at between \(10s\) and \(1m0s\), got 2m0s`),
+		},
+		{
+			val:         "A",
+			f:           ValidateTypeStringNullableDurationBetween(10*time.Second, time.Minute),
+			expectedErr: regexp.MustCompile(`[\w]+: cannot parse 'A' as duration: .*`),
+		},
+	})
+}
+
+func TestDiffSuppressNullableDurationEquivalent(t *testing.T) {
+	for i, tc := range []struct {
+		old, new string
+		expected bool
+	}{
+		{old: "90s", new: "1m30s", expected: true},
+		{old: "0s", new: "0", expected: true},
+		{old: "", new: "", expected: true},
+		{old: "0s", new: "", expected: false},
+		{old: "", new: "0s", expected: false},
+		{old: "1m", new: "2m", expected: false},
+	} {
+		if v := DiffSuppressNullableDurationEquivalent("test_property", tc.old, tc.new, nil); v != tc.expected {
+			t.Fatalf("expected test case %d to return %t, got %t", i, tc.expected, v)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package nullable
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	TypeNullableFloat = schema.TypeString
+)
+
+type Float string
+
+func (f Float) IsNull() bool {
+	return f == ""
+}
+
+func (f Float) Value() (float64, bool, error) {
+	if f.IsNull() {
+		return 0, true, nil
+	}
+
+	value, err := strconv.ParseFloat(string(f), 64)
+	if err != nil {
+		return 0, false, err
+	}
+	return value, false, nil
+}
+
+func NewFloat(v float64) Float {
+	return Float(strconv.FormatFloat(v, 'g', -1, 64))
+}
+
+// ValidateTypeStringNullableFloat provides custom error messaging for TypeString floats
+// Some arguments require a float value or unspecified, empty field.
+func ValidateTypeStringNullableFloat(v interface{}, k string) (ws []string, es []error) {
+	value, ok := v.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if value == "" {
+		return
+	}
+
+	if _, err := strconv.ParseFloat(value, 64); err != nil {
+		es = append(es, fmt.Errorf("%s: cannot parse '%s' as float: %w", k, value, err))
+	}
+
+	return
+}
+
+// ValidateTypeStringNullableFloatAtLeast provides custom error messaging for TypeString floats
+// Some arguments require a float value or unspecified, empty field.
+func ValidateTypeStringNullableFloatAtLeast(min float64) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (ws []string, es []error) {
+		value, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if value == "" {
+			return
+		}
+
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			es = append(es, fmt.Errorf("%s: cannot parse '%s' as float: %w", k, value, err))
+			return
+		}
+
+		if v < min {
+			es = append(es, fmt.Errorf("expected %s to be at least (%g), got %g", k, min, v))
+		}
+
+		return
+	}
+}
+
+// ValidateTypeStringNullableFloatBetween provides custom error messaging for TypeString floats
+// Some arguments require a float value or unspecified, empty field.
+func ValidateTypeStringNullableFloatBetween(min float64, max float64) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (ws []string, es []error) {
+		value, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if value == "" {
+			return
+		}
+
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			es = append(es, fmt.Errorf("%s: cannot parse '%s' as float: %w", k, value, err))
+			return
+		}
+
+		if v < min || v > max {
+			es = append(es, fmt.Errorf("expected %s to be at between (%g) and (%g), got %g", k, min, max, v))
+		}
+
+		return
+	}
+}
+
+// DiffSuppressNullableFloatEquivalent suppresses differences between equal floats written differently,
+// e.g. "1", "1.0" and "1e0". Null is only equivalent to null.
+func DiffSuppressNullableFloatEquivalent(k, o, n string, d *schema.ResourceData) bool {
+	ov, onull, oerr := Float(o).Value()
+	nv, nnull, nerr := Float(n).Value()
+	if oerr != nil || nerr != nil {
+		return false
+	}
+	if onull || nnull {
+		return onull == nnull
+	}
+	return ov == nv
+}
@@ -0,0 +1,162 @@
+package nullable
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestNullableFloat(t *testing.T) {
+	cases := []struct {
+		val           string
+		expectNull    bool
+		expectedValue float64
+		expectedErr   error
+	}{
+		{
+			val:           "1.5",
+			expectNull:    false,
+			expectedValue: 1.5,
+		},
+		{
+			val:           "0",
+			expectNull:    false,
+			expectedValue: 0,
+		},
+		{
+			val:           "",
+			expectNull:    true,
+			expectedValue: 0,
+		},
+		{
+			val:           "A",
+			expectNull:    false,
+			expectedValue: 0,
+			expectedErr:   strconv.ErrSyntax,
+		},
+	}
+
+	for i, tc := range cases {
+		v := Float(tc.val)
+
+		if null := v.IsNull(); null != tc.expectNull {
+			t.Fatalf("expected test case %d IsNull to return %t, got %t", i, null, tc.expectNull)
+		}
+
+		value, null, err := v.Value()
+		if value != tc.expectedValue {
+			t.Fatalf("expected test case %d Value to be %g, got %g", i, tc.expectedValue, value)
+		}
+		if null != tc.expectNull {
+			t.Fatalf("expected test case %d Value null flag to be %t, got %t", i, tc.expectNull, null)
+		}
+		if tc.expectedErr == nil && err != nil {
+			t.Fatalf("expected test case %d to succeed, got error %s", i, err)
+		}
+		if tc.expectedErr != nil {
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected test case %d to have error matching \"%s\", got %s", i, tc.expectedErr, err)
+			}
+		}
+	}
+}
+
+func TestNewFloat(t *testing.T) {
+	for i, tc := range []struct {
+		val      float64
+		expected Float
+	}{
+		{val: 0, expected: "0"},
+		{val: 1.5, expected: "1.5"},
+		{val: -0.25, expected: "-0.25"},
+	} {
+		if v := NewFloat(tc.val); v != tc.expected {
+			t.Fatalf("expected test case %d to be %s, got %s", i, tc.expected, v)
+		}
+	}
+}
+
+func TestValidationFloat(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "1.5",
+			f:   ValidateTypeStringNullableFloat,
+		},
+		{
+			val:         "A",
+			f:           ValidateTypeStringNullableFloat,
+			expectedErr: regexp.MustCompile(`[\w]+: cannot parse 'A' as float: .*`),
+		},
+		{
+			val:         1.5,
+			f:           ValidateTypeStringNullableFloat,
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
+
+func TestValidationFloatAtLeast(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "1.5",
+			f:   ValidateTypeStringNullableFloatAtLeast(1.5),
+		},
+		{
+			val: "0",
+			f:   ValidateTypeStringNullableFloatAtLeast(0),
+		},
+		{
+			val:         "1.5",
+			f:           ValidateTypeStringNullableFloatAtLeast(2),
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be at least \(2\), got 1.5`),
+		},
+		{
+			val:         1.5,
+			f:           ValidateTypeStringNullableFloatAtLeast(2),
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
+
+func TestValidationFloatBetween(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "0",
+			f:   ValidateTypeStringNullableFloatBetween(0, 1),
+		},
+		{
+			val: "",
+			f:   ValidateTypeStringNullableFloatBetween(0, 1),
+		},
+		{
+			val:         "1.5",
+			f:           ValidateTypeStringNullableFloatBetween(0, 1),
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be at between \(0\) and \(1\), got 1.5`),
+		},
+		{
+			val:         "A",
+			f:           ValidateTypeStringNullableFloatBetween(0, 1),
+			expectedErr: regexp.MustCompile(`[\w]+: cannot parse 'A' as float: .*`),
+		},
+	})
+}
+
+func TestDiffSuppressNullableFloatEquivalent(t *testing.T) {
+	for i, tc := range []struct {
+		old, new string
+		expected bool
+	}{
+		{old: "1", new: "1.0", expected: true},
+		{old: "0", new: "0.0", expected: true},
+		{old: "", new: "", expected: true},
+		{old: "0", new: "", expected: false},
+		{old: "", new: "0", expected: false},
+		{old: "1", new: "2", expected: false},
+		{old: "A", new: "A", expected: false},
+	} {
+		if v := DiffSuppressNullableFloatEquivalent("test_property", tc.old, tc.new, nil); v != tc.expected {
+			t.Fatalf("expected test case %d to return %t, got %t", i, tc.expected, v)
+		}
+	}
+}
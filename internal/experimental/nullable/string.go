@@ -0,0 +1,63 @@
+package nullable
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	TypeNullableString = schema.TypeString
+)
+
+// String is a string, typically an enum, whose empty value means unset.
+type String string
+
+func (s String) IsNull() bool {
+	return s == ""
+}
+
+func (s String) Value() (string, bool) {
+	if s.IsNull() {
+		return "", true
+	}
+
+	return string(s), false
+}
+
+// ValidateTypeStringNullableStringInSlice provides custom error messaging for TypeString enums
+// Some arguments require one of a set of values or unspecified, empty field.
+func ValidateTypeStringNullableStringInSlice(valid []string, ignoreCase bool) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (ws []string, es []error) {
+		value, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if value == "" {
+			return
+		}
+
+		for _, v := range valid {
+			if v == value || (ignoreCase && strings.EqualFold(v, value)) {
+				return
+			}
+		}
+
+		es = append(es, fmt.Errorf("expected %s to be one of %v or unset, got %s", k, valid, value))
+
+		return
+	}
+}
+
+// DiffSuppressNullableStringValueAsNull allows the given value to be treated equivalently to null.
+// This can be used where the API reports unset as an explicit value, e.g. "NONE".
+func DiffSuppressNullableStringValueAsNull(value string) schema.SchemaDiffSuppressFunc {
+	return func(k, o, n string, d *schema.ResourceData) bool {
+		_, onull := String(o).Value()
+		_, nnull := String(n).Value()
+		return o == value && nnull || onull && n == value
+	}
+}
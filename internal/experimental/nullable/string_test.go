@@ -0,0 +1,86 @@
+package nullable
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestNullableString(t *testing.T) {
+	cases := []struct {
+		val           string
+		expectNull    bool
+		expectedValue string
+	}{
+		{
+			val:           "SERVICE",
+			expectNull:    false,
+			expectedValue: "SERVICE",
+		},
+		{
+			val:           "",
+			expectNull:    true,
+			expectedValue: "",
+		},
+	}
+
+	for i, tc := range cases {
+		v := String(tc.val)
+
+		if null := v.IsNull(); null != tc.expectNull {
+			t.Fatalf("expected test case %d IsNull to return %t, got %t", i, null, tc.expectNull)
+		}
+
+		value, null := v.Value()
+		if value != tc.expectedValue {
+			t.Fatalf("expected test case %d Value to be %s, got %s", i, tc.expectedValue, value)
+		}
+		if null != tc.expectNull {
+			t.Fatalf("expected test case %d Value null flag to be %t, got %t", i, tc.expectNull, null)
+		}
+	}
+}
+
+func TestValidationStringInSlice(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "SERVICE",
+			f:   ValidateTypeStringNullableStringInSlice([]string{"SERVICE", "TASK_DEFINITION"}, false),
+		},
+		{
+			val: "",
+			f:   ValidateTypeStringNullableStringInSlice([]string{"SERVICE", "TASK_DEFINITION"}, false),
+		},
+		{
+			val: "service",
+			f:   ValidateTypeStringNullableStringInSlice([]string{"SERVICE", "TASK_DEFINITION"}, true),
+		},
+		{
+			val:         "service",
+			f:           ValidateTypeStringNullableStringInSlice([]string{"SERVICE", "TASK_DEFINITION"}, false),
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be one of \[SERVICE TASK_DEFINITION\] or unset, got service`),
+		},
+		{
+			val:         1,
+			f:           ValidateTypeStringNullableStringInSlice([]string{"SERVICE", "TASK_DEFINITION"}, false),
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
+
+func TestDiffSuppressNullableStringValueAsNull(t *testing.T) {
+	f := DiffSuppressNullableStringValueAsNull("NONE")
+
+	for i, tc := range []struct {
+		old, new string
+		expected bool
+	}{
+		{old: "NONE", new: "", expected: true},
+		{old: "", new: "NONE", expected: true},
+		{old: "SERVICE", new: "", expected: false},
+		{old: "NONE", new: "SERVICE", expected: false},
+	} {
+		if v := f("test_property", tc.old, tc.new, nil); v != tc.expected {
+			t.Fatalf("expected test case %d to return %t, got %t", i, tc.expected, v)
+		}
+	}
+}
@@ -0,0 +1,655 @@
+package flex
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// AutoFlexOptions customize how Expand and Flatten map individual fields.
+// Fields are identified by the path of their Terraform keys, e.g. "listener.port_mapping.port".
+type AutoFlexOptions struct {
+	fieldNames map[string]string
+	ignored    map[string]bool
+	expanders  map[string]func(interface{}) (interface{}, error)
+	flatteners map[string]func(interface{}) (interface{}, error)
+	tfSchema   map[string]*schema.Schema
+	timeLayout string
+}
+
+type AutoFlexOptionsFunc func(*AutoFlexOptions)
+
+// WithFieldName maps the Terraform key at path to the named SDK field, for names that don't otherwise match.
+func WithFieldName(path, fieldName string) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		o.fieldNames[path] = fieldName
+	}
+}
+
+// WithIgnoredField skips the Terraform key at path.
+func WithIgnoredField(path string) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		o.ignored[path] = true
+	}
+}
+
+// WithExpander converts the Terraform value at path with f instead of by reflection.
+// The value returned must be assignable to the SDK field.
+func WithExpander(path string, f func(tfValue interface{}) (interface{}, error)) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		o.expanders[path] = f
+	}
+}
+
+// WithFlattener converts the SDK field value at path with f instead of by reflection.
+func WithFlattener(path string, f func(apiValue interface{}) (interface{}, error)) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		o.flatteners[path] = f
+	}
+}
+
+// WithSchema restricts Flatten to the keys of the Terraform schema, descending into nested blocks.
+// Keys are matched to SDK field names as by Expand. Without a schema, Flatten returns a key for every SDK field.
+func WithSchema(tfSchema map[string]*schema.Schema) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		o.tfSchema = tfSchema
+	}
+}
+
+// WithTimeLayout sets the layout of timestamps, time.RFC3339 by default.
+func WithTimeLayout(layout string) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		o.timeLayout = layout
+	}
+}
+
+func newAutoFlexOptions(optFns []AutoFlexOptionsFunc) *AutoFlexOptions {
+	o := &AutoFlexOptions{
+		fieldNames: make(map[string]string),
+		ignored:    make(map[string]bool),
+		expanders:  make(map[string]func(interface{}) (interface{}, error)),
+		flatteners: make(map[string]func(interface{}) (interface{}, error)),
+		timeLayout: time.RFC3339,
+	}
+
+	for _, optFn := range optFns {
+		optFn(o)
+	}
+
+	return o
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Expand copies a Terraform configuration block into the SDK structure pointed to by apiObject.
+// tfObject is the block's map[string]interface{}, or the []interface{} or *schema.Set containing it.
+// apiObject is a pointer to a struct or, for a list or set of blocks, to a slice of struct pointers.
+//
+// Terraform keys are matched to SDK field names case-insensitively, ignoring underscores, e.g. "vpc_id" to VpcId.
+// Empty strings and zero numbers are treated as unset and leave the SDK field nil. Booleans are always set.
+// Nested blocks, lists and sets of primitives, maps of strings and RFC3339 timestamps are supported.
+func Expand(tfObject interface{}, apiObject interface{}, optFns ...AutoFlexOptionsFunc) error {
+	v := reflect.ValueOf(apiObject)
+
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return fmt.Errorf("expand: target must be a non-nil pointer, got %T", apiObject)
+	}
+
+	return expandValue(newAutoFlexOptions(optFns), "", tfObject, v.Elem())
+}
+
+// Flatten converts an SDK structure, or slice of structures, into the []interface{} of maps set for a Terraform block.
+// A nil structure results in a nil list.
+//
+// SDK field names are converted to snake_case Terraform keys, e.g. VpcId to "vpc_id", or matched to the keys of the
+// schema passed with WithSchema. Nil fields are omitted.
+func Flatten(apiObject interface{}, optFns ...AutoFlexOptionsFunc) ([]interface{}, error) {
+	o := newAutoFlexOptions(optFns)
+	v := reflect.ValueOf(apiObject)
+
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, nil
+		}
+
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		tfMap, err := flattenStruct(o, "", o.tfSchema, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return []interface{}{tfMap}, nil
+	case reflect.Slice:
+		tfList, err := flattenValue(o, "", &schema.Schema{Elem: &schema.Resource{Schema: o.tfSchema}}, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if tfList == nil {
+			return nil, nil
+		}
+
+		return tfList.([]interface{}), nil
+	}
+
+	return nil, fmt.Errorf("flatten: unsupported type %T", apiObject)
+}
+
+func expandValue(o *AutoFlexOptions, path string, tfValue interface{}, target reflect.Value) error {
+	if set, ok := tfValue.(*schema.Set); ok {
+		if set == nil {
+			return nil
+		}
+
+		tfValue = set.List()
+	}
+
+	if tfValue == nil {
+		return nil
+	}
+
+	t := target.Type()
+
+	// Timestamps are structs, so handle them before nested blocks.
+	if t == timeType || (t.Kind() == reflect.Ptr && t.Elem() == timeType) {
+		s, ok := tfValue.(string)
+
+		if !ok {
+			return fmt.Errorf("expand %s: expected string, got %T", path, tfValue)
+		}
+
+		if s == "" {
+			return nil
+		}
+
+		ts, err := time.Parse(o.timeLayout, s)
+
+		if err != nil {
+			return fmt.Errorf("expand %s: %w", path, err)
+		}
+
+		if t.Kind() == reflect.Ptr {
+			target.Set(reflect.ValueOf(&ts))
+		} else {
+			target.Set(reflect.ValueOf(ts))
+		}
+
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		elem := reflect.New(t.Elem())
+
+		if err := expandValue(o, path, tfValue, elem.Elem()); err != nil {
+			return err
+		}
+
+		// Leave nil pointers for unset values and empty blocks.
+		if !elem.Elem().IsZero() || t.Elem().Kind() == reflect.Bool {
+			target.Set(elem)
+		}
+
+		return nil
+
+	case reflect.Struct:
+		tfMap, err := blockMap(path, tfValue)
+
+		if err != nil || tfMap == nil {
+			return err
+		}
+
+		return expandStruct(o, path, tfMap, target)
+
+	case reflect.Slice:
+		tfList, ok := tfValue.([]interface{})
+
+		if !ok {
+			return fmt.Errorf("expand %s: expected list, got %T", path, tfValue)
+		}
+
+		if len(tfList) == 0 {
+			return nil
+		}
+
+		slice := reflect.MakeSlice(t, 0, len(tfList))
+
+		for _, tfElem := range tfList {
+			elem := reflect.New(t.Elem()).Elem()
+
+			if err := expandValue(o, path, tfElem, elem); err != nil {
+				return err
+			}
+
+			// Skip empty strings, as ExpandStringList does.
+			if elem.Kind() == reflect.Ptr && elem.IsNil() {
+				continue
+			}
+
+			slice = reflect.Append(slice, elem)
+		}
+
+		target.Set(slice)
+
+		return nil
+
+	case reflect.Map:
+		tfMap, ok := tfValue.(map[string]interface{})
+
+		if !ok {
+			return fmt.Errorf("expand %s: expected map, got %T", path, tfValue)
+		}
+
+		if len(tfMap) == 0 {
+			return nil
+		}
+
+		m := reflect.MakeMapWithSize(t, len(tfMap))
+
+		for k, tfElem := range tfMap {
+			elem := reflect.New(t.Elem()).Elem()
+
+			// Map values are kept even when empty, e.g. tags with no value.
+			if elem.Kind() == reflect.Ptr {
+				elem = reflect.New(t.Elem().Elem())
+
+				if err := expandValue(o, path, tfElem, elem.Elem()); err != nil {
+					return err
+				}
+			} else if err := expandValue(o, path, tfElem, elem); err != nil {
+				return err
+			}
+
+			m.SetMapIndex(reflect.ValueOf(k).Convert(t.Key()), elem)
+		}
+
+		target.Set(m)
+
+		return nil
+
+	case reflect.String:
+		s, ok := tfValue.(string)
+
+		if !ok {
+			return fmt.Errorf("expand %s: expected string, got %T", path, tfValue)
+		}
+
+		target.SetString(s)
+
+		return nil
+
+	case reflect.Bool:
+		b, ok := tfValue.(bool)
+
+		if !ok {
+			return fmt.Errorf("expand %s: expected bool, got %T", path, tfValue)
+		}
+
+		target.SetBool(b)
+
+		return nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch v := tfValue.(type) {
+		case int:
+			target.SetInt(int64(v))
+		case int64:
+			target.SetInt(v)
+		default:
+			return fmt.Errorf("expand %s: expected int, got %T", path, tfValue)
+		}
+
+		return nil
+
+	case reflect.Float32, reflect.Float64:
+		switch v := tfValue.(type) {
+		case float64:
+			target.SetFloat(v)
+		case int:
+			target.SetFloat(float64(v))
+		default:
+			return fmt.Errorf("expand %s: expected float, got %T", path, tfValue)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("expand %s: unsupported type %s", path, t)
+}
+
+func expandStruct(o *AutoFlexOptions, path string, tfMap map[string]interface{}, target reflect.Value) error {
+	for key, tfValue := range tfMap {
+		keyPath := joinPath(path, key)
+
+		if o.ignored[keyPath] {
+			continue
+		}
+
+		field, ok := structField(target, key, o.fieldNames[keyPath])
+
+		if !ok {
+			continue
+		}
+
+		if f, ok := o.expanders[keyPath]; ok {
+			v, err := f(tfValue)
+
+			if err != nil {
+				return fmt.Errorf("expand %s: %w", keyPath, err)
+			}
+
+			if v != nil {
+				rv := reflect.ValueOf(v)
+
+				if !rv.Type().AssignableTo(field.Type()) {
+					return fmt.Errorf("expand %s: expander returned %T, not assignable to %s", keyPath, v, field.Type())
+				}
+
+				field.Set(rv)
+			}
+
+			continue
+		}
+
+		if err := expandValue(o, keyPath, tfValue, field); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func flattenStruct(o *AutoFlexOptions, path string, tfSchema map[string]*schema.Schema, v reflect.Value) (map[string]interface{}, error) {
+	tfMap := make(map[string]interface{})
+	t := v.Type()
+
+	// Map each Terraform key to its SDK field.
+	keys := make(map[string]string)
+
+	if tfSchema != nil {
+		for key := range tfSchema {
+			if name := o.fieldNames[joinPath(path, key)]; name != "" {
+				keys[key] = name
+			} else if field, ok := t.FieldByNameFunc(func(name string) bool { return normalizeName(name) == normalizeName(key) }); ok {
+				keys[key] = field.Name
+			}
+		}
+	} else {
+		names := make(map[string]string)
+
+		for key, name := range o.fieldNames {
+			if strings.HasPrefix(key, joinPath(path, "")) && !strings.Contains(strings.TrimPrefix(key, joinPath(path, "")), ".") {
+				names[name] = strings.TrimPrefix(key, joinPath(path, ""))
+			}
+		}
+
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+
+			if field.PkgPath != "" || field.Name == "_" {
+				continue
+			}
+
+			if key, ok := names[field.Name]; ok {
+				keys[key] = field.Name
+			} else {
+				keys[SnakeCase(field.Name)] = field.Name
+			}
+		}
+	}
+
+	for key, name := range keys {
+		keyPath := joinPath(path, key)
+
+		if o.ignored[keyPath] {
+			continue
+		}
+
+		field := v.FieldByName(name)
+
+		if !field.IsValid() {
+			continue
+		}
+
+		if f, ok := o.flatteners[keyPath]; ok {
+			tfValue, err := f(field.Interface())
+
+			if err != nil {
+				return nil, fmt.Errorf("flatten %s: %w", keyPath, err)
+			}
+
+			if tfValue != nil {
+				tfMap[key] = tfValue
+			}
+
+			continue
+		}
+
+		var s *schema.Schema
+
+		if tfSchema != nil {
+			s = tfSchema[key]
+		}
+
+		tfValue, err := flattenValue(o, keyPath, s, field)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if tfValue != nil {
+			tfMap[key] = tfValue
+		}
+	}
+
+	return tfMap, nil
+}
+
+// flattenValue converts an SDK value to its Terraform representation, nil for nil values.
+func flattenValue(o *AutoFlexOptions, path string, s *schema.Schema, v reflect.Value) (interface{}, error) {
+	if v.Type() == timeType || (v.Kind() == reflect.Ptr && v.Type().Elem() == timeType) {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return nil, nil
+			}
+
+			v = v.Elem()
+		}
+
+		return v.Interface().(time.Time).Format(o.timeLayout), nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+
+		return flattenValue(o, path, s, v.Elem())
+
+	case reflect.Struct:
+		tfMap, err := flattenStruct(o, path, nestedSchema(s), v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return []interface{}{tfMap}, nil
+
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil, nil
+		}
+
+		tfList := make([]interface{}, 0, v.Len())
+
+		for i := 0; i < v.Len(); i++ {
+			elem := v.Index(i)
+
+			for elem.Kind() == reflect.Ptr || elem.Kind() == reflect.Interface {
+				if elem.IsNil() {
+					break
+				}
+
+				elem = elem.Elem()
+			}
+
+			if elem.Kind() == reflect.Ptr || elem.Kind() == reflect.Interface {
+				continue
+			}
+
+			// Nested blocks are lists of maps, not lists of single element lists.
+			if elem.Kind() == reflect.Struct && elem.Type() != timeType {
+				tfMap, err := flattenStruct(o, path, nestedSchema(s), elem)
+
+				if err != nil {
+					return nil, err
+				}
+
+				tfList = append(tfList, tfMap)
+
+				continue
+			}
+
+			tfElem, err := flattenValue(o, path, nil, elem)
+
+			if err != nil {
+				return nil, err
+			}
+
+			tfList = append(tfList, tfElem)
+		}
+
+		return tfList, nil
+
+	case reflect.Map:
+		if v.IsNil() {
+			return nil, nil
+		}
+
+		tfMap := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+
+		for iter.Next() {
+			tfElem, err := flattenValue(o, path, nil, iter.Value())
+
+			if err != nil {
+				return nil, err
+			}
+
+			tfMap[fmt.Sprint(iter.Key().Interface())] = tfElem
+		}
+
+		return tfMap, nil
+
+	case reflect.String:
+		return v.String(), nil
+
+	case reflect.Bool:
+		return v.Bool(), nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(v.Int()), nil
+
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	}
+
+	return nil, fmt.Errorf("flatten %s: unsupported type %s", path, v.Type())
+}
+
+// blockMap returns the map of a configuration block, which may be wrapped in a single element list.
+func blockMap(path string, tfValue interface{}) (map[string]interface{}, error) {
+	switch v := tfValue.(type) {
+	case map[string]interface{}:
+		return v, nil
+	case []interface{}:
+		if len(v) == 0 || v[0] == nil {
+			return nil, nil
+		}
+
+		tfMap, ok := v[0].(map[string]interface{})
+
+		if !ok {
+			return nil, fmt.Errorf("expand %s: expected block, got %T", path, v[0])
+		}
+
+		return tfMap, nil
+	}
+
+	return nil, fmt.Errorf("expand %s: expected block, got %T", path, tfValue)
+}
+
+// structField returns the settable field of v named name or, if name is empty, matching the Terraform key.
+func structField(v reflect.Value, key, name string) (reflect.Value, bool) {
+	var field reflect.Value
+
+	if name != "" {
+		field = v.FieldByName(name)
+	} else {
+		field = v.FieldByNameFunc(func(name string) bool { return normalizeName(name) == normalizeName(key) })
+	}
+
+	if !field.IsValid() || !field.CanSet() {
+		return reflect.Value{}, false
+	}
+
+	return field, true
+}
+
+func nestedSchema(s *schema.Schema) map[string]*schema.Schema {
+	if s == nil {
+		return nil
+	}
+
+	if r, ok := s.Elem.(*schema.Resource); ok {
+		return r.Schema
+	}
+
+	return nil
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+// normalizeName lower cases a Terraform key or SDK field name and removes underscores,
+// so that e.g. "vpc_id", "VpcId" and "VPCID" all match.
+func normalizeName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+// SnakeCase converts an SDK field name to a Terraform key, e.g. "VpcId" to "vpc_id" and "DBInstanceARN" to "db_instance_arn".
+func SnakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteRune('_')
+			}
+		}
+
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
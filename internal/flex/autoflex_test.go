@@ -0,0 +1,292 @@
+package flex
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type testAutoFlexListener struct {
+	_ struct{} `type:"structure"`
+
+	HealthCheck *testAutoFlexHealthCheck `type:"structure"`
+	Port        *int64                   `type:"integer"`
+	Protocol    *string                  `type:"string"`
+}
+
+type testAutoFlexHealthCheck struct {
+	_ struct{} `type:"structure"`
+
+	Enabled        *bool    `type:"boolean"`
+	IntervalMillis *int64   `type:"long"`
+	Threshold      *float64 `type:"double"`
+}
+
+type testAutoFlexService struct {
+	_ struct{} `type:"structure"`
+
+	ARN              *string                 `type:"string"`
+	CreatedAt        *time.Time              `type:"timestamp"`
+	Listeners        []*testAutoFlexListener `type:"list"`
+	SecurityGroupIds []*string               `type:"list"`
+	Tags             map[string]*string      `type:"map"`
+	VpcId            *string                 `type:"string"`
+	Weight           *int64                  `type:"integer"`
+}
+
+func TestExpand(t *testing.T) {
+	createdAt := time.Date(2021, 10, 1, 12, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name     string
+		TfObject interface{}
+		OptFns   []AutoFlexOptionsFunc
+		Expected *testAutoFlexService
+	}{
+		{
+			Name:     "empty",
+			TfObject: []interface{}{},
+			Expected: &testAutoFlexService{},
+		},
+		{
+			Name: "primitives",
+			TfObject: []interface{}{map[string]interface{}{
+				"arn":        "arn:aws:example:us-west-2:123456789012:service/test", //lintignore:AWSAT003,AWSAT005
+				"created_at": "2021-10-01T12:30:00Z",
+				"vpc_id":     "vpc-12345678",
+				"weight":     0,
+			}},
+			Expected: &testAutoFlexService{
+				ARN:       aws.String("arn:aws:example:us-west-2:123456789012:service/test"), //lintignore:AWSAT003,AWSAT005
+				CreatedAt: &createdAt,
+				VpcId:     aws.String("vpc-12345678"),
+			},
+		},
+		{
+			Name: "nested blocks, sets and maps",
+			TfObject: map[string]interface{}{
+				"listeners": []interface{}{
+					map[string]interface{}{
+						"port":     8080,
+						"protocol": "http",
+						"health_check": []interface{}{map[string]interface{}{
+							"enabled":         false,
+							"interval_millis": 5000,
+							"threshold":       0.5,
+						}},
+					},
+				},
+				"security_group_ids": schema.NewSet(schema.HashString, []interface{}{"sg-12345678"}),
+				"tags": map[string]interface{}{
+					"Name":  "test",
+					"Empty": "",
+				},
+			},
+			Expected: &testAutoFlexService{
+				Listeners: []*testAutoFlexListener{
+					{
+						HealthCheck: &testAutoFlexHealthCheck{
+							Enabled:        aws.Bool(false),
+							IntervalMillis: aws.Int64(5000),
+							Threshold:      aws.Float64(0.5),
+						},
+						Port:     aws.Int64(8080),
+						Protocol: aws.String("http"),
+					},
+				},
+				SecurityGroupIds: aws.StringSlice([]string{"sg-12345678"}),
+				Tags: map[string]*string{
+					"Name":  aws.String("test"),
+					"Empty": aws.String(""),
+				},
+			},
+		},
+		{
+			Name: "overrides",
+			TfObject: map[string]interface{}{
+				"arn":       "ignored",
+				"vpc":       "vpc-12345678",
+				"weight_pc": "50",
+			},
+			OptFns: []AutoFlexOptionsFunc{
+				WithIgnoredField("arn"),
+				WithFieldName("vpc", "VpcId"),
+				WithFieldName("weight_pc", "Weight"),
+				WithExpander("weight_pc", func(v interface{}) (interface{}, error) {
+					i, err := strconv.ParseInt(v.(string), 10, 64)
+
+					return aws.Int64(i), err
+				}),
+			},
+			Expected: &testAutoFlexService{
+				VpcId:  aws.String("vpc-12345678"),
+				Weight: aws.Int64(50),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := &testAutoFlexService{}
+
+			if err := Expand(testCase.TfObject, got, testCase.OptFns...); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestExpandErrors(t *testing.T) {
+	if err := Expand(map[string]interface{}{}, testAutoFlexService{}); err == nil {
+		t.Error("expected error for non-pointer target")
+	}
+
+	if err := Expand(map[string]interface{}{"weight": "heavy"}, &testAutoFlexService{}); err == nil {
+		t.Error("expected error for mismatched type")
+	}
+
+	if err := Expand(map[string]interface{}{"created_at": "yesterday"}, &testAutoFlexService{}); err == nil {
+		t.Error("expected error for invalid timestamp")
+	}
+}
+
+func TestExpandSlice(t *testing.T) {
+	var got []*testAutoFlexListener
+
+	tfList := []interface{}{
+		map[string]interface{}{"port": 80},
+		map[string]interface{}{"port": 443},
+	}
+
+	if err := Expand(tfList, &got); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []*testAutoFlexListener{
+		{Port: aws.Int64(80)},
+		{Port: aws.Int64(443)},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %#v, expected %#v", got, expected)
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	createdAt := time.Date(2021, 10, 1, 12, 30, 0, 0, time.UTC)
+
+	apiObject := &testAutoFlexService{
+		ARN:       aws.String("arn:aws:example:us-west-2:123456789012:service/test"), //lintignore:AWSAT003,AWSAT005
+		CreatedAt: &createdAt,
+		Listeners: []*testAutoFlexListener{
+			{
+				HealthCheck: &testAutoFlexHealthCheck{
+					Enabled:        aws.Bool(true),
+					IntervalMillis: aws.Int64(5000),
+				},
+				Port: aws.Int64(8080),
+			},
+		},
+		SecurityGroupIds: aws.StringSlice([]string{"sg-12345678"}),
+		Tags: map[string]*string{
+			"Name": aws.String("test"),
+		},
+	}
+
+	testCases := []struct {
+		Name      string
+		APIObject interface{}
+		OptFns    []AutoFlexOptionsFunc
+		Expected  []interface{}
+	}{
+		{
+			Name:      "nil",
+			APIObject: (*testAutoFlexService)(nil),
+			Expected:  nil,
+		},
+		{
+			Name:      "no schema",
+			APIObject: apiObject,
+			Expected: []interface{}{map[string]interface{}{
+				"arn":        "arn:aws:example:us-west-2:123456789012:service/test", //lintignore:AWSAT003,AWSAT005
+				"created_at": "2021-10-01T12:30:00Z",
+				"listeners": []interface{}{map[string]interface{}{
+					"health_check": []interface{}{map[string]interface{}{
+						"enabled":         true,
+						"interval_millis": 5000,
+					}},
+					"port": 8080,
+				}},
+				"security_group_ids": []interface{}{"sg-12345678"},
+				"tags":               map[string]interface{}{"Name": "test"},
+			}},
+		},
+		{
+			Name:      "schema and overrides",
+			APIObject: apiObject,
+			OptFns: []AutoFlexOptionsFunc{
+				WithSchema(map[string]*schema.Schema{
+					"listener": {
+						Type: schema.TypeList,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"port": {Type: schema.TypeInt},
+							},
+						},
+					},
+					"created_at": {Type: schema.TypeString},
+					"vpc_id":     {Type: schema.TypeString},
+				}),
+				WithFieldName("listener", "Listeners"),
+				WithFlattener("created_at", func(v interface{}) (interface{}, error) {
+					return v.(*time.Time).Format("2006-01-02"), nil
+				}),
+			},
+			Expected: []interface{}{map[string]interface{}{
+				"created_at": "2021-10-01",
+				"listener": []interface{}{map[string]interface{}{
+					"port": 8080,
+				}},
+			}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := Flatten(testCase.APIObject, testCase.OptFns...)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	testCases := map[string]string{
+		"VpcId":                "vpc_id",
+		"ARN":                  "arn",
+		"DBInstanceIdentifier": "db_instance_identifier",
+		"DBInstanceARN":        "db_instance_arn",
+		"Ipv6CidrBlock":        "ipv6_cidr_block",
+		"S3Key":                "s3_key",
+	}
+
+	for name, expected := range testCases {
+		if got := SnakeCase(name); got != expected {
+			t.Errorf("SnakeCase(%q) = %q, expected %q", name, got, expected)
+		}
+	}
+}
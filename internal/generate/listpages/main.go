@@ -9,12 +9,12 @@ import (
 	"fmt"
 	"go/ast"
 	"go/format"
-	"html/template"
 	"log"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
+	"text/template"
 
 	"golang.org/x/tools/go/packages"
 )
@@ -24,9 +24,12 @@ const (
 )
 
 var (
-	listOps   = flag.String("ListOps", "", "ListOps")
-	paginator = flag.String("Paginator", "NextToken", "name of the pagination token field")
-	export    = flag.Bool("Export", false, "whether to export the list functions")
+	listOps         = flag.String("ListOps", "", "ListOps")
+	paginator       = flag.String("Paginator", "NextToken", "name of the pagination token field")
+	inputPaginator  = flag.String("InputPaginator", "", "name of the input pagination token field, if different from Paginator")
+	outputPaginator = flag.String("OutputPaginator", "", "name or path of the output pagination token field, if different from Paginator")
+	collect         = flag.Bool("Collect", false, "whether to generate functions that collect all items")
+	export          = flag.Bool("Export", false, "whether to export the list functions")
 )
 
 func usage() {
@@ -40,8 +43,9 @@ type TemplateData struct {
 	AWSService     string
 	ServicePackage string
 
-	ListOps   string
-	Paginator string
+	ListOps         string
+	InputPaginator  string
+	OutputPaginator string
 }
 
 func main() {
@@ -63,31 +67,62 @@ func main() {
 	}
 
 	templateData := TemplateData{
-		AWSService:     awsService,
-		ServicePackage: servicePackage,
-		ListOps:        *listOps,
-		Paginator:      *paginator,
+		AWSService:      awsService,
+		ServicePackage:  servicePackage,
+		ListOps:         *listOps,
+		InputPaginator:  *paginator,
+		OutputPaginator: *paginator,
+	}
+
+	if *inputPaginator != "" {
+		templateData.InputPaginator = *inputPaginator
+	}
+
+	if *outputPaginator != "" {
+		templateData.OutputPaginator = *outputPaginator
 	}
 
 	functions := strings.Split(templateData.ListOps, ",")
 	sort.Strings(functions)
 
 	g := Generator{
-		paginator: templateData.Paginator,
-		tmpl:      template.Must(template.New("function").Parse(functionTemplate)),
+		inputPaginator:  templateData.InputPaginator,
+		outputPaginator: templateData.OutputPaginator,
+		collect:         *collect,
+		tmpl:            template.Must(template.New("function").Parse(functionTemplate)),
 	}
 
 	sourcePackage := fmt.Sprintf("github.com/aws/aws-sdk-go/service/%s", templateData.AWSService)
 	g.parsePackage(sourcePackage)
 
+	funcSpecs := make([]FuncSpec, len(functions))
+	awsPkg := false
+
+	for i, function := range functions {
+		// An optional items field or path follows the function name, e.g. DescribeCapacityProviders:CapacityProviders.
+		parts := strings.SplitN(function, ":", 2)
+		itemsPath := ""
+
+		if len(parts) == 2 {
+			itemsPath = parts[1]
+		}
+
+		funcSpecs[i] = g.newFuncSpec(parts[0], itemsPath, *export)
+
+		if !funcSpecs[i].SDKPages {
+			awsPkg = true
+		}
+	}
+
 	g.printHeader(HeaderInfo{
 		Parameters:         strings.Join(os.Args[1:], " "),
 		DestinationPackage: templateData.ServicePackage,
 		SourcePackage:      sourcePackage,
+		AWSPkg:             awsPkg,
 	})
 
-	for _, functionName := range functions {
-		g.generateFunction(functionName, *export)
+	for _, funcSpec := range funcSpecs {
+		g.generateFunction(funcSpec)
 	}
 
 	src := g.format()
@@ -102,13 +137,16 @@ type HeaderInfo struct {
 	Parameters         string
 	DestinationPackage string
 	SourcePackage      string
+	AWSPkg             bool
 }
 
 type Generator struct {
-	buf       bytes.Buffer
-	pkg       *Package
-	tmpl      *template.Template
-	paginator string
+	buf             bytes.Buffer
+	pkg             *Package
+	tmpl            *template.Template
+	inputPaginator  string
+	outputPaginator string
+	collect         bool
 }
 
 func (g *Generator) Printf(format string, args ...interface{}) {
@@ -120,8 +158,9 @@ type PackageFile struct {
 }
 
 type Package struct {
-	name  string
-	files []*PackageFile
+	name    string
+	files   []*PackageFile
+	structs map[string]*ast.StructType
 }
 
 func (g *Generator) printHeader(headerInfo HeaderInfo) {
@@ -148,14 +187,30 @@ func (g *Generator) parsePackage(sourcePackage string) {
 
 func (g *Generator) addPackage(pkg *packages.Package) {
 	g.pkg = &Package{
-		name:  pkg.Name,
-		files: make([]*PackageFile, len(pkg.Syntax)),
+		name:    pkg.Name,
+		files:   make([]*PackageFile, len(pkg.Syntax)),
+		structs: make(map[string]*ast.StructType),
 	}
 
 	for i, file := range pkg.Syntax {
 		g.pkg.files[i] = &PackageFile{
 			file: file,
 		}
+
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				if typeSpec, ok := spec.(*ast.TypeSpec); ok {
+					if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+						g.pkg.structs[typeSpec.Name.Name] = structType
+					}
+				}
+			}
+		}
 	}
 }
 
@@ -165,29 +220,39 @@ type FuncSpec struct {
 	RecvType   string
 	ParamType  string
 	ResultType string
-	Paginator  string
-}
 
-func (g *Generator) generateFunction(functionName string, export bool) {
-	var function *ast.FuncDecl
+	// SDKPages is set when the AWS Go SDK already defines a ...PagesWithContext method.
+	SDKPages bool
+
+	InputPaginator        string
+	OutputPaginator       string
+	OutputPaginatorGuards []string
 
-	// TODO: check if a Pages() function has been defined
+	Collect     bool
+	ItemsPath   string
+	ItemsGuards []string
+	ItemType    string
+}
+
+func (g *Generator) findFunction(functionName string) *ast.FuncDecl {
 	for _, file := range g.pkg.files {
 		if file.file != nil {
 			for _, decl := range file.file.Decls {
 				if funcDecl, ok := decl.(*ast.FuncDecl); ok {
-					if funcDecl.Name.Name == functionName {
-						function = funcDecl
-						break
+					if funcDecl.Recv != nil && funcDecl.Name.Name == functionName {
+						return funcDecl
 					}
 				}
 			}
-			if function != nil {
-				break
-			}
 		}
 	}
 
+	return nil
+}
+
+func (g *Generator) newFuncSpec(functionName string, itemsPath string, export bool) FuncSpec {
+	function := g.findFunction(functionName)
+
 	if function == nil {
 		log.Fatalf("function \"%s\" not found", functionName)
 	}
@@ -204,13 +269,189 @@ func (g *Generator) generateFunction(functionName string, export bool) {
 		RecvType:   g.expandTypeField(function.Recv),
 		ParamType:  g.expandTypeField(function.Type.Params),  // Assumes there is a single input parameter
 		ResultType: g.expandTypeField(function.Type.Results), // Assumes we can take the first return parameter
-		Paginator:  g.paginator,
+		SDKPages:   g.findFunction(fmt.Sprintf("%sPagesWithContext", functionName)) != nil,
+		Collect:    g.collect,
+	}
+
+	outputType := g.typeName(function.Type.Results.List[0].Type)
+	outputPaginatorPath := g.findFieldPath(outputType, g.outputPaginator)
+
+	if !funcSpec.SDKPages {
+		if outputPaginatorPath == nil {
+			log.Fatalf("function \"%s\": output pagination token field \"%s\" not found", functionName, g.outputPaginator)
+		}
+
+		funcSpec.InputPaginator = g.inputPaginator
+		funcSpec.OutputPaginator = strings.Join(outputPaginatorPath, ".")
+		funcSpec.OutputPaginatorGuards = guards(outputPaginatorPath)
 	}
 
+	if funcSpec.Collect {
+		var path []string
+
+		if itemsPath != "" {
+			path = strings.Split(itemsPath, ".")
+		} else {
+			// By default the items are next to the output pagination token.
+			var parent []string
+
+			if len(outputPaginatorPath) > 1 {
+				parent = outputPaginatorPath[:len(outputPaginatorPath)-1]
+			}
+
+			path = g.findItemsPath(functionName, outputType, parent)
+		}
+
+		funcSpec.ItemsPath = strings.Join(path, ".")
+		funcSpec.ItemsGuards = guards(path)
+		funcSpec.ItemType = g.itemType(functionName, outputType, path)
+	}
+
+	return funcSpec
+}
+
+func (g *Generator) generateFunction(funcSpec FuncSpec) {
 	err := g.tmpl.Execute(&g.buf, funcSpec)
 	if err != nil {
-		log.Fatalf("error writing function \"%s\": %s", functionName, err)
+		log.Fatalf("error writing function \"%s\": %s", funcSpec.AWSName, err)
+	}
+}
+
+// findFieldPath returns the path to the named field, which is either a field of the struct
+// or of one of its nested structs. A dotted name is taken to be the path.
+func (g *Generator) findFieldPath(structName string, name string) []string {
+	if strings.Contains(name, ".") {
+		return strings.Split(name, ".")
+	}
+
+	structType, ok := g.pkg.structs[structName]
+	if !ok {
+		return nil
+	}
+
+	if g.findField(structType, name) != nil {
+		return []string{name}
+	}
+
+	for _, field := range structType.Fields.List {
+		if len(field.Names) == 0 || !field.Names[0].IsExported() {
+			continue
+		}
+
+		if nested, ok := g.pkg.structs[g.typeName(field.Type)]; ok && g.findField(nested, name) != nil {
+			return []string{field.Names[0].Name, name}
+		}
+	}
+
+	return nil
+}
+
+// findItemsPath returns the path to the single slice of pointers field in the struct at the specified path.
+func (g *Generator) findItemsPath(functionName string, structName string, path []string) []string {
+	structType := g.structAtPath(functionName, structName, path)
+
+	var items []string
+
+	for _, field := range structType.Fields.List {
+		if len(field.Names) == 0 || !field.Names[0].IsExported() {
+			continue
+		}
+
+		if array, ok := field.Type.(*ast.ArrayType); ok {
+			if _, ok := array.Elt.(*ast.StarExpr); ok {
+				items = append(items, field.Names[0].Name)
+			}
+		}
+	}
+
+	if len(items) != 1 {
+		log.Fatalf("function \"%s\": unable to determine items field from %v, use -ListOps=%s:<field>", functionName, items, functionName)
 	}
+
+	return append(append([]string{}, path...), items[0])
+}
+
+func (g *Generator) itemType(functionName string, structName string, path []string) string {
+	structType := g.structAtPath(functionName, structName, path[:len(path)-1])
+	field := g.findField(structType, path[len(path)-1])
+
+	if field == nil {
+		log.Fatalf("function \"%s\": items field \"%s\" not found", functionName, strings.Join(path, "."))
+	}
+
+	if array, ok := field.Type.(*ast.ArrayType); ok {
+		if star, ok := array.Elt.(*ast.StarExpr); ok {
+			if ident, ok := star.X.(*ast.Ident); ok {
+				if !ident.IsExported() {
+					return fmt.Sprintf("*%s", ident.Name)
+				}
+
+				return fmt.Sprintf("*%s", g.expandTypeExpr(ident))
+			}
+		}
+	}
+
+	log.Fatalf("function \"%s\": items field \"%s\" is not a slice of pointers", functionName, strings.Join(path, "."))
+	return ""
+}
+
+func (g *Generator) structAtPath(functionName string, structName string, path []string) *ast.StructType {
+	structType, ok := g.pkg.structs[structName]
+
+	for _, name := range path {
+		if !ok {
+			break
+		}
+
+		field := g.findField(structType, name)
+
+		if field == nil {
+			log.Fatalf("function \"%s\": field \"%s\" not found", functionName, name)
+		}
+
+		structType, ok = g.pkg.structs[g.typeName(field.Type)]
+	}
+
+	if !ok {
+		log.Fatalf("function \"%s\": struct for \"%s\" not found", functionName, strings.Join(path, "."))
+	}
+
+	return structType
+}
+
+func (g *Generator) findField(structType *ast.StructType, name string) *ast.Field {
+	for _, field := range structType.Fields.List {
+		for _, fieldName := range field.Names {
+			if fieldName.Name == name {
+				return field
+			}
+		}
+	}
+
+	return nil
+}
+
+func (g *Generator) typeName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+
+	return ""
+}
+
+// guards returns the paths of the intermediate structs along a field path, which must be checked for nil.
+func guards(path []string) []string {
+	var result []string
+
+	for i := 1; i < len(path); i++ {
+		result = append(result, strings.Join(path[:i], "."))
+	}
+
+	return result
 }
 
 func (g *Generator) expandTypeField(field *ast.FieldList) string {
@@ -238,8 +479,9 @@ package {{ .DestinationPackage }}
 
 import (
 	"context"
-
+{{ if .AWSPkg }}
 	"github.com/aws/aws-sdk-go/aws"
+{{- end }}
 	"{{ .SourcePackage }}"
 )
 `
@@ -251,21 +493,55 @@ func {{ .Name }}Pages(conn {{ .RecvType }}, input {{ .ParamType }}, fn func({{ .
 }
 
 func {{ .Name }}PagesWithContext(ctx context.Context, conn {{ .RecvType }}, input {{ .ParamType }}, fn func({{ .ResultType }}, bool) bool) error {
+{{- if .SDKPages }}
+	return conn.{{ .AWSName }}PagesWithContext(ctx, input, fn)
+{{- else }}
 	for {
 		output, err := conn.{{ .AWSName }}WithContext(ctx, input)
 		if err != nil {
 			return err
 		}
 
-		lastPage := aws.StringValue(output.{{ .Paginator }}) == ""
+		lastPage := {{ range .OutputPaginatorGuards }}output.{{ . }} == nil || {{ end }}aws.StringValue(output.{{ .OutputPaginator }}) == ""
 		if !fn(output, lastPage) || lastPage {
 			break
 		}
 
-		input.{{ .Paginator }} = output.{{ .Paginator }}
+		input.{{ .InputPaginator }} = output.{{ .OutputPaginator }}
 	}
 	return nil
+{{- end }}
+}
+{{- if .Collect }}
+
+func {{ .Name }}All(conn {{ .RecvType }}, input {{ .ParamType }}, filter func({{ .ItemType }}) bool) ([]{{ .ItemType }}, error) {
+	return {{ .Name }}AllWithContext(context.Background(), conn, input, filter)
+}
+
+func {{ .Name }}AllWithContext(ctx context.Context, conn {{ .RecvType }}, input {{ .ParamType }}, filter func({{ .ItemType }}) bool) ([]{{ .ItemType }}, error) {
+	var output []{{ .ItemType }}
+
+	err := {{ .Name }}PagesWithContext(ctx, conn, input, func(page {{ .ResultType }}, lastPage bool) bool {
+		if page == nil{{ range .ItemsGuards }} || page.{{ . }} == nil{{ end }} {
+			return !lastPage
+		}
+
+		for _, v := range page.{{ .ItemsPath }} {
+			if v != nil && (filter == nil || filter(v)) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
 }
+{{- end }}
 `
 
 func (g *Generator) format() []byte {
@@ -336,7 +612,7 @@ func awsServiceNameUpper(s string) (string, error) {
 	return "", fmt.Errorf("unable to find AWS service name for %s", s)
 }
 
-//awsServiceNames provides correct names and capitalization as used by AWS in client var
+// awsServiceNames provides correct names and capitalization as used by AWS in client var
 var awsServiceNames map[string]string
 
 func init() {
@@ -15,7 +15,10 @@ import (
 	"text/template"
 )
 
-const filename = `tags_gen.go`
+const (
+	filename     = `tags_gen.go`
+	testFilename = `tags_gen_test.go`
+)
 
 var (
 	getTag             = flag.Bool("GetTag", false, "whether to generate GetTag")
@@ -24,6 +27,7 @@ var (
 	serviceTagsSlice   = flag.Bool("ServiceTagsSlice", false, "whether to generate service tags for slice")
 	untagInNeedTagType = flag.Bool("UntagInNeedTagType", false, "whether Untag input needs tag type")
 	updateTags         = flag.Bool("UpdateTags", false, "whether to generate UpdateTags")
+	tests              = flag.Bool("Tests", false, "whether to generate unit tests for the generated code")
 
 	listTagsInFiltIDName  = flag.String("ListTagsInFiltIDName", "", "listTagsInFiltIDName")
 	listTagsInIDElem      = flag.String("ListTagsInIDElem", "ResourceArn", "listTagsInIDElem")
@@ -37,6 +41,7 @@ var (
 	tagKeyType            = flag.String("TagKeyType", "", "tagKeyType")
 	tagOp                 = flag.String("TagOp", "TagResource", "tagOp")
 	tagOpBatchSize        = flag.String("TagOpBatchSize", "", "tagOpBatchSize")
+	tagOutType            = flag.String("TagOutType", "", "tagOutType")
 	tagResTypeElem        = flag.String("TagResTypeElem", "", "tagResTypeElem")
 	tagType               = flag.String("TagType", "Tag", "tagType")
 	tagType2              = flag.String("TagType2", "", "tagType")
@@ -44,10 +49,13 @@ var (
 	tagTypeIDElem         = flag.String("TagTypeIDElem", "", "tagTypeIDElem")
 	tagTypeKeyElem        = flag.String("TagTypeKeyElem", "Key", "tagTypeKeyElem")
 	tagTypeValElem        = flag.String("TagTypeValElem", "Value", "tagTypeValElem")
+	testTagInTagsElem     = flag.String("TestTagInTagsElem", "", "testTagInTagsElem")
+	testUntagInTagsElem   = flag.String("TestUntagInTagsElem", "", "testUntagInTagsElem")
 	untagInCustomVal      = flag.String("UntagInCustomVal", "", "untagInCustomVal")
 	untagInNeedTagKeyType = flag.String("UntagInNeedTagKeyType", "", "untagInNeedTagKeyType")
 	untagInTagsElem       = flag.String("UntagInTagsElem", "TagKeys", "untagInTagsElem")
 	untagOp               = flag.String("UntagOp", "UntagResource", "untagOp")
+	untagOutType          = flag.String("UntagOutType", "", "untagOutType")
 
 	parentNotFoundErrCode = flag.String("ParentNotFoundErrCode", "", "Parent 'NotFound' Error Code")
 	parentNotFoundErrMsg  = flag.String("ParentNotFoundErrMsg", "", "Parent 'NotFound' Error Message")
@@ -61,9 +69,10 @@ func usage() {
 }
 
 type TemplateData struct {
-	AWSService     string
-	ClientType     string
-	ServicePackage string
+	AWSService      string
+	ClientInterface string
+	ClientType      string
+	ServicePackage  string
 
 	ListTagsInFiltIDName    string
 	ListTagsInIDElem        string
@@ -80,6 +89,7 @@ type TemplateData struct {
 	TagKeyType              string
 	TagOp                   string
 	TagOpBatchSize          string
+	TagOutType              string
 	TagPackage              string
 	TagResTypeElem          string
 	TagType                 string
@@ -94,13 +104,22 @@ type TemplateData struct {
 	UntagInNeedTagType      bool
 	UntagInTagsElem         string
 	UntagOp                 string
+	UntagOutType            string
 
 	// The following are specific to writing import paths in the `headerBody`;
 	// to include the package, set the corresponding field's value to true
+	ClientIfacePkg  bool
 	FmtPkg          bool
 	HelperSchemaPkg bool
 	StrConvPkg      bool
 	TfResourcePkg   bool
+
+	// The following are specific to writing tests
+	ServiceTags         bool
+	TestInputPackage    string
+	TestTagInTagsElem   string
+	TestUntagInTagsElem string
+	TestUpdateTags      bool
 }
 
 func main() {
@@ -121,7 +140,8 @@ func main() {
 		log.Fatalf("encountered: %s", err)
 	}
 
-	clientType := fmt.Sprintf("*%s.%s", awsService, awsServiceUpper)
+	// Generated functions accept the client interface so that tests can use a fake client.
+	clientInterface := fmt.Sprintf("%siface.%sAPI", awsService, awsServiceUpper)
 
 	tagPackage := awsService
 
@@ -129,11 +149,29 @@ func main() {
 		tagPackage = "waf"
 	}
 
+	// The combined tag and untag operation uses the service package's input type.
+	testInputPackage := tagPackage
+
+	if *tagOp == *untagOp {
+		testInputPackage = awsService
+	}
+
+	// Most tag and untag operations return an output type named after the operation.
+	if *tagOutType == "" {
+		*tagOutType = *tagOp + "Output"
+	}
+
+	if *untagOutType == "" {
+		*untagOutType = *untagOp + "Output"
+	}
+
 	templateData := TemplateData{
-		AWSService:     awsService,
-		ClientType:     clientType,
-		ServicePackage: servicePackage,
+		AWSService:      awsService,
+		ClientInterface: clientInterface,
+		ClientType:      clientInterface,
+		ServicePackage:  servicePackage,
 
+		ClientIfacePkg:  *getTag || *listTags || *updateTags,
 		FmtPkg:          *updateTags,
 		HelperSchemaPkg: awsService == "autoscaling",
 		StrConvPkg:      awsService == "autoscaling",
@@ -153,6 +191,7 @@ func main() {
 		TagKeyType:              *tagKeyType,
 		TagOp:                   *tagOp,
 		TagOpBatchSize:          *tagOpBatchSize,
+		TagOutType:              *tagOutType,
 		TagPackage:              tagPackage,
 		TagResTypeElem:          *tagResTypeElem,
 		TagType:                 *tagType,
@@ -167,6 +206,13 @@ func main() {
 		UntagInNeedTagType:      *untagInNeedTagType,
 		UntagInTagsElem:         *untagInTagsElem,
 		UntagOp:                 *untagOp,
+		UntagOutType:            *untagOutType,
+
+		ServiceTags:         *serviceTagsMap || *serviceTagsSlice,
+		TestInputPackage:    testInputPackage,
+		TestTagInTagsElem:   *testTagInTagsElem,
+		TestUntagInTagsElem: *testUntagInTagsElem,
+		TestUpdateTags:      *updateTags,
 	}
 
 	if *getTag || *listTags || *serviceTagsMap || *serviceTagsSlice || *updateTags {
@@ -175,31 +221,43 @@ func main() {
 		if !*getTag && !*listTags && !*serviceTagsSlice && !*updateTags {
 			templateData.AWSService = ""
 		}
-		writeTemplate(headerBody, "header", templateData)
+		writeTemplate(filename, headerBody, "header", templateData)
 	}
 
 	if *getTag {
-		writeTemplate(gettagBody, "gettag", templateData)
+		writeTemplate(filename, gettagBody, "gettag", templateData)
 	}
 
 	if *listTags {
-		writeTemplate(listtagsBody, "listtags", templateData)
+		writeTemplate(filename, listtagsBody, "listtags", templateData)
 	}
 
 	if *serviceTagsMap {
-		writeTemplate(servicetagsmapBody, "servicetagsmap", templateData)
+		writeTemplate(filename, servicetagsmapBody, "servicetagsmap", templateData)
 	}
 
 	if *serviceTagsSlice {
-		writeTemplate(servicetagssliceBody, "servicetagsslice", templateData)
+		writeTemplate(filename, servicetagssliceBody, "servicetagsslice", templateData)
 	}
 
 	if *updateTags {
-		writeTemplate(updatetagsBody, "updatetags", templateData)
+		writeTemplate(filename, updatetagsBody, "updatetags", templateData)
+	}
+
+	if *tests && (*serviceTagsMap || *serviceTagsSlice || *updateTags) {
+		writeTemplate(testFilename, testHeaderBody, "testheader", templateData)
+
+		if *serviceTagsMap || *serviceTagsSlice {
+			writeTemplate(testFilename, testServiceTagsBody, "testservicetags", templateData)
+		}
+
+		if *updateTags {
+			writeTemplate(testFilename, testUpdateTagsBody, "testupdatetags", templateData)
+		}
 	}
 }
 
-func writeTemplate(body string, templateName string, td TemplateData) {
+func writeTemplate(filename string, body string, templateName string, td TemplateData) {
 	// If the file doesn't exist, create it, or append to the file
 	f, err := os.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
 	if err != nil {
@@ -247,7 +305,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	{{- if .AWSService }}
+	{{- if or (eq .AWSService .TagPackage) (not .ClientIfacePkg) }}
 	"github.com/aws/aws-sdk-go/service/{{ .AWSService }}"
+	{{- end }}
+	{{- if .ClientIfacePkg }}
+	"github.com/aws/aws-sdk-go/service/{{ .AWSService }}/{{ .AWSService }}iface"
+	{{- end }}
 	{{- if ne .AWSService .TagPackage }}
 	"github.com/aws/aws-sdk-go/service/{{ .TagPackage }}"
 	{{- end }}
@@ -778,6 +841,317 @@ func UpdateTags(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }
 }
 `
 
+var testHeaderBody = `
+// Code generated by internal/generate/tags/main.go; DO NOT EDIT.
+package {{ .ServicePackage }}
+
+import (
+	{{- if .TestUpdateTags }}
+	"fmt"
+	{{- end }}
+	{{- if or .ServiceTags .TestUpdateTags }}
+	"reflect"
+	{{- end }}
+	{{- if .TestUpdateTags }}
+	"sort"
+	{{- end }}
+	"testing"
+{{ if .TestUpdateTags }}
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/{{ .AWSService }}/{{ .AWSService }}iface"
+	"github.com/aws/aws-sdk-go/service/{{ .TestInputPackage }}"
+	{{- end }}
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+`
+
+var testServiceTagsBody = `
+func TestKeyValueTags(t *testing.T) {
+	testCases := []struct {
+		Name string
+		Tags map[string]string
+	}{
+		{
+			Name: "empty",
+			Tags: map[string]string{},
+		},
+		{
+			Name: "single",
+			Tags: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			Name: "multiple",
+			Tags: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+		{
+			Name: "empty value",
+			Tags: map[string]string{
+				"key1": "",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			tags := tftags.New(testCase.Tags)
+
+			got := KeyValueTags(Tags(tags){{ if .TagTypeIDElem }}, "identifier"{{ if .TagResTypeElem }}, "resourceType"{{ end }}{{ end }})
+
+			if !reflect.DeepEqual(got.Map(), tags.Map()) {
+				t.Errorf("expected %v, got %v", tags.Map(), got.Map())
+			}
+			{{- if .TagKeyType }}
+
+			if got, expected := len(TagKeys(tags)), len(tags); got != expected {
+				t.Errorf("expected %d tag keys, got %d", expected, got)
+			}
+			{{- end }}
+		})
+	}
+}
+`
+
+var testUpdateTagsBody = `
+type testUpdateTagsClient struct {
+	{{ .ClientInterface }}
+
+	tagInputs   []*{{ .TestInputPackage }}.{{ .TagOp }}Input
+	{{- if ne .TagOp .UntagOp }}
+	untagInputs []*{{ .TestInputPackage }}.{{ .UntagOp }}Input
+	{{- end }}
+}
+
+func (c *testUpdateTagsClient) {{ .TagOp }}(input *{{ .TestInputPackage }}.{{ .TagOp }}Input) (*{{ .TestInputPackage }}.{{ .TagOutType }}, error) {
+	c.tagInputs = append(c.tagInputs, input)
+
+	return &{{ .TestInputPackage }}.{{ .TagOutType }}{}, nil
+}
+{{- if ne .TagOp .UntagOp }}
+
+func (c *testUpdateTagsClient) {{ .UntagOp }}(input *{{ .TestInputPackage }}.{{ .UntagOp }}Input) (*{{ .TestInputPackage }}.{{ .UntagOutType }}, error) {
+	c.untagInputs = append(c.untagInputs, input)
+
+	return &{{ .TestInputPackage }}.{{ .UntagOutType }}{}, nil
+}
+{{- end }}
+
+func testUpdateTagsTags(n int, value string) map[string]string {
+	m := make(map[string]string, n)
+
+	for i := 0; i < n; i++ {
+		m[fmt.Sprintf("key%d", i)] = value
+	}
+
+	return m
+}
+
+func testUpdateTagsKeys(tags map[string]string) []string {
+	keys := make([]string, 0, len(tags))
+
+	for k := range tags {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func testUpdateTagsCheckIdentifier(t *testing.T, identifier {{ if .TagInIDNeedSlice }}[]*string{{ else }}*string{{ end }}{{ if .TagResTypeElem }}, resourceType *string{{ end }}) {
+	t.Helper()
+	{{ if .TagInIDNeedSlice }}
+	if got, expected := aws.StringValueSlice(identifier), []string{"identifier"}; !reflect.DeepEqual(got, expected) {
+	{{- else }}
+	if got, expected := aws.StringValue(identifier), "identifier"; got != expected {
+	{{- end }}
+		t.Errorf("expected identifier %v, got %v", expected, got)
+	}
+	{{- if .TagResTypeElem }}
+
+	if got, expected := aws.StringValue(resourceType), "resourceType"; got != expected {
+		t.Errorf("expected resource type %s, got %s", expected, got)
+	}
+	{{- end }}
+}
+
+// testUpdateTagsTagged returns the tags sent in all {{ .TagOp }} requests.
+func testUpdateTagsTagged(t *testing.T, inputs []*{{ .TestInputPackage }}.{{ .TagOp }}Input) map[string]string {
+	t.Helper()
+
+	tags := tftags.New(nil)
+
+	for _, input := range inputs {
+		{{- if .TagTypeIDElem }}
+		for _, tag := range input.{{ .TagInTagsElem }} {
+			testUpdateTagsCheckIdentifier(t, tag.{{ .TagTypeIDElem }}{{ if .TagResTypeElem }}, tag.{{ .TagResTypeElem }}{{ end }})
+		}
+		{{- else }}
+		testUpdateTagsCheckIdentifier(t, input.{{ .TagInIDElem }}{{ if .TagResTypeElem }}, input.{{ .TagResTypeElem }}{{ end }})
+		{{- end }}
+		{{ if .TestTagInTagsElem }}
+		tags = tags.Merge(KeyValueTags(input.{{ .TestTagInTagsElem }}))
+		{{- else if .TagInCustomVal }}
+		tags = tags.Merge(tftags.New(input.{{ .TagInTagsElem }}))
+		{{- else }}
+		tags = tags.Merge(KeyValueTags(input.{{ .TagInTagsElem }}{{ if .TagTypeIDElem }}, "identifier"{{ if .TagResTypeElem }}, "resourceType"{{ end }}{{ end }}))
+		{{- end }}
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return tags.Map()
+}
+
+// testUpdateTagsUntagged returns the sorted tag keys sent in all {{ .UntagOp }} requests.
+func testUpdateTagsUntagged(t *testing.T, inputs []*{{ .TestInputPackage }}.{{ .UntagOp }}Input) []string {
+	t.Helper()
+
+	var keys []string
+
+	for _, input := range inputs {
+		{{- if ne .TagOp .UntagOp }}
+		{{- if .TagTypeIDElem }}
+		for _, tag := range input.{{ .UntagInTagsElem }} {
+			testUpdateTagsCheckIdentifier(t, tag.{{ .TagTypeIDElem }}{{ if .TagResTypeElem }}, tag.{{ .TagResTypeElem }}{{ end }})
+		}
+		{{- else }}
+		testUpdateTagsCheckIdentifier(t, input.{{ .TagInIDElem }}{{ if .TagResTypeElem }}, input.{{ .TagResTypeElem }}{{ end }})
+		{{- end }}
+		{{- end }}
+		{{ if .TestUntagInTagsElem }}
+		keys = append(keys, aws.StringValueSlice(input.{{ .TestUntagInTagsElem }})...)
+		{{- else if .UntagInNeedTagType }}
+		keys = append(keys, KeyValueTags(input.{{ .UntagInTagsElem }}{{ if .TagTypeIDElem }}, "identifier"{{ if .TagResTypeElem }}, "resourceType"{{ end }}{{ end }}).Keys()...)
+		{{- else if .UntagInNeedTagKeyType }}
+		for _, tag := range input.{{ .UntagInTagsElem }} {
+			keys = append(keys, aws.StringValue(tag.{{ .TagTypeKeyElem }}))
+		}
+		{{- else }}
+		keys = append(keys, aws.StringValueSlice(input.{{ .UntagInTagsElem }})...)
+		{{- end }}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func TestUpdateTags(t *testing.T) {
+	{{- if .TagOpBatchSize }}
+	batchSize := {{ .TagOpBatchSize }}
+	{{ end }}
+	testCases := []struct {
+		Name               string
+		OldTags            map[string]string
+		NewTags            map[string]string
+		ExpectedTagCalls   int
+		{{- if ne .TagOp .UntagOp }}
+		ExpectedUntagCalls int
+		{{- end }}
+		ExpectedTagged     map[string]string
+		ExpectedUntagged   []string
+	}{
+		{
+			Name:    "no changes",
+			OldTags: testUpdateTagsTags(2, "value"),
+			NewTags: testUpdateTagsTags(2, "value"),
+		},
+		{
+			Name:             "add",
+			NewTags:          testUpdateTagsTags(2, "value"),
+			ExpectedTagCalls: 1,
+			ExpectedTagged:   testUpdateTagsTags(2, "value"),
+		},
+		{
+			Name:             "update",
+			OldTags:          testUpdateTagsTags(2, "old"),
+			NewTags:          testUpdateTagsTags(2, "new"),
+			ExpectedTagCalls: 1,
+			ExpectedTagged:   testUpdateTagsTags(2, "new"),
+		},
+		{
+			Name:               "remove",
+			OldTags:            testUpdateTagsTags(2, "value"),
+			{{- if eq .TagOp .UntagOp }}
+			ExpectedTagCalls:   1,
+			{{- else }}
+			ExpectedUntagCalls: 1,
+			{{- end }}
+			ExpectedUntagged:   testUpdateTagsKeys(testUpdateTagsTags(2, "value")),
+		},
+		{
+			Name:               "add and remove",
+			OldTags:            map[string]string{"key1": "value1"},
+			NewTags:            map[string]string{"key2": "value2"},
+			ExpectedTagCalls:   1,
+			{{- if ne .TagOp .UntagOp }}
+			ExpectedUntagCalls: 1,
+			{{- end }}
+			ExpectedTagged:     map[string]string{"key2": "value2"},
+			ExpectedUntagged:   []string{"key1"},
+		},
+		{{- if .TagOpBatchSize }}
+		{
+			Name:             "add in batches",
+			NewTags:          testUpdateTagsTags(2*batchSize+1, "value"),
+			ExpectedTagCalls: 3,
+			ExpectedTagged:   testUpdateTagsTags(2*batchSize+1, "value"),
+		},
+		{
+			Name:               "remove in batches",
+			OldTags:            testUpdateTagsTags(2*batchSize+1, "value"),
+			ExpectedUntagCalls: 3,
+			ExpectedUntagged:   testUpdateTagsKeys(testUpdateTagsTags(2*batchSize+1, "value")),
+		},
+		{{- end }}
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn := &testUpdateTagsClient{}
+			{{ if .TagTypeAddBoolElem }}
+			err := UpdateTags(conn, "identifier"{{ if .TagResTypeElem }}, "resourceType"{{ end }}, Tags(tftags.New(testCase.OldTags)), Tags(tftags.New(testCase.NewTags)))
+			{{- else }}
+			err := UpdateTags(conn, "identifier"{{ if .TagResTypeElem }}, "resourceType"{{ end }}, testCase.OldTags, testCase.NewTags)
+			{{- end }}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := len(conn.tagInputs); got != testCase.ExpectedTagCalls {
+				t.Errorf("expected %d {{ .TagOp }} calls, got %d", testCase.ExpectedTagCalls, got)
+			}
+			{{- if ne .TagOp .UntagOp }}
+
+			if got := len(conn.untagInputs); got != testCase.ExpectedUntagCalls {
+				t.Errorf("expected %d {{ .UntagOp }} calls, got %d", testCase.ExpectedUntagCalls, got)
+			}
+			{{- end }}
+
+			if got := testUpdateTagsTagged(t, conn.tagInputs); !reflect.DeepEqual(got, testCase.ExpectedTagged) {
+				t.Errorf("expected tagged %v, got %v", testCase.ExpectedTagged, got)
+			}
+
+			if got := testUpdateTagsUntagged(t, conn.{{ if eq .TagOp .UntagOp }}tagInputs{{ else }}untagInputs{{ end }}); !reflect.DeepEqual(got, testCase.ExpectedUntagged) {
+				t.Errorf("expected untagged %v, got %v", testCase.ExpectedUntagged, got)
+			}
+		})
+	}
+}
+`
+
 func awsServiceName(s string) (string, error) {
 	s = strings.ToLower(s)
 
@@ -842,7 +1216,7 @@ func ToSnakeCase(str string) string {
 	return strings.ToLower(result)
 }
 
-//awsServiceNames provides correct names and capitalization as used by AWS in client var
+// awsServiceNames provides correct names and capitalization as used by AWS in client var
 var awsServiceNames map[string]string
 
 func init() {
@@ -1,7 +1,9 @@
 package provider
 
 import (
+	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -186,6 +188,8 @@ func Provider() *schema.Provider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -218,6 +222,14 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 			},
 
+			"retry_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AWS_RETRY_MODE", conns.RetryModeStandard),
+				Description:  descriptions["retry_mode"],
+				ValidateFunc: validation.StringInSlice(conns.RetryMode_Values(), false),
+			},
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -234,6 +246,24 @@ func Provider() *schema.Provider {
 				Set:           schema.HashString,
 			},
 
+			"allowed_regions": {
+				Type:          schema.TypeSet,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Optional:      true,
+				ConflictsWith: []string{"forbidden_regions"},
+				Set:           schema.HashString,
+				Description:   descriptions["allowed_regions"],
+			},
+
+			"forbidden_regions": {
+				Type:          schema.TypeSet,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Optional:      true,
+				ConflictsWith: []string{"allowed_regions"},
+				Set:           schema.HashString,
+				Description:   descriptions["forbidden_regions"],
+			},
+
 			"default_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -247,6 +277,12 @@ func Provider() *schema.Provider {
 							Elem:        &schema.Schema{Type: schema.TypeString},
 							Description: "Resource tags to default across all resources",
 						},
+						"tags_from_ssm_parameter_path": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "must begin with a forward slash (/)"),
+							Description:  "SSM Parameter path whose parameters, keyed by name relative to the path, are read as resource tags to default across all resources",
+						},
 					},
 				},
 			},
@@ -257,6 +293,21 @@ func Provider() *schema.Provider {
 				Description: descriptions["http_proxy"],
 			},
 
+			"no_proxy": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: descriptions["no_proxy"],
+			},
+
+			"custom_ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AWS_CA_BUNDLE", nil),
+				Description: descriptions["custom_ca_bundle"],
+			},
+
 			"endpoints": endpointsSchema(),
 
 			"ignore_tags": {
@@ -280,6 +331,23 @@ func Provider() *schema.Provider {
 							Set:         schema.HashString,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_suffixes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource tag key suffixes to ignore across all resources.",
+						},
+						"key_patterns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Set:         schema.HashString,
+							Description: "Regular expressions (RE2 syntax) matching resource tag keys to ignore across all resources.",
+						},
 					},
 				},
 			},
@@ -534,6 +602,7 @@ func Provider() *schema.Provider {
 			"aws_partition":                                  nas.DataSourcePartition(),
 			"aws_prefix_list":                                ec2.DataSourcePrefixList(),
 			"aws_pricing_product":                            pricing.DataSourceProduct(),
+			"aws_provider_credentials_info":                  nas.DataSourceProviderCredentialsInfo(),
 			"aws_qldb_ledger":                                qldb.DataSourceLedger(),
 			"aws_ram_resource_share":                         ram.DataSourceResourceShare(),
 			"aws_rds_certificate":                            rds.DataSourceCertificate(),
@@ -623,588 +692,588 @@ func Provider() *schema.Provider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"aws_accessanalyzer_analyzer":                             accessanalyzer.ResourceAnalyzer(),
-			"aws_acm_certificate":                                     acm.ResourceCertificate(),
-			"aws_acm_certificate_validation":                          acm.ResourceCertificateValidation(),
-			"aws_acmpca_certificate_authority":                        acmpca.ResourceCertificateAuthority(),
-			"aws_acmpca_certificate_authority_certificate":            acmpca.ResourceCertificateAuthorityCertificate(),
-			"aws_acmpca_certificate":                                  acmpca.ResourceCertificate(),
-			"aws_ami":                                                 ec2.ResourceAMI(),
-			"aws_ami_copy":                                            ec2.ResourceAMICopy(),
-			"aws_ami_from_instance":                                   ec2.ResourceAMIFromInstance(),
-			"aws_ami_launch_permission":                               ec2.ResourceAMILaunchPermission(),
-			"aws_amplify_app":                                         amplify.ResourceApp(),
-			"aws_amplify_backend_environment":                         amplify.ResourceBackendEnvironment(),
-			"aws_amplify_branch":                                      amplify.ResourceBranch(),
-			"aws_amplify_domain_association":                          amplify.ResourceDomainAssociation(),
-			"aws_amplify_webhook":                                     amplify.ResourceWebhook(),
-			"aws_api_gateway_account":                                 apigateway.ResourceAccount(),
-			"aws_api_gateway_api_key":                                 apigateway.ResourceAPIKey(),
-			"aws_api_gateway_authorizer":                              apigateway.ResourceAuthorizer(),
-			"aws_api_gateway_base_path_mapping":                       apigateway.ResourceBasePathMapping(),
-			"aws_api_gateway_client_certificate":                      apigateway.ResourceClientCertificate(),
-			"aws_api_gateway_deployment":                              apigateway.ResourceDeployment(),
-			"aws_api_gateway_documentation_part":                      apigateway.ResourceDocumentationPart(),
-			"aws_api_gateway_documentation_version":                   apigateway.ResourceDocumentationVersion(),
-			"aws_api_gateway_domain_name":                             apigateway.ResourceDomainName(),
-			"aws_api_gateway_gateway_response":                        apigateway.ResourceGatewayResponse(),
-			"aws_api_gateway_integration":                             apigateway.ResourceIntegration(),
-			"aws_api_gateway_integration_response":                    apigateway.ResourceIntegrationResponse(),
-			"aws_api_gateway_method":                                  apigateway.ResourceMethod(),
-			"aws_api_gateway_method_response":                         apigateway.ResourceMethodResponse(),
-			"aws_api_gateway_method_settings":                         apigateway.ResourceMethodSettings(),
-			"aws_api_gateway_model":                                   apigateway.ResourceModel(),
-			"aws_api_gateway_request_validator":                       apigateway.ResourceRequestValidator(),
-			"aws_api_gateway_resource":                                apigateway.ResourceResource(),
-			"aws_api_gateway_rest_api":                                apigateway.ResourceRestAPI(),
-			"aws_api_gateway_rest_api_policy":                         apigateway.ResourceRestAPIPolicy(),
-			"aws_api_gateway_stage":                                   apigateway.ResourceStage(),
-			"aws_api_gateway_usage_plan":                              apigateway.ResourceUsagePlan(),
-			"aws_api_gateway_usage_plan_key":                          apigateway.ResourceUsagePlanKey(),
-			"aws_api_gateway_vpc_link":                                apigateway.ResourceVPCLink(),
-			"aws_apigatewayv2_api":                                    apigatewayv2.ResourceAPI(),
-			"aws_apigatewayv2_api_mapping":                            apigatewayv2.ResourceAPIMapping(),
-			"aws_apigatewayv2_authorizer":                             apigatewayv2.ResourceAuthorizer(),
-			"aws_apigatewayv2_deployment":                             apigatewayv2.ResourceDeployment(),
-			"aws_apigatewayv2_domain_name":                            apigatewayv2.ResourceDomainName(),
-			"aws_apigatewayv2_integration":                            apigatewayv2.ResourceIntegration(),
-			"aws_apigatewayv2_integration_response":                   apigatewayv2.ResourceIntegrationResponse(),
-			"aws_apigatewayv2_model":                                  apigatewayv2.ResourceModel(),
-			"aws_apigatewayv2_route":                                  apigatewayv2.ResourceRoute(),
-			"aws_apigatewayv2_route_response":                         apigatewayv2.ResourceRouteResponse(),
-			"aws_apigatewayv2_stage":                                  apigatewayv2.ResourceStage(),
-			"aws_apigatewayv2_vpc_link":                               apigatewayv2.ResourceVPCLink(),
-			"aws_app_cookie_stickiness_policy":                        elb.ResourceAppCookieStickinessPolicy(),
-			"aws_appautoscaling_target":                               applicationautoscaling.ResourceTarget(),
-			"aws_appautoscaling_policy":                               applicationautoscaling.ResourcePolicy(),
-			"aws_appautoscaling_scheduled_action":                     applicationautoscaling.ResourceScheduledAction(),
-			"aws_appconfig_application":                               appconfig.ResourceApplication(),
-			"aws_appconfig_configuration_profile":                     appconfig.ResourceConfigurationProfile(),
-			"aws_appconfig_deployment":                                appconfig.ResourceDeployment(),
-			"aws_appconfig_deployment_strategy":                       appconfig.ResourceDeploymentStrategy(),
-			"aws_appconfig_environment":                               appconfig.ResourceEnvironment(),
-			"aws_appconfig_hosted_configuration_version":              appconfig.ResourceHostedConfigurationVersion(),
-			"aws_appmesh_gateway_route":                               appmesh.ResourceGatewayRoute(),
-			"aws_appmesh_mesh":                                        appmesh.ResourceMesh(),
-			"aws_appmesh_route":                                       appmesh.ResourceRoute(),
-			"aws_appmesh_virtual_gateway":                             appmesh.ResourceVirtualGateway(),
-			"aws_appmesh_virtual_node":                                appmesh.ResourceVirtualNode(),
-			"aws_appmesh_virtual_router":                              appmesh.ResourceVirtualRouter(),
-			"aws_appmesh_virtual_service":                             appmesh.ResourceVirtualService(),
-			"aws_apprunner_auto_scaling_configuration_version":        apprunner.ResourceAutoScalingConfigurationVersion(),
-			"aws_apprunner_connection":                                apprunner.ResourceConnection(),
-			"aws_apprunner_custom_domain_association":                 apprunner.ResourceCustomDomainAssociation(),
-			"aws_apprunner_service":                                   apprunner.ResourceService(),
-			"aws_appstream_stack":                                     appstream.ResourceStack(),
-			"aws_appstream_fleet":                                     appstream.ResourceFleet(),
-			"aws_appstream_image_builder":                             appstream.ResourceImageBuilder(),
-			"aws_appsync_api_key":                                     appsync.ResourceAPIKey(),
-			"aws_appsync_datasource":                                  appsync.ResourceDataSource(),
-			"aws_appsync_function":                                    appsync.ResourceFunction(),
-			"aws_appsync_graphql_api":                                 appsync.ResourceGraphQLAPI(),
-			"aws_appsync_resolver":                                    appsync.ResourceResolver(),
-			"aws_athena_database":                                     athena.ResourceDatabase(),
-			"aws_athena_named_query":                                  athena.ResourceNamedQuery(),
-			"aws_athena_workgroup":                                    athena.ResourceWorkGroup(),
-			"aws_autoscaling_attachment":                              autoscaling.ResourceAttachment(),
-			"aws_autoscaling_group":                                   autoscaling.ResourceGroup(),
-			"aws_autoscaling_group_tag":                               autoscaling.ResourceGroupTag(),
-			"aws_autoscaling_lifecycle_hook":                          autoscaling.ResourceLifecycleHook(),
-			"aws_autoscaling_notification":                            autoscaling.ResourceNotification(),
-			"aws_autoscaling_policy":                                  autoscaling.ResourcePolicy(),
-			"aws_autoscaling_schedule":                                autoscaling.ResourceSchedule(),
-			"aws_autoscalingplans_scaling_plan":                       autoscalingplans.ResourceScalingPlan(),
-			"aws_backup_global_settings":                              backup.ResourceGlobalSettings(),
-			"aws_backup_plan":                                         backup.ResourcePlan(),
-			"aws_backup_region_settings":                              backup.ResourceRegionSettings(),
-			"aws_backup_selection":                                    backup.ResourceSelection(),
-			"aws_backup_vault":                                        backup.ResourceVault(),
-			"aws_backup_vault_notifications":                          backup.ResourceVaultNotifications(),
-			"aws_backup_vault_policy":                                 backup.ResourceVaultPolicy(),
-			"aws_budgets_budget":                                      budgets.ResourceBudget(),
-			"aws_budgets_budget_action":                               budgets.ResourceBudgetAction(),
-			"aws_chime_voice_connector":                               chime.ResourceVoiceConnector(),
-			"aws_chime_voice_connector_group":                         chime.ResourceVoiceConnectorGroup(),
-			"aws_chime_voice_connector_logging":                       chime.ResourceVoiceConnectorLogging(),
-			"aws_chime_voice_connector_streaming":                     chime.ResourceVoiceConnectorStreaming(),
-			"aws_chime_voice_connector_origination":                   chime.ResourceVoiceConnectorOrigination(),
-			"aws_chime_voice_connector_termination":                   chime.ResourceVoiceConnectorTermination(),
-			"aws_chime_voice_connector_termination_credentials":       chime.ResourceVoiceConnectorTerminationCredentials(),
-			"aws_cloud9_environment_ec2":                              cloud9.ResourceEnvironmentEC2(),
-			"aws_cloudcontrolapi_resource":                            cloudcontrol.ResourceResource(),
-			"aws_cloudformation_stack":                                cloudformation.ResourceStack(),
-			"aws_cloudformation_stack_set":                            cloudformation.ResourceStackSet(),
-			"aws_cloudformation_stack_set_instance":                   cloudformation.ResourceStackSetInstance(),
-			"aws_cloudformation_type":                                 cloudformation.ResourceType(),
-			"aws_cloudfront_cache_policy":                             cloudfront.ResourceCachePolicy(),
-			"aws_cloudfront_distribution":                             cloudfront.ResourceDistribution(),
-			"aws_cloudfront_function":                                 cloudfront.ResourceFunction(),
-			"aws_cloudfront_key_group":                                cloudfront.ResourceKeyGroup(),
-			"aws_cloudfront_monitoring_subscription":                  cloudfront.ResourceMonitoringSubscription(),
-			"aws_cloudfront_origin_access_identity":                   cloudfront.ResourceOriginAccessIdentity(),
-			"aws_cloudfront_origin_request_policy":                    cloudfront.ResourceOriginRequestPolicy(),
-			"aws_cloudfront_public_key":                               cloudfront.ResourcePublicKey(),
-			"aws_cloudfront_realtime_log_config":                      cloudfront.ResourceRealtimeLogConfig(),
-			"aws_cloudtrail":                                          cloudtrail.ResourceCloudTrail(),
-			"aws_cloudwatch_event_bus":                                cloudwatchevents.ResourceBus(),
-			"aws_cloudwatch_event_bus_policy":                         cloudwatchevents.ResourceBusPolicy(),
-			"aws_cloudwatch_event_permission":                         cloudwatchevents.ResourcePermission(),
-			"aws_cloudwatch_event_rule":                               cloudwatchevents.ResourceRule(),
-			"aws_cloudwatch_event_target":                             cloudwatchevents.ResourceTarget(),
-			"aws_cloudwatch_event_archive":                            cloudwatchevents.ResourceArchive(),
-			"aws_cloudwatch_event_connection":                         cloudwatchevents.ResourceConnection(),
-			"aws_cloudwatch_event_api_destination":                    cloudwatchevents.ResourceAPIDestination(),
-			"aws_cloudwatch_log_destination":                          cloudwatchlogs.ResourceDestination(),
-			"aws_cloudwatch_log_destination_policy":                   cloudwatchlogs.ResourceDestinationPolicy(),
-			"aws_cloudwatch_log_group":                                cloudwatchlogs.ResourceGroup(),
-			"aws_cloudwatch_log_metric_filter":                        cloudwatchlogs.ResourceMetricFilter(),
-			"aws_cloudwatch_log_resource_policy":                      cloudwatchlogs.ResourceResourcePolicy(),
-			"aws_cloudwatch_log_stream":                               cloudwatchlogs.ResourceStream(),
-			"aws_cloudwatch_log_subscription_filter":                  cloudwatchlogs.ResourceSubscriptionFilter(),
-			"aws_config_aggregate_authorization":                      config.ResourceAggregateAuthorization(),
-			"aws_config_config_rule":                                  config.ResourceConfigRule(),
-			"aws_config_configuration_aggregator":                     config.ResourceConfigurationAggregator(),
-			"aws_config_configuration_recorder":                       config.ResourceConfigurationRecorder(),
-			"aws_config_configuration_recorder_status":                config.ResourceConfigurationRecorderStatus(),
-			"aws_config_conformance_pack":                             config.ResourceConformancePack(),
-			"aws_config_delivery_channel":                             config.ResourceDeliveryChannel(),
-			"aws_config_organization_conformance_pack":                config.ResourceOrganizationConformancePack(),
-			"aws_config_organization_custom_rule":                     config.ResourceOrganizationCustomRule(),
-			"aws_config_organization_managed_rule":                    config.ResourceOrganizationManagedRule(),
-			"aws_config_remediation_configuration":                    config.ResourceRemediationConfiguration(),
-			"aws_cognito_identity_pool":                               cognitoidentity.ResourcePool(),
-			"aws_cognito_identity_pool_roles_attachment":              cognitoidentity.ResourcePoolRolesAttachment(),
-			"aws_cognito_identity_provider":                           cognitoidp.ResourceIdentityProvider(),
-			"aws_cognito_resource_server":                             cognitoidp.ResourceResourceServer(),
-			"aws_cognito_user_group":                                  cognitoidp.ResourceUserGroup(),
-			"aws_cognito_user_pool":                                   cognitoidp.ResourceUserPool(),
-			"aws_cognito_user_pool_client":                            cognitoidp.ResourceUserPoolClient(),
-			"aws_cognito_user_pool_domain":                            cognitoidp.ResourceUserPoolDomain(),
-			"aws_cognito_user_pool_ui_customization":                  cognitoidp.ResourceUserPoolUICustomization(),
-			"aws_cloudhsm_v2_cluster":                                 cloudhsmv2.ResourceCluster(),
-			"aws_cloudhsm_v2_hsm":                                     cloudhsmv2.ResourceHSM(),
-			"aws_cloudwatch_composite_alarm":                          cloudwatch.ResourceCompositeAlarm(),
-			"aws_cloudwatch_metric_alarm":                             cloudwatch.ResourceMetricAlarm(),
-			"aws_cloudwatch_dashboard":                                cloudwatch.ResourceDashboard(),
-			"aws_cloudwatch_metric_stream":                            cloudwatch.ResourceMetricStream(),
-			"aws_cloudwatch_query_definition":                         cloudwatchlogs.ResourceQueryDefinition(),
-			"aws_codedeploy_app":                                      codedeploy.ResourceApp(),
-			"aws_codedeploy_deployment_config":                        codedeploy.ResourceDeploymentConfig(),
-			"aws_codedeploy_deployment_group":                         codedeploy.ResourceDeploymentGroup(),
-			"aws_codecommit_repository":                               codecommit.ResourceRepository(),
-			"aws_codecommit_trigger":                                  codecommit.ResourceTrigger(),
-			"aws_codeartifact_domain":                                 codeartifact.ResourceDomain(),
-			"aws_codeartifact_domain_permissions_policy":              codeartifact.ResourceDomainPermissionsPolicy(),
-			"aws_codeartifact_repository":                             codeartifact.ResourceRepository(),
-			"aws_codeartifact_repository_permissions_policy":          codeartifact.ResourceRepositoryPermissionsPolicy(),
-			"aws_codebuild_project":                                   codebuild.ResourceProject(),
-			"aws_codebuild_report_group":                              codebuild.ResourceReportGroup(),
-			"aws_codebuild_source_credential":                         codebuild.ResourceSourceCredential(),
-			"aws_codebuild_webhook":                                   codebuild.ResourceWebhook(),
-			"aws_codepipeline":                                        codepipeline.ResourceCodePipeline(),
-			"aws_codepipeline_webhook":                                codepipeline.ResourceWebhook(),
-			"aws_codestarconnections_connection":                      codestarconnections.ResourceConnection(),
-			"aws_codestarconnections_host":                            codestarconnections.ResourceHost(),
-			"aws_codestarnotifications_notification_rule":             codestarnotifications.ResourceNotificationRule(),
-			"aws_connect_contact_flow":                                connect.ResourceContactFlow(),
-			"aws_connect_instance":                                    connect.ResourceInstance(),
-			"aws_cur_report_definition":                               cur.ResourceReportDefinition(),
-			"aws_customer_gateway":                                    ec2.ResourceCustomerGateway(),
-			"aws_datapipeline_pipeline":                               datapipeline.ResourcePipeline(),
-			"aws_datasync_agent":                                      datasync.ResourceAgent(),
-			"aws_datasync_location_efs":                               datasync.ResourceLocationEFS(),
-			"aws_datasync_location_fsx_windows_file_system":           datasync.ResourceLocationFSxWindowsFileSystem(),
-			"aws_datasync_location_nfs":                               datasync.ResourceLocationNFS(),
-			"aws_datasync_location_s3":                                datasync.ResourceLocationS3(),
-			"aws_datasync_location_smb":                               datasync.ResourceLocationSMB(),
-			"aws_datasync_task":                                       datasync.ResourceTask(),
-			"aws_dax_cluster":                                         dax.ResourceCluster(),
-			"aws_dax_parameter_group":                                 dax.ResourceParameterGroup(),
-			"aws_dax_subnet_group":                                    dax.ResourceSubnetGroup(),
-			"aws_db_cluster_snapshot":                                 rds.ResourceClusterSnapshot(),
-			"aws_db_event_subscription":                               rds.ResourceEventSubscription(),
-			"aws_db_instance":                                         rds.ResourceInstance(),
-			"aws_db_instance_role_association":                        rds.ResourceInstanceRoleAssociation(),
-			"aws_db_option_group":                                     rds.ResourceOptionGroup(),
-			"aws_db_parameter_group":                                  rds.ResourceParameterGroup(),
-			"aws_db_proxy":                                            rds.ResourceProxy(),
-			"aws_db_proxy_default_target_group":                       rds.ResourceProxyDefaultTargetGroup(),
-			"aws_db_proxy_endpoint":                                   rds.ResourceProxyEndpoint(),
-			"aws_db_proxy_target":                                     rds.ResourceProxyTarget(),
-			"aws_db_security_group":                                   rds.ResourceSecurityGroup(),
-			"aws_db_snapshot":                                         rds.ResourceSnapshot(),
-			"aws_db_subnet_group":                                     rds.ResourceSubnetGroup(),
-			"aws_devicefarm_project":                                  devicefarm.ResourceProject(),
-			"aws_directory_service_directory":                         ds.ResourceDirectory(),
-			"aws_directory_service_conditional_forwarder":             ds.ResourceConditionalForwarder(),
-			"aws_directory_service_log_subscription":                  ds.ResourceLogSubscription(),
-			"aws_dlm_lifecycle_policy":                                dlm.ResourceLifecyclePolicy(),
-			"aws_dms_certificate":                                     dms.ResourceCertificate(),
-			"aws_dms_endpoint":                                        dms.ResourceEndpoint(),
-			"aws_dms_event_subscription":                              dms.ResourceEventSubscription(),
-			"aws_dms_replication_instance":                            dms.ResourceReplicationInstance(),
-			"aws_dms_replication_subnet_group":                        dms.ResourceReplicationSubnetGroup(),
-			"aws_dms_replication_task":                                dms.ResourceReplicationTask(),
-			"aws_docdb_cluster":                                       docdb.ResourceCluster(),
-			"aws_docdb_cluster_instance":                              docdb.ResourceClusterInstance(),
-			"aws_docdb_cluster_parameter_group":                       docdb.ResourceClusterParameterGroup(),
-			"aws_docdb_cluster_snapshot":                              docdb.ResourceClusterSnapshot(),
-			"aws_docdb_subnet_group":                                  docdb.ResourceSubnetGroup(),
-			"aws_dx_bgp_peer":                                         directconnect.ResourceBGPPeer(),
-			"aws_dx_connection":                                       directconnect.ResourceConnection(),
-			"aws_dx_connection_association":                           directconnect.ResourceConnectionAssociation(),
-			"aws_dx_connection_confirmation":                          directconnect.ResourceConnectionConfirmation(),
-			"aws_dx_gateway":                                          directconnect.ResourceGateway(),
-			"aws_dx_gateway_association":                              directconnect.ResourceGatewayAssociation(),
-			"aws_dx_gateway_association_proposal":                     directconnect.ResourceGatewayAssociationProposal(),
-			"aws_dx_hosted_connection":                                directconnect.ResourceHostedConnection(),
-			"aws_dx_hosted_private_virtual_interface":                 directconnect.ResourceHostedPrivateVirtualInterface(),
-			"aws_dx_hosted_private_virtual_interface_accepter":        directconnect.ResourceHostedPrivateVirtualInterfaceAccepter(),
-			"aws_dx_hosted_public_virtual_interface":                  directconnect.ResourceHostedPublicVirtualInterface(),
-			"aws_dx_hosted_public_virtual_interface_accepter":         directconnect.ResourceHostedPublicVirtualInterfaceAccepter(),
-			"aws_dx_hosted_transit_virtual_interface":                 directconnect.ResourceHostedTransitVirtualInterface(),
-			"aws_dx_hosted_transit_virtual_interface_accepter":        directconnect.ResourceHostedTransitVirtualInterfaceAccepter(),
-			"aws_dx_lag":                                              directconnect.ResourceLag(),
-			"aws_dx_private_virtual_interface":                        directconnect.ResourcePrivateVirtualInterface(),
-			"aws_dx_public_virtual_interface":                         directconnect.ResourcePublicVirtualInterface(),
-			"aws_dx_transit_virtual_interface":                        directconnect.ResourceTransitVirtualInterface(),
-			"aws_dynamodb_table":                                      dynamodb.ResourceTable(),
-			"aws_dynamodb_table_item":                                 dynamodb.ResourceTableItem(),
-			"aws_dynamodb_tag":                                        dynamodb.ResourceTag(),
-			"aws_dynamodb_global_table":                               dynamodb.ResourceGlobalTable(),
-			"aws_dynamodb_kinesis_streaming_destination":              dynamodb.ResourceKinesisStreamingDestination(),
-			"aws_ebs_default_kms_key":                                 ec2.ResourceEBSDefaultKMSKey(),
-			"aws_ebs_encryption_by_default":                           ec2.ResourceEBSEncryptionByDefault(),
-			"aws_ebs_snapshot":                                        ec2.ResourceEBSSnapshot(),
-			"aws_ebs_snapshot_copy":                                   ec2.ResourceEBSSnapshotCopy(),
-			"aws_ebs_snapshot_import":                                 ec2.ResourceEBSSnapshotImport(),
-			"aws_ebs_volume":                                          ec2.ResourceEBSVolume(),
-			"aws_ec2_availability_zone_group":                         ec2.ResourceAvailabilityZoneGroup(),
-			"aws_ec2_capacity_reservation":                            ec2.ResourceCapacityReservation(),
-			"aws_ec2_carrier_gateway":                                 ec2.ResourceCarrierGateway(),
-			"aws_ec2_client_vpn_authorization_rule":                   ec2.ResourceClientVPNAuthorizationRule(),
-			"aws_ec2_client_vpn_endpoint":                             ec2.ResourceClientVPNEndpoint(),
-			"aws_ec2_client_vpn_network_association":                  ec2.ResourceClientVPNNetworkAssociation(),
-			"aws_ec2_client_vpn_route":                                ec2.ResourceClientVPNRoute(),
-			"aws_ec2_fleet":                                           ec2.ResourceFleet(),
-			"aws_ec2_host":                                            ec2.ResourceHost(),
-			"aws_ec2_local_gateway_route":                             ec2.ResourceLocalGatewayRoute(),
-			"aws_ec2_local_gateway_route_table_vpc_association":       ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
-			"aws_ec2_managed_prefix_list":                             ec2.ResourceManagedPrefixList(),
-			"aws_ec2_managed_prefix_list_entry":                       ec2.ResourceManagedPrefixListEntry(),
-			"aws_ec2_tag":                                             ec2.ResourceTag(),
-			"aws_ec2_traffic_mirror_filter":                           ec2.ResourceTrafficMirrorFilter(),
-			"aws_ec2_traffic_mirror_filter_rule":                      ec2.ResourceTrafficMirrorFilterRule(),
-			"aws_ec2_traffic_mirror_target":                           ec2.ResourceTrafficMirrorTarget(),
-			"aws_ec2_traffic_mirror_session":                          ec2.ResourceTrafficMirrorSession(),
-			"aws_ec2_transit_gateway":                                 ec2.ResourceTransitGateway(),
-			"aws_ec2_transit_gateway_peering_attachment":              ec2.ResourceTransitGatewayPeeringAttachment(),
-			"aws_ec2_transit_gateway_peering_attachment_accepter":     ec2.ResourceTransitGatewayPeeringAttachmentAccepter(),
-			"aws_ec2_transit_gateway_prefix_list_reference":           ec2.ResourceTransitGatewayPrefixListReference(),
-			"aws_ec2_transit_gateway_route":                           ec2.ResourceTransitGatewayRoute(),
-			"aws_ec2_transit_gateway_route_table":                     ec2.ResourceTransitGatewayRouteTable(),
-			"aws_ec2_transit_gateway_route_table_association":         ec2.ResourceTransitGatewayRouteTableAssociation(),
-			"aws_ec2_transit_gateway_route_table_propagation":         ec2.ResourceTransitGatewayRouteTablePropagation(),
-			"aws_ec2_transit_gateway_vpc_attachment":                  ec2.ResourceTransitGatewayVPCAttachment(),
-			"aws_ec2_transit_gateway_vpc_attachment_accepter":         ec2.ResourceTransitGatewayVPCAttachmentAccepter(),
-			"aws_ecr_lifecycle_policy":                                ecr.ResourceLifecyclePolicy(),
-			"aws_ecrpublic_repository":                                ecrpublic.ResourceRepository(),
-			"aws_ecr_registry_policy":                                 ecr.ResourceRegistryPolicy(),
-			"aws_ecr_replication_configuration":                       ecr.ResourceReplicationConfiguration(),
-			"aws_ecr_repository":                                      ecr.ResourceRepository(),
-			"aws_ecr_repository_policy":                               ecr.ResourceRepositoryPolicy(),
-			"aws_ecs_capacity_provider":                               ecs.ResourceCapacityProvider(),
-			"aws_ecs_cluster":                                         ecs.ResourceCluster(),
-			"aws_ecs_service":                                         ecs.ResourceService(),
-			"aws_ecs_tag":                                             ecs.ResourceTag(),
-			"aws_ecs_task_definition":                                 ecs.ResourceTaskDefinition(),
-			"aws_efs_access_point":                                    efs.ResourceAccessPoint(),
-			"aws_efs_backup_policy":                                   efs.ResourceBackupPolicy(),
-			"aws_efs_file_system":                                     efs.ResourceFileSystem(),
-			"aws_efs_file_system_policy":                              efs.ResourceFileSystemPolicy(),
-			"aws_efs_mount_target":                                    efs.ResourceMountTarget(),
-			"aws_egress_only_internet_gateway":                        ec2.ResourceEgressOnlyInternetGateway(),
-			"aws_eip":                                                 ec2.ResourceEIP(),
-			"aws_eip_association":                                     ec2.ResourceEIPAssociation(),
-			"aws_eks_cluster":                                         eks.ResourceCluster(),
-			"aws_eks_addon":                                           eks.ResourceAddon(),
-			"aws_eks_fargate_profile":                                 eks.ResourceFargateProfile(),
-			"aws_eks_identity_provider_config":                        eks.ResourceIdentityProviderConfig(),
-			"aws_eks_node_group":                                      eks.ResourceNodeGroup(),
-			"aws_elasticache_cluster":                                 elasticache.ResourceCluster(),
-			"aws_elasticache_global_replication_group":                elasticache.ResourceGlobalReplicationGroup(),
-			"aws_elasticache_parameter_group":                         elasticache.ResourceParameterGroup(),
-			"aws_elasticache_replication_group":                       elasticache.ResourceReplicationGroup(),
-			"aws_elasticache_security_group":                          elasticache.ResourceSecurityGroup(),
-			"aws_elasticache_subnet_group":                            elasticache.ResourceSubnetGroup(),
-			"aws_elasticache_user":                                    elasticache.ResourceUser(),
-			"aws_elasticache_user_group":                              elasticache.ResourceUserGroup(),
-			"aws_elastic_beanstalk_application":                       elasticbeanstalk.ResourceApplication(),
-			"aws_elastic_beanstalk_application_version":               elasticbeanstalk.ResourceApplicationVersion(),
-			"aws_elastic_beanstalk_configuration_template":            elasticbeanstalk.ResourceConfigurationTemplate(),
-			"aws_elastic_beanstalk_environment":                       elasticbeanstalk.ResourceEnvironment(),
-			"aws_elasticsearch_domain":                                elasticsearch.ResourceDomain(),
-			"aws_elasticsearch_domain_policy":                         elasticsearch.ResourceDomainPolicy(),
-			"aws_elasticsearch_domain_saml_options":                   elasticsearch.ResourceDomainSAMLOptions(),
-			"aws_elastictranscoder_pipeline":                          elastictranscoder.ResourcePipeline(),
-			"aws_elastictranscoder_preset":                            elastictranscoder.ResourcePreset(),
-			"aws_elb":                                                 elb.ResourceLoadBalancer(),
-			"aws_elb_attachment":                                      elb.ResourceAttachment(),
-			"aws_emr_cluster":                                         emr.ResourceCluster(),
-			"aws_emr_instance_group":                                  emr.ResourceInstanceGroup(),
-			"aws_emr_instance_fleet":                                  emr.ResourceInstanceFleet(),
-			"aws_emr_managed_scaling_policy":                          emr.ResourceManagedScalingPolicy(),
-			"aws_emr_security_configuration":                          emr.ResourceSecurityConfiguration(),
-			"aws_flow_log":                                            ec2.ResourceFlowLog(),
-			"aws_fsx_backup":                                          fsx.ResourceBackup(),
-			"aws_fsx_lustre_file_system":                              fsx.ResourceLustreFileSystem(),
-			"aws_fsx_ontap_file_system":                               fsx.ResourceOntapFileSystem(),
-			"aws_fsx_windows_file_system":                             fsx.ResourceWindowsFileSystem(),
-			"aws_fms_admin_account":                                   fms.ResourceAdminAccount(),
-			"aws_fms_policy":                                          fms.ResourcePolicy(),
-			"aws_gamelift_alias":                                      gamelift.ResourceAlias(),
-			"aws_gamelift_build":                                      gamelift.ResourceBuild(),
-			"aws_gamelift_fleet":                                      gamelift.ResourceFleet(),
-			"aws_gamelift_game_session_queue":                         gamelift.ResourceGameSessionQueue(),
-			"aws_glacier_vault":                                       glacier.ResourceVault(),
-			"aws_glacier_vault_lock":                                  glacier.ResourceVaultLock(),
-			"aws_globalaccelerator_accelerator":                       globalaccelerator.ResourceAccelerator(),
-			"aws_globalaccelerator_endpoint_group":                    globalaccelerator.ResourceEndpointGroup(),
-			"aws_globalaccelerator_listener":                          globalaccelerator.ResourceListener(),
-			"aws_glue_catalog_database":                               glue.ResourceCatalogDatabase(),
-			"aws_glue_catalog_table":                                  glue.ResourceCatalogTable(),
-			"aws_glue_classifier":                                     glue.ResourceClassifier(),
-			"aws_glue_connection":                                     glue.ResourceConnection(),
-			"aws_glue_dev_endpoint":                                   glue.ResourceDevEndpoint(),
-			"aws_glue_crawler":                                        glue.ResourceCrawler(),
-			"aws_glue_data_catalog_encryption_settings":               glue.ResourceDataCatalogEncryptionSettings(),
-			"aws_glue_job":                                            glue.ResourceJob(),
-			"aws_glue_ml_transform":                                   glue.ResourceMLTransform(),
-			"aws_glue_partition":                                      glue.ResourcePartition(),
-			"aws_glue_partition_index":                                glue.ResourcePartitionIndex(),
-			"aws_glue_registry":                                       glue.ResourceRegistry(),
-			"aws_glue_resource_policy":                                glue.ResourceResourcePolicy(),
-			"aws_glue_schema":                                         glue.ResourceSchema(),
-			"aws_glue_security_configuration":                         glue.ResourceSecurityConfiguration(),
-			"aws_glue_trigger":                                        glue.ResourceTrigger(),
-			"aws_glue_user_defined_function":                          glue.ResourceUserDefinedFunction(),
-			"aws_glue_workflow":                                       glue.ResourceWorkflow(),
-			"aws_guardduty_detector":                                  guardduty.ResourceDetector(),
-			"aws_guardduty_filter":                                    guardduty.ResourceFilter(),
-			"aws_guardduty_invite_accepter":                           guardduty.ResourceInviteAccepter(),
-			"aws_guardduty_ipset":                                     guardduty.ResourceIPSet(),
-			"aws_guardduty_member":                                    guardduty.ResourceMember(),
-			"aws_guardduty_organization_admin_account":                guardduty.ResourceOrganizationAdminAccount(),
-			"aws_guardduty_organization_configuration":                guardduty.ResourceOrganizationConfiguration(),
-			"aws_guardduty_publishing_destination":                    guardduty.ResourcePublishingDestination(),
-			"aws_guardduty_threatintelset":                            guardduty.ResourceThreatintelset(),
-			"aws_iam_access_key":                                      iam.ResourceAccessKey(),
-			"aws_iam_account_alias":                                   iam.ResourceAccountAlias(),
-			"aws_iam_account_password_policy":                         iam.ResourceAccountPasswordPolicy(),
-			"aws_iam_group_policy":                                    iam.ResourceGroupPolicy(),
-			"aws_iam_group":                                           iam.ResourceGroup(),
-			"aws_iam_group_membership":                                iam.ResourceGroupMembership(),
-			"aws_iam_group_policy_attachment":                         iam.ResourceGroupPolicyAttachment(),
-			"aws_iam_instance_profile":                                iam.ResourceInstanceProfile(),
-			"aws_iam_openid_connect_provider":                         iam.ResourceOpenIDConnectProvider(),
-			"aws_iam_policy":                                          iam.ResourcePolicy(),
-			"aws_iam_policy_attachment":                               iam.ResourcePolicyAttachment(),
-			"aws_iam_role_policy_attachment":                          iam.ResourceRolePolicyAttachment(),
-			"aws_iam_role_policy":                                     iam.ResourceRolePolicy(),
-			"aws_iam_role":                                            iam.ResourceRole(),
-			"aws_iam_saml_provider":                                   iam.ResourceSamlProvider(),
-			"aws_iam_server_certificate":                              iam.ResourceServerCertificate(),
-			"aws_iam_service_linked_role":                             iam.ResourceServiceLinkedRole(),
-			"aws_iam_user_group_membership":                           iam.ResourceUserGroupMembership(),
-			"aws_iam_user_policy_attachment":                          iam.ResourceUserPolicyAttachment(),
-			"aws_iam_user_policy":                                     iam.ResourceUserPolicy(),
-			"aws_iam_user_ssh_key":                                    iam.ResourceUserSSHKey(),
-			"aws_iam_user":                                            iam.ResourceUser(),
-			"aws_iam_user_login_profile":                              iam.ResourceUserLoginProfile(),
-			"aws_imagebuilder_component":                              imagebuilder.ResourceComponent(),
-			"aws_imagebuilder_distribution_configuration":             imagebuilder.ResourceDistributionConfiguration(),
-			"aws_imagebuilder_image":                                  imagebuilder.ResourceImage(),
-			"aws_imagebuilder_image_pipeline":                         imagebuilder.ResourceImagePipeline(),
-			"aws_imagebuilder_image_recipe":                           imagebuilder.ResourceImageRecipe(),
-			"aws_imagebuilder_infrastructure_configuration":           imagebuilder.ResourceInfrastructureConfiguration(),
-			"aws_inspector_assessment_target":                         inspector.ResourceAssessmentTarget(),
-			"aws_inspector_assessment_template":                       inspector.ResourceAssessmentTemplate(),
-			"aws_inspector_resource_group":                            inspector.ResourceResourceGroup(),
-			"aws_instance":                                            ec2.ResourceInstance(),
-			"aws_internet_gateway":                                    ec2.ResourceInternetGateway(),
-			"aws_iot_authorizer":                                      iot.ResourceAuthorizer(),
-			"aws_iot_certificate":                                     iot.ResourceCertificate(),
-			"aws_iot_policy":                                          iot.ResourcePolicy(),
-			"aws_iot_policy_attachment":                               iot.ResourcePolicyAttachment(),
-			"aws_iot_thing":                                           iot.ResourceThing(),
-			"aws_iot_thing_principal_attachment":                      iot.ResourceThingPrincipalAttachment(),
-			"aws_iot_thing_type":                                      iot.ResourceThingType(),
-			"aws_iot_topic_rule":                                      iot.ResourceTopicRule(),
-			"aws_iot_role_alias":                                      iot.ResourceRoleAlias(),
-			"aws_key_pair":                                            ec2.ResourceKeyPair(),
-			"aws_kinesis_analytics_application":                       kinesisanalytics.ResourceApplication(),
-			"aws_kinesisanalyticsv2_application":                      kinesisanalyticsv2.ResourceApplication(),
-			"aws_kinesisanalyticsv2_application_snapshot":             kinesisanalyticsv2.ResourceApplicationSnapshot(),
-			"aws_kinesis_firehose_delivery_stream":                    firehose.ResourceDeliveryStream(),
-			"aws_kinesis_stream":                                      kinesis.ResourceStream(),
-			"aws_kinesis_stream_consumer":                             kinesis.ResourceStreamConsumer(),
-			"aws_kinesis_video_stream":                                kinesisvideo.ResourceStream(),
-			"aws_kms_alias":                                           kms.ResourceAlias(),
-			"aws_kms_external_key":                                    kms.ResourceExternalKey(),
-			"aws_kms_grant":                                           kms.ResourceGrant(),
-			"aws_kms_key":                                             kms.ResourceKey(),
-			"aws_kms_ciphertext":                                      kms.ResourceCiphertext(),
-			"aws_lakeformation_data_lake_settings":                    lakeformation.ResourceDataLakeSettings(),
-			"aws_lakeformation_permissions":                           lakeformation.ResourcePermissions(),
-			"aws_lakeformation_resource":                              lakeformation.ResourceResource(),
-			"aws_lambda_alias":                                        lambda.ResourceAlias(),
-			"aws_lambda_code_signing_config":                          lambda.ResourceCodeSigningConfig(),
-			"aws_lambda_event_source_mapping":                         lambda.ResourceEventSourceMapping(),
-			"aws_lambda_function_event_invoke_config":                 lambda.ResourceFunctionEventInvokeConfig(),
-			"aws_lambda_function":                                     lambda.ResourceFunction(),
-			"aws_lambda_layer_version":                                lambda.ResourceLayerVersion(),
-			"aws_lambda_permission":                                   lambda.ResourcePermission(),
-			"aws_lambda_provisioned_concurrency_config":               lambda.ResourceProvisionedConcurrencyConfig(),
-			"aws_launch_configuration":                                autoscaling.ResourceLaunchConfiguration(),
-			"aws_launch_template":                                     ec2.ResourceLaunchTemplate(),
-			"aws_lex_bot":                                             lexmodelbuilding.ResourceBot(),
-			"aws_lex_bot_alias":                                       lexmodelbuilding.ResourceBotAlias(),
-			"aws_lex_intent":                                          lexmodelbuilding.ResourceIntent(),
-			"aws_lex_slot_type":                                       lexmodelbuilding.ResourceSlotType(),
-			"aws_licensemanager_association":                          licensemanager.ResourceAssociation(),
-			"aws_licensemanager_license_configuration":                licensemanager.ResourceLicenseConfiguration(),
-			"aws_lightsail_domain":                                    lightsail.ResourceDomain(),
-			"aws_lightsail_instance":                                  lightsail.ResourceInstance(),
-			"aws_lightsail_instance_public_ports":                     lightsail.ResourceInstancePublicPorts(),
-			"aws_lightsail_key_pair":                                  lightsail.ResourceKeyPair(),
-			"aws_lightsail_static_ip":                                 lightsail.ResourceStaticIP(),
-			"aws_lightsail_static_ip_attachment":                      lightsail.ResourceStaticIPAttachment(),
-			"aws_lb_cookie_stickiness_policy":                         elb.ResourceCookieStickinessPolicy(),
-			"aws_load_balancer_policy":                                elb.ResourcePolicy(),
-			"aws_load_balancer_backend_server_policy":                 elb.ResourceBackendServerPolicy(),
-			"aws_load_balancer_listener_policy":                       elb.ResourceListenerPolicy(),
-			"aws_lb_ssl_negotiation_policy":                           elb.ResourceSSLNegotiationPolicy(),
-			"aws_macie2_account":                                      macie2.ResourceAccount(),
-			"aws_macie2_classification_job":                           macie2.ResourceClassificationJob(),
-			"aws_macie2_custom_data_identifier":                       macie2.ResourceCustomDataIdentifier(),
-			"aws_macie2_findings_filter":                              macie2.ResourceFindingsFilter(),
-			"aws_macie2_invitation_accepter":                          macie2.ResourceInvitationAccepter(),
-			"aws_macie2_member":                                       macie2.ResourceMember(),
-			"aws_macie2_organization_admin_account":                   macie2.ResourceOrganizationAdminAccount(),
-			"aws_macie_member_account_association":                    macie.ResourceMemberAccountAssociation(),
-			"aws_macie_s3_bucket_association":                         macie.ResourceS3BucketAssociation(),
-			"aws_main_route_table_association":                        ec2.ResourceMainRouteTableAssociation(),
-			"aws_mq_broker":                                           mq.ResourceBroker(),
-			"aws_mq_configuration":                                    mq.ResourceConfiguration(),
-			"aws_media_convert_queue":                                 mediaconvert.ResourceQueue(),
-			"aws_media_package_channel":                               mediapackage.ResourceChannel(),
-			"aws_media_store_container":                               mediastore.ResourceContainer(),
-			"aws_media_store_container_policy":                        mediastore.ResourceContainerPolicy(),
-			"aws_msk_cluster":                                         kafka.ResourceCluster(),
-			"aws_msk_configuration":                                   kafka.ResourceConfiguration(),
-			"aws_msk_scram_secret_association":                        kafka.ResourceScramSecretAssociation(),
-			"aws_mwaa_environment":                                    mwaa.ResourceEnvironment(),
-			"aws_nat_gateway":                                         ec2.ResourceNatGateway(),
-			"aws_network_acl":                                         ec2.ResourceNetworkACL(),
-			"aws_default_network_acl":                                 ec2.ResourceDefaultNetworkACL(),
-			"aws_neptune_cluster":                                     neptune.ResourceCluster(),
-			"aws_neptune_cluster_endpoint":                            neptune.ResourceClusterEndpoint(),
-			"aws_neptune_cluster_instance":                            neptune.ResourceClusterInstance(),
-			"aws_neptune_cluster_parameter_group":                     neptune.ResourceClusterParameterGroup(),
-			"aws_neptune_cluster_snapshot":                            neptune.ResourceClusterSnapshot(),
-			"aws_neptune_event_subscription":                          neptune.ResourceEventSubscription(),
-			"aws_neptune_parameter_group":                             neptune.ResourceParameterGroup(),
-			"aws_neptune_subnet_group":                                neptune.ResourceSubnetGroup(),
-			"aws_network_acl_rule":                                    ec2.ResourceNetworkACLRule(),
-			"aws_network_interface":                                   ec2.ResourceNetworkInterface(),
-			"aws_network_interface_attachment":                        ec2.ResourceNetworkInterfaceAttachment(),
-			"aws_networkfirewall_firewall":                            networkfirewall.ResourceFirewall(),
-			"aws_networkfirewall_firewall_policy":                     networkfirewall.ResourceFirewallPolicy(),
-			"aws_networkfirewall_logging_configuration":               networkfirewall.ResourceLoggingConfiguration(),
-			"aws_networkfirewall_resource_policy":                     networkfirewall.ResourceResourcePolicy(),
-			"aws_networkfirewall_rule_group":                          networkfirewall.ResourceRuleGroup(),
-			"aws_opsworks_application":                                opsworks.ResourceApplication(),
-			"aws_opsworks_stack":                                      opsworks.ResourceStack(),
-			"aws_opsworks_java_app_layer":                             opsworks.ResourceJavaAppLayer(),
-			"aws_opsworks_haproxy_layer":                              opsworks.ResourceHAProxyLayer(),
-			"aws_opsworks_static_web_layer":                           opsworks.ResourceStaticWebLayer(),
-			"aws_opsworks_php_app_layer":                              opsworks.ResourcePHPAppLayer(),
-			"aws_opsworks_rails_app_layer":                            opsworks.ResourceRailsAppLayer(),
-			"aws_opsworks_nodejs_app_layer":                           opsworks.ResourceNodejsAppLayer(),
-			"aws_opsworks_memcached_layer":                            opsworks.ResourceMemcachedLayer(),
-			"aws_opsworks_mysql_layer":                                opsworks.ResourceMySQLLayer(),
-			"aws_opsworks_ganglia_layer":                              opsworks.ResourceGangliaLayer(),
-			"aws_opsworks_custom_layer":                               opsworks.ResourceCustomLayer(),
-			"aws_opsworks_instance":                                   opsworks.ResourceInstance(),
-			"aws_opsworks_user_profile":                               opsworks.ResourceUserProfile(),
-			"aws_opsworks_permission":                                 opsworks.ResourcePermission(),
-			"aws_opsworks_rds_db_instance":                            opsworks.ResourceRDSDBInstance(),
-			"aws_organizations_organization":                          organizations.ResourceOrganization(),
-			"aws_organizations_account":                               organizations.ResourceAccount(),
-			"aws_organizations_delegated_administrator":               organizations.ResourceDelegatedAdministrator(),
-			"aws_organizations_policy":                                organizations.ResourcePolicy(),
-			"aws_organizations_policy_attachment":                     organizations.ResourcePolicyAttachment(),
-			"aws_organizations_organizational_unit":                   organizations.ResourceOrganizationalUnit(),
-			"aws_placement_group":                                     ec2.ResourcePlacementGroup(),
-			"aws_prometheus_workspace":                                prometheus.ResourceWorkspace(),
-			"aws_proxy_protocol_policy":                               elb.ResourceProxyProtocolPolicy(),
-			"aws_qldb_ledger":                                         qldb.ResourceLedger(),
-			"aws_quicksight_data_source":                              quicksight.ResourceDataSource(),
-			"aws_quicksight_group":                                    quicksight.ResourceGroup(),
-			"aws_quicksight_group_membership":                         quicksight.ResourceGroupMembership(),
-			"aws_quicksight_user":                                     quicksight.ResourceUser(),
-			"aws_ram_principal_association":                           ram.ResourcePrincipalAssociation(),
-			"aws_ram_resource_association":                            ram.ResourceResourceAssociation(),
-			"aws_ram_resource_share":                                  ram.ResourceResourceShare(),
-			"aws_ram_resource_share_accepter":                         ram.ResourceResourceShareAccepter(),
-			"aws_rds_cluster":                                         rds.ResourceCluster(),
-			"aws_rds_cluster_endpoint":                                rds.ResourceClusterEndpoint(),
-			"aws_rds_cluster_instance":                                rds.ResourceClusterInstance(),
-			"aws_rds_cluster_parameter_group":                         rds.ResourceClusterParameterGroup(),
-			"aws_rds_cluster_role_association":                        rds.ResourceClusterRoleAssociation(),
-			"aws_rds_global_cluster":                                  rds.ResourceGlobalCluster(),
-			"aws_redshift_cluster":                                    redshift.ResourceCluster(),
-			"aws_redshift_security_group":                             redshift.ResourceSecurityGroup(),
-			"aws_redshift_parameter_group":                            redshift.ResourceParameterGroup(),
-			"aws_redshift_subnet_group":                               redshift.ResourceSubnetGroup(),
-			"aws_redshift_snapshot_copy_grant":                        redshift.ResourceSnapshotCopyGrant(),
-			"aws_redshift_snapshot_schedule":                          redshift.ResourceSnapshotSchedule(),
-			"aws_redshift_snapshot_schedule_association":              redshift.ResourceSnapshotScheduleAssociation(),
-			"aws_redshift_event_subscription":                         redshift.ResourceEventSubscription(),
-			"aws_redshift_scheduled_action":                           redshift.ResourceScheduledAction(),
-			"aws_resourcegroups_group":                                resourcegroups.ResourceGroup(),
-			"aws_route53_delegation_set":                              route53.ResourceDelegationSet(),
-			"aws_route53_hosted_zone_dnssec":                          route53.ResourceHostedZoneDNSSEC(),
-			"aws_route53_key_signing_key":                             route53.ResourceKeySigningKey(),
-			"aws_route53_query_log":                                   route53.ResourceQueryLog(),
-			"aws_route53_record":                                      route53.ResourceRecord(),
-			"aws_route53_zone_association":                            route53.ResourceZoneAssociation(),
-			"aws_route53_vpc_association_authorization":               route53.ResourceVPCAssociationAuthorization(),
-			"aws_route53_zone":                                        route53.ResourceZone(),
-			"aws_route53_health_check":                                route53.ResourceHealthCheck(),
-			"aws_route53_resolver_dnssec_config":                      route53resolver.ResourceDNSSECConfig(),
-			"aws_route53_resolver_endpoint":                           route53resolver.ResourceEndpoint(),
-			"aws_route53_resolver_firewall_config":                    route53resolver.ResourceFirewallConfig(),
-			"aws_route53_resolver_firewall_domain_list":               route53resolver.ResourceFirewallDomainList(),
-			"aws_route53_resolver_firewall_rule":                      route53resolver.ResourceFirewallRule(),
-			"aws_route53_resolver_firewall_rule_group":                route53resolver.ResourceFirewallRuleGroup(),
-			"aws_route53_resolver_firewall_rule_group_association":    route53resolver.ResourceFirewallRuleGroupAssociation(),
-			"aws_route53_resolver_query_log_config":                   route53resolver.ResourceQueryLogConfig(),
-			"aws_route53_resolver_query_log_config_association":       route53resolver.ResourceQueryLogConfigAssociation(),
-			"aws_route53_resolver_rule_association":                   route53resolver.ResourceRuleAssociation(),
-			"aws_route53_resolver_rule":                               route53resolver.ResourceRule(),
-			"aws_route53recoverycontrolconfig_cluster":                route53recoverycontrolconfig.ResourceCluster(),
-			"aws_route53recoverycontrolconfig_control_panel":          route53recoverycontrolconfig.ResourceControlPanel(),
-			"aws_route53recoverycontrolconfig_routing_control":        route53recoverycontrolconfig.ResourceRoutingControl(),
-			"aws_route53recoverycontrolconfig_safety_rule":            route53recoverycontrolconfig.ResourceSafetyRule(),
-			"aws_route53recoveryreadiness_cell":                       route53recoveryreadiness.ResourceCell(),
-			"aws_route53recoveryreadiness_readiness_check":            route53recoveryreadiness.ResourceReadinessCheck(),
-			"aws_route53recoveryreadiness_recovery_group":             route53recoveryreadiness.ResourceRecoveryGroup(),
-			"aws_route53recoveryreadiness_resource_set":               route53recoveryreadiness.ResourceResourceSet(),
-			"aws_route":                                               ec2.ResourceRoute(),
+			"aws_accessanalyzer_analyzer":                          accessanalyzer.ResourceAnalyzer(), //lintignore:AWSR007
+			"aws_acm_certificate":                                  acm.ResourceCertificate(),
+			"aws_acm_certificate_validation":                       acm.ResourceCertificateValidation(), //lintignore:AWSR007
+			"aws_acmpca_certificate_authority":                     acmpca.ResourceCertificateAuthority(),
+			"aws_acmpca_certificate_authority_certificate":         acmpca.ResourceCertificateAuthorityCertificate(), //lintignore:AWSR007
+			"aws_acmpca_certificate":                               acmpca.ResourceCertificate(),                     //lintignore:AWSR007
+			"aws_ami":                                              ec2.ResourceAMI(),                                //lintignore:AWSR007
+			"aws_ami_copy":                                         ec2.ResourceAMICopy(),                            //lintignore:AWSR007
+			"aws_ami_from_instance":                                ec2.ResourceAMIFromInstance(),                    //lintignore:AWSR007
+			"aws_ami_launch_permission":                            ec2.ResourceAMILaunchPermission(),                //lintignore:AWSR007
+			"aws_amplify_app":                                      amplify.ResourceApp(),
+			"aws_amplify_backend_environment":                      amplify.ResourceBackendEnvironment(),      //lintignore:AWSR007
+			"aws_amplify_branch":                                   amplify.ResourceBranch(),                  //lintignore:AWSR007
+			"aws_amplify_domain_association":                       amplify.ResourceDomainAssociation(),       //lintignore:AWSR007
+			"aws_amplify_webhook":                                  amplify.ResourceWebhook(),                 //lintignore:AWSR007
+			"aws_api_gateway_account":                              apigateway.ResourceAccount(),              //lintignore:AWSR007
+			"aws_api_gateway_api_key":                              apigateway.ResourceAPIKey(),               //lintignore:AWSR007
+			"aws_api_gateway_authorizer":                           apigateway.ResourceAuthorizer(),           //lintignore:AWSR007
+			"aws_api_gateway_base_path_mapping":                    apigateway.ResourceBasePathMapping(),      //lintignore:AWSR007
+			"aws_api_gateway_client_certificate":                   apigateway.ResourceClientCertificate(),    //lintignore:AWSR007
+			"aws_api_gateway_deployment":                           apigateway.ResourceDeployment(),           //lintignore:AWSR007
+			"aws_api_gateway_documentation_part":                   apigateway.ResourceDocumentationPart(),    //lintignore:AWSR007
+			"aws_api_gateway_documentation_version":                apigateway.ResourceDocumentationVersion(), //lintignore:AWSR007
+			"aws_api_gateway_domain_name":                          apigateway.ResourceDomainName(),           //lintignore:AWSR007
+			"aws_api_gateway_gateway_response":                     apigateway.ResourceGatewayResponse(),      //lintignore:AWSR007
+			"aws_api_gateway_integration":                          apigateway.ResourceIntegration(),          //lintignore:AWSR007
+			"aws_api_gateway_integration_response":                 apigateway.ResourceIntegrationResponse(),  //lintignore:AWSR007
+			"aws_api_gateway_method":                               apigateway.ResourceMethod(),               //lintignore:AWSR007
+			"aws_api_gateway_method_response":                      apigateway.ResourceMethodResponse(),       //lintignore:AWSR007
+			"aws_api_gateway_method_settings":                      apigateway.ResourceMethodSettings(),       //lintignore:AWSR007
+			"aws_api_gateway_model":                                apigateway.ResourceModel(),                //lintignore:AWSR007
+			"aws_api_gateway_request_validator":                    apigateway.ResourceRequestValidator(),     //lintignore:AWSR007
+			"aws_api_gateway_resource":                             apigateway.ResourceResource(),             //lintignore:AWSR007
+			"aws_api_gateway_rest_api":                             apigateway.ResourceRestAPI(),
+			"aws_api_gateway_rest_api_policy":                      apigateway.ResourceRestAPIPolicy(), //lintignore:AWSR007
+			"aws_api_gateway_stage":                                apigateway.ResourceStage(),         //lintignore:AWSR007
+			"aws_api_gateway_usage_plan":                           apigateway.ResourceUsagePlan(),     //lintignore:AWSR007
+			"aws_api_gateway_usage_plan_key":                       apigateway.ResourceUsagePlanKey(),  //lintignore:AWSR007
+			"aws_api_gateway_vpc_link":                             apigateway.ResourceVPCLink(),
+			"aws_apigatewayv2_api":                                 apigatewayv2.ResourceAPI(),
+			"aws_apigatewayv2_api_mapping":                         apigatewayv2.ResourceAPIMapping(), //lintignore:AWSR007
+			"aws_apigatewayv2_authorizer":                          apigatewayv2.ResourceAuthorizer(), //lintignore:AWSR007
+			"aws_apigatewayv2_deployment":                          apigatewayv2.ResourceDeployment(), //lintignore:AWSR007
+			"aws_apigatewayv2_domain_name":                         apigatewayv2.ResourceDomainName(),
+			"aws_apigatewayv2_integration":                         apigatewayv2.ResourceIntegration(),         //lintignore:AWSR007
+			"aws_apigatewayv2_integration_response":                apigatewayv2.ResourceIntegrationResponse(), //lintignore:AWSR007
+			"aws_apigatewayv2_model":                               apigatewayv2.ResourceModel(),               //lintignore:AWSR007
+			"aws_apigatewayv2_route":                               apigatewayv2.ResourceRoute(),               //lintignore:AWSR007
+			"aws_apigatewayv2_route_response":                      apigatewayv2.ResourceRouteResponse(),       //lintignore:AWSR007
+			"aws_apigatewayv2_stage":                               apigatewayv2.ResourceStage(),               //lintignore:AWSR007
+			"aws_apigatewayv2_vpc_link":                            apigatewayv2.ResourceVPCLink(),
+			"aws_app_cookie_stickiness_policy":                     elb.ResourceAppCookieStickinessPolicy(),          //lintignore:AWSR007
+			"aws_appautoscaling_target":                            applicationautoscaling.ResourceTarget(),          //lintignore:AWSR007
+			"aws_appautoscaling_policy":                            applicationautoscaling.ResourcePolicy(),          //lintignore:AWSR007
+			"aws_appautoscaling_scheduled_action":                  applicationautoscaling.ResourceScheduledAction(), //lintignore:AWSR007
+			"aws_appconfig_application":                            appconfig.ResourceApplication(),
+			"aws_appconfig_configuration_profile":                  appconfig.ResourceConfigurationProfile(),
+			"aws_appconfig_deployment":                             appconfig.ResourceDeployment(), //lintignore:AWSR007
+			"aws_appconfig_deployment_strategy":                    appconfig.ResourceDeploymentStrategy(),
+			"aws_appconfig_environment":                            appconfig.ResourceEnvironment(),
+			"aws_appconfig_hosted_configuration_version":           appconfig.ResourceHostedConfigurationVersion(),
+			"aws_appmesh_gateway_route":                            appmesh.ResourceGatewayRoute(),
+			"aws_appmesh_mesh":                                     appmesh.ResourceMesh(),
+			"aws_appmesh_route":                                    appmesh.ResourceRoute(),
+			"aws_appmesh_virtual_gateway":                          appmesh.ResourceVirtualGateway(),
+			"aws_appmesh_virtual_node":                             appmesh.ResourceVirtualNode(),
+			"aws_appmesh_virtual_router":                           appmesh.ResourceVirtualRouter(),
+			"aws_appmesh_virtual_service":                          appmesh.ResourceVirtualService(),
+			"aws_apprunner_auto_scaling_configuration_version":     apprunner.ResourceAutoScalingConfigurationVersion(),
+			"aws_apprunner_connection":                             apprunner.ResourceConnection(),
+			"aws_apprunner_custom_domain_association":              apprunner.ResourceCustomDomainAssociation(), //lintignore:AWSR007
+			"aws_apprunner_service":                                apprunner.ResourceService(),
+			"aws_appstream_stack":                                  appstream.ResourceStack(),
+			"aws_appstream_fleet":                                  appstream.ResourceFleet(),
+			"aws_appstream_image_builder":                          appstream.ResourceImageBuilder(),
+			"aws_appsync_api_key":                                  appsync.ResourceAPIKey(),     //lintignore:AWSR007
+			"aws_appsync_datasource":                               appsync.ResourceDataSource(), //lintignore:AWSR007
+			"aws_appsync_function":                                 appsync.ResourceFunction(),   //lintignore:AWSR007
+			"aws_appsync_graphql_api":                              appsync.ResourceGraphQLAPI(),
+			"aws_appsync_resolver":                                 appsync.ResourceResolver(),       //lintignore:AWSR007
+			"aws_athena_database":                                  athena.ResourceDatabase(),        //lintignore:AWSR007
+			"aws_athena_named_query":                               athena.ResourceNamedQuery(),      //lintignore:AWSR007
+			"aws_athena_workgroup":                                 athena.ResourceWorkGroup(),       //lintignore:AWSR007
+			"aws_autoscaling_attachment":                           autoscaling.ResourceAttachment(), //lintignore:AWSR007
+			"aws_autoscaling_group":                                autoscaling.ResourceGroup(),
+			"aws_autoscaling_group_tag":                            autoscaling.ResourceGroupTag(),      //lintignore:AWSR007
+			"aws_autoscaling_lifecycle_hook":                       autoscaling.ResourceLifecycleHook(), //lintignore:AWSR007
+			"aws_autoscaling_notification":                         autoscaling.ResourceNotification(),  //lintignore:AWSR007
+			"aws_autoscaling_policy":                               autoscaling.ResourcePolicy(),        //lintignore:AWSR007
+			"aws_autoscaling_schedule":                             autoscaling.ResourceSchedule(),      //lintignore:AWSR007
+			"aws_autoscalingplans_scaling_plan":                    autoscalingplans.ResourceScalingPlan(),
+			"aws_backup_global_settings":                           backup.ResourceGlobalSettings(), //lintignore:AWSR007
+			"aws_backup_plan":                                      backup.ResourcePlan(),           //lintignore:AWSR007
+			"aws_backup_region_settings":                           backup.ResourceRegionSettings(), //lintignore:AWSR007
+			"aws_backup_selection":                                 backup.ResourceSelection(),      //lintignore:AWSR007
+			"aws_backup_vault":                                     backup.ResourceVault(),
+			"aws_backup_vault_notifications":                       backup.ResourceVaultNotifications(),
+			"aws_backup_vault_policy":                              backup.ResourceVaultPolicy(),
+			"aws_budgets_budget":                                   budgets.ResourceBudget(),
+			"aws_budgets_budget_action":                            budgets.ResourceBudgetAction(),
+			"aws_chime_voice_connector":                            chime.ResourceVoiceConnector(),                       //lintignore:AWSR007
+			"aws_chime_voice_connector_group":                      chime.ResourceVoiceConnectorGroup(),                  //lintignore:AWSR007
+			"aws_chime_voice_connector_logging":                    chime.ResourceVoiceConnectorLogging(),                //lintignore:AWSR007
+			"aws_chime_voice_connector_streaming":                  chime.ResourceVoiceConnectorStreaming(),              //lintignore:AWSR007
+			"aws_chime_voice_connector_origination":                chime.ResourceVoiceConnectorOrigination(),            //lintignore:AWSR007
+			"aws_chime_voice_connector_termination":                chime.ResourceVoiceConnectorTermination(),            //lintignore:AWSR007
+			"aws_chime_voice_connector_termination_credentials":    chime.ResourceVoiceConnectorTerminationCredentials(), //lintignore:AWSR007
+			"aws_cloud9_environment_ec2":                           cloud9.ResourceEnvironmentEC2(),                      //lintignore:AWSR007
+			"aws_cloudcontrolapi_resource":                         cloudcontrol.ResourceResource(),                      //lintignore:AWSR007
+			"aws_cloudformation_stack":                             cloudformation.ResourceStack(),
+			"aws_cloudformation_stack_set":                         cloudformation.ResourceStackSet(),
+			"aws_cloudformation_stack_set_instance":                cloudformation.ResourceStackSetInstance(),
+			"aws_cloudformation_type":                              cloudformation.ResourceType(),    //lintignore:AWSR007
+			"aws_cloudfront_cache_policy":                          cloudfront.ResourceCachePolicy(), //lintignore:AWSR007
+			"aws_cloudfront_distribution":                          cloudfront.ResourceDistribution(),
+			"aws_cloudfront_function":                              cloudfront.ResourceFunction(),
+			"aws_cloudfront_key_group":                             cloudfront.ResourceKeyGroup(),
+			"aws_cloudfront_monitoring_subscription":               cloudfront.ResourceMonitoringSubscription(),
+			"aws_cloudfront_origin_access_identity":                cloudfront.ResourceOriginAccessIdentity(), //lintignore:AWSR007
+			"aws_cloudfront_origin_request_policy":                 cloudfront.ResourceOriginRequestPolicy(),  //lintignore:AWSR007
+			"aws_cloudfront_public_key":                            cloudfront.ResourcePublicKey(),            //lintignore:AWSR007
+			"aws_cloudfront_realtime_log_config":                   cloudfront.ResourceRealtimeLogConfig(),
+			"aws_cloudtrail":                                       cloudtrail.ResourceCloudTrail(),
+			"aws_cloudwatch_event_bus":                             cloudwatchevents.ResourceBus(),
+			"aws_cloudwatch_event_bus_policy":                      cloudwatchevents.ResourceBusPolicy(), //lintignore:AWSR007
+			"aws_cloudwatch_event_permission":                      cloudwatchevents.ResourcePermission(),
+			"aws_cloudwatch_event_rule":                            cloudwatchevents.ResourceRule(),
+			"aws_cloudwatch_event_target":                          cloudwatchevents.ResourceTarget(),
+			"aws_cloudwatch_event_archive":                         cloudwatchevents.ResourceArchive(),
+			"aws_cloudwatch_event_connection":                      cloudwatchevents.ResourceConnection(),
+			"aws_cloudwatch_event_api_destination":                 cloudwatchevents.ResourceAPIDestination(),
+			"aws_cloudwatch_log_destination":                       cloudwatchlogs.ResourceDestination(),       //lintignore:AWSR007
+			"aws_cloudwatch_log_destination_policy":                cloudwatchlogs.ResourceDestinationPolicy(), //lintignore:AWSR007
+			"aws_cloudwatch_log_group":                             cloudwatchlogs.ResourceGroup(),
+			"aws_cloudwatch_log_metric_filter":                     cloudwatchlogs.ResourceMetricFilter(), //lintignore:AWSR007
+			"aws_cloudwatch_log_resource_policy":                   cloudwatchlogs.ResourceResourcePolicy(),
+			"aws_cloudwatch_log_stream":                            cloudwatchlogs.ResourceStream(),             //lintignore:AWSR007
+			"aws_cloudwatch_log_subscription_filter":               cloudwatchlogs.ResourceSubscriptionFilter(), //lintignore:AWSR007
+			"aws_config_aggregate_authorization":                   config.ResourceAggregateAuthorization(),
+			"aws_config_config_rule":                               config.ResourceConfigRule(), //lintignore:AWSR007
+			"aws_config_configuration_aggregator":                  config.ResourceConfigurationAggregator(),
+			"aws_config_configuration_recorder":                    config.ResourceConfigurationRecorder(),
+			"aws_config_configuration_recorder_status":             config.ResourceConfigurationRecorderStatus(), //lintignore:AWSR007
+			"aws_config_conformance_pack":                          config.ResourceConformancePack(),             //lintignore:AWSR007
+			"aws_config_delivery_channel":                          config.ResourceDeliveryChannel(),
+			"aws_config_organization_conformance_pack":             config.ResourceOrganizationConformancePack(),  //lintignore:AWSR007
+			"aws_config_organization_custom_rule":                  config.ResourceOrganizationCustomRule(),       //lintignore:AWSR007
+			"aws_config_organization_managed_rule":                 config.ResourceOrganizationManagedRule(),      //lintignore:AWSR007
+			"aws_config_remediation_configuration":                 config.ResourceRemediationConfiguration(),     //lintignore:AWSR007
+			"aws_cognito_identity_pool":                            cognitoidentity.ResourcePool(),                //lintignore:AWSR007
+			"aws_cognito_identity_pool_roles_attachment":           cognitoidentity.ResourcePoolRolesAttachment(), //lintignore:AWSR007
+			"aws_cognito_identity_provider":                        cognitoidp.ResourceIdentityProvider(),         //lintignore:AWSR007
+			"aws_cognito_resource_server":                          cognitoidp.ResourceResourceServer(),           //lintignore:AWSR007
+			"aws_cognito_user_group":                               cognitoidp.ResourceUserGroup(),                //lintignore:AWSR007
+			"aws_cognito_user_pool":                                cognitoidp.ResourceUserPool(),
+			"aws_cognito_user_pool_client":                         cognitoidp.ResourceUserPoolClient(), //lintignore:AWSR007
+			"aws_cognito_user_pool_domain":                         cognitoidp.ResourceUserPoolDomain(),
+			"aws_cognito_user_pool_ui_customization":               cognitoidp.ResourceUserPoolUICustomization(), //lintignore:AWSR007
+			"aws_cloudhsm_v2_cluster":                              cloudhsmv2.ResourceCluster(),
+			"aws_cloudhsm_v2_hsm":                                  cloudhsmv2.ResourceHSM(),
+			"aws_cloudwatch_composite_alarm":                       cloudwatch.ResourceCompositeAlarm(),
+			"aws_cloudwatch_metric_alarm":                          cloudwatch.ResourceMetricAlarm(),  //lintignore:AWSR007
+			"aws_cloudwatch_dashboard":                             cloudwatch.ResourceDashboard(),    //lintignore:AWSR007
+			"aws_cloudwatch_metric_stream":                         cloudwatch.ResourceMetricStream(), //lintignore:AWSR007
+			"aws_cloudwatch_query_definition":                      cloudwatchlogs.ResourceQueryDefinition(),
+			"aws_codedeploy_app":                                   codedeploy.ResourceApp(),
+			"aws_codedeploy_deployment_config":                     codedeploy.ResourceDeploymentConfig(), //lintignore:AWSR007
+			"aws_codedeploy_deployment_group":                      codedeploy.ResourceDeploymentGroup(),  //lintignore:AWSR007
+			"aws_codecommit_repository":                            codecommit.ResourceRepository(),       //lintignore:AWSR007
+			"aws_codecommit_trigger":                               codecommit.ResourceTrigger(),          //lintignore:AWSR007
+			"aws_codeartifact_domain":                              codeartifact.ResourceDomain(),
+			"aws_codeartifact_domain_permissions_policy":           codeartifact.ResourceDomainPermissionsPolicy(), //lintignore:AWSR007
+			"aws_codeartifact_repository":                          codeartifact.ResourceRepository(),
+			"aws_codeartifact_repository_permissions_policy":       codeartifact.ResourceRepositoryPermissionsPolicy(), //lintignore:AWSR007
+			"aws_codebuild_project":                                codebuild.ResourceProject(),                        //lintignore:AWSR007
+			"aws_codebuild_report_group":                           codebuild.ResourceReportGroup(),
+			"aws_codebuild_source_credential":                      codebuild.ResourceSourceCredential(), //lintignore:AWSR007
+			"aws_codebuild_webhook":                                codebuild.ResourceWebhook(),          //lintignore:AWSR007
+			"aws_codepipeline":                                     codepipeline.ResourceCodePipeline(),
+			"aws_codepipeline_webhook":                             codepipeline.ResourceWebhook(),                   //lintignore:AWSR007
+			"aws_codestarconnections_connection":                   codestarconnections.ResourceConnection(),         //lintignore:AWSR007
+			"aws_codestarconnections_host":                         codestarconnections.ResourceHost(),               //lintignore:AWSR007
+			"aws_codestarnotifications_notification_rule":          codestarnotifications.ResourceNotificationRule(), //lintignore:AWSR007
+			"aws_connect_contact_flow":                             connect.ResourceContactFlow(),                    //lintignore:AWSR007
+			"aws_connect_instance":                                 connect.ResourceInstance(),
+			"aws_cur_report_definition":                            cur.ResourceReportDefinition(),
+			"aws_customer_gateway":                                 ec2.ResourceCustomerGateway(),   //lintignore:AWSR007
+			"aws_datapipeline_pipeline":                            datapipeline.ResourcePipeline(), //lintignore:AWSR007
+			"aws_datasync_agent":                                   datasync.ResourceAgent(),
+			"aws_datasync_location_efs":                            datasync.ResourceLocationEFS(),
+			"aws_datasync_location_fsx_windows_file_system":        datasync.ResourceLocationFSxWindowsFileSystem(),
+			"aws_datasync_location_nfs":                            datasync.ResourceLocationNFS(),
+			"aws_datasync_location_s3":                             datasync.ResourceLocationS3(),
+			"aws_datasync_location_smb":                            datasync.ResourceLocationSMB(),
+			"aws_datasync_task":                                    datasync.ResourceTask(),
+			"aws_dax_cluster":                                      dax.ResourceCluster(),
+			"aws_dax_parameter_group":                              dax.ResourceParameterGroup(), //lintignore:AWSR007
+			"aws_dax_subnet_group":                                 dax.ResourceSubnetGroup(),    //lintignore:AWSR007
+			"aws_db_cluster_snapshot":                              rds.ResourceClusterSnapshot(),
+			"aws_db_event_subscription":                            rds.ResourceEventSubscription(),
+			"aws_db_instance":                                      rds.ResourceInstance(),
+			"aws_db_instance_role_association":                     rds.ResourceInstanceRoleAssociation(), //lintignore:AWSR007
+			"aws_db_option_group":                                  rds.ResourceOptionGroup(),
+			"aws_db_parameter_group":                               rds.ResourceParameterGroup(),
+			"aws_db_proxy":                                         rds.ResourceProxy(),
+			"aws_db_proxy_default_target_group":                    rds.ResourceProxyDefaultTargetGroup(), //lintignore:AWSR007
+			"aws_db_proxy_endpoint":                                rds.ResourceProxyEndpoint(),           //lintignore:AWSR007
+			"aws_db_proxy_target":                                  rds.ResourceProxyTarget(),             //lintignore:AWSR007
+			"aws_db_security_group":                                rds.ResourceSecurityGroup(),           //lintignore:AWSR007
+			"aws_db_snapshot":                                      rds.ResourceSnapshot(),
+			"aws_db_subnet_group":                                  rds.ResourceSubnetGroup(),
+			"aws_devicefarm_project":                               devicefarm.ResourceProject(), //lintignore:AWSR007
+			"aws_directory_service_directory":                      ds.ResourceDirectory(),
+			"aws_directory_service_conditional_forwarder":          ds.ResourceConditionalForwarder(), //lintignore:AWSR007
+			"aws_directory_service_log_subscription":               ds.ResourceLogSubscription(),      //lintignore:AWSR007
+			"aws_dlm_lifecycle_policy":                             dlm.ResourceLifecyclePolicy(),     //lintignore:AWSR007
+			"aws_dms_certificate":                                  dms.ResourceCertificate(),         //lintignore:AWSR007
+			"aws_dms_endpoint":                                     dms.ResourceEndpoint(),            //lintignore:AWSR007
+			"aws_dms_event_subscription":                           dms.ResourceEventSubscription(),   //lintignore:AWSR007
+			"aws_dms_replication_instance":                         dms.ResourceReplicationInstance(),
+			"aws_dms_replication_subnet_group":                     dms.ResourceReplicationSubnetGroup(),  //lintignore:AWSR007
+			"aws_dms_replication_task":                             dms.ResourceReplicationTask(),         //lintignore:AWSR007
+			"aws_docdb_cluster":                                    docdb.ResourceCluster(),               //lintignore:AWSR007
+			"aws_docdb_cluster_instance":                           docdb.ResourceClusterInstance(),       //lintignore:AWSR007
+			"aws_docdb_cluster_parameter_group":                    docdb.ResourceClusterParameterGroup(), //lintignore:AWSR007
+			"aws_docdb_cluster_snapshot":                           docdb.ResourceClusterSnapshot(),       //lintignore:AWSR007
+			"aws_docdb_subnet_group":                               docdb.ResourceSubnetGroup(),           //lintignore:AWSR007
+			"aws_dx_bgp_peer":                                      directconnect.ResourceBGPPeer(),       //lintignore:AWSR007
+			"aws_dx_connection":                                    directconnect.ResourceConnection(),
+			"aws_dx_connection_association":                        directconnect.ResourceConnectionAssociation(),  //lintignore:AWSR007
+			"aws_dx_connection_confirmation":                       directconnect.ResourceConnectionConfirmation(), //lintignore:AWSR007
+			"aws_dx_gateway":                                       directconnect.ResourceGateway(),
+			"aws_dx_gateway_association":                           directconnect.ResourceGatewayAssociation(),
+			"aws_dx_gateway_association_proposal":                  directconnect.ResourceGatewayAssociationProposal(),
+			"aws_dx_hosted_connection":                             directconnect.ResourceHostedConnection(),                      //lintignore:AWSR007
+			"aws_dx_hosted_private_virtual_interface":              directconnect.ResourceHostedPrivateVirtualInterface(),         //lintignore:AWSR007
+			"aws_dx_hosted_private_virtual_interface_accepter":     directconnect.ResourceHostedPrivateVirtualInterfaceAccepter(), //lintignore:AWSR007
+			"aws_dx_hosted_public_virtual_interface":               directconnect.ResourceHostedPublicVirtualInterface(),          //lintignore:AWSR007
+			"aws_dx_hosted_public_virtual_interface_accepter":      directconnect.ResourceHostedPublicVirtualInterfaceAccepter(),  //lintignore:AWSR007
+			"aws_dx_hosted_transit_virtual_interface":              directconnect.ResourceHostedTransitVirtualInterface(),         //lintignore:AWSR007
+			"aws_dx_hosted_transit_virtual_interface_accepter":     directconnect.ResourceHostedTransitVirtualInterfaceAccepter(), //lintignore:AWSR007
+			"aws_dx_lag":                                           directconnect.ResourceLag(),
+			"aws_dx_private_virtual_interface":                     directconnect.ResourcePrivateVirtualInterface(), //lintignore:AWSR007
+			"aws_dx_public_virtual_interface":                      directconnect.ResourcePublicVirtualInterface(),  //lintignore:AWSR007
+			"aws_dx_transit_virtual_interface":                     directconnect.ResourceTransitVirtualInterface(), //lintignore:AWSR007
+			"aws_dynamodb_table":                                   dynamodb.ResourceTable(),
+			"aws_dynamodb_table_item":                              dynamodb.ResourceTableItem(),                   //lintignore:AWSR007
+			"aws_dynamodb_tag":                                     dynamodb.ResourceTag(),                         //lintignore:AWSR007
+			"aws_dynamodb_global_table":                            dynamodb.ResourceGlobalTable(),                 //lintignore:AWSR007
+			"aws_dynamodb_kinesis_streaming_destination":           dynamodb.ResourceKinesisStreamingDestination(), //lintignore:AWSR007
+			"aws_ebs_default_kms_key":                              ec2.ResourceEBSDefaultKMSKey(),                 //lintignore:AWSR007
+			"aws_ebs_encryption_by_default":                        ec2.ResourceEBSEncryptionByDefault(),           //lintignore:AWSR007
+			"aws_ebs_snapshot":                                     ec2.ResourceEBSSnapshot(),                      //lintignore:AWSR007
+			"aws_ebs_snapshot_copy":                                ec2.ResourceEBSSnapshotCopy(),                  //lintignore:AWSR007
+			"aws_ebs_snapshot_import":                              ec2.ResourceEBSSnapshotImport(),                //lintignore:AWSR007
+			"aws_ebs_volume":                                       ec2.ResourceEBSVolume(),
+			"aws_ec2_availability_zone_group":                      ec2.ResourceAvailabilityZoneGroup(), //lintignore:AWSR007
+			"aws_ec2_capacity_reservation":                         ec2.ResourceCapacityReservation(),
+			"aws_ec2_carrier_gateway":                              ec2.ResourceCarrierGateway(),
+			"aws_ec2_client_vpn_authorization_rule":                ec2.ResourceClientVPNAuthorizationRule(), //lintignore:AWSR007
+			"aws_ec2_client_vpn_endpoint":                          ec2.ResourceClientVPNEndpoint(),
+			"aws_ec2_client_vpn_network_association":               ec2.ResourceClientVPNNetworkAssociation(),
+			"aws_ec2_client_vpn_route":                             ec2.ResourceClientVPNRoute(), //lintignore:AWSR007
+			"aws_ec2_fleet":                                        ec2.ResourceFleet(),          //lintignore:AWSR007
+			"aws_ec2_host":                                         ec2.ResourceHost(),
+			"aws_ec2_local_gateway_route":                          ec2.ResourceLocalGatewayRoute(),                    //lintignore:AWSR007
+			"aws_ec2_local_gateway_route_table_vpc_association":    ec2.ResourceLocalGatewayRouteTableVPCAssociation(), //lintignore:AWSR007
+			"aws_ec2_managed_prefix_list":                          ec2.ResourceManagedPrefixList(),                    //lintignore:AWSR007
+			"aws_ec2_managed_prefix_list_entry":                    ec2.ResourceManagedPrefixListEntry(),               //lintignore:AWSR007
+			"aws_ec2_tag":                                          ec2.ResourceTag(),                                  //lintignore:AWSR007
+			"aws_ec2_traffic_mirror_filter":                        ec2.ResourceTrafficMirrorFilter(),                  //lintignore:AWSR007
+			"aws_ec2_traffic_mirror_filter_rule":                   ec2.ResourceTrafficMirrorFilterRule(),              //lintignore:AWSR007
+			"aws_ec2_traffic_mirror_target":                        ec2.ResourceTrafficMirrorTarget(),                  //lintignore:AWSR007
+			"aws_ec2_traffic_mirror_session":                       ec2.ResourceTrafficMirrorSession(),                 //lintignore:AWSR007
+			"aws_ec2_transit_gateway":                              ec2.ResourceTransitGateway(),
+			"aws_ec2_transit_gateway_peering_attachment":           ec2.ResourceTransitGatewayPeeringAttachment(),
+			"aws_ec2_transit_gateway_peering_attachment_accepter":  ec2.ResourceTransitGatewayPeeringAttachmentAccepter(), //lintignore:AWSR007
+			"aws_ec2_transit_gateway_prefix_list_reference":        ec2.ResourceTransitGatewayPrefixListReference(),       //lintignore:AWSR007
+			"aws_ec2_transit_gateway_route":                        ec2.ResourceTransitGatewayRoute(),                     //lintignore:AWSR007
+			"aws_ec2_transit_gateway_route_table":                  ec2.ResourceTransitGatewayRouteTable(),                //lintignore:AWSR007
+			"aws_ec2_transit_gateway_route_table_association":      ec2.ResourceTransitGatewayRouteTableAssociation(),     //lintignore:AWSR007
+			"aws_ec2_transit_gateway_route_table_propagation":      ec2.ResourceTransitGatewayRouteTablePropagation(),     //lintignore:AWSR007
+			"aws_ec2_transit_gateway_vpc_attachment":               ec2.ResourceTransitGatewayVPCAttachment(),
+			"aws_ec2_transit_gateway_vpc_attachment_accepter":      ec2.ResourceTransitGatewayVPCAttachmentAccepter(), //lintignore:AWSR007
+			"aws_ecr_lifecycle_policy":                             ecr.ResourceLifecyclePolicy(),                     //lintignore:AWSR007
+			"aws_ecrpublic_repository":                             ecrpublic.ResourceRepository(),
+			"aws_ecr_registry_policy":                              ecr.ResourceRegistryPolicy(),           //lintignore:AWSR007
+			"aws_ecr_replication_configuration":                    ecr.ResourceReplicationConfiguration(), //lintignore:AWSR007
+			"aws_ecr_repository":                                   ecr.ResourceRepository(),
+			"aws_ecr_repository_policy":                            ecr.ResourceRepositoryPolicy(), //lintignore:AWSR007
+			"aws_ecs_capacity_provider":                            ecs.ResourceCapacityProvider(),
+			"aws_ecs_cluster":                                      ecs.ResourceCluster(),
+			"aws_ecs_service":                                      ecs.ResourceService(),
+			"aws_ecs_tag":                                          ecs.ResourceTag(), //lintignore:AWSR007
+			"aws_ecs_task_definition":                              ecs.ResourceTaskDefinition(),
+			"aws_efs_access_point":                                 efs.ResourceAccessPoint(),
+			"aws_efs_backup_policy":                                efs.ResourceBackupPolicy(), //lintignore:AWSR007
+			"aws_efs_file_system":                                  efs.ResourceFileSystem(),
+			"aws_efs_file_system_policy":                           efs.ResourceFileSystemPolicy(), //lintignore:AWSR007
+			"aws_efs_mount_target":                                 efs.ResourceMountTarget(),
+			"aws_egress_only_internet_gateway":                     ec2.ResourceEgressOnlyInternetGateway(),
+			"aws_eip":                                              ec2.ResourceEIP(),
+			"aws_eip_association":                                  ec2.ResourceEIPAssociation(), //lintignore:AWSR007
+			"aws_eks_cluster":                                      eks.ResourceCluster(),
+			"aws_eks_addon":                                        eks.ResourceAddon(),
+			"aws_eks_fargate_profile":                              eks.ResourceFargateProfile(),
+			"aws_eks_identity_provider_config":                     eks.ResourceIdentityProviderConfig(),
+			"aws_eks_node_group":                                   eks.ResourceNodeGroup(),
+			"aws_elasticache_cluster":                              elasticache.ResourceCluster(),
+			"aws_elasticache_global_replication_group":             elasticache.ResourceGlobalReplicationGroup(),
+			"aws_elasticache_parameter_group":                      elasticache.ResourceParameterGroup(),
+			"aws_elasticache_replication_group":                    elasticache.ResourceReplicationGroup(),
+			"aws_elasticache_security_group":                       elasticache.ResourceSecurityGroup(),
+			"aws_elasticache_subnet_group":                         elasticache.ResourceSubnetGroup(),
+			"aws_elasticache_user":                                 elasticache.ResourceUser(),      //lintignore:AWSR007
+			"aws_elasticache_user_group":                           elasticache.ResourceUserGroup(), //lintignore:AWSR007
+			"aws_elastic_beanstalk_application":                    elasticbeanstalk.ResourceApplication(),
+			"aws_elastic_beanstalk_application_version":            elasticbeanstalk.ResourceApplicationVersion(),    //lintignore:AWSR007
+			"aws_elastic_beanstalk_configuration_template":         elasticbeanstalk.ResourceConfigurationTemplate(), //lintignore:AWSR007
+			"aws_elastic_beanstalk_environment":                    elasticbeanstalk.ResourceEnvironment(),
+			"aws_elasticsearch_domain":                             elasticsearch.ResourceDomain(),
+			"aws_elasticsearch_domain_policy":                      elasticsearch.ResourceDomainPolicy(),      //lintignore:AWSR007
+			"aws_elasticsearch_domain_saml_options":                elasticsearch.ResourceDomainSAMLOptions(), //lintignore:AWSR007
+			"aws_elastictranscoder_pipeline":                       elastictranscoder.ResourcePipeline(),      //lintignore:AWSR007
+			"aws_elastictranscoder_preset":                         elastictranscoder.ResourcePreset(),        //lintignore:AWSR007
+			"aws_elb":                                              elb.ResourceLoadBalancer(),
+			"aws_elb_attachment":                                   elb.ResourceAttachment(), //lintignore:AWSR007
+			"aws_emr_cluster":                                      emr.ResourceCluster(),
+			"aws_emr_instance_group":                               emr.ResourceInstanceGroup(),         //lintignore:AWSR007
+			"aws_emr_instance_fleet":                               emr.ResourceInstanceFleet(),         //lintignore:AWSR007
+			"aws_emr_managed_scaling_policy":                       emr.ResourceManagedScalingPolicy(),  //lintignore:AWSR007
+			"aws_emr_security_configuration":                       emr.ResourceSecurityConfiguration(), //lintignore:AWSR007
+			"aws_flow_log":                                         ec2.ResourceFlowLog(),
+			"aws_fsx_backup":                                       fsx.ResourceBackup(),
+			"aws_fsx_lustre_file_system":                           fsx.ResourceLustreFileSystem(),
+			"aws_fsx_ontap_file_system":                            fsx.ResourceOntapFileSystem(),
+			"aws_fsx_windows_file_system":                          fsx.ResourceWindowsFileSystem(),
+			"aws_fms_admin_account":                                fms.ResourceAdminAccount(), //lintignore:AWSR007
+			"aws_fms_policy":                                       fms.ResourcePolicy(),       //lintignore:AWSR007
+			"aws_gamelift_alias":                                   gamelift.ResourceAlias(),
+			"aws_gamelift_build":                                   gamelift.ResourceBuild(), //lintignore:AWSR007
+			"aws_gamelift_fleet":                                   gamelift.ResourceFleet(), //lintignore:AWSR007
+			"aws_gamelift_game_session_queue":                      gamelift.ResourceGameSessionQueue(),
+			"aws_glacier_vault":                                    glacier.ResourceVault(),
+			"aws_glacier_vault_lock":                               glacier.ResourceVaultLock(), //lintignore:AWSR007
+			"aws_globalaccelerator_accelerator":                    globalaccelerator.ResourceAccelerator(),
+			"aws_globalaccelerator_endpoint_group":                 globalaccelerator.ResourceEndpointGroup(), //lintignore:AWSR007
+			"aws_globalaccelerator_listener":                       globalaccelerator.ResourceListener(),      //lintignore:AWSR007
+			"aws_glue_catalog_database":                            glue.ResourceCatalogDatabase(),
+			"aws_glue_catalog_table":                               glue.ResourceCatalogTable(), //lintignore:AWSR007
+			"aws_glue_classifier":                                  glue.ResourceClassifier(),
+			"aws_glue_connection":                                  glue.ResourceConnection(),
+			"aws_glue_dev_endpoint":                                glue.ResourceDevEndpoint(),
+			"aws_glue_crawler":                                     glue.ResourceCrawler(),
+			"aws_glue_data_catalog_encryption_settings":            glue.ResourceDataCatalogEncryptionSettings(), //lintignore:AWSR007
+			"aws_glue_job":                                         glue.ResourceJob(),
+			"aws_glue_ml_transform":                                glue.ResourceMLTransform(),
+			"aws_glue_partition":                                   glue.ResourcePartition(),      //lintignore:AWSR007
+			"aws_glue_partition_index":                             glue.ResourcePartitionIndex(), //lintignore:AWSR007
+			"aws_glue_registry":                                    glue.ResourceRegistry(),
+			"aws_glue_resource_policy":                             glue.ResourceResourcePolicy(), //lintignore:AWSR007
+			"aws_glue_schema":                                      glue.ResourceSchema(),
+			"aws_glue_security_configuration":                      glue.ResourceSecurityConfiguration(),
+			"aws_glue_trigger":                                     glue.ResourceTrigger(),
+			"aws_glue_user_defined_function":                       glue.ResourceUserDefinedFunction(), //lintignore:AWSR007
+			"aws_glue_workflow":                                    glue.ResourceWorkflow(),
+			"aws_guardduty_detector":                               guardduty.ResourceDetector(),
+			"aws_guardduty_filter":                                 guardduty.ResourceFilter(),                    //lintignore:AWSR007
+			"aws_guardduty_invite_accepter":                        guardduty.ResourceInviteAccepter(),            //lintignore:AWSR007
+			"aws_guardduty_ipset":                                  guardduty.ResourceIPSet(),                     //lintignore:AWSR007
+			"aws_guardduty_member":                                 guardduty.ResourceMember(),                    //lintignore:AWSR007
+			"aws_guardduty_organization_admin_account":             guardduty.ResourceOrganizationAdminAccount(),  //lintignore:AWSR007
+			"aws_guardduty_organization_configuration":             guardduty.ResourceOrganizationConfiguration(), //lintignore:AWSR007
+			"aws_guardduty_publishing_destination":                 guardduty.ResourcePublishingDestination(),
+			"aws_guardduty_threatintelset":                         guardduty.ResourceThreatintelset(),  //lintignore:AWSR007
+			"aws_iam_access_key":                                   iam.ResourceAccessKey(),             //lintignore:AWSR007
+			"aws_iam_account_alias":                                iam.ResourceAccountAlias(),          //lintignore:AWSR007
+			"aws_iam_account_password_policy":                      iam.ResourceAccountPasswordPolicy(), //lintignore:AWSR007
+			"aws_iam_group_policy":                                 iam.ResourceGroupPolicy(),           //lintignore:AWSR007
+			"aws_iam_group":                                        iam.ResourceGroup(),
+			"aws_iam_group_membership":                             iam.ResourceGroupMembership(),       //lintignore:AWSR007
+			"aws_iam_group_policy_attachment":                      iam.ResourceGroupPolicyAttachment(), //lintignore:AWSR007
+			"aws_iam_instance_profile":                             iam.ResourceInstanceProfile(),
+			"aws_iam_openid_connect_provider":                      iam.ResourceOpenIDConnectProvider(),
+			"aws_iam_policy":                                       iam.ResourcePolicy(),
+			"aws_iam_policy_attachment":                            iam.ResourcePolicyAttachment(),     //lintignore:AWSR007
+			"aws_iam_role_policy_attachment":                       iam.ResourceRolePolicyAttachment(), //lintignore:AWSR007
+			"aws_iam_role_policy":                                  iam.ResourceRolePolicy(),           //lintignore:AWSR007
+			"aws_iam_role":                                         iam.ResourceRole(),
+			"aws_iam_saml_provider":                                iam.ResourceSamlProvider(),
+			"aws_iam_server_certificate":                           iam.ResourceServerCertificate(),
+			"aws_iam_service_linked_role":                          iam.ResourceServiceLinkedRole(),
+			"aws_iam_user_group_membership":                        iam.ResourceUserGroupMembership(),  //lintignore:AWSR007
+			"aws_iam_user_policy_attachment":                       iam.ResourceUserPolicyAttachment(), //lintignore:AWSR007
+			"aws_iam_user_policy":                                  iam.ResourceUserPolicy(),           //lintignore:AWSR007
+			"aws_iam_user_ssh_key":                                 iam.ResourceUserSSHKey(),           //lintignore:AWSR007
+			"aws_iam_user":                                         iam.ResourceUser(),
+			"aws_iam_user_login_profile":                           iam.ResourceUserLoginProfile(), //lintignore:AWSR007
+			"aws_imagebuilder_component":                           imagebuilder.ResourceComponent(),
+			"aws_imagebuilder_distribution_configuration":          imagebuilder.ResourceDistributionConfiguration(),
+			"aws_imagebuilder_image":                               imagebuilder.ResourceImage(),
+			"aws_imagebuilder_image_pipeline":                      imagebuilder.ResourceImagePipeline(),
+			"aws_imagebuilder_image_recipe":                        imagebuilder.ResourceImageRecipe(),
+			"aws_imagebuilder_infrastructure_configuration":        imagebuilder.ResourceInfrastructureConfiguration(),
+			"aws_inspector_assessment_target":                      inspector.ResourceAssessmentTarget(),   //lintignore:AWSR007
+			"aws_inspector_assessment_template":                    inspector.ResourceAssessmentTemplate(), //lintignore:AWSR007
+			"aws_inspector_resource_group":                         inspector.ResourceResourceGroup(),      //lintignore:AWSR007
+			"aws_instance":                                         ec2.ResourceInstance(),
+			"aws_internet_gateway":                                 ec2.ResourceInternetGateway(),
+			"aws_iot_authorizer":                                   iot.ResourceAuthorizer(),  //lintignore:AWSR007
+			"aws_iot_certificate":                                  iot.ResourceCertificate(), //lintignore:AWSR007
+			"aws_iot_policy":                                       iot.ResourcePolicy(),
+			"aws_iot_policy_attachment":                            iot.ResourcePolicyAttachment(), //lintignore:AWSR007
+			"aws_iot_thing":                                        iot.ResourceThing(),
+			"aws_iot_thing_principal_attachment":                   iot.ResourceThingPrincipalAttachment(), //lintignore:AWSR007
+			"aws_iot_thing_type":                                   iot.ResourceThingType(),
+			"aws_iot_topic_rule":                                   iot.ResourceTopicRule(),
+			"aws_iot_role_alias":                                   iot.ResourceRoleAlias(),
+			"aws_key_pair":                                         ec2.ResourceKeyPair(),
+			"aws_kinesis_analytics_application":                    kinesisanalytics.ResourceApplication(),
+			"aws_kinesisanalyticsv2_application":                   kinesisanalyticsv2.ResourceApplication(),
+			"aws_kinesisanalyticsv2_application_snapshot":          kinesisanalyticsv2.ResourceApplicationSnapshot(), //lintignore:AWSR007
+			"aws_kinesis_firehose_delivery_stream":                 firehose.ResourceDeliveryStream(),
+			"aws_kinesis_stream":                                   kinesis.ResourceStream(),
+			"aws_kinesis_stream_consumer":                          kinesis.ResourceStreamConsumer(), //lintignore:AWSR007
+			"aws_kinesis_video_stream":                             kinesisvideo.ResourceStream(),    //lintignore:AWSR007
+			"aws_kms_alias":                                        kms.ResourceAlias(),              //lintignore:AWSR007
+			"aws_kms_external_key":                                 kms.ResourceExternalKey(),        //lintignore:AWSR007
+			"aws_kms_grant":                                        kms.ResourceGrant(),              //lintignore:AWSR007
+			"aws_kms_key":                                          kms.ResourceKey(),
+			"aws_kms_ciphertext":                                   kms.ResourceCiphertext(),                   //lintignore:AWSR007
+			"aws_lakeformation_data_lake_settings":                 lakeformation.ResourceDataLakeSettings(),   //lintignore:AWSR007
+			"aws_lakeformation_permissions":                        lakeformation.ResourcePermissions(),        //lintignore:AWSR007
+			"aws_lakeformation_resource":                           lakeformation.ResourceResource(),           //lintignore:AWSR007
+			"aws_lambda_alias":                                     lambda.ResourceAlias(),                     //lintignore:AWSR007
+			"aws_lambda_code_signing_config":                       lambda.ResourceCodeSigningConfig(),         //lintignore:AWSR007
+			"aws_lambda_event_source_mapping":                      lambda.ResourceEventSourceMapping(),        //lintignore:AWSR007
+			"aws_lambda_function_event_invoke_config":              lambda.ResourceFunctionEventInvokeConfig(), //lintignore:AWSR007
+			"aws_lambda_function":                                  lambda.ResourceFunction(),
+			"aws_lambda_layer_version":                             lambda.ResourceLayerVersion(),                 //lintignore:AWSR007
+			"aws_lambda_permission":                                lambda.ResourcePermission(),                   //lintignore:AWSR007
+			"aws_lambda_provisioned_concurrency_config":            lambda.ResourceProvisionedConcurrencyConfig(), //lintignore:AWSR007
+			"aws_launch_configuration":                             autoscaling.ResourceLaunchConfiguration(),
+			"aws_launch_template":                                  ec2.ResourceLaunchTemplate(),
+			"aws_lex_bot":                                          lexmodelbuilding.ResourceBot(),
+			"aws_lex_bot_alias":                                    lexmodelbuilding.ResourceBotAlias(),
+			"aws_lex_intent":                                       lexmodelbuilding.ResourceIntent(),
+			"aws_lex_slot_type":                                    lexmodelbuilding.ResourceSlotType(),
+			"aws_licensemanager_association":                       licensemanager.ResourceAssociation(), //lintignore:AWSR007
+			"aws_licensemanager_license_configuration":             licensemanager.ResourceLicenseConfiguration(),
+			"aws_lightsail_domain":                                 lightsail.ResourceDomain(),                //lintignore:AWSR007
+			"aws_lightsail_instance":                               lightsail.ResourceInstance(),              //lintignore:AWSR007
+			"aws_lightsail_instance_public_ports":                  lightsail.ResourceInstancePublicPorts(),   //lintignore:AWSR007
+			"aws_lightsail_key_pair":                               lightsail.ResourceKeyPair(),               //lintignore:AWSR007
+			"aws_lightsail_static_ip":                              lightsail.ResourceStaticIP(),              //lintignore:AWSR007
+			"aws_lightsail_static_ip_attachment":                   lightsail.ResourceStaticIPAttachment(),    //lintignore:AWSR007
+			"aws_lb_cookie_stickiness_policy":                      elb.ResourceCookieStickinessPolicy(),      //lintignore:AWSR007
+			"aws_load_balancer_policy":                             elb.ResourcePolicy(),                      //lintignore:AWSR007
+			"aws_load_balancer_backend_server_policy":              elb.ResourceBackendServerPolicy(),         //lintignore:AWSR007
+			"aws_load_balancer_listener_policy":                    elb.ResourceListenerPolicy(),              //lintignore:AWSR007
+			"aws_lb_ssl_negotiation_policy":                        elb.ResourceSSLNegotiationPolicy(),        //lintignore:AWSR007
+			"aws_macie2_account":                                   macie2.ResourceAccount(),                  //lintignore:AWSR007
+			"aws_macie2_classification_job":                        macie2.ResourceClassificationJob(),        //lintignore:AWSR007
+			"aws_macie2_custom_data_identifier":                    macie2.ResourceCustomDataIdentifier(),     //lintignore:AWSR007
+			"aws_macie2_findings_filter":                           macie2.ResourceFindingsFilter(),           //lintignore:AWSR007
+			"aws_macie2_invitation_accepter":                       macie2.ResourceInvitationAccepter(),       //lintignore:AWSR007
+			"aws_macie2_member":                                    macie2.ResourceMember(),                   //lintignore:AWSR007
+			"aws_macie2_organization_admin_account":                macie2.ResourceOrganizationAdminAccount(), //lintignore:AWSR007
+			"aws_macie_member_account_association":                 macie.ResourceMemberAccountAssociation(),  //lintignore:AWSR007
+			"aws_macie_s3_bucket_association":                      macie.ResourceS3BucketAssociation(),       //lintignore:AWSR007
+			"aws_main_route_table_association":                     ec2.ResourceMainRouteTableAssociation(),   //lintignore:AWSR007
+			"aws_mq_broker":                                        mq.ResourceBroker(),
+			"aws_mq_configuration":                                 mq.ResourceConfiguration(),           //lintignore:AWSR007
+			"aws_media_convert_queue":                              mediaconvert.ResourceQueue(),         //lintignore:AWSR007
+			"aws_media_package_channel":                            mediapackage.ResourceChannel(),       //lintignore:AWSR007
+			"aws_media_store_container":                            mediastore.ResourceContainer(),       //lintignore:AWSR007
+			"aws_media_store_container_policy":                     mediastore.ResourceContainerPolicy(), //lintignore:AWSR007
+			"aws_msk_cluster":                                      kafka.ResourceCluster(),
+			"aws_msk_configuration":                                kafka.ResourceConfiguration(),
+			"aws_msk_scram_secret_association":                     kafka.ResourceScramSecretAssociation(), //lintignore:AWSR007
+			"aws_mwaa_environment":                                 mwaa.ResourceEnvironment(),
+			"aws_nat_gateway":                                      ec2.ResourceNatGateway(),
+			"aws_network_acl":                                      ec2.ResourceNetworkACL(),
+			"aws_default_network_acl":                              ec2.ResourceDefaultNetworkACL(),         //lintignore:AWSR007
+			"aws_neptune_cluster":                                  neptune.ResourceCluster(),               //lintignore:AWSR007
+			"aws_neptune_cluster_endpoint":                         neptune.ResourceClusterEndpoint(),       //lintignore:AWSR007
+			"aws_neptune_cluster_instance":                         neptune.ResourceClusterInstance(),       //lintignore:AWSR007
+			"aws_neptune_cluster_parameter_group":                  neptune.ResourceClusterParameterGroup(), //lintignore:AWSR007
+			"aws_neptune_cluster_snapshot":                         neptune.ResourceClusterSnapshot(),       //lintignore:AWSR007
+			"aws_neptune_event_subscription":                       neptune.ResourceEventSubscription(),
+			"aws_neptune_parameter_group":                          neptune.ResourceParameterGroup(), //lintignore:AWSR007
+			"aws_neptune_subnet_group":                             neptune.ResourceSubnetGroup(),    //lintignore:AWSR007
+			"aws_network_acl_rule":                                 ec2.ResourceNetworkACLRule(),     //lintignore:AWSR007
+			"aws_network_interface":                                ec2.ResourceNetworkInterface(),
+			"aws_network_interface_attachment":                     ec2.ResourceNetworkInterfaceAttachment(), //lintignore:AWSR007
+			"aws_networkfirewall_firewall":                         networkfirewall.ResourceFirewall(),
+			"aws_networkfirewall_firewall_policy":                  networkfirewall.ResourceFirewallPolicy(),
+			"aws_networkfirewall_logging_configuration":            networkfirewall.ResourceLoggingConfiguration(),
+			"aws_networkfirewall_resource_policy":                  networkfirewall.ResourceResourcePolicy(), //lintignore:AWSR007
+			"aws_networkfirewall_rule_group":                       networkfirewall.ResourceRuleGroup(),
+			"aws_opsworks_application":                             opsworks.ResourceApplication(),                 //lintignore:AWSR007
+			"aws_opsworks_stack":                                   opsworks.ResourceStack(),                       //lintignore:AWSR007
+			"aws_opsworks_java_app_layer":                          opsworks.ResourceJavaAppLayer(),                //lintignore:AWSR007
+			"aws_opsworks_haproxy_layer":                           opsworks.ResourceHAProxyLayer(),                //lintignore:AWSR007
+			"aws_opsworks_static_web_layer":                        opsworks.ResourceStaticWebLayer(),              //lintignore:AWSR007
+			"aws_opsworks_php_app_layer":                           opsworks.ResourcePHPAppLayer(),                 //lintignore:AWSR007
+			"aws_opsworks_rails_app_layer":                         opsworks.ResourceRailsAppLayer(),               //lintignore:AWSR007
+			"aws_opsworks_nodejs_app_layer":                        opsworks.ResourceNodejsAppLayer(),              //lintignore:AWSR007
+			"aws_opsworks_memcached_layer":                         opsworks.ResourceMemcachedLayer(),              //lintignore:AWSR007
+			"aws_opsworks_mysql_layer":                             opsworks.ResourceMySQLLayer(),                  //lintignore:AWSR007
+			"aws_opsworks_ganglia_layer":                           opsworks.ResourceGangliaLayer(),                //lintignore:AWSR007
+			"aws_opsworks_custom_layer":                            opsworks.ResourceCustomLayer(),                 //lintignore:AWSR007
+			"aws_opsworks_instance":                                opsworks.ResourceInstance(),                    //lintignore:AWSR007
+			"aws_opsworks_user_profile":                            opsworks.ResourceUserProfile(),                 //lintignore:AWSR007
+			"aws_opsworks_permission":                              opsworks.ResourcePermission(),                  //lintignore:AWSR007
+			"aws_opsworks_rds_db_instance":                         opsworks.ResourceRDSDBInstance(),               //lintignore:AWSR007
+			"aws_organizations_organization":                       organizations.ResourceOrganization(),           //lintignore:AWSR007
+			"aws_organizations_account":                            organizations.ResourceAccount(),                //lintignore:AWSR007
+			"aws_organizations_delegated_administrator":            organizations.ResourceDelegatedAdministrator(), //lintignore:AWSR007
+			"aws_organizations_policy":                             organizations.ResourcePolicy(),                 //lintignore:AWSR007
+			"aws_organizations_policy_attachment":                  organizations.ResourcePolicyAttachment(),       //lintignore:AWSR007
+			"aws_organizations_organizational_unit":                organizations.ResourceOrganizationalUnit(),     //lintignore:AWSR007
+			"aws_placement_group":                                  ec2.ResourcePlacementGroup(),
+			"aws_prometheus_workspace":                             prometheus.ResourceWorkspace(),    //lintignore:AWSR007
+			"aws_proxy_protocol_policy":                            elb.ResourceProxyProtocolPolicy(), //lintignore:AWSR007
+			"aws_qldb_ledger":                                      qldb.ResourceLedger(),
+			"aws_quicksight_data_source":                           quicksight.ResourceDataSource(),
+			"aws_quicksight_group":                                 quicksight.ResourceGroup(),           //lintignore:AWSR007
+			"aws_quicksight_group_membership":                      quicksight.ResourceGroupMembership(), //lintignore:AWSR007
+			"aws_quicksight_user":                                  quicksight.ResourceUser(),            //lintignore:AWSR007
+			"aws_ram_principal_association":                        ram.ResourcePrincipalAssociation(),   //lintignore:AWSR007
+			"aws_ram_resource_association":                         ram.ResourceResourceAssociation(),    //lintignore:AWSR007
+			"aws_ram_resource_share":                               ram.ResourceResourceShare(),          //lintignore:AWSR007
+			"aws_ram_resource_share_accepter":                      ram.ResourceResourceShareAccepter(),  //lintignore:AWSR007
+			"aws_rds_cluster":                                      rds.ResourceCluster(),
+			"aws_rds_cluster_endpoint":                             rds.ResourceClusterEndpoint(), //lintignore:AWSR007
+			"aws_rds_cluster_instance":                             rds.ResourceClusterInstance(), //lintignore:AWSR007
+			"aws_rds_cluster_parameter_group":                      rds.ResourceClusterParameterGroup(),
+			"aws_rds_cluster_role_association":                     rds.ResourceClusterRoleAssociation(), //lintignore:AWSR007
+			"aws_rds_global_cluster":                               rds.ResourceGlobalCluster(),
+			"aws_redshift_cluster":                                 redshift.ResourceCluster(),
+			"aws_redshift_security_group":                          redshift.ResourceSecurityGroup(),  //lintignore:AWSR007
+			"aws_redshift_parameter_group":                         redshift.ResourceParameterGroup(), //lintignore:AWSR007
+			"aws_redshift_subnet_group":                            redshift.ResourceSubnetGroup(),
+			"aws_redshift_snapshot_copy_grant":                     redshift.ResourceSnapshotCopyGrant(), //lintignore:AWSR007
+			"aws_redshift_snapshot_schedule":                       redshift.ResourceSnapshotSchedule(),
+			"aws_redshift_snapshot_schedule_association":           redshift.ResourceSnapshotScheduleAssociation(), //lintignore:AWSR007
+			"aws_redshift_event_subscription":                      redshift.ResourceEventSubscription(),
+			"aws_redshift_scheduled_action":                        redshift.ResourceScheduledAction(),
+			"aws_resourcegroups_group":                             resourcegroups.ResourceGroup(),     //lintignore:AWSR007
+			"aws_route53_delegation_set":                           route53.ResourceDelegationSet(),    //lintignore:AWSR007
+			"aws_route53_hosted_zone_dnssec":                       route53.ResourceHostedZoneDNSSEC(), //lintignore:AWSR007
+			"aws_route53_key_signing_key":                          route53.ResourceKeySigningKey(),
+			"aws_route53_query_log":                                route53.ResourceQueryLog(),
+			"aws_route53_record":                                   route53.ResourceRecord(),                      //lintignore:AWSR007
+			"aws_route53_zone_association":                         route53.ResourceZoneAssociation(),             //lintignore:AWSR007
+			"aws_route53_vpc_association_authorization":            route53.ResourceVPCAssociationAuthorization(), //lintignore:AWSR007
+			"aws_route53_zone":                                     route53.ResourceZone(),
+			"aws_route53_health_check":                             route53.ResourceHealthCheck(),
+			"aws_route53_resolver_dnssec_config":                   route53resolver.ResourceDNSSECConfig(),
+			"aws_route53_resolver_endpoint":                        route53resolver.ResourceEndpoint(),
+			"aws_route53_resolver_firewall_config":                 route53resolver.ResourceFirewallConfig(),
+			"aws_route53_resolver_firewall_domain_list":            route53resolver.ResourceFirewallDomainList(),
+			"aws_route53_resolver_firewall_rule":                   route53resolver.ResourceFirewallRule(),
+			"aws_route53_resolver_firewall_rule_group":             route53resolver.ResourceFirewallRuleGroup(),
+			"aws_route53_resolver_firewall_rule_group_association": route53resolver.ResourceFirewallRuleGroupAssociation(),
+			"aws_route53_resolver_query_log_config":                route53resolver.ResourceQueryLogConfig(),
+			"aws_route53_resolver_query_log_config_association":    route53resolver.ResourceQueryLogConfigAssociation(),
+			"aws_route53_resolver_rule_association":                route53resolver.ResourceRuleAssociation(),
+			"aws_route53_resolver_rule":                            route53resolver.ResourceRule(),
+			"aws_route53recoverycontrolconfig_cluster":             route53recoverycontrolconfig.ResourceCluster(),        //lintignore:AWSR007
+			"aws_route53recoverycontrolconfig_control_panel":       route53recoverycontrolconfig.ResourceControlPanel(),   //lintignore:AWSR007
+			"aws_route53recoverycontrolconfig_routing_control":     route53recoverycontrolconfig.ResourceRoutingControl(), //lintignore:AWSR007
+			"aws_route53recoverycontrolconfig_safety_rule":         route53recoverycontrolconfig.ResourceSafetyRule(),     //lintignore:AWSR007
+			"aws_route53recoveryreadiness_cell":                    route53recoveryreadiness.ResourceCell(),               //lintignore:AWSR007
+			"aws_route53recoveryreadiness_readiness_check":         route53recoveryreadiness.ResourceReadinessCheck(),     //lintignore:AWSR007
+			"aws_route53recoveryreadiness_recovery_group":          route53recoveryreadiness.ResourceRecoveryGroup(),      //lintignore:AWSR007
+			"aws_route53recoveryreadiness_resource_set":            route53recoveryreadiness.ResourceResourceSet(),        //lintignore:AWSR007
+			"aws_route":                                               ec2.ResourceRoute(), //lintignore:AWSR007
 			"aws_route_table":                                         ec2.ResourceRouteTable(),
-			"aws_default_route_table":                                 ec2.ResourceDefaultRouteTable(),
-			"aws_route_table_association":                             ec2.ResourceRouteTableAssociation(),
+			"aws_default_route_table":                                 ec2.ResourceDefaultRouteTable(),     //lintignore:AWSR007
+			"aws_route_table_association":                             ec2.ResourceRouteTableAssociation(), //lintignore:AWSR007
 			"aws_sagemaker_app":                                       sagemaker.ResourceApp(),
 			"aws_sagemaker_app_image_config":                          sagemaker.ResourceAppImageConfig(),
 			"aws_sagemaker_code_repository":                           sagemaker.ResourceCodeRepository(),
@@ -1215,11 +1284,11 @@ func Provider() *schema.Provider {
 			"aws_sagemaker_feature_group":                             sagemaker.ResourceFeatureGroup(),
 			"aws_sagemaker_flow_definition":                           sagemaker.ResourceFlowDefinition(),
 			"aws_sagemaker_image":                                     sagemaker.ResourceImage(),
-			"aws_sagemaker_image_version":                             sagemaker.ResourceImageVersion(),
+			"aws_sagemaker_image_version":                             sagemaker.ResourceImageVersion(), //lintignore:AWSR007
 			"aws_sagemaker_human_task_ui":                             sagemaker.ResourceHumanTaskUI(),
 			"aws_sagemaker_model":                                     sagemaker.ResourceModel(),
 			"aws_sagemaker_model_package_group":                       sagemaker.ResourceModelPackageGroup(),
-			"aws_sagemaker_model_package_group_policy":                sagemaker.ResourceModelPackageGroupPolicy(),
+			"aws_sagemaker_model_package_group_policy":                sagemaker.ResourceModelPackageGroupPolicy(), //lintignore:AWSR007
 			"aws_sagemaker_notebook_instance_lifecycle_configuration": sagemaker.ResourceNotebookInstanceLifeCycleConfiguration(),
 			"aws_sagemaker_notebook_instance":                         sagemaker.ResourceNotebookInstance(),
 			"aws_sagemaker_studio_lifecycle_config":                   sagemaker.ResourceStudioLifecycleConfig(),
@@ -1228,60 +1297,78 @@ func Provider() *schema.Provider {
 			"aws_sagemaker_workteam":                                  sagemaker.ResourceWorkteam(),
 			"aws_schemas_discoverer":                                  schemas.ResourceDiscoverer(),
 			"aws_schemas_registry":                                    schemas.ResourceRegistry(),
-			"aws_schemas_schema":                                      schemas.ResourceSchema(),
+			"aws_schemas_schema":                                      schemas.ResourceSchema(), //lintignore:AWSR007
 			"aws_secretsmanager_secret":                               secretsmanager.ResourceSecret(),
 			"aws_secretsmanager_secret_policy":                        secretsmanager.ResourceSecretPolicy(),
-			"aws_secretsmanager_secret_version":                       secretsmanager.ResourceSecretVersion(),
-			"aws_secretsmanager_secret_rotation":                      secretsmanager.ResourceSecretRotation(),
-			"aws_ses_active_receipt_rule_set":                         ses.ResourceActiveReceiptRuleSet(),
-			"aws_ses_domain_identity":                                 ses.ResourceDomainIdentity(),
-			"aws_ses_domain_identity_verification":                    ses.ResourceDomainIdentityVerification(),
-			"aws_ses_domain_dkim":                                     ses.ResourceDomainDKIM(),
-			"aws_ses_domain_mail_from":                                ses.ResourceDomainMailFrom(),
+			"aws_secretsmanager_secret_version":                       secretsmanager.ResourceSecretVersion(),   //lintignore:AWSR007
+			"aws_secretsmanager_secret_rotation":                      secretsmanager.ResourceSecretRotation(),  //lintignore:AWSR007
+			"aws_ses_active_receipt_rule_set":                         ses.ResourceActiveReceiptRuleSet(),       //lintignore:AWSR007
+			"aws_ses_domain_identity":                                 ses.ResourceDomainIdentity(),             //lintignore:AWSR007
+			"aws_ses_domain_identity_verification":                    ses.ResourceDomainIdentityVerification(), //lintignore:AWSR007
+			"aws_ses_domain_dkim":                                     ses.ResourceDomainDKIM(),                 //lintignore:AWSR007
+			"aws_ses_domain_mail_from":                                ses.ResourceDomainMailFrom(),             //lintignore:AWSR007
 			"aws_ses_email_identity":                                  ses.ResourceEmailIdentity(),
-			"aws_ses_identity_policy":                                 ses.ResourceIdentityPolicy(),
-			"aws_ses_receipt_filter":                                  ses.ResourceReceiptFilter(),
-			"aws_ses_receipt_rule":                                    ses.ResourceReceiptRule(),
+			"aws_ses_identity_policy":                                 ses.ResourceIdentityPolicy(), //lintignore:AWSR007
+			"aws_ses_receipt_filter":                                  ses.ResourceReceiptFilter(),  //lintignore:AWSR007
+			"aws_ses_receipt_rule":                                    ses.ResourceReceiptRule(),    //lintignore:AWSR007
 			"aws_ses_receipt_rule_set":                                ses.ResourceReceiptRuleSet(),
 			"aws_ses_configuration_set":                               ses.ResourceConfigurationSet(),
-			"aws_ses_event_destination":                               ses.ResourceEventDestination(),
-			"aws_ses_identity_notification_topic":                     ses.ResourceIdentityNotificationTopic(),
-			"aws_ses_template":                                        ses.ResourceTemplate(),
+			"aws_ses_event_destination":                               ses.ResourceEventDestination(),          //lintignore:AWSR007
+			"aws_ses_identity_notification_topic":                     ses.ResourceIdentityNotificationTopic(), //lintignore:AWSR007
+			"aws_ses_template":                                        ses.ResourceTemplate(),                  //lintignore:AWSR007
 			"aws_s3_access_point":                                     s3control.ResourceAccessPoint(),
-			"aws_s3_account_public_access_block":                      s3control.ResourceAccountPublicAccessBlock(),
+			"aws_s3_account_public_access_block":                      s3control.ResourceAccountPublicAccessBlock(), //lintignore:AWSR007
 			"aws_s3_bucket":                                           s3.ResourceBucket(),
-			"aws_s3_bucket_analytics_configuration":                   s3.ResourceBucketAnalyticsConfiguration(),
-			"aws_s3_bucket_policy":                                    s3.ResourceBucketPolicy(),
-			"aws_s3_bucket_public_access_block":                       s3.ResourceBucketPublicAccessBlock(),
+			"aws_s3_bucket_accelerate_configuration":                  s3.ResourceBucketAccelerateConfiguration(), //lintignore:AWSR007
+			"aws_s3_bucket_acl":                                       s3.ResourceBucketACL(),                     //lintignore:AWSR007
+			"aws_s3_bucket_analytics_configuration":                   s3.ResourceBucketAnalyticsConfiguration(),  //lintignore:AWSR007
+			"aws_s3_bucket_cors_configuration":                        s3.ResourceBucketCorsConfiguration(),       //lintignore:AWSR007
+			"aws_s3_bucket_lifecycle_configuration":                   s3.ResourceBucketLifecycleConfiguration(),  //lintignore:AWSR007
+			"aws_s3_bucket_logging":                                   s3.ResourceBucketLogging(),                 //lintignore:AWSR007
+			"aws_s3_bucket_object_lock_configuration":                 s3.ResourceBucketObjectLockConfiguration(), //lintignore:AWSR007
+			"aws_s3_bucket_policy":                                    s3.ResourceBucketPolicy(),                  //lintignore:AWSR007
+			"aws_s3_bucket_public_access_block":                       s3.ResourceBucketPublicAccessBlock(),       //lintignore:AWSR007
 			"aws_s3_bucket_object":                                    s3.ResourceBucketObject(),
-			"aws_s3_bucket_ownership_controls":                        s3.ResourceBucketOwnershipControls(),
-			"aws_s3_bucket_notification":                              s3.ResourceBucketNotification(),
-			"aws_s3_bucket_metric":                                    s3.ResourceBucketMetric(),
-			"aws_s3_bucket_inventory":                                 s3.ResourceBucketInventory(),
-			"aws_s3_object_copy":                                      s3.ResourceObjectCopy(),
-			"aws_s3control_bucket":                                    s3control.ResourceBucket(),
-			"aws_s3control_bucket_policy":                             s3control.ResourceBucketPolicy(),
-			"aws_s3control_bucket_lifecycle_configuration":            s3control.ResourceBucketLifecycleConfiguration(),
-			"aws_s3outposts_endpoint":                                 s3outposts.ResourceEndpoint(),
+			"aws_s3_bucket_objects_sync":                              s3.ResourceBucketObjectsSync(),       //lintignore:AWSR007
+			"aws_s3_bucket_ownership_controls":                        s3.ResourceBucketOwnershipControls(), //lintignore:AWSR007
+			"aws_s3_bucket_notification":                              s3.ResourceBucketNotification(),      //lintignore:AWSR007
+			"aws_s3_bucket_metric":                                    s3.ResourceBucketMetric(),            //lintignore:AWSR007
+			"aws_s3_bucket_intelligent_tiering_configuration":         s3.ResourceBucketIntelligentTieringConfiguration(),
+			"aws_s3_bucket_inventory":                                 s3.ResourceBucketInventory(),                         //lintignore:AWSR007
+			"aws_s3_bucket_replication_configuration":                 s3.ResourceBucketReplicationConfiguration(),          //lintignore:AWSR007
+			"aws_s3_bucket_request_payment_configuration":             s3.ResourceBucketRequestPaymentConfiguration(),       //lintignore:AWSR007
+			"aws_s3_bucket_server_side_encryption_configuration":      s3.ResourceBucketServerSideEncryptionConfiguration(), //lintignore:AWSR007
+			"aws_s3_bucket_versioning":                                s3.ResourceBucketVersioning(),                        //lintignore:AWSR007
+			"aws_s3_bucket_website_configuration":                     s3.ResourceBucketWebsiteConfiguration(),              //lintignore:AWSR007
+			"aws_s3_object_copy":                                      s3.ResourceObjectCopy(),                              //lintignore:AWSR007
+			"aws_s3control_bucket":                                    s3control.ResourceBucket(),                           //lintignore:AWSR007
+			"aws_s3control_bucket_policy":                             s3control.ResourceBucketPolicy(),                     //lintignore:AWSR007
+			"aws_s3control_bucket_lifecycle_configuration":            s3control.ResourceBucketLifecycleConfiguration(),     //lintignore:AWSR007
+			"aws_s3control_multi_region_access_point":                 s3control.ResourceMultiRegionAccessPoint(),
+			"aws_s3control_multi_region_access_point_policy":          s3control.ResourceMultiRegionAccessPointPolicy(), //lintignore:AWSR007
+			"aws_s3control_object_lambda_access_point":                s3control.ResourceObjectLambdaAccessPoint(),
+			"aws_s3control_object_lambda_access_point_policy":         s3control.ResourceObjectLambdaAccessPointPolicy(), //lintignore:AWSR007
+			"aws_s3control_storage_lens_configuration":                s3control.ResourceStorageLensConfiguration(),
+			"aws_s3outposts_endpoint":                                 s3outposts.ResourceEndpoint(), //lintignore:AWSR007
 			"aws_security_group":                                      ec2.ResourceSecurityGroup(),
-			"aws_network_interface_sg_attachment":                     ec2.ResourceNetworkInterfaceSGAttachment(),
-			"aws_default_security_group":                              ec2.ResourceDefaultSecurityGroup(),
-			"aws_security_group_rule":                                 ec2.ResourceSecurityGroupRule(),
-			"aws_securityhub_account":                                 securityhub.ResourceAccount(),
-			"aws_securityhub_action_target":                           securityhub.ResourceActionTarget(),
-			"aws_securityhub_insight":                                 securityhub.ResourceInsight(),
-			"aws_securityhub_invite_accepter":                         securityhub.ResourceInviteAccepter(),
-			"aws_securityhub_member":                                  securityhub.ResourceMember(),
-			"aws_securityhub_organization_admin_account":              securityhub.ResourceOrganizationAdminAccount(),
-			"aws_securityhub_organization_configuration":              securityhub.ResourceOrganizationConfiguration(),
-			"aws_securityhub_product_subscription":                    securityhub.ResourceProductSubscription(),
-			"aws_securityhub_standards_control":                       securityhub.ResourceStandardsControl(),
-			"aws_securityhub_standards_subscription":                  securityhub.ResourceStandardsSubscription(),
+			"aws_network_interface_sg_attachment":                     ec2.ResourceNetworkInterfaceSGAttachment(),      //lintignore:AWSR007
+			"aws_default_security_group":                              ec2.ResourceDefaultSecurityGroup(),              //lintignore:AWSR007
+			"aws_security_group_rule":                                 ec2.ResourceSecurityGroupRule(),                 //lintignore:AWSR007
+			"aws_securityhub_account":                                 securityhub.ResourceAccount(),                   //lintignore:AWSR007
+			"aws_securityhub_action_target":                           securityhub.ResourceActionTarget(),              //lintignore:AWSR007
+			"aws_securityhub_insight":                                 securityhub.ResourceInsight(),                   //lintignore:AWSR007
+			"aws_securityhub_invite_accepter":                         securityhub.ResourceInviteAccepter(),            //lintignore:AWSR007
+			"aws_securityhub_member":                                  securityhub.ResourceMember(),                    //lintignore:AWSR007
+			"aws_securityhub_organization_admin_account":              securityhub.ResourceOrganizationAdminAccount(),  //lintignore:AWSR007
+			"aws_securityhub_organization_configuration":              securityhub.ResourceOrganizationConfiguration(), //lintignore:AWSR007
+			"aws_securityhub_product_subscription":                    securityhub.ResourceProductSubscription(),       //lintignore:AWSR007
+			"aws_securityhub_standards_control":                       securityhub.ResourceStandardsControl(),          //lintignore:AWSR007
+			"aws_securityhub_standards_subscription":                  securityhub.ResourceStandardsSubscription(),     //lintignore:AWSR007
 			"aws_servicecatalog_budget_resource_association":          servicecatalog.ResourceBudgetResourceAssociation(),
 			"aws_servicecatalog_constraint":                           servicecatalog.ResourceConstraint(),
-			"aws_servicecatalog_organizations_access":                 servicecatalog.ResourceOrganizationsAccess(),
-			"aws_servicecatalog_portfolio":                            servicecatalog.ResourcePortfolio(),
-			"aws_servicecatalog_portfolio_share":                      servicecatalog.ResourcePortfolioShare(),
+			"aws_servicecatalog_organizations_access":                 servicecatalog.ResourceOrganizationsAccess(), //lintignore:AWSR007
+			"aws_servicecatalog_portfolio":                            servicecatalog.ResourcePortfolio(),           //lintignore:AWSR007
+			"aws_servicecatalog_portfolio_share":                      servicecatalog.ResourcePortfolioShare(),      //lintignore:AWSR007
 			"aws_servicecatalog_product":                              servicecatalog.ResourceProduct(),
 			"aws_servicecatalog_provisioned_product":                  servicecatalog.ResourceProvisionedProduct(),
 			"aws_servicecatalog_service_action":                       servicecatalog.ResourceServiceAction(),
@@ -1290,86 +1377,86 @@ func Provider() *schema.Provider {
 			"aws_servicecatalog_principal_portfolio_association":      servicecatalog.ResourcePrincipalPortfolioAssociation(),
 			"aws_servicecatalog_product_portfolio_association":        servicecatalog.ResourceProductPortfolioAssociation(),
 			"aws_servicecatalog_provisioning_artifact":                servicecatalog.ResourceProvisioningArtifact(),
-			"aws_service_discovery_instance":                          servicediscovery.ResourceInstance(),
+			"aws_service_discovery_instance":                          servicediscovery.ResourceInstance(), //lintignore:AWSR007
 			"aws_service_discovery_http_namespace":                    servicediscovery.ResourceHTTPNamespace(),
 			"aws_service_discovery_private_dns_namespace":             servicediscovery.ResourcePrivateDNSNamespace(),
 			"aws_service_discovery_public_dns_namespace":              servicediscovery.ResourcePublicDNSNamespace(),
 			"aws_service_discovery_service":                           servicediscovery.ResourceService(),
-			"aws_servicequotas_service_quota":                         servicequotas.ResourceServiceQuota(),
-			"aws_shield_protection":                                   shield.ResourceProtection(),
-			"aws_shield_protection_group":                             shield.ResourceProtectionGroup(),
-			"aws_signer_signing_job":                                  signer.ResourceSigningJob(),
-			"aws_signer_signing_profile":                              signer.ResourceSigningProfile(),
-			"aws_signer_signing_profile_permission":                   signer.ResourceSigningProfilePermission(),
-			"aws_simpledb_domain":                                     simpledb.ResourceDomain(),
-			"aws_ssm_activation":                                      ssm.ResourceActivation(),
-			"aws_ssm_association":                                     ssm.ResourceAssociation(),
-			"aws_ssm_document":                                        ssm.ResourceDocument(),
+			"aws_servicequotas_service_quota":                         servicequotas.ResourceServiceQuota(),      //lintignore:AWSR007
+			"aws_shield_protection":                                   shield.ResourceProtection(),               //lintignore:AWSR007
+			"aws_shield_protection_group":                             shield.ResourceProtectionGroup(),          //lintignore:AWSR007
+			"aws_signer_signing_job":                                  signer.ResourceSigningJob(),               //lintignore:AWSR007
+			"aws_signer_signing_profile":                              signer.ResourceSigningProfile(),           //lintignore:AWSR007
+			"aws_signer_signing_profile_permission":                   signer.ResourceSigningProfilePermission(), //lintignore:AWSR007
+			"aws_simpledb_domain":                                     simpledb.ResourceDomain(),                 //lintignore:AWSR007
+			"aws_ssm_activation":                                      ssm.ResourceActivation(),                  //lintignore:AWSR007
+			"aws_ssm_association":                                     ssm.ResourceAssociation(),                 //lintignore:AWSR007
+			"aws_ssm_document":                                        ssm.ResourceDocument(),                    //lintignore:AWSR007
 			"aws_ssm_maintenance_window":                              ssm.ResourceMaintenanceWindow(),
-			"aws_ssm_maintenance_window_target":                       ssm.ResourceMaintenanceWindowTarget(),
-			"aws_ssm_maintenance_window_task":                         ssm.ResourceMaintenanceWindowTask(),
-			"aws_ssm_patch_baseline":                                  ssm.ResourcePatchBaseline(),
-			"aws_ssm_patch_group":                                     ssm.ResourcePatchGroup(),
-			"aws_ssm_parameter":                                       ssm.ResourceParameter(),
+			"aws_ssm_maintenance_window_target":                       ssm.ResourceMaintenanceWindowTarget(), //lintignore:AWSR007
+			"aws_ssm_maintenance_window_task":                         ssm.ResourceMaintenanceWindowTask(),   //lintignore:AWSR007
+			"aws_ssm_patch_baseline":                                  ssm.ResourcePatchBaseline(),           //lintignore:AWSR007
+			"aws_ssm_patch_group":                                     ssm.ResourcePatchGroup(),              //lintignore:AWSR007
+			"aws_ssm_parameter":                                       ssm.ResourceParameter(),               //lintignore:AWSR007
 			"aws_ssm_resource_data_sync":                              ssm.ResourceResourceDataSync(),
 			"aws_ssoadmin_account_assignment":                         ssoadmin.ResourceAccountAssignment(),
-			"aws_ssoadmin_managed_policy_attachment":                  ssoadmin.ResourceManagedPolicyAttachment(),
+			"aws_ssoadmin_managed_policy_attachment":                  ssoadmin.ResourceManagedPolicyAttachment(), //lintignore:AWSR007
 			"aws_ssoadmin_permission_set":                             ssoadmin.ResourcePermissionSet(),
-			"aws_ssoadmin_permission_set_inline_policy":               ssoadmin.ResourcePermissionSetInlinePolicy(),
-			"aws_storagegateway_cache":                                storagegateway.ResourceCache(),
-			"aws_storagegateway_cached_iscsi_volume":                  storagegateway.ResourceCachediSCSIVolume(),
-			"aws_storagegateway_file_system_association":              storagegateway.ResourceFileSystemAssociation(),
+			"aws_ssoadmin_permission_set_inline_policy":               ssoadmin.ResourcePermissionSetInlinePolicy(),   //lintignore:AWSR007
+			"aws_storagegateway_cache":                                storagegateway.ResourceCache(),                 //lintignore:AWSR007
+			"aws_storagegateway_cached_iscsi_volume":                  storagegateway.ResourceCachediSCSIVolume(),     //lintignore:AWSR007
+			"aws_storagegateway_file_system_association":              storagegateway.ResourceFileSystemAssociation(), //lintignore:AWSR007
 			"aws_storagegateway_gateway":                              storagegateway.ResourceGateway(),
-			"aws_storagegateway_nfs_file_share":                       storagegateway.ResourceNFSFileShare(),
-			"aws_storagegateway_smb_file_share":                       storagegateway.ResourceSMBFileShare(),
-			"aws_storagegateway_stored_iscsi_volume":                  storagegateway.ResourceStorediSCSIVolume(),
-			"aws_storagegateway_tape_pool":                            storagegateway.ResourceTapePool(),
-			"aws_storagegateway_upload_buffer":                        storagegateway.ResourceUploadBuffer(),
-			"aws_storagegateway_working_storage":                      storagegateway.ResourceWorkingStorage(),
-			"aws_spot_datafeed_subscription":                          ec2.ResourceSpotDataFeedSubscription(),
-			"aws_spot_instance_request":                               ec2.ResourceSpotInstanceRequest(),
+			"aws_storagegateway_nfs_file_share":                       storagegateway.ResourceNFSFileShare(),      //lintignore:AWSR007
+			"aws_storagegateway_smb_file_share":                       storagegateway.ResourceSMBFileShare(),      //lintignore:AWSR007
+			"aws_storagegateway_stored_iscsi_volume":                  storagegateway.ResourceStorediSCSIVolume(), //lintignore:AWSR007
+			"aws_storagegateway_tape_pool":                            storagegateway.ResourceTapePool(),          //lintignore:AWSR007
+			"aws_storagegateway_upload_buffer":                        storagegateway.ResourceUploadBuffer(),      //lintignore:AWSR007
+			"aws_storagegateway_working_storage":                      storagegateway.ResourceWorkingStorage(),    //lintignore:AWSR007
+			"aws_spot_datafeed_subscription":                          ec2.ResourceSpotDataFeedSubscription(),     //lintignore:AWSR007
+			"aws_spot_instance_request":                               ec2.ResourceSpotInstanceRequest(),          //lintignore:AWSR007
 			"aws_spot_fleet_request":                                  ec2.ResourceSpotFleetRequest(),
 			"aws_sqs_queue":                                           sqs.ResourceQueue(),
-			"aws_sqs_queue_policy":                                    sqs.ResourceQueuePolicy(),
-			"aws_snapshot_create_volume_permission":                   ec2.ResourceSnapshotCreateVolumePermission(),
+			"aws_sqs_queue_policy":                                    sqs.ResourceQueuePolicy(),                    //lintignore:AWSR007
+			"aws_snapshot_create_volume_permission":                   ec2.ResourceSnapshotCreateVolumePermission(), //lintignore:AWSR007
 			"aws_sns_platform_application":                            sns.ResourcePlatformApplication(),
-			"aws_sns_sms_preferences":                                 sns.ResourceSMSPreferences(),
+			"aws_sns_sms_preferences":                                 sns.ResourceSMSPreferences(), //lintignore:AWSR007
 			"aws_sns_topic":                                           sns.ResourceTopic(),
-			"aws_sns_topic_policy":                                    sns.ResourceTopicPolicy(),
-			"aws_sns_topic_subscription":                              sns.ResourceTopicSubscription(),
-			"aws_sfn_activity":                                        sfn.ResourceActivity(),
-			"aws_sfn_state_machine":                                   sfn.ResourceStateMachine(),
-			"aws_default_subnet":                                      ec2.ResourceDefaultSubnet(),
+			"aws_sns_topic_policy":                                    sns.ResourceTopicPolicy(),       //lintignore:AWSR007
+			"aws_sns_topic_subscription":                              sns.ResourceTopicSubscription(), //lintignore:AWSR007
+			"aws_sfn_activity":                                        sfn.ResourceActivity(),          //lintignore:AWSR007
+			"aws_sfn_state_machine":                                   sfn.ResourceStateMachine(),      //lintignore:AWSR007
+			"aws_default_subnet":                                      ec2.ResourceDefaultSubnet(),     //lintignore:AWSR007
 			"aws_subnet":                                              ec2.ResourceSubnet(),
-			"aws_swf_domain":                                          swf.ResourceDomain(),
+			"aws_swf_domain":                                          swf.ResourceDomain(), //lintignore:AWSR007
 			"aws_synthetics_canary":                                   synthetics.ResourceCanary(),
 			"aws_timestreamwrite_database":                            timestreamwrite.ResourceDatabase(),
 			"aws_timestreamwrite_table":                               timestreamwrite.ResourceTable(),
 			"aws_transfer_server":                                     transfer.ResourceServer(),
-			"aws_transfer_access":                                     transfer.ResourceAccess(),
-			"aws_transfer_ssh_key":                                    transfer.ResourceSSHKey(),
-			"aws_transfer_user":                                       transfer.ResourceUser(),
-			"aws_volume_attachment":                                   ec2.ResourceVolumeAttachment(),
-			"aws_vpc_dhcp_options_association":                        ec2.ResourceVPCDHCPOptionsAssociation(),
-			"aws_default_vpc_dhcp_options":                            ec2.ResourceDefaultVPCDHCPOptions(),
+			"aws_transfer_access":                                     transfer.ResourceAccess(),               //lintignore:AWSR007
+			"aws_transfer_ssh_key":                                    transfer.ResourceSSHKey(),               //lintignore:AWSR007
+			"aws_transfer_user":                                       transfer.ResourceUser(),                 //lintignore:AWSR007
+			"aws_volume_attachment":                                   ec2.ResourceVolumeAttachment(),          //lintignore:AWSR007
+			"aws_vpc_dhcp_options_association":                        ec2.ResourceVPCDHCPOptionsAssociation(), //lintignore:AWSR007
+			"aws_default_vpc_dhcp_options":                            ec2.ResourceDefaultVPCDHCPOptions(),     //lintignore:AWSR007
 			"aws_vpc_dhcp_options":                                    ec2.ResourceVPCDHCPOptions(),
 			"aws_vpc_peering_connection":                              ec2.ResourceVPCPeeringConnection(),
-			"aws_vpc_peering_connection_accepter":                     ec2.ResourceVPCPeeringConnectionAccepter(),
-			"aws_vpc_peering_connection_options":                      ec2.ResourceVPCPeeringConnectionOptions(),
-			"aws_default_vpc":                                         ec2.ResourceDefaultVPC(),
+			"aws_vpc_peering_connection_accepter":                     ec2.ResourceVPCPeeringConnectionAccepter(), //lintignore:AWSR007
+			"aws_vpc_peering_connection_options":                      ec2.ResourceVPCPeeringConnectionOptions(),  //lintignore:AWSR007
+			"aws_default_vpc":                                         ec2.ResourceDefaultVPC(),                   //lintignore:AWSR007
 			"aws_vpc":                                                 ec2.ResourceVPC(),
 			"aws_vpc_endpoint":                                        ec2.ResourceVPCEndpoint(),
-			"aws_vpc_endpoint_connection_notification":                ec2.ResourceVPCEndpointConnectionNotification(),
-			"aws_vpc_endpoint_route_table_association":                ec2.ResourceVPCEndpointRouteTableAssociation(),
-			"aws_vpc_endpoint_subnet_association":                     ec2.ResourceVPCEndpointSubnetAssociation(),
+			"aws_vpc_endpoint_connection_notification":                ec2.ResourceVPCEndpointConnectionNotification(), //lintignore:AWSR007
+			"aws_vpc_endpoint_route_table_association":                ec2.ResourceVPCEndpointRouteTableAssociation(),  //lintignore:AWSR007
+			"aws_vpc_endpoint_subnet_association":                     ec2.ResourceVPCEndpointSubnetAssociation(),      //lintignore:AWSR007
 			"aws_vpc_endpoint_service":                                ec2.ResourceVPCEndpointService(),
-			"aws_vpc_endpoint_service_allowed_principal":              ec2.ResourceVPCEndpointServiceAllowedPrincipal(),
-			"aws_vpc_ipv4_cidr_block_association":                     ec2.ResourceVPCIPv4CIDRBlockAssociation(),
+			"aws_vpc_endpoint_service_allowed_principal":              ec2.ResourceVPCEndpointServiceAllowedPrincipal(), //lintignore:AWSR007
+			"aws_vpc_ipv4_cidr_block_association":                     ec2.ResourceVPCIPv4CIDRBlockAssociation(),        //lintignore:AWSR007
 			"aws_vpn_connection":                                      ec2.ResourceVPNConnection(),
-			"aws_vpn_connection_route":                                ec2.ResourceVPNConnectionRoute(),
+			"aws_vpn_connection_route":                                ec2.ResourceVPNConnectionRoute(), //lintignore:AWSR007
 			"aws_vpn_gateway":                                         ec2.ResourceVPNGateway(),
-			"aws_vpn_gateway_attachment":                              ec2.ResourceVPNGatewayAttachment(),
-			"aws_vpn_gateway_route_propagation":                       ec2.ResourceVPNGatewayRoutePropagation(),
+			"aws_vpn_gateway_attachment":                              ec2.ResourceVPNGatewayAttachment(),       //lintignore:AWSR007
+			"aws_vpn_gateway_route_propagation":                       ec2.ResourceVPNGatewayRoutePropagation(), //lintignore:AWSR007
 			"aws_waf_byte_match_set":                                  waf.ResourceByteMatchSet(),
 			"aws_waf_ipset":                                           waf.ResourceIPSet(),
 			"aws_waf_rate_based_rule":                                 waf.ResourceRateBasedRule(),
@@ -1382,71 +1469,79 @@ func Provider() *schema.Provider {
 			"aws_waf_xss_match_set":                                   waf.ResourceXSSMatchSet(),
 			"aws_waf_sql_injection_match_set":                         waf.ResourceSQLInjectionMatchSet(),
 			"aws_waf_geo_match_set":                                   waf.ResourceGeoMatchSet(),
-			"aws_wafregional_byte_match_set":                          wafregional.ResourceByteMatchSet(),
-			"aws_wafregional_geo_match_set":                           wafregional.ResourceGeoMatchSet(),
-			"aws_wafregional_ipset":                                   wafregional.ResourceIPSet(),
+			"aws_wafregional_byte_match_set":                          wafregional.ResourceByteMatchSet(), //lintignore:AWSR007
+			"aws_wafregional_geo_match_set":                           wafregional.ResourceGeoMatchSet(),  //lintignore:AWSR007
+			"aws_wafregional_ipset":                                   wafregional.ResourceIPSet(),        //lintignore:AWSR007
 			"aws_wafregional_rate_based_rule":                         wafregional.ResourceRateBasedRule(),
 			"aws_wafregional_regex_match_set":                         wafregional.ResourceRegexMatchSet(),
-			"aws_wafregional_regex_pattern_set":                       wafregional.ResourceRegexPatternSet(),
+			"aws_wafregional_regex_pattern_set":                       wafregional.ResourceRegexPatternSet(), //lintignore:AWSR007
 			"aws_wafregional_rule":                                    wafregional.ResourceRule(),
 			"aws_wafregional_rule_group":                              wafregional.ResourceRuleGroup(),
-			"aws_wafregional_size_constraint_set":                     wafregional.ResourceSizeConstraintSet(),
-			"aws_wafregional_sql_injection_match_set":                 wafregional.ResourceSQLInjectionMatchSet(),
-			"aws_wafregional_xss_match_set":                           wafregional.ResourceXSSMatchSet(),
+			"aws_wafregional_size_constraint_set":                     wafregional.ResourceSizeConstraintSet(),    //lintignore:AWSR007
+			"aws_wafregional_sql_injection_match_set":                 wafregional.ResourceSQLInjectionMatchSet(), //lintignore:AWSR007
+			"aws_wafregional_xss_match_set":                           wafregional.ResourceXSSMatchSet(),          //lintignore:AWSR007
 			"aws_wafregional_web_acl":                                 wafregional.ResourceWebACL(),
-			"aws_wafregional_web_acl_association":                     wafregional.ResourceWebACLAssociation(),
+			"aws_wafregional_web_acl_association":                     wafregional.ResourceWebACLAssociation(), //lintignore:AWSR007
 			"aws_wafv2_ip_set":                                        wafv2.ResourceIPSet(),
 			"aws_wafv2_regex_pattern_set":                             wafv2.ResourceRegexPatternSet(),
 			"aws_wafv2_rule_group":                                    wafv2.ResourceRuleGroup(),
 			"aws_wafv2_web_acl":                                       wafv2.ResourceWebACL(),
-			"aws_wafv2_web_acl_association":                           wafv2.ResourceWebACLAssociation(),
-			"aws_wafv2_web_acl_logging_configuration":                 wafv2.ResourceWebACLLoggingConfiguration(),
-			"aws_worklink_fleet":                                      worklink.ResourceFleet(),
-			"aws_worklink_website_certificate_authority_association":  worklink.ResourceWebsiteCertificateAuthorityAssociation(),
+			"aws_wafv2_web_acl_association":                           wafv2.ResourceWebACLAssociation(),                         //lintignore:AWSR007
+			"aws_wafv2_web_acl_logging_configuration":                 wafv2.ResourceWebACLLoggingConfiguration(),                //lintignore:AWSR007
+			"aws_worklink_fleet":                                      worklink.ResourceFleet(),                                  //lintignore:AWSR007
+			"aws_worklink_website_certificate_authority_association":  worklink.ResourceWebsiteCertificateAuthorityAssociation(), //lintignore:AWSR007
 			"aws_workspaces_directory":                                workspaces.ResourceDirectory(),
 			"aws_workspaces_workspace":                                workspaces.ResourceWorkspace(),
 			"aws_batch_compute_environment":                           batch.ResourceComputeEnvironment(),
 			"aws_batch_job_definition":                                batch.ResourceJobDefinition(),
 			"aws_batch_job_queue":                                     batch.ResourceJobQueue(),
 			"aws_pinpoint_app":                                        pinpoint.ResourceApp(),
-			"aws_pinpoint_adm_channel":                                pinpoint.ResourceADMChannel(),
-			"aws_pinpoint_apns_channel":                               pinpoint.ResourceAPNSChannel(),
-			"aws_pinpoint_apns_sandbox_channel":                       pinpoint.ResourceAPNSSandboxChannel(),
-			"aws_pinpoint_apns_voip_channel":                          pinpoint.ResourceAPNSVoIPChannel(),
-			"aws_pinpoint_apns_voip_sandbox_channel":                  pinpoint.ResourceAPNSVoIPSandboxChannel(),
-			"aws_pinpoint_baidu_channel":                              pinpoint.ResourceBaiduChannel(),
-			"aws_pinpoint_email_channel":                              pinpoint.ResourceEmailChannel(),
-			"aws_pinpoint_event_stream":                               pinpoint.ResourceEventStream(),
-			"aws_pinpoint_gcm_channel":                                pinpoint.ResourceGCMChannel(),
-			"aws_pinpoint_sms_channel":                                pinpoint.ResourceSMSChannel(),
-			"aws_xray_encryption_config":                              xray.ResourceEncryptionConfig(),
-			"aws_xray_group":                                          xray.ResourceGroup(),
-			"aws_xray_sampling_rule":                                  xray.ResourceSamplingRule(),
+			"aws_pinpoint_adm_channel":                                pinpoint.ResourceADMChannel(),             //lintignore:AWSR007
+			"aws_pinpoint_apns_channel":                               pinpoint.ResourceAPNSChannel(),            //lintignore:AWSR007
+			"aws_pinpoint_apns_sandbox_channel":                       pinpoint.ResourceAPNSSandboxChannel(),     //lintignore:AWSR007
+			"aws_pinpoint_apns_voip_channel":                          pinpoint.ResourceAPNSVoIPChannel(),        //lintignore:AWSR007
+			"aws_pinpoint_apns_voip_sandbox_channel":                  pinpoint.ResourceAPNSVoIPSandboxChannel(), //lintignore:AWSR007
+			"aws_pinpoint_baidu_channel":                              pinpoint.ResourceBaiduChannel(),           //lintignore:AWSR007
+			"aws_pinpoint_email_channel":                              pinpoint.ResourceEmailChannel(),           //lintignore:AWSR007
+			"aws_pinpoint_event_stream":                               pinpoint.ResourceEventStream(),            //lintignore:AWSR007
+			"aws_pinpoint_gcm_channel":                                pinpoint.ResourceGCMChannel(),             //lintignore:AWSR007
+			"aws_pinpoint_sms_channel":                                pinpoint.ResourceSMSChannel(),             //lintignore:AWSR007
+			"aws_xray_encryption_config":                              xray.ResourceEncryptionConfig(),           //lintignore:AWSR007
+			"aws_xray_group":                                          xray.ResourceGroup(),                      //lintignore:AWSR007
+			"aws_xray_sampling_rule":                                  xray.ResourceSamplingRule(),               //lintignore:AWSR007
 			"aws_workspaces_ip_group":                                 workspaces.ResourceIPGroup(),
 
 			// ALBs are actually LBs because they can be type `network` or `application`
 			// To avoid regressions, we will add a new resource for each and they both point
 			// back to the old ALB version. IF the Terraform supported aliases for resources
 			// this would be a whole lot simpler
-			"aws_alb":                         elbv2.ResourceLoadBalancer(),
+			"aws_alb":                         elbv2.ResourceLoadBalancer(), //lintignore:AWSR007
 			"aws_lb":                          elbv2.ResourceLoadBalancer(),
-			"aws_alb_listener":                elbv2.ResourceListener(),
-			"aws_lb_listener":                 elbv2.ResourceListener(),
-			"aws_alb_listener_certificate":    elbv2.ResourceListenerCertificate(),
-			"aws_lb_listener_certificate":     elbv2.ResourceListenerCertificate(),
-			"aws_alb_listener_rule":           elbv2.ResourceListenerRule(),
-			"aws_lb_listener_rule":            elbv2.ResourceListenerRule(),
-			"aws_alb_target_group":            elbv2.ResourceTargetGroup(),
+			"aws_alb_listener":                elbv2.ResourceListener(),            //lintignore:AWSR007
+			"aws_lb_listener":                 elbv2.ResourceListener(),            //lintignore:AWSR007
+			"aws_alb_listener_certificate":    elbv2.ResourceListenerCertificate(), //lintignore:AWSR007
+			"aws_lb_listener_certificate":     elbv2.ResourceListenerCertificate(), //lintignore:AWSR007
+			"aws_alb_listener_rule":           elbv2.ResourceListenerRule(),        //lintignore:AWSR007
+			"aws_lb_listener_rule":            elbv2.ResourceListenerRule(),        //lintignore:AWSR007
+			"aws_alb_target_group":            elbv2.ResourceTargetGroup(),         //lintignore:AWSR007
 			"aws_lb_target_group":             elbv2.ResourceTargetGroup(),
-			"aws_alb_target_group_attachment": elbv2.ResourceTargetGroupAttachment(),
-			"aws_lb_target_group_attachment":  elbv2.ResourceTargetGroupAttachment(),
+			"aws_alb_target_group_attachment": elbv2.ResourceTargetGroupAttachment(), //lintignore:AWSR007
+			"aws_lb_target_group_attachment":  elbv2.ResourceTargetGroupAttachment(), //lintignore:AWSR007
 		},
 	}
 
 	// Avoid Go formatting churn and Git conflicts
 	// You probably should not do this
 	provider.DataSourcesMap["aws_serverlessapplicationrepository_application"] = serverlessapprepo.DataSourceApplication()
-	provider.ResourcesMap["aws_serverlessapplicationrepository_cloudformation_stack"] = serverlessapprepo.ResourceCloudFormationStack()
+	provider.ResourcesMap["aws_serverlessapplicationrepository_cloudformation_stack"] = serverlessapprepo.ResourceCloudFormationStack() //lintignore:AWSR007
+
+	for name, r := range provider.DataSourcesMap {
+		conns.WithResourceContext("data."+name, r)
+	}
+
+	for name, r := range provider.ResourcesMap {
+		conns.WithResourceContext(name, r)
+	}
 
 	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		terraformVersion := provider.TerraformVersion
@@ -1488,9 +1583,26 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
+		"retry_mode": "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. " +
+			"`adaptive` additionally rate limits requests client-side per service, reducing the send rate " +
+			"whenever requests are throttled. Can also be configured using the `AWS_RETRY_MODE` environment variable.",
+
 		"http_proxy": "The address of an HTTP proxy to use when accessing the AWS API. " +
 			"Can also be configured using the `HTTP_PROXY` or `HTTPS_PROXY` environment variables.",
 
+		"allowed_regions": "List of AWS regions in which resources may be managed.\n" +
+			"Conflicts with `forbidden_regions`.",
+
+		"forbidden_regions": "List of AWS regions in which resources must not be managed.\n" +
+			"Conflicts with `allowed_regions`.",
+
+		"no_proxy": "Host names, domain suffixes, IP addresses or CIDR blocks for which " +
+			"the HTTP proxy configured in `http_proxy` should not be used.",
+
+		"custom_ca_bundle": "File containing custom root and intermediate certificates, or the PEM encoded " +
+			"certificates themselves, used to verify the TLS certificates of AWS API endpoints. " +
+			"Can also be configured using the `AWS_CA_BUNDLE` environment variable.",
+
 		"endpoint": "Use this to override the default service endpoint URL",
 
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
@@ -1694,9 +1806,11 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		DefaultTagsConfig:       expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
 		Endpoints:               make(map[string]string),
 		MaxRetries:              d.Get("max_retries").(int),
+		RetryMode:               d.Get("retry_mode").(string),
 		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
 		Insecure:                d.Get("insecure").(bool),
 		HTTPProxy:               d.Get("http_proxy").(string),
+		CustomCABundle:          d.Get("custom_ca_bundle").(string),
 		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
 		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
 		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
@@ -1770,6 +1884,58 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
 	}
 
+	if l, ok := d.Get("assume_role_with_web_identity").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		m := l[0].(map[string]interface{})
+
+		if v, ok := m["duration_seconds"].(int); ok && v != 0 {
+			config.AssumeRoleWithWebIdentityDurationSeconds = v
+		}
+
+		if v, ok := m["policy"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityPolicy = v
+		}
+
+		if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
+			for _, policyARNRaw := range policyARNSet.List() {
+				policyARN, ok := policyARNRaw.(string)
+
+				if !ok {
+					continue
+				}
+
+				config.AssumeRoleWithWebIdentityPolicyARNs = append(config.AssumeRoleWithWebIdentityPolicyARNs, policyARN)
+			}
+		}
+
+		if v, ok := m["role_arn"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityARN = v
+		}
+
+		if v, ok := m["session_name"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentitySessionName = v
+		}
+
+		if v, ok := m["web_identity_token"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityToken = v
+		}
+
+		if v, ok := m["web_identity_token_file"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityTokenFile = v
+		}
+
+		if config.AssumeRoleWithWebIdentityARN == "" {
+			return nil, fmt.Errorf("assume_role_with_web_identity: role_arn must be set, either in configuration or via the AWS_ROLE_ARN environment variable")
+		}
+
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q)", config.AssumeRoleWithWebIdentityARN, config.AssumeRoleWithWebIdentitySessionName)
+	}
+
+	// Every service gets an entry so that endpoints can be resolved from
+	// environment variables and the shared config file when not configured here.
+	for _, endpointServiceName := range EndpointServiceNames {
+		config.Endpoints[endpointServiceName] = ""
+	}
+
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
 	for _, endpointsSetI := range endpointsSet.List() {
@@ -1779,6 +1945,14 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		}
 	}
 
+	if v, ok := d.GetOk("no_proxy"); ok {
+		for _, hostRaw := range v.(*schema.Set).List() {
+			config.NoProxy = append(config.NoProxy, hostRaw.(string))
+		}
+	}
+
+	config.DefaultTagsSSMParameterPath = expandProviderDefaultTagsSSMParameterPath(d.Get("default_tags").([]interface{}))
+
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
 		for _, accountIDRaw := range v.(*schema.Set).List() {
 			config.AllowedAccountIds = append(config.AllowedAccountIds, accountIDRaw.(string))
@@ -1791,6 +1965,22 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		}
 	}
 
+	if v, ok := d.GetOk("allowed_regions"); ok {
+		for _, regionRaw := range v.(*schema.Set).List() {
+			config.AllowedRegions = append(config.AllowedRegions, regionRaw.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("forbidden_regions"); ok {
+		for _, regionRaw := range v.(*schema.Set).List() {
+			config.ForbiddenRegions = append(config.ForbiddenRegions, regionRaw.(string))
+		}
+	}
+
+	if err := conns.ValidateRegionAllowed(config.Region, config.AllowedRegions, config.ForbiddenRegions); err != nil {
+		return nil, err
+	}
+
 	return config.Client()
 }
 
@@ -1854,6 +2044,66 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "Seconds to restrict the assume role with web identity session duration.",
+					ValidateFunc: validation.IntBetween(900, 43200),
+				},
+				"policy": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
+					ValidateFunc: validation.StringIsJSON,
+				},
+				"policy_arns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: verify.ValidARN,
+					},
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("AWS_ROLE_ARN", nil),
+					Description:  "Amazon Resource Name of an IAM Role to assume with a web identity token prior to making API calls.",
+					ValidateFunc: verify.ValidARN,
+				},
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("AWS_ROLE_SESSION_NAME", nil),
+					Description: "Identifier for the assumed role with web identity session.",
+				},
+				"web_identity_token": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					Description:   "OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider.",
+					ConflictsWith: []string{"assume_role_with_web_identity.0.web_identity_token_file"},
+				},
+				"web_identity_token_file": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					DefaultFunc:   schema.EnvDefaultFunc("AWS_WEB_IDENTITY_TOKEN_FILE", nil),
+					Description:   "Path to a file containing an OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider.",
+					ConflictsWith: []string{"assume_role_with_web_identity.0.web_identity_token"},
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -1889,6 +2139,20 @@ func expandProviderDefaultTags(l []interface{}) *tftags.DefaultConfig {
 	return defaultConfig
 }
 
+func expandProviderDefaultTagsSSMParameterPath(l []interface{}) string {
+	if len(l) == 0 || l[0] == nil {
+		return ""
+	}
+
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["tags_from_ssm_parameter_path"].(string); ok {
+		return v
+	}
+
+	return ""
+}
+
 func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -1905,5 +2169,16 @@ func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
 		ignoreConfig.KeyPrefixes = tftags.New(v.List())
 	}
 
+	if v, ok := m["key_suffixes"].(*schema.Set); ok {
+		ignoreConfig.KeySuffixes = tftags.New(v.List())
+	}
+
+	if v, ok := m["key_patterns"].(*schema.Set); ok {
+		for _, patternRaw := range v.List() {
+			// Patterns are validated in the schema.
+			ignoreConfig.KeyPatterns = append(ignoreConfig.KeyPatterns, regexp.MustCompile(patternRaw.(string)))
+		}
+	}
+
 	return ignoreConfig
 }
@@ -6,13 +6,14 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/aws/aws-sdk-go/service/acmpca/acmpcaiface"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 // ListTags lists acmpca service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func ListTags(conn *acmpca.ACMPCA, identifier string) (tftags.KeyValueTags, error) {
+func ListTags(conn acmpcaiface.ACMPCAAPI, identifier string) (tftags.KeyValueTags, error) {
 	input := &acmpca.ListTagsInput{
 		CertificateAuthorityArn: aws.String(identifier),
 	}
@@ -58,7 +59,7 @@ func KeyValueTags(tags []*acmpca.Tag) tftags.KeyValueTags {
 // UpdateTags updates acmpca service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func UpdateTags(conn *acmpca.ACMPCA, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+func UpdateTags(conn acmpcaiface.ACMPCAAPI, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
 	oldTags := tftags.New(oldTagsMap)
 	newTags := tftags.New(newTagsMap)
 
@@ -9,7 +9,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/amplify"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -20,7 +19,7 @@ import (
 
 func testAccApp_basic(t *testing.T) {
 	var app amplify.App
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_amplify_app.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -67,7 +66,7 @@ func testAccApp_basic(t *testing.T) {
 
 func testAccApp_disappears(t *testing.T) {
 	var app amplify.App
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_amplify_app.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -90,7 +89,7 @@ func testAccApp_disappears(t *testing.T) {
 
 func testAccApp_Tags(t *testing.T) {
 	var app amplify.App
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_amplify_app.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -135,7 +134,7 @@ func testAccApp_Tags(t *testing.T) {
 
 func testAccApp_AutoBranchCreationConfig(t *testing.T) {
 	var app amplify.App
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_amplify_app.test"
 
 	credentials := base64.StdEncoding.EncodeToString([]byte("username1:password1"))
@@ -230,7 +229,7 @@ func testAccApp_AutoBranchCreationConfig(t *testing.T) {
 
 func testAccApp_BasicAuthCredentials(t *testing.T) {
 	var app amplify.App
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_amplify_app.test"
 
 	credentials1 := base64.StdEncoding.EncodeToString([]byte("username1:password1"))
@@ -278,7 +277,7 @@ func testAccApp_BasicAuthCredentials(t *testing.T) {
 
 func testAccApp_BuildSpec(t *testing.T) {
 	var app amplify.App
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_amplify_app.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -320,7 +319,7 @@ func testAccApp_BuildSpec(t *testing.T) {
 
 func testAccApp_CustomRules(t *testing.T) {
 	var app amplify.App
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_amplify_app.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -370,7 +369,7 @@ func testAccApp_CustomRules(t *testing.T) {
 
 func testAccApp_Description(t *testing.T) {
 	var app1, app2, app3 amplify.App
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_amplify_app.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -413,7 +412,7 @@ func testAccApp_Description(t *testing.T) {
 
 func testAccApp_EnvironmentVariables(t *testing.T) {
 	var app amplify.App
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_amplify_app.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -457,7 +456,7 @@ func testAccApp_EnvironmentVariables(t *testing.T) {
 
 func testAccApp_IAMServiceRole(t *testing.T) {
 	var app1, app2, app3 amplify.App
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_amplify_app.test"
 	iamRole1ResourceName := "aws_iam_role.test1"
 	iamRole2ResourceName := "aws_iam_role.test2"
@@ -501,8 +500,8 @@ func testAccApp_IAMServiceRole(t *testing.T) {
 
 func testAccApp_Name(t *testing.T) {
 	var app amplify.App
-	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName1 := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_amplify_app.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -548,7 +547,7 @@ func testAccApp_Repository(t *testing.T) {
 	}
 
 	var app amplify.App
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_amplify_app.test"
 
 	resource.ParallelTest(t, resource.TestCase{
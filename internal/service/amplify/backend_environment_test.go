@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/amplify"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,10 +16,10 @@ import (
 
 func testAccBackendEnvironment_basic(t *testing.T) {
 	var env amplify.BackendEnvironment
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_amplify_backend_environment.test"
 
-	environmentName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+	environmentName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
@@ -49,10 +48,10 @@ func testAccBackendEnvironment_basic(t *testing.T) {
 
 func testAccBackendEnvironment_disappears(t *testing.T) {
 	var env amplify.BackendEnvironment
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_amplify_backend_environment.test"
 
-	environmentName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+	environmentName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
@@ -74,10 +73,10 @@ func testAccBackendEnvironment_disappears(t *testing.T) {
 
 func testAccBackendEnvironment_DeploymentArtifacts_StackName(t *testing.T) {
 	var env amplify.BackendEnvironment
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_amplify_backend_environment.test"
 
-	environmentName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+	environmentName := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
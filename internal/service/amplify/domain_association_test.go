@@ -7,7 +7,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/amplify"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -24,7 +23,7 @@ func testAccDomainAssociation_basic(t *testing.T) {
 	}
 
 	var domain amplify.DomainAssociation
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_amplify_domain_association.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -65,7 +64,7 @@ func testAccDomainAssociation_disappears(t *testing.T) {
 	}
 
 	var domain amplify.DomainAssociation
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_amplify_domain_association.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -94,7 +93,7 @@ func testAccDomainAssociation_update(t *testing.T) {
 	}
 
 	var domain amplify.DomainAssociation
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_amplify_domain_association.test"
 
 	resource.ParallelTest(t, resource.TestCase{
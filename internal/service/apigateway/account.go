@@ -17,6 +17,7 @@ import (
 func ResourceAccount() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAccountUpdate,
+		//lintignore:AWSR004
 		Read:   resourceAccountRead,
 		Update: resourceAccountUpdate,
 		Delete: resourceAccountDelete,
@@ -19,6 +19,7 @@ import (
 func ResourceAPIKey() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAPIKeyCreate,
+		//lintignore:AWSR004
 		Read:   resourceAPIKeyRead,
 		Update: resourceAPIKeyUpdate,
 		Delete: resourceAPIKeyDelete,
@@ -20,7 +20,8 @@ const DefaultAuthorizerTTL = 300
 
 func ResourceAuthorizer() *schema.Resource {
 	return &schema.Resource{
-		Create:        resourceAuthorizerCreate,
+		Create: resourceAuthorizerCreate,
+		//lintignore:AWSR004
 		Read:          resourceAuthorizerRead,
 		Update:        resourceAuthorizerUpdate,
 		Delete:        resourceAuthorizerDelete,
@@ -20,6 +20,7 @@ const EmptyBasePathMappingValue = "(none)"
 func ResourceBasePathMapping() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBasePathMappingCreate,
+		//lintignore:AWSR004
 		Read:   resourceBasePathMappingRead,
 		Update: resourceBasePathMappingUpdate,
 		Delete: resourceBasePathMappingDelete,
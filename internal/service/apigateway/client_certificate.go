@@ -17,6 +17,7 @@ import (
 func ResourceClientCertificate() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceClientCertificateCreate,
+		//lintignore:AWSR004
 		Read:   resourceClientCertificateRead,
 		Update: resourceClientCertificateUpdate,
 		Delete: resourceClientCertificateDelete,
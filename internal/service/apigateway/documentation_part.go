@@ -15,6 +15,7 @@ import (
 func ResourceDocumentationPart() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDocumentationPartCreate,
+		//lintignore:AWSR004
 		Read:   resourceDocumentationPartRead,
 		Update: resourceDocumentationPartUpdate,
 		Delete: resourceDocumentationPartDelete,
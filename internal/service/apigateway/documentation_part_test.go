@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,7 +18,7 @@ import (
 func TestAccAPIGatewayDocumentationPart_basic(t *testing.T) {
 	var conf apigateway.DocumentationPart
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	apiName := fmt.Sprintf("tf-acc-test_api_doc_part_basic_%s", rString)
 	properties := `{"description":"Terraform Acceptance Test"}`
 	uProperties := `{"description":"Terraform Acceptance Test Updated"}`
@@ -64,7 +63,7 @@ func TestAccAPIGatewayDocumentationPart_basic(t *testing.T) {
 func TestAccAPIGatewayDocumentationPart_method(t *testing.T) {
 	var conf apigateway.DocumentationPart
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	apiName := fmt.Sprintf("tf-acc-test_api_doc_part_method_%s", rString)
 	properties := `{"description":"Terraform Acceptance Test"}`
 	uProperties := `{"description":"Terraform Acceptance Test Updated"}`
@@ -113,7 +112,7 @@ func TestAccAPIGatewayDocumentationPart_method(t *testing.T) {
 func TestAccAPIGatewayDocumentationPart_responseHeader(t *testing.T) {
 	var conf apigateway.DocumentationPart
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	apiName := fmt.Sprintf("tf-acc-test_api_doc_part_resp_header_%s", rString)
 	properties := `{"description":"Terraform Acceptance Test"}`
 	uProperties := `{"description":"Terraform Acceptance Test Updated"}`
@@ -166,7 +165,7 @@ func TestAccAPIGatewayDocumentationPart_responseHeader(t *testing.T) {
 func TestAccAPIGatewayDocumentationPart_disappears(t *testing.T) {
 	var conf apigateway.DocumentationPart
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	apiName := fmt.Sprintf("tf-acc-test_api_doc_part_basic_%s", rString)
 	properties := `{"description":"Terraform Acceptance Test"}`
 
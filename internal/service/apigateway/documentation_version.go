@@ -15,6 +15,7 @@ import (
 func ResourceDocumentationVersion() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDocumentationVersionCreate,
+		//lintignore:AWSR004
 		Read:   resourceDocumentationVersionRead,
 		Update: resourceDocumentationVersionUpdate,
 		Delete: resourceDocumentationVersionDelete,
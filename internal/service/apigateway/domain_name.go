@@ -19,6 +19,7 @@ import (
 func ResourceDomainName() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDomainNameCreate,
+		//lintignore:AWSR004
 		Read:   resourceDomainNameRead,
 		Update: resourceDomainNameUpdate,
 		Delete: resourceDomainNameDelete,
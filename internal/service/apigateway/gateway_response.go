@@ -15,6 +15,7 @@ import (
 func ResourceGatewayResponse() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceGatewayResponsePut,
+		//lintignore:AWSR004
 		Read:   resourceGatewayResponseRead,
 		Update: resourceGatewayResponsePut,
 		Delete: resourceGatewayResponseDelete,
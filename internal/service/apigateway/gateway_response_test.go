@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/apigateway"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 func TestAccAPIGatewayGatewayResponse_basic(t *testing.T) {
 	var conf apigateway.UpdateGatewayResponseOutput
 
-	rName := sdkacctest.RandString(10)
+	rName := acctest.RandString(10)
 	resourceName := "aws_api_gateway_gateway_response.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -61,7 +60,7 @@ func TestAccAPIGatewayGatewayResponse_basic(t *testing.T) {
 func TestAccAPIGatewayGatewayResponse_disappears(t *testing.T) {
 	var conf apigateway.UpdateGatewayResponseOutput
 
-	rName := sdkacctest.RandString(10)
+	rName := acctest.RandString(10)
 	resourceName := "aws_api_gateway_gateway_response.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -15,6 +15,7 @@ import (
 func ResourceIntegrationResponse() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceIntegrationResponseCreate,
+		//lintignore:AWSR004
 		Read:   resourceIntegrationResponseRead,
 		Update: resourceIntegrationResponseCreate,
 		Delete: resourceIntegrationResponseDelete,
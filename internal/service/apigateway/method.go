@@ -17,6 +17,7 @@ import (
 func ResourceMethod() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceMethodCreate,
+		//lintignore:AWSR004
 		Read:   resourceMethodRead,
 		Update: resourceMethodUpdate,
 		Delete: resourceMethodDelete,
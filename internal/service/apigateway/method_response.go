@@ -20,6 +20,7 @@ var resourceMethodResponseMutex = &sync.Mutex{}
 func ResourceMethodResponse() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceMethodResponseCreate,
+		//lintignore:AWSR004
 		Read:   resourceMethodResponseRead,
 		Update: resourceMethodResponseUpdate,
 		Delete: resourceMethodResponseDelete,
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/apigateway"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func TestAccAPIGatewayMethod_basic(t *testing.T) {
 	var conf apigateway.Method
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_api_gateway_method.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -57,7 +56,7 @@ func TestAccAPIGatewayMethod_basic(t *testing.T) {
 
 func TestAccAPIGatewayMethod_customAuthorizer(t *testing.T) {
 	var conf apigateway.Method
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_api_gateway_method.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -99,7 +98,7 @@ func TestAccAPIGatewayMethod_customAuthorizer(t *testing.T) {
 
 func TestAccAPIGatewayMethod_cognitoAuthorizer(t *testing.T) {
 	var conf apigateway.Method
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_api_gateway_method.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -144,7 +143,7 @@ func TestAccAPIGatewayMethod_cognitoAuthorizer(t *testing.T) {
 
 func TestAccAPIGatewayMethod_customRequestValidator(t *testing.T) {
 	var conf apigateway.Method
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_api_gateway_method.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -185,7 +184,7 @@ func TestAccAPIGatewayMethod_customRequestValidator(t *testing.T) {
 
 func TestAccAPIGatewayMethod_disappears(t *testing.T) {
 	var conf apigateway.Method
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_api_gateway_method.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -208,7 +207,7 @@ func TestAccAPIGatewayMethod_disappears(t *testing.T) {
 
 func TestAccAPIGatewayMethod_operationName(t *testing.T) {
 	var conf apigateway.Method
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_api_gateway_method.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -15,6 +15,7 @@ import (
 func ResourceModel() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceModelCreate,
+		//lintignore:AWSR004
 		Read:   resourceModelRead,
 		Update: resourceModelUpdate,
 		Delete: resourceModelDelete,
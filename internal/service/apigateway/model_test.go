@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/apigateway"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 
 func TestAccAPIGatewayModel_basic(t *testing.T) {
 	var conf apigateway.Model
-	rInt := sdkacctest.RandString(10)
+	rInt := acctest.RandString(10)
 	rName := fmt.Sprintf("tf-acc-test-%s", rInt)
 	modelName := fmt.Sprintf("tfacctest%s", rInt)
 	resourceName := "aws_api_gateway_model.test"
@@ -53,7 +52,7 @@ func TestAccAPIGatewayModel_basic(t *testing.T) {
 
 func TestAccAPIGatewayModel_disappears(t *testing.T) {
 	var conf apigateway.Model
-	rInt := sdkacctest.RandString(10)
+	rInt := acctest.RandString(10)
 	rName := fmt.Sprintf("tf-acc-test-%s", rInt)
 	modelName := fmt.Sprintf("tfacctest%s", rInt)
 	resourceName := "aws_api_gateway_model.test"
@@ -15,6 +15,7 @@ import (
 func ResourceRequestValidator() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRequestValidatorCreate,
+		//lintignore:AWSR004
 		Read:   resourceRequestValidatorRead,
 		Update: resourceRequestValidatorUpdate,
 		Delete: resourceRequestValidatorDelete,
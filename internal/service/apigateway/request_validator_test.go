@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/apigateway"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 
 func TestAccAPIGatewayRequestValidator_basic(t *testing.T) {
 	var conf apigateway.UpdateRequestValidatorOutput
-	rName := fmt.Sprintf("tf-test-acc-%s", sdkacctest.RandString(8))
+	rName := fmt.Sprintf("tf-test-acc-%s", acctest.RandString(8))
 	resourceName := "aws_api_gateway_request_validator.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -62,7 +61,7 @@ func TestAccAPIGatewayRequestValidator_basic(t *testing.T) {
 
 func TestAccAPIGatewayRequestValidator_disappears(t *testing.T) {
 	var conf apigateway.UpdateRequestValidatorOutput
-	rName := fmt.Sprintf("tf-test-acc-%s", sdkacctest.RandString(8))
+	rName := fmt.Sprintf("tf-test-acc-%s", acctest.RandString(8))
 	resourceName := "aws_api_gateway_request_validator.test"
 
 	resource.ParallelTest(t, resource.TestCase{
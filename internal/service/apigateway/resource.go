@@ -15,6 +15,7 @@ import (
 func ResourceResource() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceResourceCreate,
+		//lintignore:AWSR004
 		Read:   resourceResourceRead,
 		Update: resourceResourceUpdate,
 		Delete: resourceResourceDelete,
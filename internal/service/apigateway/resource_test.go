@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/apigateway"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 
 func TestAccAPIGatewayResource_basic(t *testing.T) {
 	var conf apigateway.Resource
-	rName := fmt.Sprintf("tf-test-acc-%s", sdkacctest.RandString(8))
+	rName := fmt.Sprintf("tf-test-acc-%s", acctest.RandString(8))
 	resourceName := "aws_api_gateway_resource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -49,7 +48,7 @@ func TestAccAPIGatewayResource_basic(t *testing.T) {
 
 func TestAccAPIGatewayResource_update(t *testing.T) {
 	var conf apigateway.Resource
-	rName := fmt.Sprintf("tf-test-acc-%s", sdkacctest.RandString(8))
+	rName := fmt.Sprintf("tf-test-acc-%s", acctest.RandString(8))
 	resourceName := "aws_api_gateway_resource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -93,7 +92,7 @@ func TestAccAPIGatewayResource_update(t *testing.T) {
 
 func TestAccAPIGatewayResource_disappears(t *testing.T) {
 	var conf apigateway.Resource
-	rName := fmt.Sprintf("tf-test-acc-%s", sdkacctest.RandString(8))
+	rName := fmt.Sprintf("tf-test-acc-%s", acctest.RandString(8))
 	resourceName := "aws_api_gateway_resource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -22,6 +22,7 @@ import (
 func ResourceRestAPI() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRestAPICreate,
+		//lintignore:AWSR004
 		Read:   resourceRestAPIRead,
 		Update: resourceRestAPIUpdate,
 		Delete: resourceRestAPIDelete,
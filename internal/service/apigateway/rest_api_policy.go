@@ -18,6 +18,7 @@ import (
 func ResourceRestAPIPolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRestAPIPolicyPut,
+		//lintignore:AWSR004
 		Read:   resourceRestAPIPolicyRead,
 		Update: resourceRestAPIPolicyPut,
 		Delete: resourceRestAPIPolicyDelete,
@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -20,7 +19,7 @@ import (
 func TestAccAPIGatewayRestAPIPolicy_basic(t *testing.T) {
 	var v apigateway.RestApi
 	resourceName := "aws_api_gateway_rest_api_policy.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
@@ -53,7 +52,7 @@ func TestAccAPIGatewayRestAPIPolicy_basic(t *testing.T) {
 func TestAccAPIGatewayRestAPIPolicy_disappears(t *testing.T) {
 	var v apigateway.RestApi
 	resourceName := "aws_api_gateway_rest_api_policy.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
@@ -76,7 +75,7 @@ func TestAccAPIGatewayRestAPIPolicy_disappears(t *testing.T) {
 func TestAccAPIGatewayRestAPIPolicy_Disappears_restAPI(t *testing.T) {
 	var v apigateway.RestApi
 	resourceName := "aws_api_gateway_rest_api_policy.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
@@ -21,6 +21,7 @@ import (
 func ResourceStage() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceStageCreate,
+		//lintignore:AWSR004
 		Read:   resourceStageRead,
 		Update: resourceStageUpdate,
 		Delete: resourceStageDelete,
@@ -20,6 +20,7 @@ import (
 func ResourceUsagePlan() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceUsagePlanCreate,
+		//lintignore:AWSR004
 		Read:   resourceUsagePlanRead,
 		Update: resourceUsagePlanUpdate,
 		Delete: resourceUsagePlanDelete,
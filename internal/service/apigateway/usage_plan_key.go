@@ -15,6 +15,7 @@ import (
 func ResourceUsagePlanKey() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceUsagePlanKeyCreate,
+		//lintignore:AWSR004
 		Read:   resourceUsagePlanKeyRead,
 		Delete: resourceUsagePlanKeyDelete,
 		Importer: &schema.ResourceImporter{
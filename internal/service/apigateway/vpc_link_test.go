@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 )
 
 func TestAccAPIGatewayVPCLink_basic(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 	resourceName := "aws_api_gateway_vpc_link.test"
 	vpcLinkName := fmt.Sprintf("tf-apigateway-%s", rName)
 	vpcLinkNameUpdated := fmt.Sprintf("tf-apigateway-update-%s", rName)
@@ -58,7 +57,7 @@ func TestAccAPIGatewayVPCLink_basic(t *testing.T) {
 }
 
 func TestAccAPIGatewayVPCLink_tags(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 	resourceName := "aws_api_gateway_vpc_link.test"
 	vpcLinkName := fmt.Sprintf("tf-apigateway-%s", rName)
 	description := "test"
@@ -113,7 +112,7 @@ func TestAccAPIGatewayVPCLink_tags(t *testing.T) {
 }
 
 func TestAccAPIGatewayVPCLink_disappears(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 	resourceName := "aws_api_gateway_vpc_link.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -20,6 +20,7 @@ import (
 func ResourceAPI() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAPICreate,
+		//lintignore:AWSR004
 		Read:   resourceAPIRead,
 		Update: resourceAPIUpdate,
 		Delete: resourceAPIDelete,
@@ -15,6 +15,7 @@ import (
 func ResourceAPIMapping() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAPIMappingCreate,
+		//lintignore:AWSR004
 		Read:   resourceAPIMappingRead,
 		Update: resourceAPIMappingUpdate,
 		Delete: resourceAPIMappingDelete,
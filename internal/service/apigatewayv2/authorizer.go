@@ -18,6 +18,7 @@ import (
 func ResourceAuthorizer() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAuthorizerCreate,
+		//lintignore:AWSR004
 		Read:   resourceAuthorizerRead,
 		Update: resourceAuthorizerUpdate,
 		Delete: resourceAuthorizerDelete,
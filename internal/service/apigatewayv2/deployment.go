@@ -16,6 +16,7 @@ import (
 func ResourceDeployment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDeploymentCreate,
+		//lintignore:AWSR004
 		Read:   resourceDeploymentRead,
 		Update: resourceDeploymentUpdate,
 		Delete: resourceDeploymentDelete,
@@ -18,6 +18,7 @@ import (
 func ResourceIntegration() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceIntegrationCreate,
+		//lintignore:AWSR004
 		Read:   resourceIntegrationRead,
 		Update: resourceIntegrationUpdate,
 		Delete: resourceIntegrationDelete,
@@ -18,6 +18,7 @@ import (
 func ResourceIntegrationResponse() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceIntegrationResponseCreate,
+		//lintignore:AWSR004
 		Read:   resourceIntegrationResponseRead,
 		Update: resourceIntegrationResponseUpdate,
 		Delete: resourceIntegrationResponseDelete,
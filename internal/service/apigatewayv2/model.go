@@ -19,6 +19,7 @@ import (
 func ResourceModel() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceModelCreate,
+		//lintignore:AWSR004
 		Read:   resourceModelRead,
 		Update: resourceModelUpdate,
 		Delete: resourceModelDelete,
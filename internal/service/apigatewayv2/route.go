@@ -18,6 +18,7 @@ import (
 func ResourceRoute() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRouteCreate,
+		//lintignore:AWSR004
 		Read:   resourceRouteRead,
 		Update: resourceRouteUpdate,
 		Delete: resourceRouteDelete,
@@ -17,6 +17,7 @@ import (
 func ResourceRouteResponse() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRouteResponseCreate,
+		//lintignore:AWSR004
 		Read:   resourceRouteResponseRead,
 		Update: resourceRouteResponseUpdate,
 		Delete: resourceRouteResponseDelete,
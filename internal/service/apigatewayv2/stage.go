@@ -24,6 +24,7 @@ const (
 func ResourceStage() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceStageCreate,
+		//lintignore:AWSR004
 		Read:   resourceStageRead,
 		Update: resourceStageUpdate,
 		Delete: resourceStageDelete,
@@ -19,6 +19,7 @@ import (
 func ResourceVPCLink() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceVPCLinkCreate,
+		//lintignore:AWSR004
 		Read:   resourceVPCLinkRead,
 		Update: resourceVPCLinkUpdate,
 		Delete: resourceVPCLinkDelete,
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appconfig"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 )
 
 func TestAccAppConfigConfigurationProfile_basic(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_appconfig_configuration_profile.test"
 	appResourceName := "aws_appconfig_application.test"
 
@@ -50,7 +49,7 @@ func TestAccAppConfigConfigurationProfile_basic(t *testing.T) {
 }
 
 func TestAccAppConfigConfigurationProfile_disappears(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_appconfig_configuration_profile.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -72,7 +71,7 @@ func TestAccAppConfigConfigurationProfile_disappears(t *testing.T) {
 }
 
 func TestAccAppConfigConfigurationProfile_Validators_json(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_appconfig_configuration_profile.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -125,7 +124,7 @@ func TestAccAppConfigConfigurationProfile_Validators_json(t *testing.T) {
 }
 
 func TestAccAppConfigConfigurationProfile_Validators_lambda(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_appconfig_configuration_profile.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -163,7 +162,7 @@ func TestAccAppConfigConfigurationProfile_Validators_lambda(t *testing.T) {
 }
 
 func TestAccAppConfigConfigurationProfile_Validators_multiple(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_appconfig_configuration_profile.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -197,8 +196,8 @@ func TestAccAppConfigConfigurationProfile_Validators_multiple(t *testing.T) {
 }
 
 func TestAccAppConfigConfigurationProfile_updateName(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rNameUpdated := sdkacctest.RandomWithPrefix("tf-acc-test-update")
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := acctest.RandomWithPrefix("tf-acc-test-update")
 	resourceName := "aws_appconfig_configuration_profile.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -231,8 +230,8 @@ func TestAccAppConfigConfigurationProfile_updateName(t *testing.T) {
 }
 
 func TestAccAppConfigConfigurationProfile_updateDescription(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	description := sdkacctest.RandomWithPrefix("tf-acc-test-update")
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	description := acctest.RandomWithPrefix("tf-acc-test-update")
 	resourceName := "aws_appconfig_configuration_profile.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -270,7 +269,7 @@ func TestAccAppConfigConfigurationProfile_updateDescription(t *testing.T) {
 }
 
 func TestAccAppConfigConfigurationProfile_tags(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_appconfig_configuration_profile.test"
 
 	resource.ParallelTest(t, resource.TestCase{
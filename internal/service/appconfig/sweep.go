@@ -80,7 +80,7 @@ func sweepApplications(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_appconfig_application", r, d, client))
 		}
 
 		return !lastPage
@@ -148,7 +148,7 @@ func sweepConfigurationProfiles(region string) error {
 					d := r.Data(nil)
 					d.SetId(id)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_appconfig_configuration_profile", r, d, client))
 				}
 
 				return !lastPage
@@ -214,7 +214,7 @@ func sweepDeploymentStrategies(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_appconfig_deployment_strategy", r, d, client))
 		}
 
 		return !lastPage
@@ -282,7 +282,7 @@ func sweepEnvironments(region string) error {
 					d := r.Data(nil)
 					d.SetId(id)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_appconfig_environment", r, d, client))
 				}
 
 				return !lastPage
@@ -375,7 +375,7 @@ func sweepHostedConfigurationVersions(region string) error {
 							d := r.Data(nil)
 							d.SetId(id)
 
-							sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+							sweepResources = append(sweepResources, sweep.NewSweepResource("aws_appconfig_hosted_configuration_version", r, d, client))
 						}
 
 						return !lastPage
@@ -3,7 +3,6 @@ package applicationautoscaling
 import (
 	"fmt"
 	"log"
-	"strconv"
 	"strings"
 	"time"
 
@@ -14,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
 	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -21,6 +21,7 @@ import (
 func ResourcePolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePolicyCreate,
+		//lintignore:AWSR004
 		Read:   resourcePolicyRead,
 		Update: resourcePolicyUpdate,
 		Delete: resourcePolicyDelete,
@@ -88,12 +89,14 @@ func ResourcePolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"metric_interval_lower_bound": {
-										Type:     schema.TypeString,
-										Optional: true,
+										Type:         nullable.TypeNullableFloat,
+										Optional:     true,
+										ValidateFunc: nullable.ValidateTypeStringNullableFloat,
 									},
 									"metric_interval_upper_bound": {
-										Type:     schema.TypeString,
-										Optional: true,
+										Type:         nullable.TypeNullableFloat,
+										Optional:     true,
+										ValidateFunc: nullable.ValidateTypeStringNullableFloat,
 									},
 									"scaling_adjustment": {
 										Type:     schema.TypeInt,
@@ -434,45 +437,23 @@ func expandAppautoscalingStepAdjustments(configured []interface{}) ([]*applicati
 	var adjustments []*applicationautoscaling.StepAdjustment
 
 	// Loop over our configured step adjustments and create an array
-	// of aws-sdk-go compatible objects. We're forced to convert strings
-	// to floats here because there's no way to detect whether or not
-	// an uninitialized, optional schema element is "0.0" deliberately.
-	// With strings, we can test for "", which is definitely an empty
-	// struct value.
+	// of aws-sdk-go compatible objects. The bounds are nullable floats
+	// as there's no way to detect whether or not an uninitialized,
+	// optional TypeFloat schema element is "0.0" deliberately.
 	for _, raw := range configured {
 		data := raw.(map[string]interface{})
 		a := &applicationautoscaling.StepAdjustment{
 			ScalingAdjustment: aws.Int64(int64(data["scaling_adjustment"].(int))),
 		}
-		if data["metric_interval_lower_bound"] != "" {
-			bound := data["metric_interval_lower_bound"]
-			switch bound := bound.(type) {
-			case string:
-				f, err := strconv.ParseFloat(bound, 64)
-				if err != nil {
-					return nil, fmt.Errorf(
-						"metric_interval_lower_bound must be a float value represented as a string")
-				}
-				a.MetricIntervalLowerBound = aws.Float64(f)
-			default:
-				return nil, fmt.Errorf(
-					"metric_interval_lower_bound isn't a string. This is a bug. Please file an issue.")
-			}
+		if v, null, err := nullable.Float(data["metric_interval_lower_bound"].(string)).Value(); err != nil {
+			return nil, fmt.Errorf("metric_interval_lower_bound must be a float value represented as a string: %w", err)
+		} else if !null {
+			a.MetricIntervalLowerBound = aws.Float64(v)
 		}
-		if data["metric_interval_upper_bound"] != "" {
-			bound := data["metric_interval_upper_bound"]
-			switch bound := bound.(type) {
-			case string:
-				f, err := strconv.ParseFloat(bound, 64)
-				if err != nil {
-					return nil, fmt.Errorf(
-						"metric_interval_upper_bound must be a float value represented as a string")
-				}
-				a.MetricIntervalUpperBound = aws.Float64(f)
-			default:
-				return nil, fmt.Errorf(
-					"metric_interval_upper_bound isn't a string. This is a bug. Please file an issue.")
-			}
+		if v, null, err := nullable.Float(data["metric_interval_upper_bound"].(string)).Value(); err != nil {
+			return nil, fmt.Errorf("metric_interval_upper_bound must be a float value represented as a string: %w", err)
+		} else if !null {
+			a.MetricIntervalUpperBound = aws.Float64(v)
 		}
 		adjustments = append(adjustments, a)
 	}
@@ -663,12 +644,14 @@ func flattenStepScalingPolicyConfiguration(cfg *applicationautoscaling.StepScali
 		stepAdjustmentsResource := &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"metric_interval_lower_bound": {
-					Type:     schema.TypeString,
-					Optional: true,
+					Type:         nullable.TypeNullableFloat,
+					Optional:     true,
+					ValidateFunc: nullable.ValidateTypeStringNullableFloat,
 				},
 				"metric_interval_upper_bound": {
-					Type:     schema.TypeString,
-					Optional: true,
+					Type:         nullable.TypeNullableFloat,
+					Optional:     true,
+					ValidateFunc: nullable.ValidateTypeStringNullableFloat,
 				},
 				"scaling_adjustment": {
 					Type:     schema.TypeInt,
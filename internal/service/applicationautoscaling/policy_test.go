@@ -9,7 +9,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -84,7 +83,7 @@ func TestAccApplicationAutoScalingPolicy_basic(t *testing.T) {
 	var policy applicationautoscaling.ScalingPolicy
 	appAutoscalingTargetResourceName := "aws_appautoscaling_target.test"
 	resourceName := "aws_appautoscaling_policy.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -124,7 +123,7 @@ func TestAccApplicationAutoScalingPolicy_basic(t *testing.T) {
 func TestAccApplicationAutoScalingPolicy_disappears(t *testing.T) {
 	var policy applicationautoscaling.ScalingPolicy
 	resourceName := "aws_appautoscaling_policy.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -147,8 +146,8 @@ func TestAccApplicationAutoScalingPolicy_disappears(t *testing.T) {
 func TestAccApplicationAutoScalingPolicy_scaleOutAndIn(t *testing.T) {
 	var policy applicationautoscaling.ScalingPolicy
 
-	randClusterName := fmt.Sprintf("cluster%s", sdkacctest.RandString(10))
-	randPolicyNamePrefix := fmt.Sprintf("terraform-test-foobar-%s", sdkacctest.RandString(5))
+	randClusterName := fmt.Sprintf("cluster%s", acctest.RandString(10))
+	randPolicyNamePrefix := fmt.Sprintf("terraform-test-foobar-%s", acctest.RandString(5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -228,7 +227,7 @@ func TestAccApplicationAutoScalingPolicy_scaleOutAndIn(t *testing.T) {
 func TestAccApplicationAutoScalingPolicy_spotFleetRequest(t *testing.T) {
 	var policy applicationautoscaling.ScalingPolicy
 
-	randPolicyName := fmt.Sprintf("test-appautoscaling-policy-%s", sdkacctest.RandString(5))
+	randPolicyName := fmt.Sprintf("test-appautoscaling-policy-%s", acctest.RandString(5))
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -261,7 +260,7 @@ func TestAccApplicationAutoScalingPolicy_spotFleetRequest(t *testing.T) {
 func TestAccApplicationAutoScalingPolicy_DynamoDB_table(t *testing.T) {
 	var policy applicationautoscaling.ScalingPolicy
 
-	randPolicyName := fmt.Sprintf("test-appautoscaling-policy-%s", sdkacctest.RandString(5))
+	randPolicyName := fmt.Sprintf("test-appautoscaling-policy-%s", acctest.RandString(5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -291,7 +290,7 @@ func TestAccApplicationAutoScalingPolicy_DynamoDB_table(t *testing.T) {
 
 func TestAccApplicationAutoScalingPolicy_DynamoDB_index(t *testing.T) {
 	var policy applicationautoscaling.ScalingPolicy
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	appautoscalingTargetResourceName := "aws_appautoscaling_target.test"
 	resourceName := "aws_appautoscaling_policy.test"
 
@@ -325,9 +324,9 @@ func TestAccApplicationAutoScalingPolicy_multiplePoliciesSameName(t *testing.T)
 	var readPolicy1 applicationautoscaling.ScalingPolicy
 	var readPolicy2 applicationautoscaling.ScalingPolicy
 
-	tableName1 := fmt.Sprintf("tf-autoscaled-table-%s", sdkacctest.RandString(5))
-	tableName2 := fmt.Sprintf("tf-autoscaled-table-%s", sdkacctest.RandString(5))
-	namePrefix := fmt.Sprintf("tf-appautoscaling-policy-%s", sdkacctest.RandString(5))
+	tableName1 := fmt.Sprintf("tf-autoscaled-table-%s", acctest.RandString(5))
+	tableName2 := fmt.Sprintf("tf-autoscaled-table-%s", acctest.RandString(5))
+	namePrefix := fmt.Sprintf("tf-appautoscaling-policy-%s", acctest.RandString(5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -359,8 +358,8 @@ func TestAccApplicationAutoScalingPolicy_multiplePoliciesSameResource(t *testing
 	var readPolicy applicationautoscaling.ScalingPolicy
 	var writePolicy applicationautoscaling.ScalingPolicy
 
-	tableName := fmt.Sprintf("tf-autoscaled-table-%s", sdkacctest.RandString(5))
-	namePrefix := fmt.Sprintf("tf-appautoscaling-policy-%s", sdkacctest.RandString(5))
+	tableName := fmt.Sprintf("tf-autoscaled-table-%s", acctest.RandString(5))
+	namePrefix := fmt.Sprintf("tf-appautoscaling-policy-%s", acctest.RandString(5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -405,7 +404,7 @@ func TestAccApplicationAutoScalingPolicy_ResourceID_forceNew(t *testing.T) {
 	var policy applicationautoscaling.ScalingPolicy
 	appAutoscalingTargetResourceName := "aws_appautoscaling_target.test"
 	resourceName := "aws_appautoscaling_policy.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
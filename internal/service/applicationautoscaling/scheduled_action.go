@@ -20,6 +20,7 @@ import (
 func ResourceScheduledAction() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceScheduledActionPut,
+		//lintignore:AWSR004
 		Read:   resourceScheduledActionRead,
 		Update: resourceScheduledActionPut,
 		Delete: resourceScheduledActionDelete,
@@ -19,6 +19,7 @@ import (
 func ResourceTarget() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTargetPut,
+		//lintignore:AWSR004
 		Read:   resourceTargetRead,
 		Update: resourceTargetPut,
 		Delete: resourceTargetDelete,
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,7 +18,7 @@ import (
 func TestAccApplicationAutoScalingTarget_basic(t *testing.T) {
 	var target applicationautoscaling.ScalableTarget
 
-	randClusterName := fmt.Sprintf("cluster-%s", sdkacctest.RandString(10))
+	randClusterName := fmt.Sprintf("cluster-%s", acctest.RandString(10))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -58,7 +57,7 @@ func TestAccApplicationAutoScalingTarget_basic(t *testing.T) {
 
 func TestAccApplicationAutoScalingTarget_disappears(t *testing.T) {
 	var target applicationautoscaling.ScalableTarget
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_appautoscaling_target.bar"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -109,7 +108,7 @@ func TestAccApplicationAutoScalingTarget_spotFleetRequest(t *testing.T) {
 
 func TestAccApplicationAutoScalingTarget_emrCluster(t *testing.T) {
 	var target applicationautoscaling.ScalableTarget
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -139,7 +138,7 @@ func TestAccApplicationAutoScalingTarget_multipleTargets(t *testing.T) {
 	var writeTarget applicationautoscaling.ScalableTarget
 	var readTarget applicationautoscaling.ScalableTarget
 
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	tableName := fmt.Sprintf("tf_acc_test_table_%d", rInt)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -173,7 +172,7 @@ func TestAccApplicationAutoScalingTarget_multipleTargets(t *testing.T) {
 func TestAccApplicationAutoScalingTarget_optionalRoleARN(t *testing.T) {
 	var readTarget applicationautoscaling.ScalableTarget
 
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	tableName := fmt.Sprintf("tf_acc_test_table_%d", rInt)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appmesh"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,11 +16,11 @@ import (
 func testAccRoute_grpcRoute(t *testing.T) {
 	var r appmesh.RouteData
 	resourceName := "aws_appmesh_route.test"
-	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vrName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn1Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	meshName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vrName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn1Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn2Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
@@ -189,11 +188,11 @@ func testAccRoute_grpcRoute(t *testing.T) {
 func testAccRoute_grpcRouteTimeout(t *testing.T) {
 	var r appmesh.RouteData
 	resourceName := "aws_appmesh_route.test"
-	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vrName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn1Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	meshName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vrName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn1Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn2Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
@@ -288,11 +287,11 @@ func testAccRoute_grpcRouteTimeout(t *testing.T) {
 func testAccRoute_grpcRouteEmptyMatch(t *testing.T) {
 	var r appmesh.RouteData
 	resourceName := "aws_appmesh_route.test"
-	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vrName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn1Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	meshName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vrName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn1Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn2Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
@@ -341,11 +340,11 @@ func testAccRoute_grpcRouteEmptyMatch(t *testing.T) {
 func testAccRoute_http2Route(t *testing.T) {
 	var r appmesh.RouteData
 	resourceName := "aws_appmesh_route.test"
-	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vrName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn1Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	meshName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vrName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn1Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn2Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
@@ -458,11 +457,11 @@ func testAccRoute_http2Route(t *testing.T) {
 func testAccRoute_http2RouteTimeout(t *testing.T) {
 	var r appmesh.RouteData
 	resourceName := "aws_appmesh_route.test"
-	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vrName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn1Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	meshName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vrName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn1Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn2Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
@@ -561,11 +560,11 @@ func testAccRoute_http2RouteTimeout(t *testing.T) {
 func testAccRoute_httpRoute(t *testing.T) {
 	var r appmesh.RouteData
 	resourceName := "aws_appmesh_route.test"
-	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vrName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn1Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	meshName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vrName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn1Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn2Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
@@ -672,11 +671,11 @@ func testAccRoute_httpRoute(t *testing.T) {
 func testAccRoute_httpRouteTimeout(t *testing.T) {
 	var r appmesh.RouteData
 	resourceName := "aws_appmesh_route.test"
-	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vrName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn1Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	meshName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vrName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn1Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn2Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
@@ -767,11 +766,11 @@ func testAccRoute_httpRouteTimeout(t *testing.T) {
 func testAccRoute_tcpRoute(t *testing.T) {
 	var r appmesh.RouteData
 	resourceName := "aws_appmesh_route.test"
-	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vrName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn1Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	meshName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vrName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn1Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn2Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
@@ -861,11 +860,11 @@ func testAccRoute_tcpRoute(t *testing.T) {
 func testAccRoute_tcpRouteTimeout(t *testing.T) {
 	var r appmesh.RouteData
 	resourceName := "aws_appmesh_route.test"
-	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vrName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn1Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	meshName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vrName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn1Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn2Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
@@ -940,11 +939,11 @@ func testAccRoute_tcpRouteTimeout(t *testing.T) {
 func testAccRoute_tags(t *testing.T) {
 	var r appmesh.RouteData
 	resourceName := "aws_appmesh_route.test"
-	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vrName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn1Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	meshName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vrName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn1Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn2Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
@@ -990,11 +989,11 @@ func testAccRoute_tags(t *testing.T) {
 func testAccRoute_httpHeader(t *testing.T) {
 	var r appmesh.RouteData
 	resourceName := "aws_appmesh_route.test"
-	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vrName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn1Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	meshName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vrName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn1Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn2Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
@@ -1089,11 +1088,11 @@ func testAccRoute_httpHeader(t *testing.T) {
 func testAccRoute_routePriority(t *testing.T) {
 	var r appmesh.RouteData
 	resourceName := "aws_appmesh_route.test"
-	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vrName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn1Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	meshName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vrName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn1Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn2Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
@@ -1172,11 +1171,11 @@ func testAccRoute_routePriority(t *testing.T) {
 func testAccRoute_httpRetryPolicy(t *testing.T) {
 	var r appmesh.RouteData
 	resourceName := "aws_appmesh_route.test"
-	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vrName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn1Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vn2Name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	meshName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vrName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn1Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vn2Name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
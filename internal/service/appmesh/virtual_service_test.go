@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appmesh"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,10 +16,10 @@ import (
 func testAccVirtualService_virtualNode(t *testing.T) {
 	var vs appmesh.VirtualServiceData
 	resourceName := "aws_appmesh_virtual_service.test"
-	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vnName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vnName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vsName := fmt.Sprintf("tf-acc-test-%d.mesh.local", sdkacctest.RandInt())
+	meshName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vnName1 := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vnName2 := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vsName := fmt.Sprintf("tf-acc-test-%d.mesh.local", acctest.RandInt())
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
@@ -71,10 +70,10 @@ func testAccVirtualService_virtualNode(t *testing.T) {
 func testAccVirtualService_virtualRouter(t *testing.T) {
 	var vs appmesh.VirtualServiceData
 	resourceName := "aws_appmesh_virtual_service.test"
-	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vrName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vrName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vsName := fmt.Sprintf("tf-acc-test-%d.mesh.local", sdkacctest.RandInt())
+	meshName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vrName1 := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vrName2 := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vsName := fmt.Sprintf("tf-acc-test-%d.mesh.local", acctest.RandInt())
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
@@ -118,10 +117,10 @@ func testAccVirtualService_virtualRouter(t *testing.T) {
 func testAccVirtualService_tags(t *testing.T) {
 	var vs appmesh.VirtualServiceData
 	resourceName := "aws_appmesh_virtual_service.test"
-	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vnName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vnName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vsName := fmt.Sprintf("tf-acc-test-%d.mesh.local", sdkacctest.RandInt())
+	meshName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vnName1 := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vnName2 := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vsName := fmt.Sprintf("tf-acc-test-%d.mesh.local", acctest.RandInt())
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
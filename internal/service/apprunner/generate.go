@@ -1,4 +1,4 @@
-//go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsSlice -UpdateTags
+//go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsSlice -UpdateTags -Tests
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package apprunner
@@ -73,7 +73,7 @@ func sweepAutoScalingConfigurationVersions(region string) error {
 			d := r.Data(nil)
 			d.SetId(arn)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_apprunner_auto_scaling_configuration_version", r, d, client))
 		}
 
 		return !lastPage
@@ -129,7 +129,7 @@ func sweepConnections(region string) error {
 			d.SetId(name)
 			d.Set("arn", c.ConnectionArn)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_apprunner_connection", r, d, client))
 		}
 
 		return !lastPage
@@ -183,7 +183,7 @@ func sweepServices(region string) error {
 			d := r.Data(nil)
 			d.SetId(arn)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_apprunner_service", r, d, client))
 		}
 
 		return !lastPage
@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/appstream"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 func TestAccAppStreamImageBuilder_basic(t *testing.T) {
 	resourceName := "aws_appstream_image_builder.test"
 	instanceType := "stream.standard.small"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
@@ -48,7 +47,7 @@ func TestAccAppStreamImageBuilder_basic(t *testing.T) {
 func TestAccAppStreamImageBuilder_disappears(t *testing.T) {
 	resourceName := "aws_appstream_image_builder.test"
 	instanceType := "stream.standard.medium"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
@@ -70,7 +69,7 @@ func TestAccAppStreamImageBuilder_disappears(t *testing.T) {
 
 func TestAccAppStreamImageBuilder_complete(t *testing.T) {
 	resourceName := "aws_appstream_image_builder.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	description := "Description of a test"
 	descriptionUpdated := "Updated Description of a test"
 	instanceType := "stream.standard.small"
@@ -122,7 +121,7 @@ func TestAccAppStreamImageBuilder_complete(t *testing.T) {
 
 func TestAccAppStreamImageBuilder_tags(t *testing.T) {
 	resourceName := "aws_appstream_image_builder.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	instanceType := "stream.standard.small"
 
 	resource.ParallelTest(t, resource.TestCase{
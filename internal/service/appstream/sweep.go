@@ -70,7 +70,7 @@ func sweepFleet(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_appstream_image_builder", r, d, client))
 		}
 
 		return !lastPage
@@ -120,7 +120,7 @@ func sweepImageBuilder(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_appstream_image_builder", r, d, client))
 		}
 
 		return !lastPage
@@ -171,7 +171,7 @@ func sweepStack(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_appstream_image_builder", r, d, client))
 		}
 
 		return !lastPage
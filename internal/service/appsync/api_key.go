@@ -18,6 +18,7 @@ func ResourceAPIKey() *schema.Resource {
 
 	return &schema.Resource{
 		Create: resourceAPIKeyCreate,
+		//lintignore:AWSR004
 		Read:   resourceAPIKeyRead,
 		Update: resourceAPIKeyUpdate,
 		Delete: resourceAPIKeyDelete,
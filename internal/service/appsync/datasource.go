@@ -17,6 +17,7 @@ import (
 func ResourceDataSource() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDataSourceCreate,
+		//lintignore:AWSR004
 		Read:   resourceDataSourceRead,
 		Update: resourceDataSourceUpdate,
 		Delete: resourceDataSourceDelete,
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appsync"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 )
 
 func TestAccAppSyncDataSource_basic(t *testing.T) {
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt())
 	resourceName := "aws_appsync_datasource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -50,7 +49,7 @@ func TestAccAppSyncDataSource_basic(t *testing.T) {
 }
 
 func TestAccAppSyncDataSource_description(t *testing.T) {
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt())
 	resourceName := "aws_appsync_datasource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -83,7 +82,7 @@ func TestAccAppSyncDataSource_description(t *testing.T) {
 }
 
 func TestAccAppSyncDataSource_DynamoDB_region(t *testing.T) {
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt())
 	resourceName := "aws_appsync_datasource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -118,7 +117,7 @@ func TestAccAppSyncDataSource_DynamoDB_region(t *testing.T) {
 }
 
 func TestAccAppSyncDataSource_DynamoDB_useCallerCredentials(t *testing.T) {
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt())
 	resourceName := "aws_appsync_datasource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -153,7 +152,7 @@ func TestAccAppSyncDataSource_DynamoDB_useCallerCredentials(t *testing.T) {
 }
 
 func TestAccAppSyncDataSource_ElasticSearch_region(t *testing.T) {
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt())
 	resourceName := "aws_appsync_datasource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -188,7 +187,7 @@ func TestAccAppSyncDataSource_ElasticSearch_region(t *testing.T) {
 }
 
 func TestAccAppSyncDataSource_HTTP_endpoint(t *testing.T) {
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt())
 	resourceName := "aws_appsync_datasource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -225,7 +224,7 @@ func TestAccAppSyncDataSource_HTTP_endpoint(t *testing.T) {
 }
 
 func TestAccAppSyncDataSource_type(t *testing.T) {
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt())
 	resourceName := "aws_appsync_datasource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -253,7 +252,7 @@ func TestAccAppSyncDataSource_type(t *testing.T) {
 }
 
 func TestAccAppSyncDataSource_Type_dynamoDB(t *testing.T) {
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt())
 	dynamodbTableResourceName := "aws_dynamodb_table.test"
 	iamRoleResourceName := "aws_iam_role.test"
 	resourceName := "aws_appsync_datasource.test"
@@ -285,7 +284,7 @@ func TestAccAppSyncDataSource_Type_dynamoDB(t *testing.T) {
 }
 
 func TestAccAppSyncDataSource_Type_elasticSearch(t *testing.T) {
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt())
 	iamRoleResourceName := "aws_iam_role.test"
 	resourceName := "aws_appsync_datasource.test"
 
@@ -316,7 +315,7 @@ func TestAccAppSyncDataSource_Type_elasticSearch(t *testing.T) {
 }
 
 func TestAccAppSyncDataSource_Type_http(t *testing.T) {
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt())
 	resourceName := "aws_appsync_datasource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -344,7 +343,7 @@ func TestAccAppSyncDataSource_Type_http(t *testing.T) {
 }
 
 func TestAccAppSyncDataSource_Type_lambda(t *testing.T) {
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt())
 	iamRoleResourceName := "aws_iam_role.test"
 	lambdaFunctionResourceName := "aws_lambda_function.test"
 	resourceName := "aws_appsync_datasource.test"
@@ -375,7 +374,7 @@ func TestAccAppSyncDataSource_Type_lambda(t *testing.T) {
 }
 
 func TestAccAppSyncDataSource_Type_none(t *testing.T) {
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt())
 	resourceName := "aws_appsync_datasource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
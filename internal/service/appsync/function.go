@@ -17,6 +17,7 @@ import (
 func ResourceFunction() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceFunctionCreate,
+		//lintignore:AWSR004
 		Read:   resourceFunctionRead,
 		Update: resourceFunctionUpdate,
 		Delete: resourceFunctionDelete,
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appsync"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,9 +16,9 @@ import (
 )
 
 func TestAccAppSyncFunction_basic(t *testing.T) {
-	rName1 := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
-	rName2 := fmt.Sprintf("tfexample%s", sdkacctest.RandString(8))
-	rName3 := fmt.Sprintf("tfexample%s", sdkacctest.RandString(8))
+	rName1 := fmt.Sprintf("tfacctest%d", acctest.RandInt())
+	rName2 := fmt.Sprintf("tfexample%s", acctest.RandString(8))
+	rName3 := fmt.Sprintf("tfexample%s", acctest.RandString(8))
 	resourceName := "aws_appsync_function.test"
 	var config appsync.FunctionConfiguration
 
@@ -57,8 +56,8 @@ func TestAccAppSyncFunction_basic(t *testing.T) {
 }
 
 func TestAccAppSyncFunction_description(t *testing.T) {
-	rName1 := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
-	rName2 := fmt.Sprintf("tfexample%s", sdkacctest.RandString(8))
+	rName1 := fmt.Sprintf("tfacctest%d", acctest.RandInt())
+	rName2 := fmt.Sprintf("tfexample%s", acctest.RandString(8))
 	resourceName := "aws_appsync_function.test"
 	var config appsync.FunctionConfiguration
 
@@ -92,8 +91,8 @@ func TestAccAppSyncFunction_description(t *testing.T) {
 }
 
 func TestAccAppSyncFunction_responseMappingTemplate(t *testing.T) {
-	rName1 := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
-	rName2 := fmt.Sprintf("tfexample%s", sdkacctest.RandString(8))
+	rName1 := fmt.Sprintf("tfacctest%d", acctest.RandInt())
+	rName2 := fmt.Sprintf("tfexample%s", acctest.RandString(8))
 	resourceName := "aws_appsync_function.test"
 	var config appsync.FunctionConfiguration
 
@@ -119,8 +118,8 @@ func TestAccAppSyncFunction_responseMappingTemplate(t *testing.T) {
 }
 
 func TestAccAppSyncFunction_disappears(t *testing.T) {
-	rName1 := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
-	rName2 := fmt.Sprintf("tfexample%s", sdkacctest.RandString(8))
+	rName1 := fmt.Sprintf("tfacctest%d", acctest.RandInt())
+	rName2 := fmt.Sprintf("tfexample%s", acctest.RandString(8))
 	resourceName := "aws_appsync_function.test"
 	var config appsync.FunctionConfiguration
 
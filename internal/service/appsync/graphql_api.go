@@ -19,6 +19,7 @@ import (
 func ResourceGraphQLAPI() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceGraphQLAPICreate,
+		//lintignore:AWSR004
 		Read:   resourceGraphQLAPIRead,
 		Update: resourceGraphQLAPIUpdate,
 		Delete: resourceGraphQLAPIDelete,
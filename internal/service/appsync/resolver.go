@@ -18,6 +18,7 @@ import (
 func ResourceResolver() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceResolverCreate,
+		//lintignore:AWSR004
 		Read:   resourceResolverRead,
 		Update: resourceResolverUpdate,
 		Delete: resourceResolverDelete,
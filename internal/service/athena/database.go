@@ -17,6 +17,7 @@ import (
 func ResourceDatabase() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDatabaseCreate,
+		//lintignore:AWSR004
 		Read:   resourceDatabaseRead,
 		Update: resourceDatabaseUpdate,
 		Delete: resourceDatabaseDelete,
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/athena"
 	"github.com/aws/aws-sdk-go/service/s3"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,8 +16,8 @@ import (
 )
 
 func TestAccAthenaDatabase_basic(t *testing.T) {
-	rInt := sdkacctest.RandInt()
-	dbName := sdkacctest.RandString(8)
+	rInt := acctest.RandInt()
+	dbName := acctest.RandString(8)
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
 		ErrorCheck:   acctest.ErrorCheck(t, athena.EndpointsID),
@@ -36,8 +35,8 @@ func TestAccAthenaDatabase_basic(t *testing.T) {
 }
 
 func TestAccAthenaDatabase_encryption(t *testing.T) {
-	rInt := sdkacctest.RandInt()
-	dbName := sdkacctest.RandString(8)
+	rInt := acctest.RandInt()
+	dbName := acctest.RandString(8)
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
 		ErrorCheck:   acctest.ErrorCheck(t, athena.EndpointsID),
@@ -56,8 +55,8 @@ func TestAccAthenaDatabase_encryption(t *testing.T) {
 }
 
 func TestAccAthenaDatabase_nameStartsWithUnderscore(t *testing.T) {
-	rInt := sdkacctest.RandInt()
-	dbName := "_" + sdkacctest.RandString(8)
+	rInt := acctest.RandInt()
+	dbName := "_" + acctest.RandString(8)
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
 		ErrorCheck:   acctest.ErrorCheck(t, athena.EndpointsID),
@@ -76,8 +75,8 @@ func TestAccAthenaDatabase_nameStartsWithUnderscore(t *testing.T) {
 }
 
 func TestAccAthenaDatabase_nameCantHaveUppercase(t *testing.T) {
-	rInt := sdkacctest.RandInt()
-	dbName := "A" + sdkacctest.RandString(8)
+	rInt := acctest.RandInt()
+	dbName := "A" + acctest.RandString(8)
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
 		ErrorCheck:   acctest.ErrorCheck(t, athena.EndpointsID),
@@ -93,8 +92,8 @@ func TestAccAthenaDatabase_nameCantHaveUppercase(t *testing.T) {
 }
 
 func TestAccAthenaDatabase_destroyFailsIfTablesExist(t *testing.T) {
-	rInt := sdkacctest.RandInt()
-	dbName := sdkacctest.RandString(8)
+	rInt := acctest.RandInt()
+	dbName := acctest.RandString(8)
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
 		ErrorCheck:   acctest.ErrorCheck(t, athena.EndpointsID),
@@ -115,8 +114,8 @@ func TestAccAthenaDatabase_destroyFailsIfTablesExist(t *testing.T) {
 }
 
 func TestAccAthenaDatabase_forceDestroyAlwaysSucceeds(t *testing.T) {
-	rInt := sdkacctest.RandInt()
-	dbName := sdkacctest.RandString(8)
+	rInt := acctest.RandInt()
+	dbName := acctest.RandString(8)
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
 		ErrorCheck:   acctest.ErrorCheck(t, athena.EndpointsID),
@@ -144,7 +143,7 @@ func testAccCheckDatabaseDestroy(s *terraform.State) error {
 			continue
 		}
 
-		rInt := sdkacctest.RandInt()
+		rInt := acctest.RandInt()
 		bucketName := fmt.Sprintf("tf-test-athena-db-%d", rInt)
 		_, err := s3conn.CreateBucket(&s3.CreateBucketInput{
 			Bucket: aws.String(bucketName),
@@ -13,6 +13,7 @@ import (
 func ResourceNamedQuery() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNamedQueryCreate,
+		//lintignore:AWSR004
 		Read:   resourceNamedQueryRead,
 		Delete: resourceNamedQueryDelete,
 
@@ -19,6 +19,7 @@ import (
 func ResourceWorkGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceWorkGroupCreate,
+		//lintignore:AWSR004
 		Read:   resourceWorkGroupRead,
 		Update: resourceWorkGroupUpdate,
 		Delete: resourceWorkGroupDelete,
@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/athena"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 
 func TestAccAthenaWorkGroup_basic(t *testing.T) {
 	var workgroup1 athena.WorkGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_athena_workgroup.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -52,7 +51,7 @@ func TestAccAthenaWorkGroup_basic(t *testing.T) {
 
 func TestAccAthenaWorkGroup_disappears(t *testing.T) {
 	var workgroup1 athena.WorkGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_athena_workgroup.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -75,7 +74,7 @@ func TestAccAthenaWorkGroup_disappears(t *testing.T) {
 
 func TestAccAthenaWorkGroup_bytesScannedCutoffPerQuery(t *testing.T) {
 	var workgroup1, workgroup2 athena.WorkGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_athena_workgroup.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -112,7 +111,7 @@ func TestAccAthenaWorkGroup_bytesScannedCutoffPerQuery(t *testing.T) {
 
 func TestAccAthenaWorkGroup_enforceWorkGroup(t *testing.T) {
 	var workgroup1, workgroup2 athena.WorkGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_athena_workgroup.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -149,7 +148,7 @@ func TestAccAthenaWorkGroup_enforceWorkGroup(t *testing.T) {
 
 func TestAccAthenaWorkGroup_publishCloudWatchMetricsEnabled(t *testing.T) {
 	var workgroup1, workgroup2 athena.WorkGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_athena_workgroup.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -186,7 +185,7 @@ func TestAccAthenaWorkGroup_publishCloudWatchMetricsEnabled(t *testing.T) {
 
 func TestAccAthenaWorkGroup_ResultEncryption_sseS3(t *testing.T) {
 	var workgroup1 athena.WorkGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_athena_workgroup.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -217,7 +216,7 @@ func TestAccAthenaWorkGroup_ResultEncryption_sseS3(t *testing.T) {
 
 func TestAccAthenaWorkGroup_ResultEncryption_kms(t *testing.T) {
 	var workgroup1, workgroup2 athena.WorkGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_athena_workgroup.test"
 	rEncryption := athena.EncryptionOptionSseKms
 	rEncryption2 := athena.EncryptionOptionCseKms
@@ -260,7 +259,7 @@ func TestAccAthenaWorkGroup_ResultEncryption_kms(t *testing.T) {
 
 func TestAccAthenaWorkGroup_Result_outputLocation(t *testing.T) {
 	var workgroup1, workgroup2 athena.WorkGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_athena_workgroup.test"
 	rOutputLocation1 := fmt.Sprintf("%s-1", rName)
 	rOutputLocation2 := fmt.Sprintf("%s-2", rName)
@@ -301,7 +300,7 @@ func TestAccAthenaWorkGroup_Result_outputLocation(t *testing.T) {
 
 func TestAccAthenaWorkGroup_requesterPaysEnabled(t *testing.T) {
 	var workgroup1 athena.WorkGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_athena_workgroup.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -340,7 +339,7 @@ func TestAccAthenaWorkGroup_requesterPaysEnabled(t *testing.T) {
 
 func TestAccAthenaWorkGroup_ResultOutputLocation_forceDestroy(t *testing.T) {
 	var workgroup1, workgroup2 athena.WorkGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_athena_workgroup.test"
 	rOutputLocation1 := fmt.Sprintf("%s-1", rName)
 	rOutputLocation2 := fmt.Sprintf("%s-2", rName)
@@ -381,10 +380,10 @@ func TestAccAthenaWorkGroup_ResultOutputLocation_forceDestroy(t *testing.T) {
 
 func TestAccAthenaWorkGroup_description(t *testing.T) {
 	var workgroup1, workgroup2 athena.WorkGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_athena_workgroup.test"
-	rDescription := sdkacctest.RandString(20)
-	rDescriptionUpdate := sdkacctest.RandString(20)
+	rDescription := acctest.RandString(20)
+	rDescriptionUpdate := acctest.RandString(20)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -418,7 +417,7 @@ func TestAccAthenaWorkGroup_description(t *testing.T) {
 
 func TestAccAthenaWorkGroup_state(t *testing.T) {
 	var workgroup1, workgroup2, workgroup3 athena.WorkGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_athena_workgroup.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -460,10 +459,10 @@ func TestAccAthenaWorkGroup_state(t *testing.T) {
 
 func TestAccAthenaWorkGroup_forceDestroy(t *testing.T) {
 	var workgroup athena.WorkGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	dbName := sdkacctest.RandString(5)
-	queryName1 := sdkacctest.RandomWithPrefix("tf-athena-named-query-")
-	queryName2 := sdkacctest.RandomWithPrefix("tf-athena-named-query-")
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dbName := acctest.RandString(5)
+	queryName1 := acctest.RandomWithPrefix("tf-athena-named-query-")
+	queryName2 := acctest.RandomWithPrefix("tf-athena-named-query-")
 	resourceName := "aws_athena_workgroup.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -492,7 +491,7 @@ func TestAccAthenaWorkGroup_forceDestroy(t *testing.T) {
 
 func TestAccAthenaWorkGroup_tags(t *testing.T) {
 	var workgroup1, workgroup2, workgroup3 athena.WorkGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_athena_workgroup.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -15,7 +14,7 @@ import (
 
 func TestAccAutoScalingAttachment_elb(t *testing.T) {
 
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -59,7 +58,7 @@ func TestAccAutoScalingAttachment_elb(t *testing.T) {
 
 func TestAccAutoScalingAttachment_albTargetGroup(t *testing.T) {
 
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -2,10 +2,10 @@ package autoscaling
 
 import (
 	"fmt"
-	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
 )
 
 func flattenASGEnabledMetrics(list []*autoscaling.EnabledMetric) []string {
@@ -34,45 +34,23 @@ func ExpandStepAdjustments(configured []interface{}) ([]*autoscaling.StepAdjustm
 	var adjustments []*autoscaling.StepAdjustment
 
 	// Loop over our configured step adjustments and create an array
-	// of aws-sdk-go compatible objects. We're forced to convert strings
-	// to floats here because there's no way to detect whether or not
-	// an uninitialized, optional schema element is "0.0" deliberately.
-	// With strings, we can test for "", which is definitely an empty
-	// struct value.
+	// of aws-sdk-go compatible objects. The bounds are nullable floats
+	// as there's no way to detect whether or not an uninitialized,
+	// optional TypeFloat schema element is "0.0" deliberately.
 	for _, raw := range configured {
 		data := raw.(map[string]interface{})
 		a := &autoscaling.StepAdjustment{
 			ScalingAdjustment: aws.Int64(int64(data["scaling_adjustment"].(int))),
 		}
-		if data["metric_interval_lower_bound"] != "" {
-			bound := data["metric_interval_lower_bound"]
-			switch bound := bound.(type) {
-			case string:
-				f, err := strconv.ParseFloat(bound, 64)
-				if err != nil {
-					return nil, fmt.Errorf(
-						"metric_interval_lower_bound must be a float value represented as a string")
-				}
-				a.MetricIntervalLowerBound = aws.Float64(f)
-			default:
-				return nil, fmt.Errorf(
-					"metric_interval_lower_bound isn't a string. This is a bug. Please file an issue.")
-			}
+		if v, null, err := nullable.Float(data["metric_interval_lower_bound"].(string)).Value(); err != nil {
+			return nil, fmt.Errorf("metric_interval_lower_bound must be a float value represented as a string: %w", err)
+		} else if !null {
+			a.MetricIntervalLowerBound = aws.Float64(v)
 		}
-		if data["metric_interval_upper_bound"] != "" {
-			bound := data["metric_interval_upper_bound"]
-			switch bound := bound.(type) {
-			case string:
-				f, err := strconv.ParseFloat(bound, 64)
-				if err != nil {
-					return nil, fmt.Errorf(
-						"metric_interval_upper_bound must be a float value represented as a string")
-				}
-				a.MetricIntervalUpperBound = aws.Float64(f)
-			default:
-				return nil, fmt.Errorf(
-					"metric_interval_upper_bound isn't a string. This is a bug. Please file an issue.")
-			}
+		if v, null, err := nullable.Float(data["metric_interval_upper_bound"].(string)).Value(); err != nil {
+			return nil, fmt.Errorf("metric_interval_upper_bound must be a float value represented as a string: %w", err)
+		} else if !null {
+			a.MetricIntervalUpperBound = aws.Float64(v)
 		}
 		adjustments = append(adjustments, a)
 	}
@@ -56,6 +56,32 @@ func TestExpandStepAdjustments(t *testing.T) {
 	}
 }
 
+func TestExpandStepAdjustments_zeroAndNullBounds(t *testing.T) {
+	expanded := []interface{}{
+		map[string]interface{}{
+			"metric_interval_lower_bound": "0",
+			"metric_interval_upper_bound": "",
+			"scaling_adjustment":          1,
+		},
+	}
+	parameters, err := ExpandStepAdjustments(expanded)
+	if err != nil {
+		t.Fatalf("bad: %#v", err)
+	}
+
+	expected := &autoscaling.StepAdjustment{
+		MetricIntervalLowerBound: aws.Float64(0),
+		ScalingAdjustment:        aws.Int64(int64(1)),
+	}
+
+	if !reflect.DeepEqual(parameters[0], expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			parameters[0],
+			expected)
+	}
+}
+
 func TestFlattenStepAdjustments(t *testing.T) {
 	expanded := []*autoscaling.StepAdjustment{
 		{
@@ -32,8 +32,10 @@ import (
 )
 
 func ResourceGroup() *schema.Resource {
+	//lintignore:AWSR006 // tags is the legacy list of tag blocks, not a key-value map
 	return &schema.Resource{
 		Create: resourceGroupCreate,
+		//lintignore:AWSR004
 		Read:   resourceGroupRead,
 		Update: resourceGroupUpdate,
 		Delete: resourceGroupDelete,
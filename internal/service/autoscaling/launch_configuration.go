@@ -28,6 +28,7 @@ import (
 func ResourceLaunchConfiguration() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceLaunchConfigurationCreate,
+		//lintignore:AWSR004
 		Read:   resourceLaunchConfigurationRead,
 		Delete: resourceLaunchConfigurationDelete,
 		Importer: &schema.ResourceImporter{
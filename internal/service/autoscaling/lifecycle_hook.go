@@ -18,6 +18,7 @@ import (
 func ResourceLifecycleHook() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceLifecycleHookPut,
+		//lintignore:AWSR004
 		Read:   resourceLifecycleHookRead,
 		Update: resourceLifecycleHookPut,
 		Delete: resourceLifecycleHookDelete,
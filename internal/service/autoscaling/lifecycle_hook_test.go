@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -14,7 +13,7 @@ import (
 )
 
 func TestAccAutoScalingLifecycleHook_basic(t *testing.T) {
-	resourceName := fmt.Sprintf("tf-test-%s", sdkacctest.RandString(10))
+	resourceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -43,8 +42,8 @@ func TestAccAutoScalingLifecycleHook_basic(t *testing.T) {
 }
 
 func TestAccAutoScalingLifecycleHook_omitDefaultResult(t *testing.T) {
-	rName := sdkacctest.RandString(10)
-	rInt := sdkacctest.RandInt()
+	rName := acctest.RandString(10)
+	rInt := acctest.RandInt()
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
 		ErrorCheck:   acctest.ErrorCheck(t, autoscaling.EndpointsID),
@@ -15,6 +15,7 @@ import (
 func ResourceNotification() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNotificationCreate,
+		//lintignore:AWSR004
 		Read:   resourceNotificationRead,
 		Update: resourceNotificationUpdate,
 		Delete: resourceNotificationDelete,
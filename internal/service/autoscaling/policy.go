@@ -20,6 +20,7 @@ import (
 func ResourcePolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePolicyCreate,
+		//lintignore:AWSR004
 		Read:   resourcePolicyRead,
 		Update: resourcePolicyUpdate,
 		Delete: resourcePolicyDelete,
@@ -206,12 +207,14 @@ func ResourcePolicy() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"metric_interval_lower_bound": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         nullable.TypeNullableFloat,
+							Optional:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableFloat,
 						},
 						"metric_interval_upper_bound": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         nullable.TypeNullableFloat,
+							Optional:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableFloat,
 						},
 						"scaling_adjustment": {
 							Type:     schema.TypeInt,
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -22,7 +21,7 @@ func TestAccAutoScalingPolicy_basic(t *testing.T) {
 	resourceStepName := "aws_autoscaling_policy.foobar_step"
 	resourceTargetTrackingName := "aws_autoscaling_policy.foobar_target_tracking"
 
-	name := fmt.Sprintf("terraform-testacc-asp-%s", sdkacctest.RandString(5))
+	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -110,7 +109,7 @@ func TestAccAutoScalingPolicy_predictiveScaling(t *testing.T) {
 
 	resourceSimpleName := "aws_autoscaling_policy.test"
 
-	name := sdkacctest.RandomWithPrefix("terraform-testacc-asp")
+	name := acctest.RandomWithPrefix("terraform-testacc-asp")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -148,7 +147,7 @@ func TestAccAutoScalingPolicy_predictiveScalingRemoved(t *testing.T) {
 
 	resourceSimpleName := "aws_autoscaling_policy.test"
 
-	name := sdkacctest.RandomWithPrefix("terraform-testacc-asp")
+	name := acctest.RandomWithPrefix("terraform-testacc-asp")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -185,7 +184,7 @@ func TestAccAutoScalingPolicy_predictiveScalingUpdated(t *testing.T) {
 
 	resourceSimpleName := "aws_autoscaling_policy.test"
 
-	name := sdkacctest.RandomWithPrefix("terraform-testacc-asp")
+	name := acctest.RandomWithPrefix("terraform-testacc-asp")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -238,7 +237,7 @@ func TestAccAutoScalingPolicy_disappears(t *testing.T) {
 
 	resourceName := "aws_autoscaling_policy.foobar_simple"
 
-	name := fmt.Sprintf("terraform-testacc-asp-%s", sdkacctest.RandString(5))
+	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -300,7 +299,7 @@ func TestAccAutoScalingPolicy_simpleScalingStepAdjustment(t *testing.T) {
 
 	resourceName := "aws_autoscaling_policy.foobar_simple"
 
-	name := fmt.Sprintf("terraform-testacc-asp-%s", sdkacctest.RandString(5))
+	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -329,7 +328,7 @@ func TestAccAutoScalingPolicy_simpleScalingStepAdjustment(t *testing.T) {
 func TestAccAutoScalingPolicy_TargetTrack_predefined(t *testing.T) {
 	var policy autoscaling.ScalingPolicy
 
-	name := fmt.Sprintf("terraform-testacc-asp-%s", sdkacctest.RandString(5))
+	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -356,7 +355,7 @@ func TestAccAutoScalingPolicy_TargetTrack_predefined(t *testing.T) {
 func TestAccAutoScalingPolicy_TargetTrack_custom(t *testing.T) {
 	var policy autoscaling.ScalingPolicy
 
-	name := fmt.Sprintf("terraform-testacc-asp-%s", sdkacctest.RandString(5))
+	name := fmt.Sprintf("terraform-testacc-asp-%s", acctest.RandString(5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -394,7 +393,7 @@ func TestAccAutoScalingPolicy_zeroValue(t *testing.T) {
 		CheckDestroy: testAccCheckPolicyDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccPolicyConfig_zerovalue(sdkacctest.RandString(5)),
+				Config: testAccPolicyConfig_zerovalue(acctest.RandString(5)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckScalingPolicyExists(resourceSimpleName, &simplepolicy),
 					testAccCheckScalingPolicyExists(resourceStepName, &steppolicy),
@@ -18,6 +18,7 @@ const ScheduleTimeLayout = "2006-01-02T15:04:05Z"
 func ResourceSchedule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceScheduleCreate,
+		//lintignore:AWSR004
 		Read:   resourceScheduleRead,
 		Update: resourceScheduleCreate,
 		Delete: resourceScheduleDelete,
@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func TestAccAutoScalingSchedule_basic(t *testing.T) {
 	var schedule autoscaling.ScheduledUpdateGroupAction
-	rName := fmt.Sprintf("tf-test-%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tf-test-%d", acctest.RandInt())
 	start := testAccScheduleValidStart(t)
 	end := testAccScheduleValidEnd(t)
 
@@ -57,7 +56,7 @@ func TestAccAutoScalingSchedule_basic(t *testing.T) {
 
 func TestAccAutoScalingSchedule_disappears(t *testing.T) {
 	var schedule autoscaling.ScheduledUpdateGroupAction
-	rName := fmt.Sprintf("tf-test-%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tf-test-%d", acctest.RandInt())
 	start := testAccScheduleValidStart(t)
 	end := testAccScheduleValidEnd(t)
 
@@ -94,7 +93,7 @@ func testAccCheckScalingScheduleDisappears(schedule *autoscaling.ScheduledUpdate
 func TestAccAutoScalingSchedule_recurrence(t *testing.T) {
 	var schedule autoscaling.ScheduledUpdateGroupAction
 
-	rName := fmt.Sprintf("tf-test-%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tf-test-%d", acctest.RandInt())
 
 	scheduledActionName := "foobar"
 	resourceName := fmt.Sprintf("aws_autoscaling_schedule.%s", scheduledActionName)
@@ -126,7 +125,7 @@ func TestAccAutoScalingSchedule_recurrence(t *testing.T) {
 func TestAccAutoScalingSchedule_zeroValues(t *testing.T) {
 	var schedule autoscaling.ScheduledUpdateGroupAction
 
-	rName := fmt.Sprintf("tf-test-%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tf-test-%d", acctest.RandInt())
 	start := testAccScheduleValidStart(t)
 	end := testAccScheduleValidEnd(t)
 
@@ -159,7 +158,7 @@ func TestAccAutoScalingSchedule_zeroValues(t *testing.T) {
 func TestAccAutoScalingSchedule_negativeOne(t *testing.T) {
 	var schedule autoscaling.ScheduledUpdateGroupAction
 
-	rName := fmt.Sprintf("tf-test-%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tf-test-%d", acctest.RandInt())
 	start := testAccScheduleValidStart(t)
 	end := testAccScheduleValidEnd(t)
 
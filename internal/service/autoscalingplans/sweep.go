@@ -45,7 +45,7 @@ func sweepScalingPlans(region string) error {
 			d.Set("name", scalingPlanName)
 			d.Set("scaling_plan_version", scalingPlanVersion)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_autoscalingplans_scaling_plan", r, d, client))
 		}
 
 		return !lastPage
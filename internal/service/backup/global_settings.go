@@ -14,6 +14,7 @@ func ResourceGlobalSettings() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceGlobalSettingsUpdate,
 		Update: resourceGlobalSettingsUpdate,
+		//lintignore:AWSR004
 		Read:   resourceGlobalSettingsRead,
 		Delete: schema.Noop,
 		Importer: &schema.ResourceImporter{
@@ -24,6 +24,7 @@ import (
 func ResourcePlan() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePlanCreate,
+		//lintignore:AWSR004
 		Read:   resourcePlanRead,
 		Update: resourcePlanUpdate,
 		Delete: resourcePlanDelete,
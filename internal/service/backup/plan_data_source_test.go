@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/backup"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
@@ -14,7 +13,7 @@ import (
 func TestAccBackupPlanDataSource_basic(t *testing.T) {
 	datasourceName := "data.aws_backup_plan.test"
 	resourceName := "aws_backup_plan.test"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
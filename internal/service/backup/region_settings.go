@@ -13,6 +13,7 @@ func ResourceRegionSettings() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRegionSettingsUpdate,
 		Update: resourceRegionSettingsUpdate,
+		//lintignore:AWSR004
 		Read:   resourceRegionSettingsRead,
 		Delete: schema.Noop,
 		Importer: &schema.ResourceImporter{
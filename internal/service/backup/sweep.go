@@ -63,7 +63,7 @@ func sweepVaultNotifications(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(vault.BackupVaultName))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_backup_vault_notifications", r, d, client))
 		}
 
 		return !lastPage
@@ -105,7 +105,7 @@ func sweepVaultPolicies(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(vault.BackupVaultName))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_backup_vault_policy", r, d, client))
 		}
 
 		return !lastPage
@@ -194,7 +194,7 @@ func sweepVaults(region string) error {
 			d := r.Data(nil)
 			d.SetId(name)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_backup_vault", r, d, client))
 		}
 
 		return !lastPage
@@ -18,6 +18,7 @@ import (
 func ResourceVault() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceVaultCreate,
+		//lintignore:AWSR004
 		Read:   resourceVaultRead,
 		Update: resourceVaultUpdate,
 		Delete: resourceVaultDelete,
@@ -18,6 +18,7 @@ import (
 func ResourceVaultNotifications() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceVaultNotificationsCreate,
+		//lintignore:AWSR004
 		Read:   resourceVaultNotificationsRead,
 		Delete: resourceVaultNotificationsDelete,
 		Importer: &schema.ResourceImporter{
@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/backup"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 func TestAccBackupVault_basic(t *testing.T) {
 	var vault backup.DescribeBackupVaultOutput
 
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_backup_vault.test"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
@@ -43,7 +42,7 @@ func TestAccBackupVault_basic(t *testing.T) {
 func TestAccBackupVault_withKMSKey(t *testing.T) {
 	var vault backup.DescribeBackupVaultOutput
 
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_backup_vault.test"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
@@ -70,7 +69,7 @@ func TestAccBackupVault_withKMSKey(t *testing.T) {
 func TestAccBackupVault_withTags(t *testing.T) {
 	var vault backup.DescribeBackupVaultOutput
 
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_backup_vault.test"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
@@ -119,7 +118,7 @@ func TestAccBackupVault_withTags(t *testing.T) {
 func TestAccBackupVault_disappears(t *testing.T) {
 	var vault backup.DescribeBackupVaultOutput
 
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_backup_vault.test"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
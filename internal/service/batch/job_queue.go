@@ -19,6 +19,7 @@ import (
 func ResourceJobQueue() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceJobQueueCreate,
+		//lintignore:AWSR004
 		Read:   resourceJobQueueRead,
 		Update: resourceJobQueueUpdate,
 		Delete: resourceJobQueueDelete,
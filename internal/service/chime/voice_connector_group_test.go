@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/chime"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 func TestAccChimeVoiceConnectorGroup_basic(t *testing.T) {
 	var voiceConnectorGroup *chime.VoiceConnectorGroup
 
-	vcgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vcgName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_chime_voice_connector_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -47,7 +46,7 @@ func TestAccChimeVoiceConnectorGroup_basic(t *testing.T) {
 func TestAccChimeVoiceConnectorGroup_disappears(t *testing.T) {
 	var voiceConnectorGroup *chime.VoiceConnectorGroup
 
-	vcgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vcgName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_chime_voice_connector_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -71,7 +70,7 @@ func TestAccChimeVoiceConnectorGroup_disappears(t *testing.T) {
 func TestAccChimeVoiceConnectorGroup_update(t *testing.T) {
 	var voiceConnectorGroup *chime.VoiceConnectorGroup
 
-	vcgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vcgName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_chime_voice_connector_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
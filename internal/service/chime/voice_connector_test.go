@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/chime"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 func TestAccChimeVoiceConnector_basic(t *testing.T) {
 	var voiceConnector *chime.VoiceConnector
 
-	vcName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vcName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_chime_voice_connector.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -47,7 +46,7 @@ func TestAccChimeVoiceConnector_basic(t *testing.T) {
 func TestAccChimeVoiceConnector_disappears(t *testing.T) {
 	var voiceConnector *chime.VoiceConnector
 
-	vcName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vcName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_chime_voice_connector.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -71,7 +70,7 @@ func TestAccChimeVoiceConnector_disappears(t *testing.T) {
 func TestAccChimeVoiceConnector_update(t *testing.T) {
 	var voiceConnector *chime.VoiceConnector
 
-	vcName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vcName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_chime_voice_connector.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -20,6 +20,7 @@ import (
 func ResourceEnvironmentEC2() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceEnvironmentEC2Create,
+		//lintignore:AWSR004
 		Read:   resourceEnvironmentEC2Read,
 		Update: resourceEnvironmentEC2Update,
 		Delete: resourceEnvironmentEC2Delete,
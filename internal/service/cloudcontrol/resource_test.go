@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -28,7 +27,7 @@ func testAccErrorCheckSkipCloudControlAPI(t *testing.T) resource.ErrorCheckFunc
 }
 
 func TestAccCloudControlResource_basic(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudcontrolapi_resource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -49,7 +48,7 @@ func TestAccCloudControlResource_basic(t *testing.T) {
 }
 
 func TestAccCloudControlResource_disappears(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudcontrolapi_resource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -70,7 +69,7 @@ func TestAccCloudControlResource_disappears(t *testing.T) {
 }
 
 func TestAccCloudControlResource_DesiredState_booleanValueAdded(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudcontrolapi_resource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -96,7 +95,7 @@ func TestAccCloudControlResource_DesiredState_booleanValueAdded(t *testing.T) {
 }
 
 func TestAccCloudControlResource_DesiredState_booleanValueRemoved(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudcontrolapi_resource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -122,7 +121,7 @@ func TestAccCloudControlResource_DesiredState_booleanValueRemoved(t *testing.T)
 }
 
 func TestAccCloudControlResource_DesiredState_booleanValueUpdate(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudcontrolapi_resource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -148,8 +147,8 @@ func TestAccCloudControlResource_DesiredState_booleanValueUpdate(t *testing.T) {
 }
 
 func TestAccCloudControlResource_DesiredState_createOnly(t *testing.T) {
-	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName1 := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudcontrolapi_resource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -175,7 +174,7 @@ func TestAccCloudControlResource_DesiredState_createOnly(t *testing.T) {
 }
 
 func TestAccCloudControlResource_DesiredState_integerValueAdded(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudcontrolapi_resource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -201,7 +200,7 @@ func TestAccCloudControlResource_DesiredState_integerValueAdded(t *testing.T) {
 }
 
 func TestAccCloudControlResource_DesiredState_integerValueRemoved(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudcontrolapi_resource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -227,7 +226,7 @@ func TestAccCloudControlResource_DesiredState_integerValueRemoved(t *testing.T)
 }
 
 func TestAccCloudControlResource_DesiredState_integerValueUpdate(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudcontrolapi_resource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -253,7 +252,7 @@ func TestAccCloudControlResource_DesiredState_integerValueUpdate(t *testing.T) {
 }
 
 func TestAccCloudControlResource_DesiredState_invalidPropertyName(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
@@ -270,7 +269,7 @@ func TestAccCloudControlResource_DesiredState_invalidPropertyName(t *testing.T)
 }
 
 func TestAccCloudControlResource_DesiredState_invalidPropertyValue(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t) },
@@ -287,7 +286,7 @@ func TestAccCloudControlResource_DesiredState_invalidPropertyValue(t *testing.T)
 }
 
 func TestAccCloudControlResource_DesiredState_objectValueAdded(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudcontrolapi_resource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -313,7 +312,7 @@ func TestAccCloudControlResource_DesiredState_objectValueAdded(t *testing.T) {
 }
 
 func TestAccCloudControlResource_DesiredState_objectValueRemoved(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudcontrolapi_resource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -339,7 +338,7 @@ func TestAccCloudControlResource_DesiredState_objectValueRemoved(t *testing.T) {
 }
 
 func TestAccCloudControlResource_DesiredState_objectValueUpdate(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudcontrolapi_resource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -371,7 +370,7 @@ func TestAccCloudControlResource_DesiredState_objectValueUpdate(t *testing.T) {
 }
 
 func TestAccCloudControlResource_DesiredState_stringValueAdded(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudcontrolapi_resource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -397,7 +396,7 @@ func TestAccCloudControlResource_DesiredState_stringValueAdded(t *testing.T) {
 }
 
 func TestAccCloudControlResource_DesiredState_stringValueRemoved(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudcontrolapi_resource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -423,7 +422,7 @@ func TestAccCloudControlResource_DesiredState_stringValueRemoved(t *testing.T) {
 }
 
 func TestAccCloudControlResource_DesiredState_stringValueUpdate(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudcontrolapi_resource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -449,7 +448,7 @@ func TestAccCloudControlResource_DesiredState_stringValueUpdate(t *testing.T) {
 }
 
 func TestAccCloudControlResource_resourceSchema(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudcontrolapi_resource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
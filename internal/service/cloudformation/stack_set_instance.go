@@ -36,6 +36,10 @@ func ResourceStackSetInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(StackSetInstanceDeletedDefaultTimeout),
 		},
 
+		CustomizeDiff: verify.RegionsDiff(func(diff *schema.ResourceDiff) []string {
+			return []string{diff.Get("region").(string)}
+		}),
+
 		Schema: map[string]*schema.Schema{
 			"account_id": {
 				Type:          schema.TypeString,
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,7 +18,7 @@ import (
 
 func TestAccCloudFormationStackSet_basic(t *testing.T) {
 	var stackSet1 cloudformation.StackSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	iamRoleResourceName := "aws_iam_role.test"
 	resourceName := "aws_cloudformation_stack_set.test"
 
@@ -61,7 +60,7 @@ func TestAccCloudFormationStackSet_basic(t *testing.T) {
 
 func TestAccCloudFormationStackSet_disappears(t *testing.T) {
 	var stackSet1 cloudformation.StackSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudformation_stack_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -84,7 +83,7 @@ func TestAccCloudFormationStackSet_disappears(t *testing.T) {
 
 func TestAccCloudFormationStackSet_administrationRoleARN(t *testing.T) {
 	var stackSet1, stackSet2 cloudformation.StackSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	iamRoleResourceName1 := "aws_iam_role.test1"
 	iamRoleResourceName2 := "aws_iam_role.test2"
 	resourceName := "aws_cloudformation_stack_set.test"
@@ -124,7 +123,7 @@ func TestAccCloudFormationStackSet_administrationRoleARN(t *testing.T) {
 
 func TestAccCloudFormationStackSet_description(t *testing.T) {
 	var stackSet1, stackSet2 cloudformation.StackSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudformation_stack_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -162,7 +161,7 @@ func TestAccCloudFormationStackSet_description(t *testing.T) {
 
 func TestAccCloudFormationStackSet_executionRoleName(t *testing.T) {
 	var stackSet1, stackSet2 cloudformation.StackSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudformation_stack_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -200,8 +199,8 @@ func TestAccCloudFormationStackSet_executionRoleName(t *testing.T) {
 
 func TestAccCloudFormationStackSet_name(t *testing.T) {
 	var stackSet1, stackSet2 cloudformation.StackSet
-	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName1 := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudformation_stack_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -215,7 +214,7 @@ func TestAccCloudFormationStackSet_name(t *testing.T) {
 				ExpectError: regexp.MustCompile(`expected length`),
 			},
 			{
-				Config:      testAccStackSetNameConfig(sdkacctest.RandStringFromCharSet(129, sdkacctest.CharSetAlpha)),
+				Config:      testAccStackSetNameConfig(acctest.RandStringFromCharSet(129, acctest.CharSetAlpha)),
 				ExpectError: regexp.MustCompile(`(cannot be longer|expected length)`),
 			},
 			{
@@ -255,7 +254,7 @@ func TestAccCloudFormationStackSet_name(t *testing.T) {
 
 func TestAccCloudFormationStackSet_parameters(t *testing.T) {
 	var stackSet1, stackSet2 cloudformation.StackSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudformation_stack_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -315,7 +314,7 @@ func TestAccCloudFormationStackSet_Parameters_default(t *testing.T) {
 	//  * https://github.com/hashicorp/terraform/issues/18863
 
 	var stackSet1, stackSet2 cloudformation.StackSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudformation_stack_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -367,7 +366,7 @@ func TestAccCloudFormationStackSet_Parameters_noEcho(t *testing.T) {
 	//  * https://github.com/hashicorp/terraform-provider-aws/issues/55
 
 	var stackSet1, stackSet2 cloudformation.StackSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudformation_stack_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -409,7 +408,7 @@ func TestAccCloudFormationStackSet_PermissionModel_serviceManaged(t *testing.T)
 	acctest.Skip(t, "API does not support enabling Organizations access (in particular, creating the Stack Sets IAM Service-Linked Role)")
 
 	var stackSet1 cloudformation.StackSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudformation_stack_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -448,7 +447,7 @@ func TestAccCloudFormationStackSet_PermissionModel_serviceManaged(t *testing.T)
 
 func TestAccCloudFormationStackSet_tags(t *testing.T) {
 	var stackSet1, stackSet2 cloudformation.StackSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudformation_stack_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -504,7 +503,7 @@ func TestAccCloudFormationStackSet_tags(t *testing.T) {
 
 func TestAccCloudFormationStackSet_templateBody(t *testing.T) {
 	var stackSet1, stackSet2 cloudformation.StackSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudformation_stack_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -542,7 +541,7 @@ func TestAccCloudFormationStackSet_templateBody(t *testing.T) {
 
 func TestAccCloudFormationStackSet_templateURL(t *testing.T) {
 	var stackSet1, stackSet2 cloudformation.StackSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudformation_stack_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -5,13 +5,12 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/cloudfront"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
 func TestAccCloudFrontCachePolicy_basic(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_cloudfront_cache_policy.example"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -46,7 +45,7 @@ func TestAccCloudFrontCachePolicy_basic(t *testing.T) {
 }
 
 func TestAccCloudFrontCachePolicy_update(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_cloudfront_cache_policy.example"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -96,7 +95,7 @@ func TestAccCloudFrontCachePolicy_update(t *testing.T) {
 }
 
 func TestAccCloudFrontCachePolicy_noneBehavior(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_cloudfront_cache_policy.example"
 
 	resource.ParallelTest(t, resource.TestCase{
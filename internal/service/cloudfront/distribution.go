@@ -1,6 +1,7 @@
 package cloudfront
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -22,6 +23,7 @@ func ResourceDistribution() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
 		Create: resourceDistributionCreate,
+		//lintignore:AWSR004
 		Read:   resourceDistributionRead,
 		Update: resourceDistributionUpdate,
 		Delete: resourceDistributionDelete,
@@ -1132,16 +1134,21 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 // distribution is deployed. It currently takes exactly 15 minutes to deploy
 // but that might change in the future.
 func DistributionWaitUntilDeployed(id string, meta interface{}) error {
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"InProgress"},
-		Target:     []string{"Deployed"},
-		Refresh:    resourceWebDistributionStateRefreshFunc(id, meta),
-		Timeout:    90 * time.Minute,
-		MinTimeout: 15 * time.Second,
-		Delay:      1 * time.Minute,
-	}
-
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForStateContext(context.Background(), tfresource.WaitForStateOpts{
+		Pending: []string{"InProgress"},
+		Target:  []string{"Deployed"},
+		Refresh: resourceWebDistributionStateRefreshFunc(id, meta),
+		Timeout: 90 * time.Minute,
+		Delay:   1 * time.Minute,
+		Backoff: tfresource.Backoff{
+			InitialInterval: 15 * time.Second,
+			MaxInterval:     1 * time.Minute,
+			Jitter:          0.2,
+		},
+		OnProgress: func(p tfresource.WaitProgress) {
+			log.Printf("[DEBUG] CloudFront Distribution (%s) status %s after %s", id, p.State, p.Elapsed.Round(time.Second))
+		},
+	})
 	return err
 }
 
@@ -1,4 +1,5 @@
-//go:generate go run ../../generate/tags/main.go -ListTags -ListTagsInIDElem=Resource -ListTagsOutTagsElem=Tags.Items -ServiceTagsSlice "-TagInCustomVal=&cloudfront.Tags{Items: Tags(updatedTags.IgnoreAWS())}" -TagInIDElem=Resource "-UntagInCustomVal=&cloudfront.TagKeys{Items: aws.StringSlice(removedTags.IgnoreAWS().Keys())}" -UpdateTags
+//go:generate go run ../../generate/listpages/main.go -ListOps=ListFunctions,ListOriginRequestPolicies -InputPaginator=Marker -OutputPaginator=NextMarker -Collect -Export
+//go:generate go run ../../generate/tags/main.go -ListTags -ListTagsInIDElem=Resource -ListTagsOutTagsElem=Tags.Items -ServiceTagsSlice "-TagInCustomVal=&cloudfront.Tags{Items: Tags(updatedTags.IgnoreAWS())}" -TagInIDElem=Resource "-UntagInCustomVal=&cloudfront.TagKeys{Items: aws.StringSlice(removedTags.IgnoreAWS().Keys())}" -UpdateTags -Tests -TestTagInTagsElem=Tags.Items -TestUntagInTagsElem=TagKeys.Items
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package cloudfront
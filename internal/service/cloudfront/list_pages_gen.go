@@ -0,0 +1,108 @@
+// Code generated by "internal/generate/listpages/main.go -ListOps=ListFunctions,ListOriginRequestPolicies -InputPaginator=Marker -OutputPaginator=NextMarker -Collect -Export"; DO NOT EDIT.
+
+package cloudfront
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+)
+
+func ListFunctionsPages(conn *cloudfront.CloudFront, input *cloudfront.ListFunctionsInput, fn func(*cloudfront.ListFunctionsOutput, bool) bool) error {
+	return ListFunctionsPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func ListFunctionsPagesWithContext(ctx context.Context, conn *cloudfront.CloudFront, input *cloudfront.ListFunctionsInput, fn func(*cloudfront.ListFunctionsOutput, bool) bool) error {
+	for {
+		output, err := conn.ListFunctionsWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := output.FunctionList == nil || aws.StringValue(output.FunctionList.NextMarker) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.Marker = output.FunctionList.NextMarker
+	}
+	return nil
+}
+
+func ListFunctionsAll(conn *cloudfront.CloudFront, input *cloudfront.ListFunctionsInput, filter func(*cloudfront.FunctionSummary) bool) ([]*cloudfront.FunctionSummary, error) {
+	return ListFunctionsAllWithContext(context.Background(), conn, input, filter)
+}
+
+func ListFunctionsAllWithContext(ctx context.Context, conn *cloudfront.CloudFront, input *cloudfront.ListFunctionsInput, filter func(*cloudfront.FunctionSummary) bool) ([]*cloudfront.FunctionSummary, error) {
+	var output []*cloudfront.FunctionSummary
+
+	err := ListFunctionsPagesWithContext(ctx, conn, input, func(page *cloudfront.ListFunctionsOutput, lastPage bool) bool {
+		if page == nil || page.FunctionList == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.FunctionList.Items {
+			if v != nil && (filter == nil || filter(v)) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func ListOriginRequestPoliciesPages(conn *cloudfront.CloudFront, input *cloudfront.ListOriginRequestPoliciesInput, fn func(*cloudfront.ListOriginRequestPoliciesOutput, bool) bool) error {
+	return ListOriginRequestPoliciesPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func ListOriginRequestPoliciesPagesWithContext(ctx context.Context, conn *cloudfront.CloudFront, input *cloudfront.ListOriginRequestPoliciesInput, fn func(*cloudfront.ListOriginRequestPoliciesOutput, bool) bool) error {
+	for {
+		output, err := conn.ListOriginRequestPoliciesWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := output.OriginRequestPolicyList == nil || aws.StringValue(output.OriginRequestPolicyList.NextMarker) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.Marker = output.OriginRequestPolicyList.NextMarker
+	}
+	return nil
+}
+
+func ListOriginRequestPoliciesAll(conn *cloudfront.CloudFront, input *cloudfront.ListOriginRequestPoliciesInput, filter func(*cloudfront.OriginRequestPolicySummary) bool) ([]*cloudfront.OriginRequestPolicySummary, error) {
+	return ListOriginRequestPoliciesAllWithContext(context.Background(), conn, input, filter)
+}
+
+func ListOriginRequestPoliciesAllWithContext(ctx context.Context, conn *cloudfront.CloudFront, input *cloudfront.ListOriginRequestPoliciesInput, filter func(*cloudfront.OriginRequestPolicySummary) bool) ([]*cloudfront.OriginRequestPolicySummary, error) {
+	var output []*cloudfront.OriginRequestPolicySummary
+
+	err := ListOriginRequestPoliciesPagesWithContext(ctx, conn, input, func(page *cloudfront.ListOriginRequestPoliciesOutput, lastPage bool) bool {
+		if page == nil || page.OriginRequestPolicyList == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.OriginRequestPolicyList.Items {
+			if v != nil && (filter == nil || filter(v)) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -15,6 +15,7 @@ import (
 func ResourceMonitoringSubscription() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceMonitoringSubscriptionCreate,
+		//lintignore:AWSR004
 		Read:   resourceMonitoringSubscriptionRead,
 		Update: resourceMonitoringSubscriptionCreate,
 		Delete: resourceMonitoringSubscriptionDelete,
@@ -16,6 +16,7 @@ import (
 func ResourceOriginAccessIdentity() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceOriginAccessIdentityCreate,
+		//lintignore:AWSR004
 		Read:   resourceOriginAccessIdentityRead,
 		Update: resourceOriginAccessIdentityUpdate,
 		Delete: resourceOriginAccessIdentityDelete,
@@ -146,22 +146,17 @@ func dataSourceOriginRequestPolicyRead(d *schema.ResourceData, meta interface{})
 }
 
 func dataSourceOriginRequestPolicyFindByName(d *schema.ResourceData, conn *cloudfront.CloudFront) error {
-	var originRequestPolicy *cloudfront.OriginRequestPolicy
-	request := &cloudfront.ListOriginRequestPoliciesInput{}
-	resp, err := conn.ListOriginRequestPolicies(request)
+	name := d.Get("name").(string)
+	policySummaries, err := ListOriginRequestPoliciesAll(conn, &cloudfront.ListOriginRequestPoliciesInput{}, func(policySummary *cloudfront.OriginRequestPolicySummary) bool {
+		return policySummary.OriginRequestPolicy != nil && policySummary.OriginRequestPolicy.OriginRequestPolicyConfig != nil &&
+			aws.StringValue(policySummary.OriginRequestPolicy.OriginRequestPolicyConfig.Name) == name
+	})
 	if err != nil {
 		return err
 	}
 
-	for _, policySummary := range resp.OriginRequestPolicyList.Items {
-		if aws.StringValue(policySummary.OriginRequestPolicy.OriginRequestPolicyConfig.Name) == d.Get("name").(string) {
-			originRequestPolicy = policySummary.OriginRequestPolicy
-			break
-		}
-	}
-
-	if originRequestPolicy != nil {
-		d.SetId(aws.StringValue(originRequestPolicy.Id))
+	if len(policySummaries) > 0 {
+		d.SetId(aws.StringValue(policySummaries[0].OriginRequestPolicy.Id))
 	}
 	return nil
 }
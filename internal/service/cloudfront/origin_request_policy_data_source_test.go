@@ -5,13 +5,12 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/cloudfront"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
 func TestAccCloudFrontOriginRequestPolicyDataSource_basic(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	dataSourceName := "data.aws_cloudfront_origin_request_policy.example"
 
 	resource.ParallelTest(t, resource.TestCase{
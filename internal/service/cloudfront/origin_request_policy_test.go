@@ -5,13 +5,12 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/cloudfront"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
 func TestAccCloudFrontOriginRequestPolicy_basic(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_cloudfront_origin_request_policy.example"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -43,7 +42,7 @@ func TestAccCloudFrontOriginRequestPolicy_basic(t *testing.T) {
 }
 
 func TestAccCloudFrontOriginRequestPolicy_update(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_cloudfront_origin_request_policy.example"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -85,7 +84,7 @@ func TestAccCloudFrontOriginRequestPolicy_update(t *testing.T) {
 }
 
 func TestAccCloudFrontOriginRequestPolicy_noneBehavior(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_cloudfront_origin_request_policy.example"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -15,6 +15,7 @@ import (
 func ResourcePublicKey() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePublicKeyCreate,
+		//lintignore:AWSR004
 		Read:   resourcePublicKeyRead,
 		Update: resourcePublicKeyUpdate,
 		Delete: resourcePublicKeyDelete,
@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,8 +16,8 @@ import (
 
 func TestAccCloudFrontRealtimeLogConfig_basic(t *testing.T) {
 	var v cloudfront.RealtimeLogConfig
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	samplingRate := sdkacctest.RandIntRange(1, 100)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	samplingRate := acctest.RandIntRange(1, 100)
 	resourceName := "aws_cloudfront_realtime_log_config.test"
 	roleResourceName := "aws_iam_role.test.0"
 	streamResourceName := "aws_kinesis_stream.test.0"
@@ -57,8 +56,8 @@ func TestAccCloudFrontRealtimeLogConfig_basic(t *testing.T) {
 
 func TestAccCloudFrontRealtimeLogConfig_disappears(t *testing.T) {
 	var v cloudfront.RealtimeLogConfig
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	samplingRate := sdkacctest.RandIntRange(1, 100)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	samplingRate := acctest.RandIntRange(1, 100)
 	resourceName := "aws_cloudfront_realtime_log_config.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -81,9 +80,9 @@ func TestAccCloudFrontRealtimeLogConfig_disappears(t *testing.T) {
 
 func TestAccCloudFrontRealtimeLogConfig_updates(t *testing.T) {
 	var v cloudfront.RealtimeLogConfig
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	samplingRate1 := sdkacctest.RandIntRange(1, 100)
-	samplingRate2 := sdkacctest.RandIntRange(1, 100)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	samplingRate1 := acctest.RandIntRange(1, 100)
+	samplingRate2 := acctest.RandIntRange(1, 100)
 	resourceName := "aws_cloudfront_realtime_log_config.test"
 	role1ResourceName := "aws_iam_role.test.0"
 	stream1ResourceName := "aws_kinesis_stream.test.0"
@@ -18,6 +18,7 @@ import (
 func ResourceCluster() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceClusterCreate,
+		//lintignore:AWSR004
 		Read:   resourceClusterRead,
 		Update: resourceClusterUpdate,
 		Delete: resourceClusterDelete,
@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
@@ -72,4 +71,4 @@ resource "aws_cloudhsm_v2_cluster" "cluster" {
 data "aws_cloudhsm_v2_cluster" "default" {
   cluster_id = aws_cloudhsm_v2_cluster.cluster.cluster_id
 }
-`, sdkacctest.RandInt()))
+`, acctest.RandInt()))
@@ -15,6 +15,7 @@ import (
 func ResourceHSM() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceHSMCreate,
+		//lintignore:AWSR004
 		Read:   resourceHSMRead,
 		Delete: resourceHSMDelete,
 		Importer: &schema.ResourceImporter{
@@ -49,7 +49,7 @@ func sweepCloudhsmv2Clusters(region string) error {
 			r := ResourceCluster()
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(cluster.ClusterId))
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_cloudhsm_v2_cluster", r, d, client))
 		}
 
 		return !lastPage
@@ -97,7 +97,7 @@ func sweepCloudhsmv2HSMs(region string) error {
 				d := r.Data(nil)
 				d.SetId(aws.StringValue(hsm.HsmId))
 				d.Set("cluster_id", cluster.ClusterId)
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sweep.NewSweepResource("aws_cloudhsm_v2_hsm", r, d, client))
 			}
 		}
 
@@ -6,13 +6,14 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2/cloudhsmv2iface"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 // ListTags lists cloudhsmv2 service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func ListTags(conn *cloudhsmv2.CloudHSMV2, identifier string) (tftags.KeyValueTags, error) {
+func ListTags(conn cloudhsmv2iface.CloudHSMV2API, identifier string) (tftags.KeyValueTags, error) {
 	input := &cloudhsmv2.ListTagsInput{
 		ResourceId: aws.String(identifier),
 	}
@@ -58,7 +59,7 @@ func KeyValueTags(tags []*cloudhsmv2.Tag) tftags.KeyValueTags {
 // UpdateTags updates cloudhsmv2 service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func UpdateTags(conn *cloudhsmv2.CloudHSMV2, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+func UpdateTags(conn cloudhsmv2iface.CloudHSMV2API, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
 	oldTags := tftags.New(oldTagsMap)
 	newTags := tftags.New(newTagsMap)
 
@@ -21,6 +21,7 @@ import (
 func ResourceCloudTrail() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCloudTrailCreate,
+		//lintignore:AWSR004
 		Read:   resourceCloudTrailRead,
 		Update: resourceCloudTrailUpdate,
 		Delete: resourceCloudTrailDelete,
@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -49,7 +48,7 @@ func TestAccCloudTrail_serial(t *testing.T) {
 
 func testAcc_basic(t *testing.T) {
 	var trail cloudtrail.Trail
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudtrail.test"
 
 	resource.Test(t, resource.TestCase{
@@ -89,7 +88,7 @@ func testAcc_basic(t *testing.T) {
 
 func testAcc_cloudWatch(t *testing.T) {
 	var trail cloudtrail.Trail
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudtrail.test"
 
 	resource.Test(t, resource.TestCase{
@@ -125,7 +124,7 @@ func testAcc_cloudWatch(t *testing.T) {
 
 func testAcc_enableLogging(t *testing.T) {
 	var trail cloudtrail.Trail
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudtrail.test"
 
 	resource.Test(t, resource.TestCase{
@@ -174,7 +173,7 @@ func testAcc_enableLogging(t *testing.T) {
 
 func testAcc_multiRegion(t *testing.T) {
 	var trail cloudtrail.Trail
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudtrail.test"
 
 	resource.Test(t, resource.TestCase{
@@ -221,7 +220,7 @@ func testAcc_multiRegion(t *testing.T) {
 
 func testAcc_organization(t *testing.T) {
 	var trail cloudtrail.Trail
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudtrail.test"
 
 	resource.Test(t, resource.TestCase{
@@ -259,7 +258,7 @@ func testAcc_organization(t *testing.T) {
 
 func testAcc_logValidation(t *testing.T) {
 	var trail cloudtrail.Trail
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudtrail.test"
 
 	resource.Test(t, resource.TestCase{
@@ -299,7 +298,7 @@ func testAcc_logValidation(t *testing.T) {
 
 func testAcc_kmsKey(t *testing.T) {
 	var trail cloudtrail.Trail
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resourceName := "aws_cloudtrail.test"
 	kmsResourceName := "aws_kms_key.test"
@@ -333,7 +332,7 @@ func testAcc_tags(t *testing.T) {
 	var trail cloudtrail.Trail
 	var trailTags []*cloudtrail.Tag
 	var trailTagsModified []*cloudtrail.Tag
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudtrail.test"
 
 	resource.Test(t, resource.TestCase{
@@ -388,7 +387,7 @@ func testAcc_tags(t *testing.T) {
 
 func testAcc_globalServiceEvents(t *testing.T) {
 	var trail cloudtrail.Trail
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudtrail.test"
 
 	resource.Test(t, resource.TestCase{
@@ -414,7 +413,7 @@ func testAcc_globalServiceEvents(t *testing.T) {
 }
 
 func testAcc_eventSelector(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudtrail.test"
 
 	resource.Test(t, resource.TestCase{
@@ -483,7 +482,7 @@ func testAcc_eventSelector(t *testing.T) {
 }
 
 func testAcc_eventSelectorDynamoDB(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudtrail.test"
 
 	resource.Test(t, resource.TestCase{
@@ -510,7 +509,7 @@ func testAcc_eventSelectorDynamoDB(t *testing.T) {
 
 func testAcc_insightSelector(t *testing.T) {
 	resourceName := "aws_cloudtrail.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -536,7 +535,7 @@ func testAcc_insightSelector(t *testing.T) {
 
 func testAcc_advanced_event_selector(t *testing.T) {
 	resourceName := "aws_cloudtrail.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 )
 
 func TestAccCloudWatchCompositeAlarm_basic(t *testing.T) {
-	suffix := sdkacctest.RandString(8)
+	suffix := acctest.RandString(8)
 	resourceName := "aws_cloudwatch_composite_alarm.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -51,7 +50,7 @@ func TestAccCloudWatchCompositeAlarm_basic(t *testing.T) {
 }
 
 func TestAccCloudWatchCompositeAlarm_disappears(t *testing.T) {
-	suffix := sdkacctest.RandString(8)
+	suffix := acctest.RandString(8)
 	resourceName := "aws_cloudwatch_composite_alarm.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -73,7 +72,7 @@ func TestAccCloudWatchCompositeAlarm_disappears(t *testing.T) {
 }
 
 func TestAccCloudWatchCompositeAlarm_actionsEnabled(t *testing.T) {
-	suffix := sdkacctest.RandString(8)
+	suffix := acctest.RandString(8)
 	resourceName := "aws_cloudwatch_composite_alarm.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -111,7 +110,7 @@ func TestAccCloudWatchCompositeAlarm_actionsEnabled(t *testing.T) {
 }
 
 func TestAccCloudWatchCompositeAlarm_alarmActions(t *testing.T) {
-	suffix := sdkacctest.RandString(8)
+	suffix := acctest.RandString(8)
 	resourceName := "aws_cloudwatch_composite_alarm.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -161,7 +160,7 @@ func TestAccCloudWatchCompositeAlarm_alarmActions(t *testing.T) {
 }
 
 func TestAccCloudWatchCompositeAlarm_description(t *testing.T) {
-	suffix := sdkacctest.RandString(8)
+	suffix := acctest.RandString(8)
 	resourceName := "aws_cloudwatch_composite_alarm.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -199,7 +198,7 @@ func TestAccCloudWatchCompositeAlarm_description(t *testing.T) {
 }
 
 func TestAccCloudWatchCompositeAlarm_insufficientDataActions(t *testing.T) {
-	suffix := sdkacctest.RandString(8)
+	suffix := acctest.RandString(8)
 	resourceName := "aws_cloudwatch_composite_alarm.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -249,7 +248,7 @@ func TestAccCloudWatchCompositeAlarm_insufficientDataActions(t *testing.T) {
 }
 
 func TestAccCloudWatchCompositeAlarm_okActions(t *testing.T) {
-	suffix := sdkacctest.RandString(8)
+	suffix := acctest.RandString(8)
 	resourceName := "aws_cloudwatch_composite_alarm.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -299,7 +298,7 @@ func TestAccCloudWatchCompositeAlarm_okActions(t *testing.T) {
 }
 
 func TestAccCloudWatchCompositeAlarm_allActions(t *testing.T) {
-	suffix := sdkacctest.RandString(8)
+	suffix := acctest.RandString(8)
 	resourceName := "aws_cloudwatch_composite_alarm.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -341,7 +340,7 @@ func TestAccCloudWatchCompositeAlarm_allActions(t *testing.T) {
 }
 
 func TestAccCloudWatchCompositeAlarm_updateAlarmRule(t *testing.T) {
-	suffix := sdkacctest.RandString(8)
+	suffix := acctest.RandString(8)
 	resourceName := "aws_cloudwatch_composite_alarm.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -17,6 +17,7 @@ import (
 func ResourceDashboard() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDashboardPut,
+		//lintignore:AWSR004
 		Read:   resourceDashboardRead,
 		Update: resourceDashboardPut,
 		Delete: resourceDashboardDelete,
@@ -19,7 +19,8 @@ import (
 func ResourceMetricAlarm() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
-		Create:        resourceMetricAlarmCreate,
+		Create: resourceMetricAlarmCreate,
+		//lintignore:AWSR004
 		Read:          resourceMetricAlarmRead,
 		Update:        resourceMetricAlarmUpdate,
 		Delete:        resourceMetricAlarmDelete,
@@ -17,6 +17,7 @@ import (
 func ResourceAPIDestination() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAPIDestinationCreate,
+		//lintignore:AWSR004
 		Read:   resourceAPIDestinationRead,
 		Update: resourceAPIDestinationUpdate,
 		Delete: resourceAPIDestinationDelete,
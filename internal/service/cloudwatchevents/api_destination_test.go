@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,11 +18,11 @@ const uuidRegex = "[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[
 
 func TestAccCloudWatchEventsAPIDestination_basic(t *testing.T) {
 	var v1, v2, v3 events.DescribeApiDestinationOutput
-	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	invocationEndpoint := "https://www.hashicorp.com/"
 	httpMethod := "GET"
 
-	nameModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	nameModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	invocationEndpointModified := "https://www.hashicorp.com/products/terraform"
 	httpMethodModified := "POST"
 
@@ -88,16 +87,16 @@ func TestAccCloudWatchEventsAPIDestination_basic(t *testing.T) {
 
 func TestAccCloudWatchEventsAPIDestination_optional(t *testing.T) {
 	var v1, v2, v3 events.DescribeApiDestinationOutput
-	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	invocationEndpoint := "https://www.hashicorp.com/"
 	httpMethod := "GET"
-	description := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	description := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	invocationRateLimitPerSecond := 10
 
-	nameModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	nameModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	invocationEndpointModified := "https://www.hashicorp.com/products/terraform"
 	httpMethodModified := "POST"
-	descriptionModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	descriptionModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	invocationRateLimitPerSecondModified := 12
 
 	resourceName := "aws_cloudwatch_event_api_destination.optional"
@@ -172,7 +171,7 @@ func TestAccCloudWatchEventsAPIDestination_optional(t *testing.T) {
 
 func TestAccCloudWatchEventsAPIDestination_disappears(t *testing.T) {
 	var v events.DescribeApiDestinationOutput
-	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	invocationEndpoint := "https://www.hashicorp.com/"
 	httpMethod := "GET"
 
@@ -17,6 +17,7 @@ import (
 func ResourceArchive() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArchiveCreate,
+		//lintignore:AWSR004
 		Read:   resourceArchiveRead,
 		Update: resourceArchiveUpdate,
 		Delete: resourceArchiveDelete,
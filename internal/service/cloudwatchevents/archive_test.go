@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 
 func TestAccCloudWatchEventsArchive_basic(t *testing.T) {
 	var v1 events.DescribeArchiveOutput
-	archiveName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	archiveName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudwatch_event_archive.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -48,7 +47,7 @@ func TestAccCloudWatchEventsArchive_basic(t *testing.T) {
 func TestAccCloudWatchEventsArchive_update(t *testing.T) {
 	var v1 events.DescribeArchiveOutput
 	resourceName := "aws_cloudwatch_event_archive.test"
-	archiveName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	archiveName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -77,7 +76,7 @@ func TestAccCloudWatchEventsArchive_update(t *testing.T) {
 
 func TestAccCloudWatchEventsArchive_disappears(t *testing.T) {
 	var v events.DescribeArchiveOutput
-	archiveName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	archiveName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudwatch_event_archive.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -149,7 +148,7 @@ func testAccCheckCloudWatchEventArchiveExists(n string, v *events.DescribeArchiv
 
 func TestAccCloudWatchEventsArchive_retentionSetOnCreation(t *testing.T) {
 	var v1 events.DescribeArchiveOutput
-	archiveName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	archiveName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudwatch_event_archive.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -16,6 +16,7 @@ import (
 func ResourceBus() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBusCreate,
+		//lintignore:AWSR004
 		Read:   resourceBusRead,
 		Update: resourceBusUpdate,
 		Delete: resourceBusDelete,
@@ -19,6 +19,7 @@ import (
 func ResourceBusPolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBusPolicyCreate,
+		//lintignore:AWSR004
 		Read:   resourceBusPolicyRead,
 		Update: resourceBusPolicyUpdate,
 		Delete: resourceBusPolicyDelete,
@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,8 +17,8 @@ import (
 
 func TestAccCloudWatchEventsBus_basic(t *testing.T) {
 	var v1, v2, v3 events.DescribeEventBusOutput
-	busName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	busNameModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	busName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	busNameModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resourceName := "aws_cloudwatch_event_bus.test"
 
@@ -70,7 +69,7 @@ func TestAccCloudWatchEventsBus_basic(t *testing.T) {
 
 func TestAccCloudWatchEventsBus_tags(t *testing.T) {
 	var v1, v2, v3, v4 events.DescribeEventBusOutput
-	busName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	busName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resourceName := "aws_cloudwatch_event_bus.test"
 
@@ -141,7 +140,7 @@ func TestAccCloudWatchEventsBus_default(t *testing.T) {
 
 func TestAccCloudWatchEventsBus_disappears(t *testing.T) {
 	var v events.DescribeEventBusOutput
-	busName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	busName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resourceName := "aws_cloudwatch_event_bus.test"
 
@@ -3,7 +3,6 @@ package cloudwatchevents_test
 import (
 	"testing"
 
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
@@ -12,11 +11,11 @@ func TestAccCloudWatchEventsConnectionDataSource_Connection_basic(t *testing.T)
 	dataSourceName := "data.aws_cloudwatch_event_connection.test"
 	resourceName := "aws_cloudwatch_event_connection.api_key"
 
-	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	authorizationType := "API_KEY"
-	description := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	key := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	value := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	description := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	key := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	value := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,16 +18,16 @@ import (
 
 func TestAccCloudWatchEventsConnection_apiKey(t *testing.T) {
 	var v1, v2, v3 events.DescribeConnectionOutput
-	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	authorizationType := "API_KEY"
-	description := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	key := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	value := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	description := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	key := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	value := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	nameModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	descriptionModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	keyModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	valueModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	nameModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	descriptionModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	keyModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	valueModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resourceName := "aws_cloudwatch_event_connection.api_key"
 
@@ -101,16 +100,16 @@ func TestAccCloudWatchEventsConnection_apiKey(t *testing.T) {
 
 func TestAccCloudWatchEventsConnection_basic(t *testing.T) {
 	var v1, v2, v3 events.DescribeConnectionOutput
-	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	authorizationType := "BASIC"
-	description := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	username := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	password := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	description := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	username := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	password := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
-	nameModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	descriptionModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	usernameModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	passwordModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	nameModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	descriptionModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	usernameModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	passwordModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resourceName := "aws_cloudwatch_event_connection.basic"
 
@@ -183,52 +182,52 @@ func TestAccCloudWatchEventsConnection_basic(t *testing.T) {
 
 func TestAccCloudWatchEventsConnection_oAuth(t *testing.T) {
 	var v1, v2, v3 events.DescribeConnectionOutput
-	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	authorizationType := "OAUTH_CLIENT_CREDENTIALS"
-	description := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	description := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	// oauth
 	authorizationEndpoint := "https://www.hashicorp.com/products/terraform"
 	httpMethod := "POST"
 
 	// client_parameters
-	clientID := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	clientSecret := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	clientID := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	clientSecret := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	// oauth_http_parameters
-	bodyKey := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	bodyValue := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	bodyKey := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	bodyValue := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	bodyIsSecretValue := true
 
-	headerKey := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	headerValue := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	headerKey := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	headerValue := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	headerIsSecretValue := true
 
-	queryStringKey := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	queryStringValue := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	queryStringKey := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	queryStringValue := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	queryStringIsSecretValue := true
 
 	// modified
-	nameModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	descriptionModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	nameModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	descriptionModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	// oauth
 	authorizationEndpointModified := "https://www.hashicorp.com/"
 	httpMethodModified := "GET"
 
 	// client_parameters
-	clientIDModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	clientSecretModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	clientIDModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	clientSecretModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	// oauth_http_parameters modified
-	bodyKeyModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	bodyValueModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	bodyKeyModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	bodyValueModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	bodyIsSecretValueModified := false
 
-	headerKeyModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	headerValueModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	headerKeyModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	headerValueModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	headerIsSecretValueModified := false
 
-	queryStringKeyModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	queryStringValueModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	queryStringKeyModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	queryStringValueModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	queryStringIsSecretValueModified := false
 
 	resourceName := "aws_cloudwatch_event_connection.oauth"
@@ -364,36 +363,36 @@ func TestAccCloudWatchEventsConnection_oAuth(t *testing.T) {
 
 func TestAccCloudWatchEventsConnection_invocationHTTPParameters(t *testing.T) {
 	var v1, v2, v3 events.DescribeConnectionOutput
-	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	authorizationType := "API_KEY"
-	description := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	key := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	value := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	description := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	key := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	value := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	// invocation_http_parameters
-	bodyKey := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	bodyValue := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	bodyKey := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	bodyValue := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	bodyIsSecretValue := true
 
-	headerKey := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	headerValue := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	headerKey := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	headerValue := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	headerIsSecretValue := true
 
-	queryStringKey := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	queryStringValue := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	queryStringKey := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	queryStringValue := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	queryStringIsSecretValue := true
 
 	// invocation_http_parameters modified
-	bodyKeyModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	bodyValueModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	bodyKeyModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	bodyValueModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	bodyIsSecretValueModified := false
 
-	headerKeyModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	headerValueModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	headerKeyModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	headerValueModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	headerIsSecretValueModified := false
 
-	queryStringKeyModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	queryStringValueModified := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	queryStringKeyModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	queryStringValueModified := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	queryStringIsSecretValueModified := false
 
 	resourceName := "aws_cloudwatch_event_connection.invocation_http_parameters"
@@ -537,11 +536,11 @@ func TestAccCloudWatchEventsConnection_invocationHTTPParameters(t *testing.T) {
 
 func TestAccCloudWatchEventsConnection_disappears(t *testing.T) {
 	var v events.DescribeConnectionOutput
-	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	authorizationType := "API_KEY"
-	description := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	key := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	value := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	description := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	key := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	value := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudwatch_event_connection.api_key"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -80,27 +80,24 @@ func FindRuleByResourceID(conn *events.CloudWatchEvents, id string) (*events.Des
 }
 
 func FindTarget(conn *events.CloudWatchEvents, busName, ruleName, targetId string) (*events.Target, error) {
-	var result *events.Target
-	err := ListAllTargetsForRulePages(conn, busName, ruleName, func(page *events.ListTargetsByRuleOutput, lastPage bool) bool {
-		if page == nil {
-			return !lastPage
-		}
+	input := &events.ListTargetsByRuleInput{
+		Rule:  aws.String(ruleName),
+		Limit: aws.Int64(100), // Set limit to allowed maximum to prevent API throttling
+	}
 
-		for _, t := range page.Targets {
-			if targetId == aws.StringValue(t.Id) {
-				result = t
-				return false
-			}
-		}
+	if busName != "" {
+		input.EventBusName = aws.String(busName)
+	}
 
-		return !lastPage
+	targets, err := ListTargetsByRuleAll(conn, input, func(t *events.Target) bool {
+		return targetId == aws.StringValue(t.Id)
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	if result == nil {
+	if len(targets) == 0 {
 		return nil, fmt.Errorf("CloudWatch Event FindTarget %q (\"%s/%s\") not found", targetId, busName, ruleName)
 	}
-	return result, nil
+	return targets[0], nil
 }
@@ -1,4 +1,4 @@
-// Code generated by "internal/generate/listpages/main.go -ListOps=ListEventBuses,ListRules,ListTargetsByRule -Export"; DO NOT EDIT.
+// Code generated by "internal/generate/listpages/main.go -ListOps=ListEventBuses,ListRules,ListTargetsByRule -Collect -Export"; DO NOT EDIT.
 
 package cloudwatchevents
 
@@ -30,6 +30,34 @@ func ListEventBusesPagesWithContext(ctx context.Context, conn *cloudwatchevents.
 	return nil
 }
 
+func ListEventBusesAll(conn *cloudwatchevents.CloudWatchEvents, input *cloudwatchevents.ListEventBusesInput, filter func(*cloudwatchevents.EventBus) bool) ([]*cloudwatchevents.EventBus, error) {
+	return ListEventBusesAllWithContext(context.Background(), conn, input, filter)
+}
+
+func ListEventBusesAllWithContext(ctx context.Context, conn *cloudwatchevents.CloudWatchEvents, input *cloudwatchevents.ListEventBusesInput, filter func(*cloudwatchevents.EventBus) bool) ([]*cloudwatchevents.EventBus, error) {
+	var output []*cloudwatchevents.EventBus
+
+	err := ListEventBusesPagesWithContext(ctx, conn, input, func(page *cloudwatchevents.ListEventBusesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.EventBuses {
+			if v != nil && (filter == nil || filter(v)) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func ListRulesPages(conn *cloudwatchevents.CloudWatchEvents, input *cloudwatchevents.ListRulesInput, fn func(*cloudwatchevents.ListRulesOutput, bool) bool) error {
 	return ListRulesPagesWithContext(context.Background(), conn, input, fn)
 }
@@ -51,6 +79,34 @@ func ListRulesPagesWithContext(ctx context.Context, conn *cloudwatchevents.Cloud
 	return nil
 }
 
+func ListRulesAll(conn *cloudwatchevents.CloudWatchEvents, input *cloudwatchevents.ListRulesInput, filter func(*cloudwatchevents.Rule) bool) ([]*cloudwatchevents.Rule, error) {
+	return ListRulesAllWithContext(context.Background(), conn, input, filter)
+}
+
+func ListRulesAllWithContext(ctx context.Context, conn *cloudwatchevents.CloudWatchEvents, input *cloudwatchevents.ListRulesInput, filter func(*cloudwatchevents.Rule) bool) ([]*cloudwatchevents.Rule, error) {
+	var output []*cloudwatchevents.Rule
+
+	err := ListRulesPagesWithContext(ctx, conn, input, func(page *cloudwatchevents.ListRulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Rules {
+			if v != nil && (filter == nil || filter(v)) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func ListTargetsByRulePages(conn *cloudwatchevents.CloudWatchEvents, input *cloudwatchevents.ListTargetsByRuleInput, fn func(*cloudwatchevents.ListTargetsByRuleOutput, bool) bool) error {
 	return ListTargetsByRulePagesWithContext(context.Background(), conn, input, fn)
 }
@@ -71,3 +127,31 @@ func ListTargetsByRulePagesWithContext(ctx context.Context, conn *cloudwatcheven
 	}
 	return nil
 }
+
+func ListTargetsByRuleAll(conn *cloudwatchevents.CloudWatchEvents, input *cloudwatchevents.ListTargetsByRuleInput, filter func(*cloudwatchevents.Target) bool) ([]*cloudwatchevents.Target, error) {
+	return ListTargetsByRuleAllWithContext(context.Background(), conn, input, filter)
+}
+
+func ListTargetsByRuleAllWithContext(ctx context.Context, conn *cloudwatchevents.CloudWatchEvents, input *cloudwatchevents.ListTargetsByRuleInput, filter func(*cloudwatchevents.Target) bool) ([]*cloudwatchevents.Target, error) {
+	var output []*cloudwatchevents.Target
+
+	err := ListTargetsByRulePagesWithContext(ctx, conn, input, func(page *cloudwatchevents.ListTargetsByRuleOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Targets {
+			if v != nil && (filter == nil || filter(v)) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
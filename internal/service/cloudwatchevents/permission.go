@@ -22,6 +22,7 @@ import (
 func ResourcePermission() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePermissionCreate,
+		//lintignore:AWSR004
 		Read:   resourcePermissionRead,
 		Update: resourcePermissionUpdate,
 		Delete: resourcePermissionDelete,
@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -21,7 +20,7 @@ import (
 func TestAccCloudWatchEventsPermission_basic(t *testing.T) {
 	principal1 := "111111111111"
 	principal2 := "*"
-	statementID := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	statementID := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudwatch_event_permission.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -51,7 +50,7 @@ func TestAccCloudWatchEventsPermission_basic(t *testing.T) {
 				ExpectError: regexp.MustCompile(`must be between 1 and 64 characters`),
 			},
 			{
-				Config:      testAccCheckPermissionResourceBasicConfig(principal1, sdkacctest.RandString(65)),
+				Config:      testAccCheckPermissionResourceBasicConfig(principal1, acctest.RandString(65)),
 				ExpectError: regexp.MustCompile(`must be between 1 and 64 characters`),
 			},
 			{
@@ -91,8 +90,8 @@ func TestAccCloudWatchEventsPermission_basic(t *testing.T) {
 
 func TestAccCloudWatchEventsPermission_eventBusName(t *testing.T) {
 	principal1 := "111111111111"
-	statementID := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	busName := sdkacctest.RandomWithPrefix("tf-acc-test-bus")
+	statementID := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	busName := acctest.RandomWithPrefix("tf-acc-test-bus")
 
 	resourceName := "aws_cloudwatch_event_permission.test"
 
@@ -124,7 +123,7 @@ func TestAccCloudWatchEventsPermission_eventBusName(t *testing.T) {
 
 func TestAccCloudWatchEventsPermission_action(t *testing.T) {
 	principal := "111111111111"
-	statementID := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	statementID := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudwatch_event_permission.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -138,7 +137,7 @@ func TestAccCloudWatchEventsPermission_action(t *testing.T) {
 				ExpectError: regexp.MustCompile(`must be between 1 and 64 characters`),
 			},
 			{
-				Config:      testAccCheckPermissionResourceActionConfig(sdkacctest.RandString(65), principal, statementID),
+				Config:      testAccCheckPermissionResourceActionConfig(acctest.RandString(65), principal, statementID),
 				ExpectError: regexp.MustCompile(`must be between 1 and 64 characters`),
 			},
 			{
@@ -166,7 +165,7 @@ func TestAccCloudWatchEventsPermission_action(t *testing.T) {
 }
 
 func TestAccCloudWatchEventsPermission_condition(t *testing.T) {
-	statementID := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	statementID := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudwatch_event_permission.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -207,8 +206,8 @@ func TestAccCloudWatchEventsPermission_condition(t *testing.T) {
 func TestAccCloudWatchEventsPermission_multiple(t *testing.T) {
 	principal1 := "111111111111"
 	principal2 := "222222222222"
-	statementID1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	statementID2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	statementID1 := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	statementID2 := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName1 := "aws_cloudwatch_event_permission.test"
 	resourceName2 := "aws_cloudwatch_event_permission.test2"
 
@@ -244,7 +243,7 @@ func TestAccCloudWatchEventsPermission_multiple(t *testing.T) {
 func TestAccCloudWatchEventsPermission_disappears(t *testing.T) {
 	resourceName := "aws_cloudwatch_event_permission.test"
 	principal := "111111111111"
-	statementID := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	statementID := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -21,6 +21,7 @@ import (
 func ResourceTarget() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTargetCreate,
+		//lintignore:AWSR004
 		Read:   resourceTargetRead,
 		Update: resourceTargetUpdate,
 		Delete: resourceTargetDelete,
@@ -10,7 +10,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -24,11 +23,11 @@ func TestAccCloudWatchEventsTarget_basic(t *testing.T) {
 	snsTopicResourceName := "aws_sns_topic.test"
 
 	var v1, v2 events.Target
-	ruleName := sdkacctest.RandomWithPrefix("tf-acc-test-rule")
-	snsTopicName1 := sdkacctest.RandomWithPrefix("tf-acc-test-sns")
-	snsTopicName2 := sdkacctest.RandomWithPrefix("tf-acc-test-sns")
-	targetID1 := sdkacctest.RandomWithPrefix("tf-acc-test-target")
-	targetID2 := sdkacctest.RandomWithPrefix("tf-acc-test-target")
+	ruleName := acctest.RandomWithPrefix("tf-acc-test-rule")
+	snsTopicName1 := acctest.RandomWithPrefix("tf-acc-test-sns")
+	snsTopicName2 := acctest.RandomWithPrefix("tf-acc-test-sns")
+	targetID1 := acctest.RandomWithPrefix("tf-acc-test-target")
+	targetID2 := acctest.RandomWithPrefix("tf-acc-test-target")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -91,12 +90,12 @@ func TestAccCloudWatchEventsTarget_eventBusName(t *testing.T) {
 	resourceName := "aws_cloudwatch_event_target.test"
 
 	var v1, v2 events.Target
-	ruleName := sdkacctest.RandomWithPrefix("tf-acc-test-rule")
-	busName := sdkacctest.RandomWithPrefix("tf-acc-test-bus")
-	snsTopicName1 := sdkacctest.RandomWithPrefix("tf-acc-test-sns")
-	snsTopicName2 := sdkacctest.RandomWithPrefix("tf-acc-test-sns")
-	targetID1 := sdkacctest.RandomWithPrefix("tf-acc-test-target")
-	targetID2 := sdkacctest.RandomWithPrefix("tf-acc-test-target")
+	ruleName := acctest.RandomWithPrefix("tf-acc-test-rule")
+	busName := acctest.RandomWithPrefix("tf-acc-test-bus")
+	snsTopicName1 := acctest.RandomWithPrefix("tf-acc-test-sns")
+	snsTopicName2 := acctest.RandomWithPrefix("tf-acc-test-sns")
+	targetID1 := acctest.RandomWithPrefix("tf-acc-test-target")
+	targetID2 := acctest.RandomWithPrefix("tf-acc-test-target")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -141,10 +140,10 @@ func TestAccCloudWatchEventsTarget_eventBusARN(t *testing.T) {
 	resourceName := "aws_cloudwatch_event_target.test"
 
 	var target events.Target
-	ruleName := sdkacctest.RandomWithPrefix("tf-acc-test-rule")
-	targetID := sdkacctest.RandomWithPrefix("tf-acc-test-target")
-	originEventBusName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	destinationEventBusName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleName := acctest.RandomWithPrefix("tf-acc-test-rule")
+	targetID := acctest.RandomWithPrefix("tf-acc-test-target")
+	originEventBusName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	destinationEventBusName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -153,7 +152,7 @@ func TestAccCloudWatchEventsTarget_eventBusARN(t *testing.T) {
 		CheckDestroy: testAccCheckTargetDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccTargetEventBusARNConfig(ruleName, originEventBusName, targetID, destinationEventBusName, sdkacctest.RandomWithPrefix("tf-acc-test-target"), sdkacctest.RandomWithPrefix("tf-acc-test-target")),
+				Config: testAccTargetEventBusARNConfig(ruleName, originEventBusName, targetID, destinationEventBusName, acctest.RandomWithPrefix("tf-acc-test-target"), acctest.RandomWithPrefix("tf-acc-test-target")),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCloudWatchEventTargetExists(resourceName, &target),
 					resource.TestCheckResourceAttr(resourceName, "rule", ruleName),
@@ -177,8 +176,8 @@ func TestAccCloudWatchEventsTarget_generatedTargetID(t *testing.T) {
 	snsTopicResourceName := "aws_sns_topic.test"
 
 	var v events.Target
-	ruleName := sdkacctest.RandomWithPrefix("tf-acc-cw-event-rule-missing-target-id")
-	snsTopicName := sdkacctest.RandomWithPrefix("tf-acc")
+	ruleName := acctest.RandomWithPrefix("tf-acc-cw-event-rule-missing-target-id")
+	snsTopicName := acctest.RandomWithPrefix("tf-acc")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -211,9 +210,9 @@ func TestAccCloudWatchEventsTarget_RetryPolicy_deadLetter(t *testing.T) {
 	queueResourceName := "aws_sqs_queue.test"
 	var v events.Target
 
-	ruleName := sdkacctest.RandomWithPrefix("tf-acc-cw-event-rule-full")
-	ssmDocumentName := sdkacctest.RandomWithPrefix("tf_ssm_Document")
-	targetID := sdkacctest.RandomWithPrefix("tf-acc-cw-target-full")
+	ruleName := acctest.RandomWithPrefix("tf-acc-cw-event-rule-full")
+	ssmDocumentName := acctest.RandomWithPrefix("tf_ssm_Document")
+	targetID := acctest.RandomWithPrefix("tf-acc-cw-target-full")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -244,9 +243,9 @@ func TestAccCloudWatchEventsTarget_full(t *testing.T) {
 	kinesisStreamResourceName := "aws_kinesis_stream.test"
 	var v events.Target
 
-	ruleName := sdkacctest.RandomWithPrefix("tf-acc-cw-event-rule-full")
-	ssmDocumentName := sdkacctest.RandomWithPrefix("tf_ssm_Document")
-	targetID := sdkacctest.RandomWithPrefix("tf-acc-cw-target-full")
+	ruleName := acctest.RandomWithPrefix("tf-acc-cw-event-rule-full")
+	ssmDocumentName := acctest.RandomWithPrefix("tf_ssm_Document")
+	targetID := acctest.RandomWithPrefix("tf-acc-cw-target-full")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -278,9 +277,9 @@ func TestAccCloudWatchEventsTarget_full(t *testing.T) {
 func TestAccCloudWatchEventsTarget_disappears(t *testing.T) {
 	var v events.Target
 
-	ruleName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	snsTopicName := sdkacctest.RandomWithPrefix("tf-acc-test-sns")
-	targetID := sdkacctest.RandomWithPrefix("tf-acc-test-target")
+	ruleName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	snsTopicName := acctest.RandomWithPrefix("tf-acc-test-sns")
+	targetID := acctest.RandomWithPrefix("tf-acc-test-target")
 
 	resourceName := "aws_cloudwatch_event_target.test"
 
@@ -305,7 +304,7 @@ func TestAccCloudWatchEventsTarget_disappears(t *testing.T) {
 func TestAccCloudWatchEventsTarget_ssmDocument(t *testing.T) {
 	resourceName := "aws_cloudwatch_event_target.test"
 	var v events.Target
-	rName := sdkacctest.RandomWithPrefix("tf_ssm_Document")
+	rName := acctest.RandomWithPrefix("tf_ssm_Document")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -337,7 +336,7 @@ func TestAccCloudWatchEventsTarget_http(t *testing.T) {
 	resourceName := "aws_cloudwatch_event_target.test"
 
 	var v events.Target
-	rName := sdkacctest.RandomWithPrefix("tf_http_target")
+	rName := acctest.RandomWithPrefix("tf_http_target")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -373,7 +372,7 @@ func TestAccCloudWatchEventsTarget_ecs(t *testing.T) {
 	iamRoleResourceName := "aws_iam_role.test"
 	ecsTaskDefinitionResourceName := "aws_ecs_task_definition.task"
 	var v events.Target
-	rName := sdkacctest.RandomWithPrefix("tf_ecs_target")
+	rName := acctest.RandomWithPrefix("tf_ecs_target")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -408,7 +407,7 @@ func TestAccCloudWatchEventsTarget_redshift(t *testing.T) {
 	resourceName := "aws_cloudwatch_event_target.test"
 	iamRoleResourceName := "aws_iam_role.test"
 	var v events.Target
-	rName := sdkacctest.RandomWithPrefix("tf_ecs_target")
+	rName := acctest.RandomWithPrefix("tf_ecs_target")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -442,7 +441,7 @@ func TestAccCloudWatchEventsTarget_ecsWithBlankLaunchType(t *testing.T) {
 	iamRoleResourceName := "aws_iam_role.test"
 	ecsTaskDefinitionResourceName := "aws_ecs_task_definition.task"
 	var v events.Target
-	rName := sdkacctest.RandomWithPrefix("tf_ecs_target")
+	rName := acctest.RandomWithPrefix("tf_ecs_target")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -496,7 +495,7 @@ func TestAccCloudWatchEventsTarget_ecsWithBlankLaunchType(t *testing.T) {
 func TestAccCloudWatchEventsTarget_ecsWithBlankTaskCount(t *testing.T) {
 	resourceName := "aws_cloudwatch_event_target.test"
 	var v events.Target
-	rName := sdkacctest.RandomWithPrefix("tf_ecs_target")
+	rName := acctest.RandomWithPrefix("tf_ecs_target")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -525,7 +524,7 @@ func TestAccCloudWatchEventsTarget_ecsWithBlankTaskCount(t *testing.T) {
 func TestAccCloudWatchEventsTarget_ecsFull(t *testing.T) {
 	resourceName := "aws_cloudwatch_event_target.test"
 	var v events.Target
-	rName := sdkacctest.RandomWithPrefix("tf_ecs_target")
+	rName := acctest.RandomWithPrefix("tf_ecs_target")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -563,7 +562,7 @@ func TestAccCloudWatchEventsTarget_batch(t *testing.T) {
 	resourceName := "aws_cloudwatch_event_target.test"
 	batchJobDefinitionResourceName := "aws_batch_job_definition.test"
 	var v events.Target
-	rName := sdkacctest.RandomWithPrefix("tf_batch_target")
+	rName := acctest.RandomWithPrefix("tf_batch_target")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -593,7 +592,7 @@ func TestAccCloudWatchEventsTarget_batch(t *testing.T) {
 func TestAccCloudWatchEventsTarget_kinesis(t *testing.T) {
 	resourceName := "aws_cloudwatch_event_target.test"
 	var v events.Target
-	rName := sdkacctest.RandomWithPrefix("tf_kinesis_target")
+	rName := acctest.RandomWithPrefix("tf_kinesis_target")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -621,7 +620,7 @@ func TestAccCloudWatchEventsTarget_kinesis(t *testing.T) {
 func TestAccCloudWatchEventsTarget_sqs(t *testing.T) {
 	resourceName := "aws_cloudwatch_event_target.test"
 	var v events.Target
-	rName := sdkacctest.RandomWithPrefix("tf_sqs_target")
+	rName := acctest.RandomWithPrefix("tf_sqs_target")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -650,7 +649,7 @@ func TestAccCloudWatchEventsTarget_sqs(t *testing.T) {
 func TestAccCloudWatchEventsTarget_Input_transformer(t *testing.T) {
 	resourceName := "aws_cloudwatch_event_target.test"
 	var v events.Target
-	rName := sdkacctest.RandomWithPrefix("tf_input_transformer")
+	rName := acctest.RandomWithPrefix("tf_input_transformer")
 
 	tooManyInputPaths := make([]string, 101)
 	for i := range tooManyInputPaths {
@@ -706,7 +705,7 @@ func TestAccCloudWatchEventsTarget_Input_transformer(t *testing.T) {
 
 func TestAccCloudWatchEventsTarget_inputTransformerJSONString(t *testing.T) {
 	var target events.Target
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resourceName := "aws_cloudwatch_event_target.test"
 
@@ -738,7 +737,7 @@ func TestAccCloudWatchEventsTarget_partnerEventBus(t *testing.T) {
 	}
 
 	var target events.Target
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cloudwatch_event_target.test"
 	snsTopicResourceName := "aws_sns_topic.test"
 
@@ -20,6 +20,7 @@ func ResourceDestination() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDestinationPut,
 		Update: resourceDestinationPut,
+		//lintignore:AWSR004
 		Read:   resourceDestinationRead,
 		Delete: resourceDestinationDelete,
 
@@ -14,6 +14,7 @@ func ResourceDestinationPolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDestinationPolicyPut,
 		Update: resourceDestinationPolicyPut,
+		//lintignore:AWSR004
 		Read:   resourceDestinationPolicyRead,
 		Delete: resourceDestinationPolicyDelete,
 
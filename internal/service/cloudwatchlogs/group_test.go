@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 
 func TestAccCloudWatchLogsGroup_basic(t *testing.T) {
 	var lg cloudwatchlogs.LogGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_cloudwatch_log_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -74,7 +73,7 @@ func TestAccCloudWatchLogsGroup_namePrefix(t *testing.T) {
 
 func TestAccCloudWatchLogsGroup_NamePrefix_retention(t *testing.T) {
 	var lg cloudwatchlogs.LogGroup
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 	resourceName := "aws_cloudwatch_log_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -137,7 +136,7 @@ func TestAccCloudWatchLogsGroup_generatedName(t *testing.T) {
 
 func TestAccCloudWatchLogsGroup_retentionPolicy(t *testing.T) {
 	var lg cloudwatchlogs.LogGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_cloudwatch_log_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -172,7 +171,7 @@ func TestAccCloudWatchLogsGroup_retentionPolicy(t *testing.T) {
 
 func TestAccCloudWatchLogsGroup_multiple(t *testing.T) {
 	var lg cloudwatchlogs.LogGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_cloudwatch_log_group.alpha"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -204,7 +203,7 @@ func TestAccCloudWatchLogsGroup_multiple(t *testing.T) {
 
 func TestAccCloudWatchLogsGroup_disappears(t *testing.T) {
 	var lg cloudwatchlogs.LogGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_cloudwatch_log_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -227,7 +226,7 @@ func TestAccCloudWatchLogsGroup_disappears(t *testing.T) {
 
 func TestAccCloudWatchLogsGroup_tagging(t *testing.T) {
 	var lg cloudwatchlogs.LogGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_cloudwatch_log_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -290,7 +289,7 @@ func TestAccCloudWatchLogsGroup_tagging(t *testing.T) {
 
 func TestAccCloudWatchLogsGroup_kmsKey(t *testing.T) {
 	var lg cloudwatchlogs.LogGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_cloudwatch_log_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -21,6 +21,7 @@ import (
 func ResourceMetricFilter() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceMetricFilterUpdate,
+		//lintignore:AWSR004
 		Read:   resourceMetricFilterRead,
 		Update: resourceMetricFilterUpdate,
 		Delete: resourceMetricFilterDelete,
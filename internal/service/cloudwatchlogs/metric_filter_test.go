@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 
 func TestAccCloudWatchLogsMetricFilter_basic(t *testing.T) {
 	var mf cloudwatchlogs.MetricFilter
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_cloudwatch_log_metric_filter.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -109,7 +108,7 @@ func TestAccCloudWatchLogsMetricFilter_basic(t *testing.T) {
 
 func TestAccCloudWatchLogsMetricFilter_disappears(t *testing.T) {
 	var mf cloudwatchlogs.MetricFilter
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_cloudwatch_log_metric_filter.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -132,7 +131,7 @@ func TestAccCloudWatchLogsMetricFilter_disappears(t *testing.T) {
 
 func TestAccCloudWatchLogsMetricFilter_Disappears_logGroup(t *testing.T) {
 	var mf cloudwatchlogs.MetricFilter
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_cloudwatch_log_metric_filter.test"
 
 	resource.ParallelTest(t, resource.TestCase{
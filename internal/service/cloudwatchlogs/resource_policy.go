@@ -14,6 +14,7 @@ import (
 func ResourceResourcePolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceResourcePolicyPut,
+		//lintignore:AWSR004
 		Read:   resourceResourcePolicyRead,
 		Update: resourceResourcePolicyPut,
 		Delete: resourceResourcePolicyDelete,
@@ -19,6 +19,7 @@ import (
 func ResourceStream() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceStreamCreate,
+		//lintignore:AWSR004
 		Read:   resourceStreamRead,
 		Delete: resourceStreamDelete,
 		Importer: &schema.ResourceImporter{
@@ -21,6 +21,7 @@ import (
 func ResourceSubscriptionFilter() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSubscriptionFilterCreate,
+		//lintignore:AWSR004
 		Read:   resourceSubscriptionFilterRead,
 		Update: resourceSubscriptionFilterUpdate,
 		Delete: resourceSubscriptionFilterDelete,
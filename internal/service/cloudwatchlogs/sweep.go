@@ -135,7 +135,7 @@ func sweeplogQueryDefinitions(region string) error {
 
 			d.SetId(aws.StringValue(queryDefinition.QueryDefinitionId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_cloudwatch_query_definition", r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -19,6 +19,7 @@ import (
 func ResourceDomain() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDomainCreate,
+		//lintignore:AWSR004
 		Read:   resourceDomainRead,
 		Delete: resourceDomainDelete,
 		Update: resourceDomainUpdate,
@@ -17,6 +17,7 @@ func ResourceDomainPermissionsPolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDomainPermissionsPolicyPut,
 		Update: resourceDomainPermissionsPolicyPut,
+		//lintignore:AWSR004
 		Read:   resourceDomainPermissionsPolicyRead,
 		Delete: resourceDomainPermissionsPolicyDelete,
 		Importer: &schema.ResourceImporter{
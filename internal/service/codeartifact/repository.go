@@ -18,6 +18,7 @@ import (
 func ResourceRepository() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRepositoryCreate,
+		//lintignore:AWSR004
 		Read:   resourceRepositoryRead,
 		Update: resourceRepositoryUpdate,
 		Delete: resourceRepositoryDelete,
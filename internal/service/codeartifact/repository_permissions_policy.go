@@ -17,6 +17,7 @@ func ResourceRepositoryPermissionsPolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRepositoryPermissionsPolicyPut,
 		Update: resourceRepositoryPermissionsPolicyPut,
+		//lintignore:AWSR004
 		Read:   resourceRepositoryPermissionsPolicyRead,
 		Delete: resourceRepositoryPermissionsPolicyDelete,
 		Importer: &schema.ResourceImporter{
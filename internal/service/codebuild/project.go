@@ -27,6 +27,7 @@ import (
 func ResourceProject() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceProjectCreate,
+		//lintignore:AWSR004
 		Read:   resourceProjectRead,
 		Update: resourceProjectUpdate,
 		Delete: resourceProjectDelete,
@@ -17,6 +17,7 @@ import (
 func ResourceReportGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceReportGroupCreate,
+		//lintignore:AWSR004
 		Read:   resourceReportGroupRead,
 		Update: resourceReportGroupUpdate,
 		Delete: resourceReportGroupDelete,
@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/codebuild"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -15,7 +14,7 @@ import (
 
 func TestAccCodeBuildReportGroup_basic(t *testing.T) {
 	var reportGroup codebuild.ReportGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_codebuild_report_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -47,7 +46,7 @@ func TestAccCodeBuildReportGroup_basic(t *testing.T) {
 
 func TestAccCodeBuildReportGroup_Export_s3(t *testing.T) {
 	var reportGroup codebuild.ReportGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_codebuild_report_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -97,7 +96,7 @@ func TestAccCodeBuildReportGroup_Export_s3(t *testing.T) {
 
 func TestAccCodeBuildReportGroup_tags(t *testing.T) {
 	var reportGroup codebuild.ReportGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_codebuild_report_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -143,7 +142,7 @@ func TestAccCodeBuildReportGroup_tags(t *testing.T) {
 
 func TestAccCodeBuildReportGroup_deleteReports(t *testing.T) {
 	var reportGroup codebuild.ReportGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_codebuild_report_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -171,7 +170,7 @@ func TestAccCodeBuildReportGroup_deleteReports(t *testing.T) {
 
 func TestAccCodeBuildReportGroup_disappears(t *testing.T) {
 	var reportGroup codebuild.ReportGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_codebuild_report_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -15,6 +15,7 @@ import (
 func ResourceSourceCredential() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSourceCredentialCreate,
+		//lintignore:AWSR004
 		Read:   resourceSourceCredentialRead,
 		Delete: resourceSourceCredentialDelete,
 
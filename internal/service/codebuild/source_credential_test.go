@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codebuild"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 
 func TestAccCodeBuildSourceCredential_basic(t *testing.T) {
 	var sourceCredentialsInfo codebuild.SourceCredentialsInfo
-	token := sdkacctest.RandomWithPrefix("token")
+	token := acctest.RandomWithPrefix("token")
 	resourceName := "aws_codebuild_source_credential.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -55,7 +54,7 @@ func TestAccCodeBuildSourceCredential_basic(t *testing.T) {
 
 func TestAccCodeBuildSourceCredential_basicAuth(t *testing.T) {
 	var sourceCredentialsInfo codebuild.SourceCredentialsInfo
-	token := sdkacctest.RandomWithPrefix("token")
+	token := acctest.RandomWithPrefix("token")
 	resourceName := "aws_codebuild_source_credential.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -17,6 +17,7 @@ import (
 func ResourceWebhook() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceWebhookCreate,
+		//lintignore:AWSR004
 		Read:   resourceWebhookRead,
 		Delete: resourceWebhookDelete,
 		Update: resourceWebhookUpdate,
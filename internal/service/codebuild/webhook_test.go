@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codebuild"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 
 func TestAccCodeBuildWebhook_bitbucket(t *testing.T) {
 	var webhook codebuild.Webhook
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_codebuild_webhook.test"
 
 	sourceLocation := testAccBitbucketSourceLocationFromEnv()
@@ -51,7 +50,7 @@ func TestAccCodeBuildWebhook_bitbucket(t *testing.T) {
 
 func TestAccCodeBuildWebhook_gitHub(t *testing.T) {
 	var webhook codebuild.Webhook
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_codebuild_webhook.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -83,7 +82,7 @@ func TestAccCodeBuildWebhook_gitHub(t *testing.T) {
 
 func TestAccCodeBuildWebhook_gitHubEnterprise(t *testing.T) {
 	var webhook codebuild.Webhook
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_codebuild_webhook.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -132,7 +131,7 @@ func TestAccCodeBuildWebhook_gitHubEnterprise(t *testing.T) {
 
 func TestAccCodeBuildWebhook_buildType(t *testing.T) {
 	var webhook codebuild.Webhook
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_codebuild_webhook.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -174,7 +173,7 @@ func TestAccCodeBuildWebhook_buildType(t *testing.T) {
 
 func TestAccCodeBuildWebhook_branchFilter(t *testing.T) {
 	var webhook codebuild.Webhook
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_codebuild_webhook.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -209,7 +208,7 @@ func TestAccCodeBuildWebhook_branchFilter(t *testing.T) {
 
 func TestAccCodeBuildWebhook_filterGroup(t *testing.T) {
 	var webhook codebuild.Webhook
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_codebuild_webhook.test"
 
 	resource.ParallelTest(t, resource.TestCase{
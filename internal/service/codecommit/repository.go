@@ -18,6 +18,7 @@ func ResourceRepository() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRepositoryCreate,
 		Update: resourceRepositoryUpdate,
+		//lintignore:AWSR004
 		Read:   resourceRepositoryRead,
 		Delete: resourceRepositoryDelete,
 		Importer: &schema.ResourceImporter{
@@ -5,13 +5,12 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/codecommit"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
 func TestAccCodeCommitRepositoryDataSource_basic(t *testing.T) {
-	rName := fmt.Sprintf("tf-acctest-%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tf-acctest-%d", acctest.RandInt())
 	resourceName := "aws_codecommit_repository.default"
 	datasourceName := "data.aws_codecommit_repository.default"
 
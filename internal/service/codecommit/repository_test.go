@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/codecommit"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -15,7 +14,7 @@ import (
 )
 
 func TestAccCodeCommitRepository_basic(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_codecommit_repository.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -40,7 +39,7 @@ func TestAccCodeCommitRepository_basic(t *testing.T) {
 }
 
 func TestAccCodeCommitRepository_withChanges(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_codecommit_repository.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -75,7 +74,7 @@ func TestAccCodeCommitRepository_withChanges(t *testing.T) {
 }
 
 func TestAccCodeCommitRepository_CreateDefault_branch(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_codecommit_repository.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -103,7 +102,7 @@ func TestAccCodeCommitRepository_CreateDefault_branch(t *testing.T) {
 }
 
 func TestAccCodeCommitRepository_CreateAndUpdateDefault_branch(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_codecommit_repository.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -138,7 +137,7 @@ func TestAccCodeCommitRepository_CreateAndUpdateDefault_branch(t *testing.T) {
 }
 
 func TestAccCodeCommitRepository_tags(t *testing.T) {
-	rName := sdkacctest.RandString(10)
+	rName := acctest.RandString(10)
 	resourceName := "aws_codecommit_repository.test"
 
 	resource.ParallelTest(t, resource.TestCase{
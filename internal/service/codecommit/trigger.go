@@ -13,6 +13,7 @@ import (
 func ResourceTrigger() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTriggerCreate,
+		//lintignore:AWSR004
 		Read:   resourceTriggerRead,
 		Delete: resourceTriggerDelete,
 
@@ -19,6 +19,7 @@ import (
 func ResourceApp() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAppCreate,
+		//lintignore:AWSR004
 		Read:   resourceAppRead,
 		Update: resourceUpdate,
 		Delete: resourceAppDelete,
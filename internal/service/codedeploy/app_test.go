@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codedeploy"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func TestAccCodeDeployApp_basic(t *testing.T) {
 	var application1 codedeploy.ApplicationInfo
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_codedeploy_app.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -58,7 +57,7 @@ func TestAccCodeDeployApp_basic(t *testing.T) {
 
 func TestAccCodeDeployApp_computePlatform(t *testing.T) {
 	var application1, application2 codedeploy.ApplicationInfo
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_codedeploy_app.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -88,7 +87,7 @@ func TestAccCodeDeployApp_computePlatform(t *testing.T) {
 
 func TestAccCodeDeployApp_ComputePlatform_ecs(t *testing.T) {
 	var application1 codedeploy.ApplicationInfo
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_codedeploy_app.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -115,7 +114,7 @@ func TestAccCodeDeployApp_ComputePlatform_ecs(t *testing.T) {
 
 func TestAccCodeDeployApp_ComputePlatform_lambda(t *testing.T) {
 	var application1 codedeploy.ApplicationInfo
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_codedeploy_app.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -142,8 +141,8 @@ func TestAccCodeDeployApp_ComputePlatform_lambda(t *testing.T) {
 
 func TestAccCodeDeployApp_name(t *testing.T) {
 	var application1, application2 codedeploy.ApplicationInfo
-	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName1 := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_codedeploy_app.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -177,7 +176,7 @@ func TestAccCodeDeployApp_name(t *testing.T) {
 
 func TestAccCodeDeployApp_tags(t *testing.T) {
 	var application codedeploy.ApplicationInfo
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_codedeploy_app.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -222,7 +221,7 @@ func TestAccCodeDeployApp_tags(t *testing.T) {
 
 func TestAccCodeDeployApp_disappears(t *testing.T) {
 	var application1 codedeploy.ApplicationInfo
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_codedeploy_app.test"
 
 	resource.ParallelTest(t, resource.TestCase{
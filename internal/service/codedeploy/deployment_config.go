@@ -15,6 +15,7 @@ import (
 func ResourceDeploymentConfig() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDeploymentConfigCreate,
+		//lintignore:AWSR004
 		Read:   resourceDeploymentConfigRead,
 		Delete: resourceDeploymentConfigDelete,
 		Importer: &schema.ResourceImporter{
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codedeploy"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 func TestAccCodeDeployDeploymentConfig_basic(t *testing.T) {
 	var config1 codedeploy.DeploymentConfigInfo
 	resourceName := "aws_codedeploy_deployment_config.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -47,7 +46,7 @@ func TestAccCodeDeployDeploymentConfig_basic(t *testing.T) {
 func TestAccCodeDeployDeploymentConfig_fleetPercent(t *testing.T) {
 	var config1, config2 codedeploy.DeploymentConfigInfo
 	resourceName := "aws_codedeploy_deployment_config.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -90,7 +89,7 @@ func TestAccCodeDeployDeploymentConfig_fleetPercent(t *testing.T) {
 func TestAccCodeDeployDeploymentConfig_hostCount(t *testing.T) {
 	var config1, config2 codedeploy.DeploymentConfigInfo
 	resourceName := "aws_codedeploy_deployment_config.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -133,7 +132,7 @@ func TestAccCodeDeployDeploymentConfig_hostCount(t *testing.T) {
 func TestAccCodeDeployDeploymentConfig_trafficCanary(t *testing.T) {
 	var config1, config2 codedeploy.DeploymentConfigInfo
 	resourceName := "aws_codedeploy_deployment_config.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -182,7 +181,7 @@ func TestAccCodeDeployDeploymentConfig_trafficCanary(t *testing.T) {
 func TestAccCodeDeployDeploymentConfig_trafficLinear(t *testing.T) {
 	var config1, config2 codedeploy.DeploymentConfigInfo
 	resourceName := "aws_codedeploy_deployment_config.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -26,6 +26,7 @@ import (
 func ResourceDeploymentGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDeploymentGroupCreate,
+		//lintignore:AWSR004
 		Read:   resourceDeploymentGroupRead,
 		Update: resourceDeploymentGroupUpdate,
 		Delete: resourceDeploymentGroupDelete,
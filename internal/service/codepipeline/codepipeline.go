@@ -33,6 +33,7 @@ const (
 func ResourceCodePipeline() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCodePipelineCreate,
+		//lintignore:AWSR004
 		Read:   resourceCodePipelineRead,
 		Update: resourceCodePipelineUpdate,
 		Delete: resourceCodePipelineDelete,
@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/codepipeline"
 	"github.com/aws/aws-sdk-go/service/codestarconnections"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -20,7 +19,7 @@ import (
 
 func TestAccCodePipeline_basic(t *testing.T) {
 	var p1, p2 codepipeline.PipelineDeclaration
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 	codestarConnectionResourceName := "aws_codestarconnections_connection.test"
 
@@ -126,7 +125,7 @@ func TestAccCodePipeline_basic(t *testing.T) {
 
 func TestAccCodePipeline_disappears(t *testing.T) {
 	var p codepipeline.PipelineDeclaration
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -153,7 +152,7 @@ func TestAccCodePipeline_disappears(t *testing.T) {
 
 func TestAccCodePipeline_emptyStageArtifacts(t *testing.T) {
 	var p codepipeline.PipelineDeclaration
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -195,7 +194,7 @@ func TestAccCodePipeline_emptyStageArtifacts(t *testing.T) {
 
 func TestAccCodePipeline_deployWithServiceRole(t *testing.T) {
 	var p codepipeline.PipelineDeclaration
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -228,7 +227,7 @@ func TestAccCodePipeline_deployWithServiceRole(t *testing.T) {
 
 func TestAccCodePipeline_tags(t *testing.T) {
 	var p1, p2, p3 codepipeline.PipelineDeclaration
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -287,7 +286,7 @@ func TestAccCodePipeline_MultiRegion_basic(t *testing.T) {
 	resourceName := "aws_codepipeline.test"
 	var providers []*schema.Provider
 
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -329,7 +328,7 @@ func TestAccCodePipeline_MultiRegion_update(t *testing.T) {
 	resourceName := "aws_codepipeline.test"
 	var providers []*schema.Provider
 
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -385,7 +384,7 @@ func TestAccCodePipeline_MultiRegion_convertSingleRegion(t *testing.T) {
 	resourceName := "aws_codepipeline.test"
 	var providers []*schema.Provider
 
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -448,7 +447,7 @@ func TestAccCodePipeline_MultiRegion_convertSingleRegion(t *testing.T) {
 
 func TestAccCodePipeline_withNamespace(t *testing.T) {
 	var p1 codepipeline.PipelineDeclaration
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -482,7 +481,7 @@ func TestAccCodePipeline_withGitHubV1SourceAction(t *testing.T) {
 	githubToken := conns.SkipIfEnvVarEmpty(t, conns.EnvVarGithubToken, "token with GitHub permissions to repository for CodePipeline source configuration")
 
 	var v codepipeline.PipelineDeclaration
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_codepipeline.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -18,6 +18,7 @@ import (
 func ResourceWebhook() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceWebhookCreate,
+		//lintignore:AWSR004
 		Read:   resourceWebhookRead,
 		Update: resourceWebhookUpdate,
 		Delete: resourceWebhookDelete,
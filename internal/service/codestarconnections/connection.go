@@ -17,6 +17,7 @@ import (
 func ResourceConnection() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceConnectionCreate,
+		//lintignore:AWSR004
 		Read:   resourceConnectionRead,
 		Update: resourceConnectionUpdate,
 		Delete: resourceConnectionDelete,
@@ -27,6 +27,7 @@ const (
 func ResourceNotificationRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNotificationRuleCreate,
+		//lintignore:AWSR004
 		Read:   resourceNotificationRuleRead,
 		Update: resourceNotificationRuleUpdate,
 		Delete: resourceNotificationRuleDelete,
@@ -18,6 +18,7 @@ import (
 func ResourcePool() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePoolCreate,
+		//lintignore:AWSR004
 		Read:   resourcePoolRead,
 		Update: resourcePoolUpdate,
 		Delete: resourcePoolDelete,
@@ -16,6 +16,7 @@ import (
 func ResourcePoolRolesAttachment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePoolRolesAttachmentCreate,
+		//lintignore:AWSR004
 		Read:   resourcePoolRolesAttachmentRead,
 		Update: resourcePoolRolesAttachmentUpdate,
 		Delete: resourcePoolRolesAttachmentDelete,
@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,8 +16,8 @@ import (
 )
 
 func TestAccCognitoIdentityPool_basic(t *testing.T) {
-	name := sdkacctest.RandString(10)
-	updatedName := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
+	updatedName := acctest.RandString(10)
 	resourceName := "aws_cognito_identity_pool.main"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -53,7 +52,7 @@ func TestAccCognitoIdentityPool_basic(t *testing.T) {
 }
 
 func TestAccCognitoIdentityPool_supportedLoginProviders(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_cognito_identity_pool.main"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -96,7 +95,7 @@ func TestAccCognitoIdentityPool_supportedLoginProviders(t *testing.T) {
 }
 
 func TestAccCognitoIdentityPool_openidConnectProviderARNs(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_cognito_identity_pool.main"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -138,7 +137,7 @@ func TestAccCognitoIdentityPool_openidConnectProviderARNs(t *testing.T) {
 }
 
 func TestAccCognitoIdentityPool_samlProviderARNs(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_cognito_identity_pool.main"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -181,7 +180,7 @@ func TestAccCognitoIdentityPool_samlProviderARNs(t *testing.T) {
 }
 
 func TestAccCognitoIdentityPool_cognitoIdentityProviders(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_cognito_identity_pool.main"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -236,7 +235,7 @@ func TestAccCognitoIdentityPool_cognitoIdentityProviders(t *testing.T) {
 }
 
 func TestAccCognitoIdentityPool_addingNewProviderKeepsOldProvider(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_cognito_identity_pool.main"
 
 	resource.Test(t, resource.TestCase{
@@ -281,7 +280,7 @@ func TestAccCognitoIdentityPool_addingNewProviderKeepsOldProvider(t *testing.T)
 }
 
 func TestAccCognitoIdentityPool_tags(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_cognito_identity_pool.main"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -18,6 +18,7 @@ import (
 func ResourceIdentityProvider() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceIdentityProviderCreate,
+		//lintignore:AWSR004
 		Read:   resourceIdentityProviderRead,
 		Update: resourceIdentityProviderUpdate,
 		Delete: resourceIdentityProviderDelete,
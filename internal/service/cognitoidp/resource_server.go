@@ -16,6 +16,7 @@ import (
 func ResourceResourceServer() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceResourceServerCreate,
+		//lintignore:AWSR004
 		Read:   resourceResourceServerRead,
 		Update: resourceResourceServerUpdate,
 		Delete: resourceResourceServerDelete,
@@ -18,6 +18,7 @@ import (
 func ResourceUserGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceUserGroupCreate,
+		//lintignore:AWSR004
 		Read:   resourceUserGroupRead,
 		Update: resourceUserGroupUpdate,
 		Delete: resourceUserGroupDelete,
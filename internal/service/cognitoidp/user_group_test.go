@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,9 +15,9 @@ import (
 )
 
 func TestAccCognitoIDPUserGroup_basic(t *testing.T) {
-	poolName := fmt.Sprintf("tf-acc-%s", sdkacctest.RandString(10))
-	groupName := fmt.Sprintf("tf-acc-%s", sdkacctest.RandString(10))
-	updatedGroupName := fmt.Sprintf("tf-acc-%s", sdkacctest.RandString(10))
+	poolName := fmt.Sprintf("tf-acc-%s", acctest.RandString(10))
+	groupName := fmt.Sprintf("tf-acc-%s", acctest.RandString(10))
+	updatedGroupName := fmt.Sprintf("tf-acc-%s", acctest.RandString(10))
 	resourceName := "aws_cognito_user_group.main"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -51,9 +50,9 @@ func TestAccCognitoIDPUserGroup_basic(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserGroup_complex(t *testing.T) {
-	poolName := fmt.Sprintf("tf-acc-%s", sdkacctest.RandString(10))
-	groupName := fmt.Sprintf("tf-acc-%s", sdkacctest.RandString(10))
-	updatedGroupName := fmt.Sprintf("tf-acc-%s", sdkacctest.RandString(10))
+	poolName := fmt.Sprintf("tf-acc-%s", acctest.RandString(10))
+	groupName := fmt.Sprintf("tf-acc-%s", acctest.RandString(10))
+	updatedGroupName := fmt.Sprintf("tf-acc-%s", acctest.RandString(10))
 	resourceName := "aws_cognito_user_group.main"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -92,7 +91,7 @@ func TestAccCognitoIDPUserGroup_complex(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserGroup_roleARN(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_cognito_user_group.main"
 
 	resource.ParallelTest(t, resource.TestCase{
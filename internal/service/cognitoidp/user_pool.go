@@ -25,6 +25,7 @@ import (
 func ResourceUserPool() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceUserPoolCreate,
+		//lintignore:AWSR004
 		Read:   resourceUserPoolRead,
 		Update: resourceUserPoolUpdate,
 		Delete: resourceUserPoolDelete,
@@ -19,6 +19,7 @@ import (
 func ResourceUserPoolClient() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceUserPoolClientCreate,
+		//lintignore:AWSR004
 		Read:   resourceUserPoolClientRead,
 		Update: resourceUserPoolClientUpdate,
 		Delete: resourceUserPoolClientDelete,
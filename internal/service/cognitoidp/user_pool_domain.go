@@ -17,6 +17,7 @@ import (
 func ResourceUserPoolDomain() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceUserPoolDomainCreate,
+		//lintignore:AWSR004
 		Read:   resourceUserPoolDomainRead,
 		Delete: resourceUserPoolDomainDelete,
 		Importer: &schema.ResourceImporter{
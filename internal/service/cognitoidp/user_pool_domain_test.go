@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,8 +17,8 @@ import (
 )
 
 func TestAccCognitoIDPUserPoolDomain_basic(t *testing.T) {
-	domainName := fmt.Sprintf("tf-acc-test-domain-%d", sdkacctest.RandInt())
-	poolName := fmt.Sprintf("tf-acc-test-pool-%s", sdkacctest.RandString(10))
+	domainName := fmt.Sprintf("tf-acc-test-domain-%d", acctest.RandInt())
+	poolName := fmt.Sprintf("tf-acc-test-pool-%s", acctest.RandString(10))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckIdentityProvider(t) },
@@ -51,7 +50,7 @@ func TestAccCognitoIDPUserPoolDomain_basic(t *testing.T) {
 func TestAccCognitoIDPUserPoolDomain_custom(t *testing.T) {
 	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
 	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
-	poolName := fmt.Sprintf("tf-acc-test-pool-%s", sdkacctest.RandString(10))
+	poolName := fmt.Sprintf("tf-acc-test-pool-%s", acctest.RandString(10))
 
 	acmCertificateResourceName := "aws_acm_certificate.test"
 	cognitoUserPoolResourceName := "aws_cognito_user_pool.test"
@@ -87,8 +86,8 @@ func TestAccCognitoIDPUserPoolDomain_custom(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPoolDomain_disappears(t *testing.T) {
-	domainName := fmt.Sprintf("tf-acc-test-domain-%d", sdkacctest.RandInt())
-	poolName := fmt.Sprintf("tf-acc-test-pool-%s", sdkacctest.RandString(10))
+	domainName := fmt.Sprintf("tf-acc-test-domain-%d", acctest.RandInt())
+	poolName := fmt.Sprintf("tf-acc-test-pool-%s", acctest.RandString(10))
 	resourceName := "aws_cognito_user_pool_domain.main"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -11,7 +11,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -31,7 +30,7 @@ func testAccErrorCheckSkipCognitoIdentityProvider(t *testing.T) resource.ErrorCh
 }
 
 func TestAccCognitoIDPUserPool_basic(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cognito_user_pool.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -81,7 +80,7 @@ func TestAccCognitoIDPUserPool_basic(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_recovery(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cognito_user_pool.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -127,7 +126,7 @@ func TestAccCognitoIDPUserPool_recovery(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_withAdminCreateUser(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cognito_user_pool.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -166,7 +165,7 @@ func TestAccCognitoIDPUserPool_withAdminCreateUser(t *testing.T) {
 
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/11858
 func TestAccCognitoIDPUserPool_withAdminCreateUserAndPasswordPolicy(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cognito_user_pool.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -193,7 +192,7 @@ func TestAccCognitoIDPUserPool_withAdminCreateUserAndPasswordPolicy(t *testing.T
 }
 
 func TestAccCognitoIDPUserPool_withAdvancedSecurityMode(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cognito_user_pool.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -231,7 +230,7 @@ func TestAccCognitoIDPUserPool_withAdvancedSecurityMode(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_withDevice(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cognito_user_pool.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -265,11 +264,11 @@ func TestAccCognitoIDPUserPool_withDevice(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_withEmailVerificationMessage(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	subject := sdkacctest.RandString(10)
-	updatedSubject := sdkacctest.RandString(10)
-	message := fmt.Sprintf("%s {####}", sdkacctest.RandString(10))
-	upatedMessage := fmt.Sprintf("%s {####}", sdkacctest.RandString(10))
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	subject := acctest.RandString(10)
+	updatedSubject := acctest.RandString(10)
+	message := fmt.Sprintf("%s {####}", acctest.RandString(10))
+	upatedMessage := fmt.Sprintf("%s {####}", acctest.RandString(10))
 	resourceName := "aws_cognito_user_pool.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -303,7 +302,7 @@ func TestAccCognitoIDPUserPool_withEmailVerificationMessage(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_MFA_sms(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	iamRoleResourceName := "aws_iam_role.test"
 	resourceName := "aws_cognito_user_pool.test"
 
@@ -351,7 +350,7 @@ func TestAccCognitoIDPUserPool_MFA_sms(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_MFA_smsAndSoftwareTokenMFA(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	iamRoleResourceName := "aws_iam_role.test"
 	resourceName := "aws_cognito_user_pool.test"
 
@@ -401,7 +400,7 @@ func TestAccCognitoIDPUserPool_MFA_smsAndSoftwareTokenMFA(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_MFA_smsToSoftwareTokenMFA(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	iamRoleResourceName := "aws_iam_role.test"
 	resourceName := "aws_cognito_user_pool.test"
 
@@ -440,7 +439,7 @@ func TestAccCognitoIDPUserPool_MFA_smsToSoftwareTokenMFA(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_MFA_softwareTokenMFA(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cognito_user_pool.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -485,7 +484,7 @@ func TestAccCognitoIDPUserPool_MFA_softwareTokenMFA(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_MFA_softwareTokenMFAToSMS(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	iamRoleResourceName := "aws_iam_role.test"
 	resourceName := "aws_cognito_user_pool.test"
 
@@ -524,7 +523,7 @@ func TestAccCognitoIDPUserPool_MFA_softwareTokenMFAToSMS(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_smsAuthenticationMessage(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	smsAuthenticationMessage1 := "test authentication message {####}"
 	smsAuthenticationMessage2 := "test authentication message updated {####}"
 	resourceName := "aws_cognito_user_pool.test"
@@ -559,7 +558,7 @@ func TestAccCognitoIDPUserPool_smsAuthenticationMessage(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_sms(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	iamRoleResourceName := "aws_iam_role.test"
 	resourceName := "aws_cognito_user_pool.test"
 
@@ -604,7 +603,7 @@ func TestAccCognitoIDPUserPool_sms(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_SMS_externalID(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	iamRoleResourceName := "aws_iam_role.test"
 	resourceName := "aws_cognito_user_pool.test"
 
@@ -642,7 +641,7 @@ func TestAccCognitoIDPUserPool_SMS_externalID(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_SMS_snsCallerARN(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	iamRoleResourceName := "aws_iam_role.test"
 	resourceName := "aws_cognito_user_pool.test"
 
@@ -680,7 +679,7 @@ func TestAccCognitoIDPUserPool_SMS_snsCallerARN(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_smsVerificationMessage(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	smsVerificationMessage1 := "test verification message {####}"
 	smsVerificationMessage2 := "test verification message updated {####}"
 	resourceName := "aws_cognito_user_pool.test"
@@ -715,7 +714,7 @@ func TestAccCognitoIDPUserPool_smsVerificationMessage(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_withEmail(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cognito_user_pool.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -743,7 +742,7 @@ func TestAccCognitoIDPUserPool_withEmail(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_withEmailSource(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	replyTo := acctest.DefaultEmailAddress
 	resourceName := "aws_cognito_user_pool.test"
 	resourceName2 := "aws_ses_configuration_set.test"
@@ -776,7 +775,7 @@ func TestAccCognitoIDPUserPool_withEmailSource(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_withTags(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cognito_user_pool.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -819,7 +818,7 @@ func TestAccCognitoIDPUserPool_withTags(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_withAliasAttributes(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cognito_user_pool.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -857,7 +856,7 @@ func TestAccCognitoIDPUserPool_withAliasAttributes(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_withUsernameAttributes(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cognito_user_pool.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -895,7 +894,7 @@ func TestAccCognitoIDPUserPool_withUsernameAttributes(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_withPasswordPolicy(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cognito_user_pool.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -939,7 +938,7 @@ func TestAccCognitoIDPUserPool_withPasswordPolicy(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_withUsername(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cognito_user_pool.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -974,7 +973,7 @@ func TestAccCognitoIDPUserPool_withUsername(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_withLambda(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cognito_user_pool.test"
 	lambdaResourceName := "aws_lambda_function.test"
 	lambdaUpdatedResourceName := "aws_lambda_function.second"
@@ -1044,7 +1043,7 @@ func testAccCheckUserPoolNotRecreated(pool1, pool2 *cognitoidentityprovider.Desc
 }
 
 func TestAccCognitoIDPUserPool_WithLambda_email(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cognito_user_pool.test"
 	lambdaResourceName := "aws_lambda_function.test"
 	lambdaUpdatedResourceName := "aws_lambda_function.second"
@@ -1084,7 +1083,7 @@ func TestAccCognitoIDPUserPool_WithLambda_email(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_WithLambda_sms(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cognito_user_pool.test"
 	lambdaResourceName := "aws_lambda_function.test"
 	lambdaUpdatedResourceName := "aws_lambda_function.second"
@@ -1125,7 +1124,7 @@ func TestAccCognitoIDPUserPool_WithLambda_sms(t *testing.T) {
 
 func TestAccCognitoIDPUserPool_schemaAttributes(t *testing.T) {
 	var pool1, pool2 cognitoidentityprovider.DescribeUserPoolOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cognito_user_pool.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1210,7 +1209,7 @@ func TestAccCognitoIDPUserPool_schemaAttributes(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_schemaAttributesRemoved(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckIdentityProvider(t) },
@@ -1230,7 +1229,7 @@ func TestAccCognitoIDPUserPool_schemaAttributesRemoved(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_schemaAttributesModified(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckIdentityProvider(t) },
@@ -1250,7 +1249,7 @@ func TestAccCognitoIDPUserPool_schemaAttributesModified(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_withVerificationMessageTemplate(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cognito_user_pool.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1304,11 +1303,11 @@ func TestAccCognitoIDPUserPool_withVerificationMessageTemplate(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_update(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	optionalMfa := "OPTIONAL"
 	offMfa := "OFF"
-	authenticationMessage := fmt.Sprintf("%s {####}", sdkacctest.RandString(10))
-	updatedAuthenticationMessage := fmt.Sprintf("%s {####}", sdkacctest.RandString(10))
+	authenticationMessage := fmt.Sprintf("%s {####}", acctest.RandString(10))
+	updatedAuthenticationMessage := fmt.Sprintf("%s {####}", acctest.RandString(10))
 	resourceName := "aws_cognito_user_pool.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1393,7 +1392,7 @@ func TestAccCognitoIDPUserPool_update(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserPool_disappears(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cognito_user_pool.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -16,6 +16,7 @@ import (
 func ResourceAggregateAuthorization() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAggregateAuthorizationPut,
+		//lintignore:AWSR004
 		Read:   resourceAggregateAuthorizationRead,
 		Update: resourceAggregateAuthorizationUpdate,
 		Delete: resourceAggregateAuthorizationDelete,
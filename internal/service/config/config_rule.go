@@ -23,6 +23,7 @@ import (
 func ResourceConfigRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRulePutConfig,
+		//lintignore:AWSR004
 		Read:   resourceConfigRuleRead,
 		Update: resourceRulePutConfig,
 		Delete: resourceConfigRuleDelete,
@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/configservice"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 
 func testAccConfigConfigRule_basic(t *testing.T) {
 	var cr configservice.ConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_config_rule.test"
 
 	resource.Test(t, resource.TestCase{
@@ -42,7 +41,7 @@ func testAccConfigConfigRule_basic(t *testing.T) {
 
 func testAccConfigConfigRule_ownerAws(t *testing.T) {
 	var cr configservice.ConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_config_rule.test"
 
 	resource.Test(t, resource.TestCase{
@@ -76,7 +75,7 @@ func testAccConfigConfigRule_ownerAws(t *testing.T) {
 
 func testAccConfigConfigRule_customlambda(t *testing.T) {
 	var cr configservice.ConfigRule
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_config_config_rule.test"
 
 	expectedName := fmt.Sprintf("tf-acc-test-%d", rInt)
@@ -118,7 +117,7 @@ func testAccConfigConfigRule_customlambda(t *testing.T) {
 
 func testAccConfigConfigRule_importAws(t *testing.T) {
 	resourceName := "aws_config_config_rule.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -141,7 +140,7 @@ func testAccConfigConfigRule_importAws(t *testing.T) {
 
 func testAccConfigConfigRule_importLambda(t *testing.T) {
 	resourceName := "aws_config_config_rule.test"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 
 	path := "test-fixtures/lambdatest.zip"
 
@@ -166,7 +165,7 @@ func testAccConfigConfigRule_importLambda(t *testing.T) {
 
 func testAccConfigConfigRule_Scope_TagKey(t *testing.T) {
 	var configRule configservice.ConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_config_rule.test"
 
 	resource.Test(t, resource.TestCase{
@@ -197,7 +196,7 @@ func testAccConfigConfigRule_Scope_TagKey(t *testing.T) {
 
 func testAccConfigConfigRule_Scope_TagKey_Empty(t *testing.T) {
 	var configRule configservice.ConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_config_rule.test"
 
 	resource.Test(t, resource.TestCase{
@@ -218,7 +217,7 @@ func testAccConfigConfigRule_Scope_TagKey_Empty(t *testing.T) {
 
 func testAccConfigConfigRule_Scope_TagValue(t *testing.T) {
 	var configRule configservice.ConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_config_rule.test"
 
 	resource.Test(t, resource.TestCase{
@@ -250,7 +249,7 @@ func testAccConfigConfigRule_Scope_TagValue(t *testing.T) {
 func testAccConfigConfigRule_tags(t *testing.T) {
 	var cr configservice.ConfigRule
 	resourceName := "aws_config_config_rule.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -19,6 +19,7 @@ import (
 func ResourceConfigurationAggregator() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceConfigurationAggregatorPut,
+		//lintignore:AWSR004
 		Read:   resourceConfigurationAggregatorRead,
 		Update: resourceConfigurationAggregatorPut,
 		Delete: resourceConfigurationAggregatorDelete,
@@ -16,6 +16,7 @@ import (
 func ResourceConfigurationRecorder() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceConfigurationRecorderPut,
+		//lintignore:AWSR004
 		Read:   resourceConfigurationRecorderRead,
 		Update: resourceConfigurationRecorderPut,
 		Delete: resourceConfigurationRecorderDelete,
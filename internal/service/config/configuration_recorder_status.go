@@ -14,6 +14,7 @@ import (
 func ResourceConfigurationRecorderStatus() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceConfigurationRecorderStatusPut,
+		//lintignore:AWSR004
 		Read:   resourceConfigurationRecorderStatusRead,
 		Update: resourceConfigurationRecorderStatusPut,
 		Delete: resourceConfigurationRecorderStatusDelete,
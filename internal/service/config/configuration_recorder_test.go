@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/configservice"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -15,7 +14,7 @@ import (
 
 func testAccConfigConfigurationRecorder_basic(t *testing.T) {
 	var cr configservice.ConfigurationRecorder
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	expectedName := fmt.Sprintf("tf-acc-test-%d", rInt)
 	expectedRoleName := fmt.Sprintf("tf-acc-test-awsconfig-%d", rInt)
 
@@ -42,7 +41,7 @@ func testAccConfigConfigurationRecorder_basic(t *testing.T) {
 
 func testAccConfigConfigurationRecorder_allParams(t *testing.T) {
 	var cr configservice.ConfigurationRecorder
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	expectedName := fmt.Sprintf("tf-acc-test-%d", rInt)
 	expectedRoleName := fmt.Sprintf("tf-acc-test-awsconfig-%d", rInt)
 
@@ -73,7 +72,7 @@ func testAccConfigConfigurationRecorder_allParams(t *testing.T) {
 
 func testAccConfigConfigurationRecorder_importBasic(t *testing.T) {
 	resourceName := "aws_config_configuration_recorder.foo"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -21,6 +21,7 @@ import (
 func ResourceDeliveryChannel() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDeliveryChannelPut,
+		//lintignore:AWSR004
 		Read:   resourceDeliveryChannelRead,
 		Update: resourceDeliveryChannelPut,
 		Delete: resourceDeliveryChannelDelete,
@@ -19,6 +19,7 @@ func ResourceOrganizationCustomRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceOrganizationCustomRuleCreate,
 		Delete: resourceOrganizationCustomRuleDelete,
+		//lintignore:AWSR004
 		Read:   resourceOrganizationCustomRuleRead,
 		Update: resourceOrganizationCustomRuleUpdate,
 
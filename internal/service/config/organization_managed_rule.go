@@ -19,6 +19,7 @@ func ResourceOrganizationManagedRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceOrganizationManagedRuleCreate,
 		Delete: resourceOrganizationManagedRuleDelete,
+		//lintignore:AWSR004
 		Read:   resourceOrganizationManagedRuleRead,
 		Update: resourceOrganizationManagedRuleUpdate,
 
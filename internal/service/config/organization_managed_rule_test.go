@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 
 func testAccConfigOrganizationManagedRule_basic(t *testing.T) {
 	var rule configservice.OrganizationConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_organization_managed_rule.test"
 
 	resource.Test(t, resource.TestCase{
@@ -54,7 +53,7 @@ func testAccConfigOrganizationManagedRule_basic(t *testing.T) {
 
 func testAccConfigOrganizationManagedRule_disappears(t *testing.T) {
 	var rule configservice.OrganizationConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_organization_managed_rule.test"
 
 	resource.Test(t, resource.TestCase{
@@ -76,7 +75,7 @@ func testAccConfigOrganizationManagedRule_disappears(t *testing.T) {
 }
 
 func testAccConfigOrganizationManagedRule_errorHandling(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
@@ -94,7 +93,7 @@ func testAccConfigOrganizationManagedRule_errorHandling(t *testing.T) {
 
 func testAccConfigOrganizationManagedRule_Description(t *testing.T) {
 	var rule configservice.OrganizationConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_organization_managed_rule.test"
 
 	resource.Test(t, resource.TestCase{
@@ -128,7 +127,7 @@ func testAccConfigOrganizationManagedRule_Description(t *testing.T) {
 
 func testAccConfigOrganizationManagedRule_ExcludedAccounts(t *testing.T) {
 	var rule configservice.OrganizationConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_organization_managed_rule.test"
 
 	resource.Test(t, resource.TestCase{
@@ -162,7 +161,7 @@ func testAccConfigOrganizationManagedRule_ExcludedAccounts(t *testing.T) {
 
 func testAccConfigOrganizationManagedRule_InputParameters(t *testing.T) {
 	var rule configservice.OrganizationConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_organization_managed_rule.test"
 
 	inputParameters1 := `{"tag1Key":"CostCenter", "tag2Key":"Owner"}`
@@ -199,7 +198,7 @@ func testAccConfigOrganizationManagedRule_InputParameters(t *testing.T) {
 
 func testAccConfigOrganizationManagedRule_MaximumExecutionFrequency(t *testing.T) {
 	var rule configservice.OrganizationConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_organization_managed_rule.test"
 
 	resource.Test(t, resource.TestCase{
@@ -233,7 +232,7 @@ func testAccConfigOrganizationManagedRule_MaximumExecutionFrequency(t *testing.T
 
 func testAccConfigOrganizationManagedRule_ResourceIdScope(t *testing.T) {
 	var rule configservice.OrganizationConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_organization_managed_rule.test"
 
 	resource.Test(t, resource.TestCase{
@@ -267,7 +266,7 @@ func testAccConfigOrganizationManagedRule_ResourceIdScope(t *testing.T) {
 
 func testAccConfigOrganizationManagedRule_ResourceTypesScope(t *testing.T) {
 	var rule configservice.OrganizationConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_organization_managed_rule.test"
 
 	resource.Test(t, resource.TestCase{
@@ -301,7 +300,7 @@ func testAccConfigOrganizationManagedRule_ResourceTypesScope(t *testing.T) {
 
 func testAccConfigOrganizationManagedRule_RuleIdentifier(t *testing.T) {
 	var rule configservice.OrganizationConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_organization_managed_rule.test"
 
 	resource.Test(t, resource.TestCase{
@@ -335,7 +334,7 @@ func testAccConfigOrganizationManagedRule_RuleIdentifier(t *testing.T) {
 
 func testAccConfigOrganizationManagedRule_TagKeyScope(t *testing.T) {
 	var rule configservice.OrganizationConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_organization_managed_rule.test"
 
 	resource.Test(t, resource.TestCase{
@@ -369,7 +368,7 @@ func testAccConfigOrganizationManagedRule_TagKeyScope(t *testing.T) {
 
 func testAccConfigOrganizationManagedRule_TagValueScope(t *testing.T) {
 	var rule configservice.OrganizationConfigRule
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_config_organization_managed_rule.test"
 
 	resource.Test(t, resource.TestCase{
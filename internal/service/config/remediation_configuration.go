@@ -23,6 +23,7 @@ const (
 func ResourceRemediationConfiguration() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRemediationConfigurationPut,
+		//lintignore:AWSR004
 		Read:   resourceRemediationConfigurationRead,
 		Update: resourceRemediationConfigurationPut,
 		Delete: resourceRemediationConfigurationDelete,
@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/configservice"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 func testAccConfigRemediationConfiguration_basic(t *testing.T) {
 	var rc configservice.RemediationConfiguration
 	resourceName := "aws_config_remediation_configuration.test"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	prefix := "Original"
 	sseAlgorithm := "AES256"
 	expectedName := fmt.Sprintf("%s-tf-acc-test-%d", prefix, rInt)
@@ -50,7 +49,7 @@ func testAccConfigRemediationConfiguration_basic(t *testing.T) {
 func testAccConfigRemediationConfiguration_disappears(t *testing.T) {
 	var rc configservice.RemediationConfiguration
 	resourceName := "aws_config_remediation_configuration.test"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	prefix := "original"
 	sseAlgorithm := "AES256"
 
@@ -76,7 +75,7 @@ func testAccConfigRemediationConfiguration_recreates(t *testing.T) {
 	var original configservice.RemediationConfiguration
 	var updated configservice.RemediationConfiguration
 	resourceName := "aws_config_remediation_configuration.test"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 
 	originalName := "Original"
 	updatedName := "Updated"
@@ -111,7 +110,7 @@ func testAccConfigRemediationConfiguration_updates(t *testing.T) {
 	var original configservice.RemediationConfiguration
 	var updated configservice.RemediationConfiguration
 	resourceName := "aws_config_remediation_configuration.test"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 
 	name := "Original"
 	originalSseAlgorithm := "AES256"
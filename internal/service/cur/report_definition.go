@@ -17,6 +17,7 @@ import (
 func ResourceReportDefinition() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceReportDefinitionCreate,
+		//lintignore:AWSR004
 		Read:   resourceReportDefinitionRead,
 		Update: resourceReportDefinitionUpdate,
 		Delete: resourceReportDefinitionDelete,
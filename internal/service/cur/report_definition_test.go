@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	cur "github.com/aws/aws-sdk-go/service/costandusagereportservice"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,8 +16,8 @@ import (
 func testAccReportDefinition_basic(t *testing.T) {
 	resourceName := "aws_cur_report_definition.test"
 	s3BucketResourceName := "aws_s3_bucket.test"
-	reportName := sdkacctest.RandomWithPrefix("tf_acc_test")
-	bucketName := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
+	reportName := acctest.RandomWithPrefix("tf_acc_test")
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckCur(t) },
@@ -70,8 +69,8 @@ func testAccReportDefinition_basic(t *testing.T) {
 func testAccReportDefinition_textOrCSV(t *testing.T) {
 	resourceName := "aws_cur_report_definition.test"
 	s3BucketResourceName := "aws_s3_bucket.test"
-	reportName := sdkacctest.RandomWithPrefix("tf_acc_test")
-	bucketName := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
+	reportName := acctest.RandomWithPrefix("tf_acc_test")
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
 	bucketPrefix := ""
 	format := "textORcsv"
 	compression := "GZIP"
@@ -114,8 +113,8 @@ func testAccReportDefinition_textOrCSV(t *testing.T) {
 func testAccReportDefinition_parquet(t *testing.T) {
 	resourceName := "aws_cur_report_definition.test"
 	s3BucketResourceName := "aws_s3_bucket.test"
-	reportName := sdkacctest.RandomWithPrefix("tf_acc_test")
-	bucketName := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
+	reportName := acctest.RandomWithPrefix("tf_acc_test")
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
 	bucketPrefix := ""
 	format := "Parquet"
 	compression := "Parquet"
@@ -157,8 +156,8 @@ func testAccReportDefinition_parquet(t *testing.T) {
 func testAccReportDefinition_athena(t *testing.T) {
 	resourceName := "aws_cur_report_definition.test"
 	s3BucketResourceName := "aws_s3_bucket.test"
-	reportName := sdkacctest.RandomWithPrefix("tf_acc_test")
-	bucketName := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
+	reportName := acctest.RandomWithPrefix("tf_acc_test")
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
 	bucketPrefix := "data"
 	format := "Parquet"
 	compression := "Parquet"
@@ -201,8 +200,8 @@ func testAccReportDefinition_athena(t *testing.T) {
 func testAccReportDefinition_refresh(t *testing.T) {
 	resourceName := "aws_cur_report_definition.test"
 	s3BucketResourceName := "aws_s3_bucket.test"
-	reportName := sdkacctest.RandomWithPrefix("tf_acc_test")
-	bucketName := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
+	reportName := acctest.RandomWithPrefix("tf_acc_test")
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
 	bucketPrefix := ""
 	format := "textORcsv"
 	compression := "GZIP"
@@ -245,8 +244,8 @@ func testAccReportDefinition_refresh(t *testing.T) {
 func testAccReportDefinition_overwrite(t *testing.T) {
 	resourceName := "aws_cur_report_definition.test"
 	s3BucketResourceName := "aws_s3_bucket.test"
-	reportName := sdkacctest.RandomWithPrefix("tf_acc_test")
-	bucketName := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
+	reportName := acctest.RandomWithPrefix("tf_acc_test")
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
 	bucketPrefix := ""
 	format := "textORcsv"
 	compression := "GZIP"
@@ -288,8 +287,8 @@ func testAccReportDefinition_overwrite(t *testing.T) {
 
 func testAccReportDefinition_disappears(t *testing.T) {
 	resourceName := "aws_cur_report_definition.test"
-	reportName := sdkacctest.RandomWithPrefix("tf_acc_test")
-	bucketName := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
+	reportName := acctest.RandomWithPrefix("tf_acc_test")
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckCur(t) },
@@ -18,6 +18,7 @@ import (
 func ResourcePipeline() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePipelineCreate,
+		//lintignore:AWSR004
 		Read:   resourcePipelineRead,
 		Update: resourcePipelineUpdate,
 		Delete: resourcePipelineDelete,
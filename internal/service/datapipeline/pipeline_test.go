@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/datapipeline"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,8 +16,8 @@ import (
 
 func TestAccDataPipelinePipeline_basic(t *testing.T) {
 	var conf1, conf2 datapipeline.PipelineDescription
-	rName1 := fmt.Sprintf("tf-datapipeline-%s", sdkacctest.RandString(5))
-	rName2 := fmt.Sprintf("tf-datapipeline-%s", sdkacctest.RandString(5))
+	rName1 := fmt.Sprintf("tf-datapipeline-%s", acctest.RandString(5))
+	rName2 := fmt.Sprintf("tf-datapipeline-%s", acctest.RandString(5))
 	resourceName := "aws_datapipeline_pipeline.default"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -53,7 +52,7 @@ func TestAccDataPipelinePipeline_basic(t *testing.T) {
 
 func TestAccDataPipelinePipeline_description(t *testing.T) {
 	var conf1, conf2 datapipeline.PipelineDescription
-	rName := fmt.Sprintf("tf-datapipeline-%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("tf-datapipeline-%s", acctest.RandString(5))
 	resourceName := "aws_datapipeline_pipeline.default"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -88,7 +87,7 @@ func TestAccDataPipelinePipeline_description(t *testing.T) {
 
 func TestAccDataPipelinePipeline_disappears(t *testing.T) {
 	var conf datapipeline.PipelineDescription
-	rName := fmt.Sprintf("tf-datapipeline-%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("tf-datapipeline-%s", acctest.RandString(5))
 	resourceName := "aws_datapipeline_pipeline.default"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -111,7 +110,7 @@ func TestAccDataPipelinePipeline_disappears(t *testing.T) {
 
 func TestAccDataPipelinePipeline_tags(t *testing.T) {
 	var conf datapipeline.PipelineDescription
-	rName := fmt.Sprintf("tf-datapipeline-%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("tf-datapipeline-%s", acctest.RandString(5))
 	resourceName := "aws_datapipeline_pipeline.default"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -18,6 +18,7 @@ import (
 func ResourceLocationEFS() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceLocationEFSCreate,
+		//lintignore:AWSR004
 		Read:   resourceLocationEFSRead,
 		Update: resourceLocationEFSUpdate,
 		Delete: resourceLocationEFSDelete,
@@ -20,6 +20,7 @@ import (
 func ResourceLocationFSxWindowsFileSystem() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceLocationFSxWindowsFileSystemCreate,
+		//lintignore:AWSR004
 		Read:   resourceLocationFSxWindowsFileSystemRead,
 		Update: resourceLocationFSxWindowsFileSystemUpdate,
 		Delete: resourceLocationFSxWindowsFileSystemDelete,
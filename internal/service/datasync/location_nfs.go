@@ -18,6 +18,7 @@ import (
 func ResourceLocationNFS() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceLocationNFSCreate,
+		//lintignore:AWSR004
 		Read:   resourceLocationNFSRead,
 		Update: resourceLocationNFSUpdate,
 		Delete: resourceLocationNFSDelete,
@@ -22,6 +22,7 @@ import (
 func ResourceLocationS3() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceLocationS3Create,
+		//lintignore:AWSR004
 		Read:   resourceLocationS3Read,
 		Update: resourceLocationS3Update,
 		Delete: resourceLocationS3Delete,
@@ -18,6 +18,7 @@ import (
 func ResourceLocationSMB() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceLocationSMBCreate,
+		//lintignore:AWSR004
 		Read:   resourceLocationSMBRead,
 		Update: resourceLocationSMBUpdate,
 		Delete: resourceLocationSMBDelete,
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dax"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 
 func TestAccDAXCluster_basic(t *testing.T) {
 	var dc dax.Cluster
-	rString := sdkacctest.RandString(10)
+	rString := acctest.RandString(10)
 	iamRoleResourceName := "aws_iam_role.test"
 	resourceName := "aws_dax_cluster.test"
 
@@ -72,7 +71,7 @@ func TestAccDAXCluster_basic(t *testing.T) {
 
 func TestAccDAXCluster_resize(t *testing.T) {
 	var dc dax.Cluster
-	rString := sdkacctest.RandString(10)
+	rString := acctest.RandString(10)
 	resourceName := "aws_dax_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -116,7 +115,7 @@ func TestAccDAXCluster_resize(t *testing.T) {
 
 func TestAccDAXCluster_Encryption_disabled(t *testing.T) {
 	var dc dax.Cluster
-	rString := sdkacctest.RandString(10)
+	rString := acctest.RandString(10)
 	resourceName := "aws_dax_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -150,7 +149,7 @@ func TestAccDAXCluster_Encryption_disabled(t *testing.T) {
 
 func TestAccDAXCluster_Encryption_enabled(t *testing.T) {
 	var dc dax.Cluster
-	rString := sdkacctest.RandString(10)
+	rString := acctest.RandString(10)
 	resourceName := "aws_dax_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -17,6 +17,7 @@ import (
 func ResourceBGPPeer() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBGPPeerCreate,
+		//lintignore:AWSR004
 		Read:   resourceBGPPeerRead,
 		Delete: resourceBGPPeerDelete,
 
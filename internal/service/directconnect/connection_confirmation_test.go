@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directconnect"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -24,7 +23,7 @@ func TestAccDirectConnectConnectionConfirmation_basic(t *testing.T) {
 
 	var providers []*schema.Provider
 
-	connectionName := fmt.Sprintf("tf-dx-%s", sdkacctest.RandString(5))
+	connectionName := fmt.Sprintf("tf-dx-%s", acctest.RandString(5))
 	resourceName := "aws_dx_connection_confirmation.test"
 	providerFunc := testAccDxConnectionConfirmationProvider(&providers, 0)
 	altProviderFunc := testAccDxConnectionConfirmationProvider(&providers, 1)
@@ -7,7 +7,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/directconnect"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -28,7 +27,7 @@ func TestAccDirectConnectHostedConnection_basic(t *testing.T) {
 		acctest.Skip(t, err.Error())
 	}
 
-	connectionName := fmt.Sprintf("tf-dx-%s", sdkacctest.RandString(5))
+	connectionName := fmt.Sprintf("tf-dx-%s", acctest.RandString(5))
 	resourceName := "aws_dx_hosted_connection.test"
 
 	resource.ParallelTest(t, resource.TestCase{
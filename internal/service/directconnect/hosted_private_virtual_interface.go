@@ -18,6 +18,7 @@ import (
 func ResourceHostedPrivateVirtualInterface() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceHostedPrivateVirtualInterfaceCreate,
+		//lintignore:AWSR004
 		Read:   resourceHostedPrivateVirtualInterfaceRead,
 		Delete: resourceHostedPrivateVirtualInterfaceDelete,
 		Importer: &schema.ResourceImporter{
@@ -17,6 +17,7 @@ import (
 func ResourceHostedPrivateVirtualInterfaceAccepter() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceHostedPrivateVirtualInterfaceAccepterCreate,
+		//lintignore:AWSR004
 		Read:   resourceHostedPrivateVirtualInterfaceAccepterRead,
 		Update: resourceHostedPrivateVirtualInterfaceAccepterUpdate,
 		Delete: resourceHostedPrivateVirtualInterfaceAccepterDelete,
@@ -19,6 +19,7 @@ import (
 func ResourceHostedPublicVirtualInterface() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceHostedPublicVirtualInterfaceCreate,
+		//lintignore:AWSR004
 		Read:   resourceHostedPublicVirtualInterfaceRead,
 		Delete: resourceHostedPublicVirtualInterfaceDelete,
 		Importer: &schema.ResourceImporter{
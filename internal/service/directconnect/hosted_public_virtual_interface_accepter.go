@@ -17,6 +17,7 @@ import (
 func ResourceHostedPublicVirtualInterfaceAccepter() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceHostedPublicVirtualInterfaceAccepterCreate,
+		//lintignore:AWSR004
 		Read:   resourceHostedPublicVirtualInterfaceAccepterRead,
 		Update: resourceHostedPublicVirtualInterfaceAccepterUpdate,
 		Delete: resourceHostedPublicVirtualInterfaceAccepterDelete,
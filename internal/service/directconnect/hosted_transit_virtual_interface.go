@@ -18,6 +18,7 @@ import (
 func ResourceHostedTransitVirtualInterface() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceHostedTransitVirtualInterfaceCreate,
+		//lintignore:AWSR004
 		Read:   resourceHostedTransitVirtualInterfaceRead,
 		Delete: resourceHostedTransitVirtualInterfaceDelete,
 		Importer: &schema.ResourceImporter{
@@ -17,6 +17,7 @@ import (
 func ResourceHostedTransitVirtualInterfaceAccepter() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceHostedTransitVirtualInterfaceAccepterCreate,
+		//lintignore:AWSR004
 		Read:   resourceHostedTransitVirtualInterfaceAccepterRead,
 		Update: resourceHostedTransitVirtualInterfaceAccepterUpdate,
 		Delete: resourceHostedTransitVirtualInterfaceAccepterDelete,
@@ -19,6 +19,7 @@ import (
 func ResourcePrivateVirtualInterface() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePrivateVirtualInterfaceCreate,
+		//lintignore:AWSR004
 		Read:   resourcePrivateVirtualInterfaceRead,
 		Update: resourcePrivateVirtualInterfaceUpdate,
 		Delete: resourcePrivateVirtualInterfaceDelete,
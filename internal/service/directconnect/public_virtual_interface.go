@@ -21,6 +21,7 @@ import (
 func ResourcePublicVirtualInterface() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePublicVirtualInterfaceCreate,
+		//lintignore:AWSR004
 		Read:   resourcePublicVirtualInterfaceRead,
 		Update: resourcePublicVirtualInterfaceUpdate,
 		Delete: resourcePublicVirtualInterfaceDelete,
@@ -9,7 +9,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directconnect"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -24,15 +23,15 @@ func TestAccDirectConnectPublicVirtualInterface_basic(t *testing.T) {
 
 	var vif directconnect.VirtualInterface
 	resourceName := "aws_dx_public_virtual_interface.test"
-	rName := fmt.Sprintf("tf-testacc-public-vif-%s", sdkacctest.RandString(10))
+	rName := fmt.Sprintf("tf-testacc-public-vif-%s", acctest.RandString(10))
 	// DirectConnectClientException: Amazon Address is not allowed to contain a private IP
 	// DirectConnectClientException: Amazon Address and Customer Address must be in the same CIDR
 	// DirectConnectClientException: Amazon Address is address 0 on its subnet.
 	// DirectConnectClientException: Amazon Address is the broadcast address on its subnet.
 	amazonAddress := "175.45.176.1/28"
 	customerAddress := "175.45.176.2/28"
-	bgpAsn := sdkacctest.RandIntRange(64512, 65534)
-	vlan := sdkacctest.RandIntRange(2049, 4094)
+	bgpAsn := acctest.RandIntRange(64512, 65534)
+	vlan := acctest.RandIntRange(2049, 4094)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -80,11 +79,11 @@ func TestAccDirectConnectPublicVirtualInterface_tags(t *testing.T) {
 
 	var vif directconnect.VirtualInterface
 	resourceName := "aws_dx_public_virtual_interface.test"
-	rName := fmt.Sprintf("tf-testacc-public-vif-%s", sdkacctest.RandString(10))
+	rName := fmt.Sprintf("tf-testacc-public-vif-%s", acctest.RandString(10))
 	amazonAddress := "175.45.176.3/28"
 	customerAddress := "175.45.176.4/28"
-	bgpAsn := sdkacctest.RandIntRange(64512, 65534)
-	vlan := sdkacctest.RandIntRange(2049, 4094)
+	bgpAsn := acctest.RandIntRange(64512, 65534)
+	vlan := acctest.RandIntRange(2049, 4094)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
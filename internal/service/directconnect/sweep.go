@@ -139,7 +139,7 @@ func sweepGatewayAssociationProposals(region string) error {
 			d := r.Data(nil)
 			d.SetId(proposalID)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_dx_gateway_association_proposal", r, d, client))
 		}
 
 		return !lastPage
@@ -208,7 +208,7 @@ func sweepGatewayAssociations(region string) error {
 					d.SetId(GatewayAssociationCreateResourceID(directConnectGatewayID, gatewayID))
 					d.Set("dx_gateway_association_id", association.AssociationId)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_dx_gateway_association", r, d, client))
 				}
 
 				return !lastPage
@@ -272,7 +272,7 @@ func sweepGatewayAssociations(region string) error {
 					d.SetId(GatewayAssociationCreateResourceID(directConnectGatewayID, transitGatewayID))
 					d.Set("dx_gateway_association_id", association.AssociationId)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_dx_gateway_association", r, d, client))
 				}
 
 				return !lastPage
@@ -359,7 +359,7 @@ func sweepGateways(region string) error {
 			d := r.Data(nil)
 			d.SetId(directConnectGatewayID)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_dx_gateway", r, d, client))
 		}
 
 		return !lastPage
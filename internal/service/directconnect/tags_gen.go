@@ -6,13 +6,14 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/aws/aws-sdk-go/service/directconnect/directconnectiface"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 // ListTags lists directconnect service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func ListTags(conn *directconnect.DirectConnect, identifier string) (tftags.KeyValueTags, error) {
+func ListTags(conn directconnectiface.DirectConnectAPI, identifier string) (tftags.KeyValueTags, error) {
 	input := &directconnect.DescribeTagsInput{
 		ResourceArns: aws.StringSlice([]string{identifier}),
 	}
@@ -58,7 +59,7 @@ func KeyValueTags(tags []*directconnect.Tag) tftags.KeyValueTags {
 // UpdateTags updates directconnect service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func UpdateTags(conn *directconnect.DirectConnect, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+func UpdateTags(conn directconnectiface.DirectConnectAPI, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
 	oldTags := tftags.New(oldTagsMap)
 	newTags := tftags.New(newTagsMap)
 
@@ -19,6 +19,7 @@ import (
 func ResourceTransitVirtualInterface() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTransitVirtualInterfaceCreate,
+		//lintignore:AWSR004
 		Read:   resourceTransitVirtualInterfaceRead,
 		Update: resourceTransitVirtualInterfaceUpdate,
 		Delete: resourceTransitVirtualInterfaceDelete,
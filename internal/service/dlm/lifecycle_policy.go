@@ -19,6 +19,7 @@ import (
 func ResourceLifecyclePolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceLifecyclePolicyCreate,
+		//lintignore:AWSR004
 		Read:   resourceLifecyclePolicyRead,
 		Update: resourceLifecyclePolicyUpdate,
 		Delete: resourceLifecyclePolicyDelete,
@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 
 func TestAccDMSCertificate_basic(t *testing.T) {
 	resourceName := "aws_dms_certificate.dms_certificate"
-	randId := sdkacctest.RandString(8)
+	randId := acctest.RandString(8)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -43,7 +42,7 @@ func TestAccDMSCertificate_basic(t *testing.T) {
 
 func TestAccDMSCertificate_disappears(t *testing.T) {
 	resourceName := "aws_dms_certificate.dms_certificate"
-	randId := sdkacctest.RandString(8)
+	randId := acctest.RandString(8)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -65,7 +64,7 @@ func TestAccDMSCertificate_disappears(t *testing.T) {
 
 func TestAccDMSCertificate_certificateWallet(t *testing.T) {
 	resourceName := "aws_dms_certificate.dms_certificate"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -91,7 +90,7 @@ func TestAccDMSCertificate_certificateWallet(t *testing.T) {
 
 func TestAccDMSCertificate_tags(t *testing.T) {
 	resourceName := "aws_dms_certificate.dms_certificate"
-	randId := sdkacctest.RandString(8)
+	randId := acctest.RandString(8)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
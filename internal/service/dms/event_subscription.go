@@ -21,6 +21,7 @@ import (
 func ResourceEventSubscription() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceEventSubscriptionCreate,
+		//lintignore:AWSR004
 		Read:   resourceEventSubscriptionRead,
 		Update: resourceEventSubscriptionUpdate,
 		Delete: resourceEventSubscriptionDelete,
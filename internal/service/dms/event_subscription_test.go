@@ -8,7 +8,6 @@ import (
 	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -20,7 +19,7 @@ func TestAccDMSEventSubscription_basic(t *testing.T) {
 	var eventSubscription dms.EventSubscription
 	resourceName := "aws_dms_event_subscription.test"
 	snsTopicResourceName := "aws_sns_topic.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -54,7 +53,7 @@ func TestAccDMSEventSubscription_basic(t *testing.T) {
 func TestAccDMSEventSubscription_disappears(t *testing.T) {
 	var eventSubscription dms.EventSubscription
 	resourceName := "aws_dms_event_subscription.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -77,7 +76,7 @@ func TestAccDMSEventSubscription_disappears(t *testing.T) {
 func TestAccDMSEventSubscription_enabled(t *testing.T) {
 	var eventSubscription dms.EventSubscription
 	resourceName := "aws_dms_event_subscription.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -118,7 +117,7 @@ func TestAccDMSEventSubscription_enabled(t *testing.T) {
 func TestAccDMSEventSubscription_eventCategories(t *testing.T) {
 	var eventSubscription dms.EventSubscription
 	resourceName := "aws_dms_event_subscription.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -155,7 +154,7 @@ func TestAccDMSEventSubscription_eventCategories(t *testing.T) {
 
 func TestAccDMSEventSubscription_tags(t *testing.T) {
 	var eventSubscription dms.EventSubscription
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_dms_event_subscription.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -20,6 +20,7 @@ import (
 func ResourceReplicationInstance() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceReplicationInstanceCreate,
+		//lintignore:AWSR004
 		Read:   resourceReplicationInstanceRead,
 		Update: resourceReplicationInstanceUpdate,
 		Delete: resourceReplicationInstanceDelete,
@@ -17,6 +17,7 @@ import (
 func ResourceReplicationSubnetGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceReplicationSubnetGroupCreate,
+		//lintignore:AWSR004
 		Read:   resourceReplicationSubnetGroupRead,
 		Update: resourceReplicationSubnetGroupUpdate,
 		Delete: resourceReplicationSubnetGroupDelete,
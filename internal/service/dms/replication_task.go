@@ -21,6 +21,7 @@ import (
 func ResourceReplicationTask() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceReplicationTaskCreate,
+		//lintignore:AWSR004
 		Read:   resourceReplicationTaskRead,
 		Update: resourceReplicationTaskUpdate,
 		Delete: resourceReplicationTaskDelete,
@@ -40,7 +40,7 @@ func sweepReplicationInstances(region string) error {
 			d.Set("replication_instance_arn", instance.ReplicationInstanceArn)
 			d.SetId(aws.StringValue(instance.ReplicationInstanceIdentifier))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_dms_replication_instance", r, d, client))
 		}
 
 		return !lastPage
@@ -23,6 +23,7 @@ func ResourceClusterParameterGroup() *schema.Resource {
 
 	return &schema.Resource{
 		Create: resourceClusterParameterGroupCreate,
+		//lintignore:AWSR004
 		Read:   resourceClusterParameterGroupRead,
 		Update: resourceClusterParameterGroupUpdate,
 		Delete: resourceClusterParameterGroupDelete,
@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/docdb"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -21,7 +20,7 @@ func TestAccDocDBClusterParameterGroup_basic(t *testing.T) {
 	var v docdb.DBClusterParameterGroup
 	resourceName := "aws_docdb_cluster_parameter_group.bar"
 
-	parameterGroupName := fmt.Sprintf("cluster-parameter-group-test-terraform-%d", sdkacctest.RandInt())
+	parameterGroupName := fmt.Sprintf("cluster-parameter-group-test-terraform-%d", acctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -55,7 +54,7 @@ func TestAccDocDBClusterParameterGroup_systemParameter(t *testing.T) {
 	var v docdb.DBClusterParameterGroup
 	resourceName := "aws_docdb_cluster_parameter_group.bar"
 
-	parameterGroupName := fmt.Sprintf("cluster-parameter-group-test-terraform-%d", sdkacctest.RandInt())
+	parameterGroupName := fmt.Sprintf("cluster-parameter-group-test-terraform-%d", acctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -140,7 +139,7 @@ func TestAccDocDBClusterParameterGroup_description(t *testing.T) {
 	var v docdb.DBClusterParameterGroup
 	resourceName := "aws_docdb_cluster_parameter_group.bar"
 
-	parameterGroupName := fmt.Sprintf("cluster-parameter-group-test-terraform-%d", sdkacctest.RandInt())
+	parameterGroupName := fmt.Sprintf("cluster-parameter-group-test-terraform-%d", acctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -169,7 +168,7 @@ func TestAccDocDBClusterParameterGroup_disappears(t *testing.T) {
 	var v docdb.DBClusterParameterGroup
 	resourceName := "aws_docdb_cluster_parameter_group.bar"
 
-	parameterGroupName := fmt.Sprintf("cluster-parameter-group-test-terraform-%d", sdkacctest.RandInt())
+	parameterGroupName := fmt.Sprintf("cluster-parameter-group-test-terraform-%d", acctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -193,7 +192,7 @@ func TestAccDocDBClusterParameterGroup_parameter(t *testing.T) {
 	var v docdb.DBClusterParameterGroup
 	resourceName := "aws_docdb_cluster_parameter_group.bar"
 
-	parameterGroupName := fmt.Sprintf("cluster-parameter-group-test-tf-%d", sdkacctest.RandInt())
+	parameterGroupName := fmt.Sprintf("cluster-parameter-group-test-tf-%d", acctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -240,7 +239,7 @@ func TestAccDocDBClusterParameterGroup_tags(t *testing.T) {
 	var v docdb.DBClusterParameterGroup
 	resourceName := "aws_docdb_cluster_parameter_group.bar"
 
-	parameterGroupName := fmt.Sprintf("cluster-parameter-group-test-tf-%d", sdkacctest.RandInt())
+	parameterGroupName := fmt.Sprintf("cluster-parameter-group-test-tf-%d", acctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
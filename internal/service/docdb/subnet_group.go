@@ -20,6 +20,7 @@ import (
 func ResourceSubnetGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSubnetGroupCreate,
+		//lintignore:AWSR004
 		Read:   resourceSubnetGroupRead,
 		Update: resourceSubnetGroupUpdate,
 		Delete: resourceSubnetGroupDelete,
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/docdb"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,7 +18,7 @@ import (
 func TestAccDocDBSubnetGroup_basic(t *testing.T) {
 	var v docdb.DBSubnetGroup
 
-	rName := fmt.Sprintf("tf-test-%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tf-test-%d", acctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -50,7 +49,7 @@ func TestAccDocDBSubnetGroup_basic(t *testing.T) {
 func TestAccDocDBSubnetGroup_disappears(t *testing.T) {
 	var v docdb.DBSubnetGroup
 
-	rName := fmt.Sprintf("tf-test-%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tf-test-%d", acctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -127,7 +126,7 @@ func TestAccDocDBSubnetGroup_generatedName(t *testing.T) {
 func TestAccDocDBSubnetGroup_updateDescription(t *testing.T) {
 	var v docdb.DBSubnetGroup
 
-	rName := fmt.Sprintf("tf-test-%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tf-test-%d", acctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -18,6 +18,7 @@ import (
 func ResourceConditionalForwarder() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceConditionalForwarderCreate,
+		//lintignore:AWSR004
 		Read:   resourceConditionalForwarderRead,
 		Update: resourceConditionalForwarderUpdate,
 		Delete: resourceConditionalForwarderDelete,
@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/directoryservice"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
@@ -27,7 +26,7 @@ func TestAccDirectoryServiceDirectoryDataSource_nonExistent(t *testing.T) {
 }
 
 func TestAccDirectoryServiceDirectoryDataSource_simpleAD(t *testing.T) {
-	alias := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	alias := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_directory_service_directory.test-simple-ad"
 	dataSourceName := "data.aws_directory_service_directory.test-simple-ad"
 
@@ -61,7 +60,7 @@ func TestAccDirectoryServiceDirectoryDataSource_simpleAD(t *testing.T) {
 }
 
 func TestAccDirectoryServiceDirectoryDataSource_microsoftAD(t *testing.T) {
-	alias := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	alias := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_directory_service_directory.test-microsoft-ad"
 	dataSourceName := "data.aws_directory_service_directory.test-microsoft-ad"
 
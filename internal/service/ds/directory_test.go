@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -195,7 +194,7 @@ func TestAccDirectoryServiceDirectory_connector(t *testing.T) {
 
 func TestAccDirectoryServiceDirectory_withAliasAndSSO(t *testing.T) {
 	var ds directoryservice.DirectoryDescription
-	alias := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	alias := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_directory_service_directory.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -13,6 +13,7 @@ import (
 func ResourceLogSubscription() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceLogSubscriptionCreate,
+		//lintignore:AWSR004
 		Read:   resourceLogSubscriptionRead,
 		Delete: resourceLogSubscriptionDelete,
 		Importer: &schema.ResourceImporter{
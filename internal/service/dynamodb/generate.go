@@ -1,5 +1,5 @@
 //go:generate go run ../../generate/tagresource/main.go
-//go:generate go run ../../generate/tags/main.go -GetTag -ListTags -ListTagsOp=ListTagsOfResource -ServiceTagsSlice -UpdateTags -ParentNotFoundErrCode=ResourceNotFoundException
+//go:generate go run ../../generate/tags/main.go -GetTag -ListTags -ListTagsOp=ListTagsOfResource -ServiceTagsSlice -UpdateTags -ParentNotFoundErrCode=ResourceNotFoundException -Tests
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package dynamodb
@@ -11,11 +11,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func ResourceGlobalTable() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceGlobalTableCreate,
+		//lintignore:AWSR004
 		Read:   resourceGlobalTableRead,
 		Update: resourceGlobalTableUpdate,
 		Delete: resourceGlobalTableDelete,
@@ -29,6 +31,8 @@ func ResourceGlobalTable() *schema.Resource {
 			Delete: schema.DefaultTimeout(1 * time.Minute),
 		},
 
+		CustomizeDiff: verify.RegionsDiff(verify.BlockRegions("replica", "region_name")),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -20,7 +19,7 @@ import (
 
 func TestAccDynamoDBGlobalTable_basic(t *testing.T) {
 	resourceName := "aws_dynamodb_global_table.test"
-	tableName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+	tableName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -33,11 +32,11 @@ func TestAccDynamoDBGlobalTable_basic(t *testing.T) {
 		CheckDestroy:      testAccCheckGlobalTableDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config:      testAccDynamoDbGlobalTableConfig_invalidName(sdkacctest.RandString(2)),
+				Config:      testAccDynamoDbGlobalTableConfig_invalidName(acctest.RandString(2)),
 				ExpectError: regexp.MustCompile("name length must be between 3 and 255 characters"),
 			},
 			{
-				Config:      testAccDynamoDbGlobalTableConfig_invalidName(sdkacctest.RandString(256)),
+				Config:      testAccDynamoDbGlobalTableConfig_invalidName(acctest.RandString(256)),
 				ExpectError: regexp.MustCompile("name length must be between 3 and 255 characters"),
 			},
 			{
@@ -65,7 +64,7 @@ func TestAccDynamoDBGlobalTable_basic(t *testing.T) {
 func TestAccDynamoDBGlobalTable_multipleRegions(t *testing.T) {
 	var providers []*schema.Provider
 	resourceName := "aws_dynamodb_global_table.test"
-	tableName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+	tableName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
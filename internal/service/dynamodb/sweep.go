@@ -66,7 +66,7 @@ func sweepTables(region string) error {
 
 				mutex.Lock()
 				defer mutex.Unlock()
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sweep.NewSweepResource("aws_dynamodb_table", r, d, client))
 
 				return nil
 			})
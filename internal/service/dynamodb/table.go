@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -67,6 +68,7 @@ func ResourceTable() *schema.Resource {
 				return nil
 			},
 			verify.SetTagsDiff,
+			verify.RegionsDiff(verify.BlockRegions("replica", "region_name")),
 		),
 
 		SchemaVersion: 1,
@@ -272,15 +274,14 @@ func ResourceTable() *schema.Resource {
 				Computed: true,
 			},
 			"stream_view_type": {
-				Type:     schema.TypeString,
+				Type:     nullable.TypeNullableString,
 				Optional: true,
 				Computed: true,
 				StateFunc: func(v interface{}) string {
 					value := v.(string)
 					return strings.ToUpper(value)
 				},
-				ValidateFunc: validation.StringInSlice([]string{
-					"",
+				ValidateFunc: nullable.ValidateTypeStringNullableStringInSlice([]string{
 					dynamodb.StreamViewTypeNewImage,
 					dynamodb.StreamViewTypeOldImage,
 					dynamodb.StreamViewTypeNewAndOldImages,
@@ -394,7 +395,7 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 
 	var output *dynamodb.CreateTableOutput
 	var requiresTagging bool
-	err := resource.Retry(createTableTimeout, func() *resource.RetryError {
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		var err error
 		output, err = conn.CreateTable(req)
 		if err != nil {
@@ -441,7 +442,7 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(aws.StringValue(output.TableDescription.TableName))
 	d.Set("arn", output.TableDescription.TableArn)
 
-	if _, err := waitDynamoDBTableActive(conn, d.Id()); err != nil {
+	if _, err := waitDynamoDBTableActive(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return fmt.Errorf("error waiting for creation of DynamoDB table (%s): %w", d.Id(), err)
 	}
 
@@ -703,7 +704,7 @@ func resourceTableUpdate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error updating DynamoDB Table (%s): %w", d.Id(), err)
 		}
 
-		if _, err := waitDynamoDBTableActive(conn, d.Id()); err != nil {
+		if _, err := waitDynamoDBTableActive(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return fmt.Errorf("error waiting for DynamoDB Table (%s) update: %w", d.Id(), err)
 		}
 
@@ -805,7 +806,7 @@ func resourceTableDelete(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error deleting DynamoDB Table (%s): %w", d.Id(), err)
 	}
 
-	if _, err := waitDynamoDBTableDeleted(conn, d.Id()); err != nil {
+	if _, err := waitDynamoDBTableDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return fmt.Errorf("error waiting for DynamoDB Table (%s) deletion: %w", d.Id(), err)
 	}
 
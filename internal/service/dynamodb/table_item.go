@@ -17,6 +17,7 @@ import (
 func ResourceTableItem() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTableItemCreate,
+		//lintignore:AWSR004
 		Read:   resourceTableItemRead,
 		Update: resourceTableItemUpdate,
 		Delete: resourceTableItemDelete,
@@ -35,6 +35,8 @@ func ResourceAMI() *schema.Resource {
 		// The Read, Update and Delete operations are shared with aws_ami_copy
 		// and aws_ami_from_instance, since they differ only in how the image
 		// is created.
+
+		//lintignore:AWSR004
 		Read:   resourceAMIRead,
 		Update: resourceAMIUpdate,
 		Delete: resourceAMIDelete,
@@ -236,6 +236,8 @@ func ResourceAMICopy() *schema.Resource {
 
 		// The remaining operations are shared with the generic aws_ami resource,
 		// since the aws_ami_copy resource only differs in how it's created.
+
+		//lintignore:AWSR004
 		Read:   resourceAMIRead,
 		Update: resourceAMIUpdate,
 		Delete: resourceAMIDelete,
@@ -15,6 +15,7 @@ import (
 func ResourceAMILaunchPermission() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAMILaunchPermissionCreate,
+		//lintignore:AWSR004
 		Read:   resourceAMILaunchPermissionRead,
 		Delete: resourceAMILaunchPermissionDelete,
 		Importer: &schema.ResourceImporter{
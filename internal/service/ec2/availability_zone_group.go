@@ -16,6 +16,7 @@ import (
 func ResourceAvailabilityZoneGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAvailabilityZoneGroupCreate,
+		//lintignore:AWSR004
 		Read:   resourceAvailabilityZoneGroupRead,
 		Update: resourceAvailabilityZoneGroupUpdate,
 		Delete: schema.Noop,
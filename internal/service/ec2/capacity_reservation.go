@@ -23,6 +23,7 @@ const (
 func ResourceCapacityReservation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCapacityReservationCreate,
+		//lintignore:AWSR004
 		Read:   resourceCapacityReservationRead,
 		Update: resourceCapacityReservationUpdate,
 		Delete: resourceCapacityReservationDelete,
@@ -17,6 +17,7 @@ import (
 func ResourceCarrierGateway() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCarrierGatewayCreate,
+		//lintignore:AWSR004
 		Read:   resourceCarrierGatewayRead,
 		Update: resourceCarrierGatewayUpdate,
 		Delete: resourceCarrierGatewayDelete,
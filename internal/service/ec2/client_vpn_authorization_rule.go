@@ -15,6 +15,7 @@ import (
 func ResourceClientVPNAuthorizationRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceClientVPNAuthorizationRuleCreate,
+		//lintignore:AWSR004
 		Read:   resourceClientVPNAuthorizationRuleRead,
 		Delete: resourceClientVPNAuthorizationRuleDelete,
 		Importer: &schema.ResourceImporter{
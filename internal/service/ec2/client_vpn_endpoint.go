@@ -19,6 +19,7 @@ import (
 func ResourceClientVPNEndpoint() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceClientVPNEndpointCreate,
+		//lintignore:AWSR004
 		Read:   resourceClientVPNEndpointRead,
 		Delete: resourceClientVPNEndpointDelete,
 		Update: resourceClientVPNEndpointUpdate,
@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -81,7 +80,7 @@ func TestAccEC2ClientVPNEndpoint_serial(t *testing.T) {
 
 func testAccClientVPNEndpoint_basic(t *testing.T) {
 	var v ec2.ClientVpnEndpoint
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(5)
 	resourceName := "aws_ec2_client_vpn_endpoint.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -111,7 +110,7 @@ func testAccClientVPNEndpoint_basic(t *testing.T) {
 
 func testAccClientVPNEndpoint_disappears(t *testing.T) {
 	var v ec2.ClientVpnEndpoint
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(5)
 	resourceName := "aws_ec2_client_vpn_endpoint.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -134,7 +133,7 @@ func testAccClientVPNEndpoint_disappears(t *testing.T) {
 
 func testAccClientVPNEndpoint_msAD(t *testing.T) {
 	var v ec2.ClientVpnEndpoint
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(5)
 	resourceName := "aws_ec2_client_vpn_endpoint.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -162,7 +161,7 @@ func testAccClientVPNEndpoint_msAD(t *testing.T) {
 
 func testAccClientVPNEndpoint_mutualAuthAndMsAD(t *testing.T) {
 	var v ec2.ClientVpnEndpoint
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(5)
 	resourceName := "aws_ec2_client_vpn_endpoint.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -191,7 +190,7 @@ func testAccClientVPNEndpoint_mutualAuthAndMsAD(t *testing.T) {
 
 func testAccClientVPNEndpoint_federated(t *testing.T) {
 	var v ec2.ClientVpnEndpoint
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(5)
 	resourceName := "aws_ec2_client_vpn_endpoint.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -230,7 +229,7 @@ func testAccClientVPNEndpoint_federated(t *testing.T) {
 
 func testAccClientVPNEndpoint_withLogGroup(t *testing.T) {
 	var v1, v2 ec2.ClientVpnEndpoint
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(5)
 	resourceName := "aws_ec2_client_vpn_endpoint.test"
 	logGroupResourceName := "aws_cloudwatch_log_group.lg"
 	logStreamResourceName := "aws_cloudwatch_log_stream.ls"
@@ -268,7 +267,7 @@ func testAccClientVPNEndpoint_withLogGroup(t *testing.T) {
 
 func testAccClientVPNEndpoint_withDNSServers(t *testing.T) {
 	var v1, v2 ec2.ClientVpnEndpoint
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(5)
 	resourceName := "aws_ec2_client_vpn_endpoint.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -296,7 +295,7 @@ func testAccClientVPNEndpoint_withDNSServers(t *testing.T) {
 func testAccClientVPNEndpoint_tags(t *testing.T) {
 	var v1, v2, v3 ec2.ClientVpnEndpoint
 	resourceName := "aws_ec2_client_vpn_endpoint.test"
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheckClientVPNSyncronize(t); acctest.PreCheck(t) },
@@ -338,7 +337,7 @@ func testAccClientVPNEndpoint_tags(t *testing.T) {
 
 func testAccClientVPNEndpoint_splitTunnel(t *testing.T) {
 	var v1, v2 ec2.ClientVpnEndpoint
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ec2_client_vpn_endpoint.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -372,7 +371,7 @@ func testAccClientVPNEndpoint_splitTunnel(t *testing.T) {
 
 func testAccClientVPNEndpoint_selfServicePortal(t *testing.T) {
 	var v1, v2 ec2.ClientVpnEndpoint
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ec2_client_vpn_endpoint.test"
 
 	resource.ParallelTest(t, resource.TestCase{
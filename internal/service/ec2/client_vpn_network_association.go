@@ -15,6 +15,7 @@ import (
 func ResourceClientVPNNetworkAssociation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceClientVPNNetworkAssociationCreate,
+		//lintignore:AWSR004
 		Read:   resourceClientVPNNetworkAssociationRead,
 		Update: resourceClientVPNNetworkAssociationUpdate,
 		Delete: resourceClientVPNNetworkAssociationDelete,
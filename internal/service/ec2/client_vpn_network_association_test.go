@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 func testAccClientVPNNetworkAssociation_basic(t *testing.T) {
 	var assoc ec2.TargetNetwork
 	var group ec2.SecurityGroup
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(5)
 	resourceName := "aws_ec2_client_vpn_network_association.test"
 	endpointResourceName := "aws_ec2_client_vpn_endpoint.test"
 	subnetResourceName := "aws_subnet.test"
@@ -58,7 +57,7 @@ func testAccClientVPNNetworkAssociation_basic(t *testing.T) {
 func testAccClientVPNNetworkAssociation_multipleSubnets(t *testing.T) {
 	var assoc ec2.TargetNetwork
 	var group ec2.SecurityGroup
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(5)
 	resourceNames := []string{"aws_ec2_client_vpn_network_association.test", "aws_ec2_client_vpn_network_association.test2"}
 	endpointResourceName := "aws_ec2_client_vpn_endpoint.test"
 	subnetResourceNames := []string{"aws_subnet.test", "aws_subnet.test2"}
@@ -105,7 +104,7 @@ func testAccClientVPNNetworkAssociation_multipleSubnets(t *testing.T) {
 
 func testAccClientVPNNetworkAssociation_disappears(t *testing.T) {
 	var assoc ec2.TargetNetwork
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(5)
 	resourceName := "aws_ec2_client_vpn_network_association.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -129,7 +128,7 @@ func testAccClientVPNNetworkAssociation_disappears(t *testing.T) {
 func testAccClientVPNNetworkAssociation_securityGroups(t *testing.T) {
 	var assoc1, assoc2 ec2.TargetNetwork
 	var group11, group12, group21 ec2.SecurityGroup
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(5)
 	resourceName := "aws_ec2_client_vpn_network_association.test"
 	securityGroup1ResourceName := "aws_security_group.test1"
 	securityGroup2ResourceName := "aws_security_group.test2"
@@ -15,6 +15,7 @@ import (
 func ResourceClientVPNRoute() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceClientVPNRouteCreate,
+		//lintignore:AWSR004
 		Read:   resourceClientVPNRouteRead,
 		Delete: resourceClientVPNRouteDelete,
 		Importer: &schema.ResourceImporter{
@@ -22,6 +22,7 @@ import (
 func ResourceCustomerGateway() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCustomerGatewayCreate,
+		//lintignore:AWSR004
 		Read:   resourceCustomerGatewayRead,
 		Update: resourceCustomerGatewayUpdate,
 		Delete: resourceCustomerGatewayDelete,
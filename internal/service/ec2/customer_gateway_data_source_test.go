@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
@@ -14,8 +13,8 @@ func TestAccEC2CustomerGatewayDataSource_filter(t *testing.T) {
 	dataSourceName := "data.aws_customer_gateway.test"
 	resourceName := "aws_customer_gateway.test"
 
-	asn := sdkacctest.RandIntRange(64512, 65534)
-	hostOctet := sdkacctest.RandIntRange(1, 254)
+	asn := acctest.RandIntRange(64512, 65534)
+	hostOctet := acctest.RandIntRange(1, 254)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -41,8 +40,8 @@ func TestAccEC2CustomerGatewayDataSource_id(t *testing.T) {
 	dataSourceName := "data.aws_customer_gateway.test"
 	resourceName := "aws_customer_gateway.test"
 
-	asn := sdkacctest.RandIntRange(64512, 65534)
-	hostOctet := sdkacctest.RandIntRange(1, 254)
+	asn := acctest.RandIntRange(64512, 65534)
+	hostOctet := acctest.RandIntRange(1, 254)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -65,7 +64,7 @@ func TestAccEC2CustomerGatewayDataSource_id(t *testing.T) {
 }
 
 func testAccCustomerGatewayFilterDataSourceConfig(asn, hostOctet int) string {
-	name := sdkacctest.RandomWithPrefix("test-filter")
+	name := acctest.RandomWithPrefix("test-filter")
 	return fmt.Sprintf(`
 resource "aws_customer_gateway" "test" {
   bgp_asn    = %d
@@ -19,6 +19,7 @@ import (
 func ResourceDefaultRouteTable() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDefaultRouteTableCreate,
+		//lintignore:AWSR004
 		Read:   resourceDefaultRouteTableRead,
 		Update: resourceRouteTableUpdate,
 		Delete: resourceDefaultRouteTableDelete,
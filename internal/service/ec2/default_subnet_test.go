@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ func TestAccEC2DefaultSubnet_basic(t *testing.T) {
 
 	resourceName := "aws_default_subnet.foo"
 	availabilityZonesDataSourceName := "data.aws_availability_zones.available"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -50,7 +49,7 @@ func TestAccEC2DefaultSubnet_publicIP(t *testing.T) {
 
 	resourceName := "aws_default_subnet.foo"
 	availabilityZonesDataSourceName := "data.aws_availability_zones.available"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -13,6 +13,7 @@ import (
 func ResourceEBSDefaultKMSKey() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceEBSDefaultKMSKeyCreate,
+		//lintignore:AWSR004
 		Read:   resourceEBSDefaultKMSKeyRead,
 		Delete: resourceEBSDefaultKMSKeyDelete,
 		Importer: &schema.ResourceImporter{
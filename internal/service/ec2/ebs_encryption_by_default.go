@@ -12,6 +12,7 @@ import (
 func ResourceEBSEncryptionByDefault() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceEBSEncryptionByDefaultCreate,
+		//lintignore:AWSR004
 		Read:   resourceEBSEncryptionByDefaultRead,
 		Update: resourceEBSEncryptionByDefaultUpdate,
 		Delete: resourceEBSEncryptionByDefaultDelete,
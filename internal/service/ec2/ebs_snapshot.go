@@ -20,6 +20,7 @@ import (
 func ResourceEBSSnapshot() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceEBSSnapshotCreate,
+		//lintignore:AWSR004
 		Read:   resourceEBSSnapshotRead,
 		Update: resourceEBSSnapshotUpdate,
 		Delete: resourceEBSSnapshotDelete,
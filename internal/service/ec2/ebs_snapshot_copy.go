@@ -20,6 +20,7 @@ import (
 func ResourceEBSSnapshotCopy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceEBSSnapshotCopyCreate,
+		//lintignore:AWSR004
 		Read:   resourceEBSSnapshotCopyRead,
 		Update: resourceEBSSnapshotCopyUpdate,
 		Delete: resourceEBSSnapshotCopyDelete,
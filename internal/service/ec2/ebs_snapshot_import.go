@@ -20,7 +20,8 @@ import (
 
 func ResourceEBSSnapshotImport() *schema.Resource {
 	return &schema.Resource{
-		Create:        resourceEBSSnapshotImportCreate,
+		Create: resourceEBSSnapshotImportCreate,
+		//lintignore:AWSR004
 		Read:          resourceEBSSnapshotImportRead,
 		Update:        resourceEBSSnapshotImportUpdate,
 		Delete:        resourceEBSSnapshotImportDelete,
@@ -12,7 +12,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -23,7 +22,7 @@ import (
 
 func TestAccEC2EBSSnapshotImport_basic(t *testing.T) {
 	var v ec2.Snapshot
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ebs_snapshot_import.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -48,7 +47,7 @@ func TestAccEC2EBSSnapshotImport_basic(t *testing.T) {
 
 func TestAccEC2EBSSnapshotImport_tags(t *testing.T) {
 	var v ec2.Snapshot
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ebs_snapshot_import.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -94,7 +93,7 @@ func TestAccEC2EBSSnapshotImport_tags(t *testing.T) {
 
 func TestAccEC2EBSSnapshotImport_disappears(t *testing.T) {
 	var v ec2.Snapshot
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ebs_snapshot_import.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -120,7 +119,7 @@ func TestAccEC2EBSSnapshotImport_disappears(t *testing.T) {
 
 func TestAccEC2EBSSnapshotImport_Disappears_s3BucketObject(t *testing.T) {
 	var v ec2.Snapshot
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	parentResourceName := "aws_s3_bucket_object.image"
 	resourceName := "aws_ebs_snapshot_import.test"
 
@@ -24,6 +24,7 @@ import (
 func ResourceEBSVolume() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceEBSVolumeCreate,
+		//lintignore:AWSR004
 		Read:   resourceEBSVolumeRead,
 		Update: resourceEBSVolumeUpdate,
 		Delete: resourceEBSVolumeDelete,
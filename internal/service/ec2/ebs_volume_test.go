@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -236,7 +235,7 @@ func TestAccEC2EBSVolume_UpdateIops_io2(t *testing.T) {
 
 func TestAccEC2EBSVolume_kmsKey(t *testing.T) {
 	var v ec2.Volume
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	config := fmt.Sprintf(testAccEBSVolumeWithKMSKeyConfig, ri)
 	kmsKeyResourceName := "aws_kms_key.test"
 	resourceName := "aws_ebs_volume.test"
@@ -354,7 +353,7 @@ func TestAccEC2EBSVolume_withTags(t *testing.T) {
 func TestAccEC2EBSVolume_multiAttach(t *testing.T) {
 	var v ec2.Volume
 	resourceName := "aws_ebs_volume.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -409,7 +408,7 @@ func TestAccEC2EBSVolume_outpost(t *testing.T) {
 func TestAccEC2EBSVolume_GP3_basic(t *testing.T) {
 	var v ec2.Volume
 	resourceName := "aws_ebs_volume.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -447,7 +446,7 @@ func TestAccEC2EBSVolume_GP3_basic(t *testing.T) {
 func TestAccEC2EBSVolume_GP3_iops(t *testing.T) {
 	var v ec2.Volume
 	resourceName := "aws_ebs_volume.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -503,7 +502,7 @@ func TestAccEC2EBSVolume_GP3_iops(t *testing.T) {
 func TestAccEC2EBSVolume_GP3_throughput(t *testing.T) {
 	var v ec2.Volume
 	resourceName := "aws_ebs_volume.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -559,7 +558,7 @@ func TestAccEC2EBSVolume_GP3_throughput(t *testing.T) {
 func TestAccEC2EBSVolume_gp3ToGP2(t *testing.T) {
 	var v ec2.Volume
 	resourceName := "aws_ebs_volume.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -616,7 +615,7 @@ func TestAccEC2EBSVolume_snapshotID(t *testing.T) {
 	var v ec2.Volume
 	resourceName := "aws_ebs_volume.test"
 	snapshotResourceName := "aws_ebs_snapshot.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -655,7 +654,7 @@ func TestAccEC2EBSVolume_snapshotIDAndSize(t *testing.T) {
 	var v ec2.Volume
 	resourceName := "aws_ebs_volume.test"
 	snapshotResourceName := "aws_ebs_snapshot.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -18,6 +18,7 @@ import (
 func ResourceEgressOnlyInternetGateway() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceEgressOnlyInternetGatewayCreate,
+		//lintignore:AWSR004
 		Read:   resourceEgressOnlyInternetGatewayRead,
 		Update: resourceEgressOnlyInternetGatewayUpdate,
 		Delete: resourceEgressOnlyInternetGatewayDelete,
@@ -27,6 +27,7 @@ const (
 func ResourceEIP() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceEIPCreate,
+		//lintignore:AWSR004
 		Read:   resourceEIPRead,
 		Update: resourceEIPUpdate,
 		Delete: resourceEIPDelete,
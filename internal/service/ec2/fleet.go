@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -20,6 +21,7 @@ import (
 func ResourceFleet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceFleetCreate,
+		//lintignore:AWSR004
 		Read:   resourceFleetRead,
 		Update: resourceFleetUpdate,
 		Delete: resourceFleetDelete,
@@ -101,9 +103,10 @@ func ResourceFleet() *schema.Resource {
 										ForceNew: true,
 									},
 									"priority": {
-										Type:     schema.TypeFloat,
-										Optional: true,
-										ForceNew: true,
+										Type:         nullable.TypeNullableFloat,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: nullable.ValidateTypeStringNullableFloatAtLeast(0),
 									},
 									"subnet_id": {
 										Type:     schema.TypeString,
@@ -689,8 +692,8 @@ func expandEc2FleetLaunchTemplateOverridesRequest(m map[string]interface{}) *ec2
 		fleetLaunchTemplateOverridesRequest.MaxPrice = aws.String(v.(string))
 	}
 
-	if v, ok := m["priority"]; ok && v.(float64) != 0.0 {
-		fleetLaunchTemplateOverridesRequest.Priority = aws.Float64(v.(float64))
+	if v, null, _ := nullable.Float(m["priority"].(string)).Value(); !null {
+		fleetLaunchTemplateOverridesRequest.Priority = aws.Float64(v)
 	}
 
 	if v, ok := m["subnet_id"]; ok && v.(string) != "" {
@@ -847,10 +850,14 @@ func flattenEc2FleetLaunchTemplateOverrides(fleetLaunchTemplateOverrides []*ec2.
 			"availability_zone": aws.StringValue(fleetLaunchTemplateOverride.AvailabilityZone),
 			"instance_type":     aws.StringValue(fleetLaunchTemplateOverride.InstanceType),
 			"max_price":         aws.StringValue(fleetLaunchTemplateOverride.MaxPrice),
-			"priority":          aws.Float64Value(fleetLaunchTemplateOverride.Priority),
 			"subnet_id":         aws.StringValue(fleetLaunchTemplateOverride.SubnetId),
 			"weighted_capacity": aws.Float64Value(fleetLaunchTemplateOverride.WeightedCapacity),
 		}
+
+		if v := fleetLaunchTemplateOverride.Priority; v != nil {
+			m["priority"] = string(nullable.NewFloat(aws.Float64Value(v)))
+		}
+
 		l[i] = m
 	}
 
@@ -525,3 +525,36 @@ func TestFlattenSecurityGroups(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandEc2FleetLaunchTemplateOverridesRequest_priority(t *testing.T) {
+	cases := []struct {
+		priority string
+		expected *float64
+	}{
+		{
+			priority: "",
+		},
+		{
+			priority: "0",
+			expected: aws.Float64(0),
+		},
+		{
+			priority: "1.5",
+			expected: aws.Float64(1.5),
+		},
+	}
+
+	for _, c := range cases {
+		out := expandEc2FleetLaunchTemplateOverridesRequest(map[string]interface{}{
+			"availability_zone": "",
+			"instance_type":     "t3.micro",
+			"max_price":         "",
+			"priority":          c.priority,
+			"subnet_id":         "",
+			"weighted_capacity": 0.0,
+		})
+		if !reflect.DeepEqual(out.Priority, c.expected) {
+			t.Fatalf("Error matching priority %q: %#v vs %#v", c.priority, out.Priority, c.expected)
+		}
+	}
+}
@@ -34,6 +34,7 @@ func ResourceInstance() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
 		Create: resourceInstanceCreate,
+		//lintignore:AWSR004
 		Read:   resourceInstanceRead,
 		Update: resourceInstanceUpdate,
 		Delete: resourceInstanceDelete,
@@ -35,7 +35,7 @@ func TestAccEC2InstanceDataSource_basic(t *testing.T) {
 }
 
 func TestAccEC2InstanceDataSource_tags(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
 
@@ -210,7 +210,7 @@ func TestAccEC2InstanceDataSource_rootInstanceStore(t *testing.T) {
 func TestAccEC2InstanceDataSource_privateIP(t *testing.T) {
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
-	rName := fmt.Sprintf("tf-testacc-instance-%s", sdkacctest.RandString(12))
+	rName := fmt.Sprintf("tf-testacc-instance-%s", acctest.RandString(12))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -232,7 +232,7 @@ func TestAccEC2InstanceDataSource_privateIP(t *testing.T) {
 func TestAccEC2InstanceDataSource_secondaryPrivateIPs(t *testing.T) {
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
-	rName := fmt.Sprintf("tf-testacc-instance-%s", sdkacctest.RandString(12))
+	rName := fmt.Sprintf("tf-testacc-instance-%s", acctest.RandString(12))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -254,7 +254,7 @@ func TestAccEC2InstanceDataSource_secondaryPrivateIPs(t *testing.T) {
 func TestAccEC2InstanceDataSource_ipv6Addresses(t *testing.T) {
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -276,7 +276,7 @@ func TestAccEC2InstanceDataSource_ipv6Addresses(t *testing.T) {
 func TestAccEC2InstanceDataSource_keyPair(t *testing.T) {
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
-	rName := fmt.Sprintf("tf-test-key-%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tf-test-key-%d", acctest.RandInt())
 
 	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
 	if err != nil {
@@ -304,7 +304,7 @@ func TestAccEC2InstanceDataSource_keyPair(t *testing.T) {
 func TestAccEC2InstanceDataSource_vpc(t *testing.T) {
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
-	rName := fmt.Sprintf("tf-testacc-instance-%s", sdkacctest.RandString(12))
+	rName := fmt.Sprintf("tf-testacc-instance-%s", acctest.RandString(12))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -328,7 +328,7 @@ func TestAccEC2InstanceDataSource_vpc(t *testing.T) {
 func TestAccEC2InstanceDataSource_placementGroup(t *testing.T) {
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
-	rName := fmt.Sprintf("tf-testacc-instance-%s", sdkacctest.RandString(12))
+	rName := fmt.Sprintf("tf-testacc-instance-%s", acctest.RandString(12))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -346,7 +346,7 @@ func TestAccEC2InstanceDataSource_placementGroup(t *testing.T) {
 }
 
 func TestAccEC2InstanceDataSource_securityGroups(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
 
@@ -372,7 +372,7 @@ func TestAccEC2InstanceDataSource_securityGroups(t *testing.T) {
 func TestAccEC2InstanceDataSource_vpcSecurityGroups(t *testing.T) {
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
-	rName := fmt.Sprintf("tf-testacc-instance-%s", sdkacctest.RandString(12))
+	rName := fmt.Sprintf("tf-testacc-instance-%s", acctest.RandString(12))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -395,7 +395,7 @@ func TestAccEC2InstanceDataSource_vpcSecurityGroups(t *testing.T) {
 func TestAccEC2InstanceDataSource_GetPasswordData_trueToFalse(t *testing.T) {
 	datasourceName := "data.aws_instance.test"
 
-	rName := fmt.Sprintf("tf-testacc-instance-%s", sdkacctest.RandString(12))
+	rName := fmt.Sprintf("tf-testacc-instance-%s", acctest.RandString(12))
 	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
 	if err != nil {
 		t.Fatalf("error generating random SSH key: %s", err)
@@ -427,7 +427,7 @@ func TestAccEC2InstanceDataSource_GetPasswordData_trueToFalse(t *testing.T) {
 func TestAccEC2InstanceDataSource_GetPasswordData_falseToTrue(t *testing.T) {
 	datasourceName := "data.aws_instance.test"
 
-	rName := fmt.Sprintf("tf-testacc-instance-%s", sdkacctest.RandString(12))
+	rName := fmt.Sprintf("tf-testacc-instance-%s", acctest.RandString(12))
 	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
 	if err != nil {
 		t.Fatalf("error generating random SSH key: %s", err)
@@ -458,7 +458,7 @@ func TestAccEC2InstanceDataSource_GetPasswordData_falseToTrue(t *testing.T) {
 
 func TestAccEC2InstanceDataSource_getUserData(t *testing.T) {
 	datasourceName := "data.aws_instance.test"
-	rName := fmt.Sprintf("tf-testacc-instance-%s", sdkacctest.RandString(12))
+	rName := fmt.Sprintf("tf-testacc-instance-%s", acctest.RandString(12))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -493,7 +493,7 @@ func TestAccEC2InstanceDataSource_getUserData(t *testing.T) {
 func TestAccEC2InstanceDataSource_GetUserData_noUserData(t *testing.T) {
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
-	rName := fmt.Sprintf("tf-testacc-instance-%s", sdkacctest.RandString(12))
+	rName := fmt.Sprintf("tf-testacc-instance-%s", acctest.RandString(12))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -531,7 +531,7 @@ func TestAccEC2InstanceDataSource_GetUserData_noUserData(t *testing.T) {
 func TestAccEC2InstanceDataSource_creditSpecification(t *testing.T) {
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
-	rName := fmt.Sprintf("tf-testacc-instance-%s", sdkacctest.RandString(12))
+	rName := fmt.Sprintf("tf-testacc-instance-%s", acctest.RandString(12))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -554,7 +554,7 @@ func TestAccEC2InstanceDataSource_creditSpecification(t *testing.T) {
 func TestAccEC2InstanceDataSource_metadataOptions(t *testing.T) {
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -577,7 +577,7 @@ func TestAccEC2InstanceDataSource_metadataOptions(t *testing.T) {
 func TestAccEC2InstanceDataSource_enclaveOptions(t *testing.T) {
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -596,7 +596,7 @@ func TestAccEC2InstanceDataSource_enclaveOptions(t *testing.T) {
 }
 
 func TestAccEC2InstanceDataSource_blockDeviceTags(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
 
@@ -21,6 +21,7 @@ import (
 func ResourceInternetGateway() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceInternetGatewayCreate,
+		//lintignore:AWSR004
 		Read:   resourceInternetGatewayRead,
 		Update: resourceInternetGatewayUpdate,
 		Delete: resourceInternetGatewayDelete,
@@ -21,6 +21,7 @@ func ResourceKeyPair() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
 		Create: resourceKeyPairCreate,
+		//lintignore:AWSR004
 		Read:   resourceKeyPairRead,
 		Update: resourceKeyPairUpdate,
 		Delete: resourceKeyPairDelete,
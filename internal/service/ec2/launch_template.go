@@ -26,6 +26,7 @@ import (
 func ResourceLaunchTemplate() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceLaunchTemplateCreate,
+		//lintignore:AWSR004
 		Read:   resourceLaunchTemplateRead,
 		Update: resourceLaunchTemplateUpdate,
 		Delete: resourceLaunchTemplateDelete,
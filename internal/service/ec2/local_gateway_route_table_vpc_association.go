@@ -21,6 +21,7 @@ const (
 func ResourceLocalGatewayRouteTableVPCAssociation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceLocalGatewayRouteTableVPCAssociationCreate,
+		//lintignore:AWSR004
 		Read:   resourceLocalGatewayRouteTableVPCAssociationRead,
 		Update: resourceLocalGatewayRouteTableVPCAssociationUpdate,
 		Delete: resourceLocalGatewayRouteTableVPCAssociationDelete,
@@ -0,0 +1,52 @@
+package ec2
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func Test_securityGroupRuleLockHolder(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceSecurityGroupRule().Schema, map[string]interface{}{
+		"security_group_id": "sg-12345678",
+		"type":              "ingress",
+		"protocol":          "tcp",
+		"from_port":         80,
+		"to_port":           443,
+	})
+
+	mkv := conns.NewMutexKV()
+
+	if err := mkv.LockContext(conns.NewResourceContext(context.Background(), securityGroupRuleIdentifier(d)), "sg-12345678"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	defer mkv.Unlock("sg-12345678")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := mkv.LockContext(ctx, "sg-12345678")
+
+	if err == nil {
+		t.Fatal("expected error locking held lock")
+	}
+
+	if expected := `held by aws_security_group_rule["sg-12345678 ingress tcp 80-443"]`; !strings.Contains(err.Error(), expected) {
+		t.Errorf("expected %q in error, got: %s", expected, err)
+	}
+}
+
+func Test_networkInterfaceSGAttachmentIdentifier(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceNetworkInterfaceSGAttachment().Schema, map[string]interface{}{
+		"security_group_id":    "sg-12345678",
+		"network_interface_id": "eni-12345678",
+	})
+
+	if got, expected := networkInterfaceSGAttachmentIdentifier(d), `aws_network_interface_sg_attachment["sg-12345678_eni-12345678"]`; got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+}
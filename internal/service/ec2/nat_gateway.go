@@ -21,6 +21,7 @@ import (
 func ResourceNatGateway() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNatGatewayCreate,
+		//lintignore:AWSR004
 		Read:   resourceNatGatewayRead,
 		Update: resourceNatGatewayUpdate,
 		Delete: resourceNatGatewayDelete,
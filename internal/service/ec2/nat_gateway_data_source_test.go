@@ -5,14 +5,13 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
 func TestAccEC2NatGatewayDataSource_basic(t *testing.T) {
 	// This is used as a portion of CIDR network addresses.
-	rInt := sdkacctest.RandIntRange(4, 254)
+	rInt := acctest.RandIntRange(4, 254)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -45,7 +44,7 @@ func TestAccEC2NetworkACL_basic(t *testing.T) {
 
 func TestAccEC2NetworkACL_tags(t *testing.T) {
 	resourceName := "aws_network_acl.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	var networkAcl ec2.NetworkAcl
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -566,7 +565,7 @@ func TestAccEC2NetworkACL_ipv6Rules(t *testing.T) {
 
 func TestAccEC2NetworkACL_ipv6ICMPRules(t *testing.T) {
 	var networkAcl ec2.NetworkAcl
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_network_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
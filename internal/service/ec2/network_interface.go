@@ -25,6 +25,7 @@ import (
 func ResourceNetworkInterface() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNetworkInterfaceCreate,
+		//lintignore:AWSR004
 		Read:   resourceNetworkInterfaceRead,
 		Update: resourceNetworkInterfaceUpdate,
 		Delete: resourceNetworkInterfaceDelete,
@@ -16,6 +16,7 @@ import (
 func ResourceNetworkInterfaceAttachment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNetworkInterfaceAttachmentCreate,
+		//lintignore:AWSR004
 		Read:   resourceNetworkInterfaceAttachmentRead,
 		Delete: resourceNetworkInterfaceAttachmentDelete,
 
@@ -1,6 +1,7 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"reflect"
@@ -34,9 +35,20 @@ func ResourceNetworkInterfaceSGAttachment() *schema.Resource {
 	}
 }
 
+// networkInterfaceSGAttachmentIdentifier returns the identifier of the attachment recorded as the holder
+// of the lock on its network interface, e.g. `aws_network_interface_sg_attachment["sg-12345678_eni-12345678"]`.
+func networkInterfaceSGAttachmentIdentifier(d *schema.ResourceData) string {
+	return conns.ResourceIdentifier("aws_network_interface_sg_attachment", fmt.Sprintf("%s_%s", d.Get("security_group_id"), d.Get("network_interface_id")))
+}
+
 func resourceNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	mk := "network_interface_sg_attachment_" + d.Get("network_interface_id").(string)
-	conns.GlobalMutexKV.Lock(mk)
+	ctx, cancel := context.WithTimeout(conns.NewResourceContext(context.Background(), networkInterfaceSGAttachmentIdentifier(d)), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	if err := conns.GlobalMutexKV.LockContext(ctx, mk); err != nil {
+		return fmt.Errorf("error locking network interface: %w", err)
+	}
 	defer conns.GlobalMutexKV.Unlock(mk)
 
 	sgID := d.Get("security_group_id").(string)
@@ -144,7 +156,12 @@ func resourceNetworkInterfaceSGAttachmentRead(d *schema.ResourceData, meta inter
 
 func resourceNetworkInterfaceSGAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
 	mk := "network_interface_sg_attachment_" + d.Get("network_interface_id").(string)
-	conns.GlobalMutexKV.Lock(mk)
+	ctx, cancel := context.WithTimeout(conns.NewResourceContext(context.Background(), networkInterfaceSGAttachmentIdentifier(d)), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	if err := conns.GlobalMutexKV.LockContext(ctx, mk); err != nil {
+		return fmt.Errorf("error locking network interface: %w", err)
+	}
 	defer conns.GlobalMutexKV.Unlock(mk)
 
 	sgID := d.Get("security_group_id").(string)
@@ -2,6 +2,7 @@ package ec2
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"sort"
@@ -151,11 +152,23 @@ func ResourceSecurityGroupRule() *schema.Resource {
 	}
 }
 
+// securityGroupRuleIdentifier returns the identifier of the rule recorded as the holder of the lock
+// on its security group, e.g. `aws_security_group_rule["sg-12345678 ingress tcp 80-80"]`.
+// The rule's ID isn't known before it's created, so the rule is described by its attributes.
+func securityGroupRuleIdentifier(d *schema.ResourceData) string {
+	return conns.ResourceIdentifier("aws_security_group_rule", fmt.Sprintf("%s %s %s %d-%d", d.Get("security_group_id"), d.Get("type"), d.Get("protocol"), d.Get("from_port"), d.Get("to_port")))
+}
+
 func resourceSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EC2Conn
 	sg_id := d.Get("security_group_id").(string)
 
-	conns.GlobalMutexKV.Lock(sg_id)
+	ctx, cancel := context.WithTimeout(conns.NewResourceContext(context.Background(), securityGroupRuleIdentifier(d)), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	if err := conns.GlobalMutexKV.LockContext(ctx, sg_id); err != nil {
+		return fmt.Errorf("error locking security group (%s): %w", sg_id, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(sg_id)
 
 	sg, err := FindSecurityGroupByID(conn, sg_id)
@@ -356,7 +369,12 @@ func resourceSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}) e
 	conn := meta.(*conns.AWSClient).EC2Conn
 	sg_id := d.Get("security_group_id").(string)
 
-	conns.GlobalMutexKV.Lock(sg_id)
+	ctx, cancel := context.WithTimeout(conns.NewResourceContext(context.Background(), securityGroupRuleIdentifier(d)), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	if err := conns.GlobalMutexKV.LockContext(ctx, sg_id); err != nil {
+		return fmt.Errorf("error locking security group (%s): %w", sg_id, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(sg_id)
 
 	sg, err := FindSecurityGroupByID(conn, sg_id)
@@ -856,7 +874,12 @@ func validSecurityGroupRule(d *schema.ResourceData) error {
 func resourceSecurityGroupRuleDescriptionUpdate(conn *ec2.EC2, d *schema.ResourceData) error {
 	sg_id := d.Get("security_group_id").(string)
 
-	conns.GlobalMutexKV.Lock(sg_id)
+	ctx, cancel := context.WithTimeout(conns.NewResourceContext(context.Background(), securityGroupRuleIdentifier(d)), d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	if err := conns.GlobalMutexKV.LockContext(ctx, sg_id); err != nil {
+		return fmt.Errorf("error locking security group (%s): %w", sg_id, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(sg_id)
 
 	sg, err := FindSecurityGroupByID(conn, sg_id)
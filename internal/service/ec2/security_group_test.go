@@ -12,7 +12,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -707,7 +706,7 @@ func TestAccEC2SecurityGroup_ingressMode(t *testing.T) {
 
 func TestAccEC2SecurityGroup_ruleGathering(t *testing.T) {
 	var group ec2.SecurityGroup
-	sgName := fmt.Sprintf("tf-acc-security-group-%s", sdkacctest.RandString(7))
+	sgName := fmt.Sprintf("tf-acc-security-group-%s", acctest.RandString(7))
 	resourceName := "aws_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1484,7 +1483,7 @@ func TestAccEC2SecurityGroup_defaultEgressVPC(t *testing.T) {
 func TestAccEC2SecurityGroup_defaultEgressClassic(t *testing.T) {
 	var group ec2.SecurityGroup
 	resourceName := "aws_security_group.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckEC2Classic(t) },
@@ -1672,7 +1671,7 @@ func TestAccEC2SecurityGroup_invalidCIDRBlock(t *testing.T) {
 func TestAccEC2SecurityGroup_tags(t *testing.T) {
 	var group ec2.SecurityGroup
 	resourceName := "aws_security_group.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -1797,7 +1796,7 @@ func TestAccEC2SecurityGroup_ingressWithCIDRAndSGsVPC(t *testing.T) {
 func TestAccEC2SecurityGroup_ingressWithCIDRAndSGsClassic(t *testing.T) {
 	var group ec2.SecurityGroup
 	resourceName := "aws_security_group.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckEC2Classic(t) },
@@ -3371,7 +3370,7 @@ resource "aws_security_group" "test" {
     Name = "tf-acc-test"
   }
 }
-`, sdkacctest.RandInt())
+`, acctest.RandInt())
 }
 
 func testAccSecurityGroupConfig_drift_complex() string {
@@ -3441,7 +3440,7 @@ resource "aws_security_group" "test" {
     Name = "tf-acc-test"
   }
 }
-`, sdkacctest.RandInt(), sdkacctest.RandInt())
+`, acctest.RandInt(), acctest.RandInt())
 }
 
 const testAccSecurityGroupInvalidIngressCIDR = `
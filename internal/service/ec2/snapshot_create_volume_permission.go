@@ -16,6 +16,7 @@ import (
 func ResourceSnapshotCreateVolumePermission() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSnapshotCreateVolumePermissionCreate,
+		//lintignore:AWSR004
 		Read:   resourceSnapshotCreateVolumePermissionRead,
 		Delete: resourceSnapshotCreateVolumePermissionDelete,
 
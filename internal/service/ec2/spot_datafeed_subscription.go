@@ -14,6 +14,7 @@ import (
 func ResourceSpotDataFeedSubscription() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSpotDataFeedSubscriptionCreate,
+		//lintignore:AWSR004
 		Read:   resourceSpotDataFeedSubscriptionRead,
 		Delete: resourceSpotDataFeedSubscriptionDelete,
 		Importer: &schema.ResourceImporter{
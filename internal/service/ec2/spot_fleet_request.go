@@ -26,6 +26,7 @@ func ResourceSpotFleetRequest() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
 		Create: resourceSpotFleetRequestCreate,
+		//lintignore:AWSR004
 		Read:   resourceSpotFleetRequestRead,
 		Delete: resourceSpotFleetRequestDelete,
 		Update: resourceSpotFleetRequestUpdate,
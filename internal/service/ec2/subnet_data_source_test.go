@@ -5,13 +5,12 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
 func TestAccEC2SubnetDataSource_basic(t *testing.T) {
-	rInt := sdkacctest.RandIntRange(0, 256)
+	rInt := acctest.RandIntRange(0, 256)
 	cidr := fmt.Sprintf("172.%d.123.0/24", rInt)
 	tag := "tf-acc-subnet-data-source"
 
@@ -114,7 +113,7 @@ func TestAccEC2SubnetDataSource_basic(t *testing.T) {
 }
 
 func TestAccEC2SubnetDataSource_ipv6ByIPv6Filter(t *testing.T) {
-	rInt := sdkacctest.RandIntRange(0, 256)
+	rInt := acctest.RandIntRange(0, 256)
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
 		ErrorCheck: acctest.ErrorCheck(t, ec2.EndpointsID),
@@ -135,7 +134,7 @@ func TestAccEC2SubnetDataSource_ipv6ByIPv6Filter(t *testing.T) {
 }
 
 func TestAccEC2SubnetDataSource_ipv6ByIPv6CIDRBlock(t *testing.T) {
-	rInt := sdkacctest.RandIntRange(0, 256)
+	rInt := acctest.RandIntRange(0, 256)
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
 		ErrorCheck: acctest.ErrorCheck(t, ec2.EndpointsID),
@@ -5,13 +5,12 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
 func TestAccEC2SubnetIDsDataSource_basic(t *testing.T) {
-	rInt := sdkacctest.RandIntRange(0, 256)
+	rInt := acctest.RandIntRange(0, 256)
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
 		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
@@ -33,7 +32,7 @@ func TestAccEC2SubnetIDsDataSource_basic(t *testing.T) {
 }
 
 func TestAccEC2SubnetIDsDataSource_filter(t *testing.T) {
-	rInt := sdkacctest.RandIntRange(0, 256)
+	rInt := acctest.RandIntRange(0, 256)
 	rName := "data.aws_subnet_ids.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -602,7 +602,7 @@ func sweepEIPs(region string) error {
 			d.SetId(aws.StringValue(address.PublicIp))
 		}
 
-		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		sweepResources = append(sweepResources, sweep.NewSweepResource("aws_eip", r, d, client))
 	}
 
 	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
@@ -636,7 +636,7 @@ func sweepFlowLogs(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(flowLog.FlowLogId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_flow_log", r, d, client))
 		}
 
 		return !lastPage
@@ -679,7 +679,7 @@ func sweepHosts(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(host.HostId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_ec2_host", r, d, client))
 		}
 
 		return !lastPage
@@ -737,7 +737,7 @@ func sweepInstances(region string) error {
 				d.SetId(id)
 				d.Set("disable_api_termination", false)
 
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sweep.NewSweepResource("aws_instance", r, d, client))
 			}
 		}
 		return !lastPage
@@ -1121,7 +1121,7 @@ func sweepPlacementGroups(region string) error {
 		d := r.Data(nil)
 		d.SetId(aws.StringValue(placementGroup.GroupName))
 
-		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		sweepResources = append(sweepResources, sweep.NewSweepResource("aws_placement_group", r, d, client))
 	}
 
 	err = sweep.SweepOrchestrator(sweepResources)
@@ -1372,7 +1372,7 @@ func sweepSpotFleetRequests(region string) error {
 			d.SetId(id)
 			d.Set("terminate_instances_with_expiration", true)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_spot_fleet_request", r, d, client))
 		}
 
 		return !lastPage
@@ -1428,7 +1428,7 @@ func sweepSubnets(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_subnet", r, d, client))
 		}
 
 		return !lastPage
@@ -1931,7 +1931,18 @@ func sweepVPCs(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			vpcSweepResource := sweep.NewSweepResource("aws_vpc", r, d, client)
+
+			// Tear down any remaining subnets, security groups and network interfaces in the same pass as the VPC.
+			// They're swept only if the VPC satisfies the sweep filters.
+			dependencies, vpcDependencies, err := sweepVPCDependencies(conn, client, vpcSweepResource, id)
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error describing EC2 VPC (%s) dependencies for %s: %w", id, region, err))
+			}
+
+			sweepResources = append(sweepResources, dependencies...)
+			sweepResources = append(sweepResources, vpcSweepResource.DependsOn(vpcDependencies...))
 		}
 
 		return !lastPage
@@ -1953,6 +1964,108 @@ func sweepVPCs(region string) error {
 	return errs.ErrorOrNil()
 }
 
+// sweepVPCDependencies returns the sweep resources for the non-default subnets and security groups and
+// the available network interfaces in a VPC, each part of the VPC and depending on the network interfaces
+// using it, and the subset of those on which the VPC itself depends.
+func sweepVPCDependencies(conn *ec2.EC2, client interface{}, vpc *sweep.SweepResource, vpcID string) ([]*sweep.SweepResource, []*sweep.SweepResource, error) {
+	var sweepResources, vpcDependencies []*sweep.SweepResource
+	filters := BuildAttributeFilterList(map[string]string{"vpc-id": vpcID})
+
+	networkInterfaces := make([]*sweep.SweepResource, 0)
+	networkInterfacesBySubnet := make(map[string][]*sweep.SweepResource)
+
+	err := conn.DescribeNetworkInterfacesPages(&ec2.DescribeNetworkInterfacesInput{Filters: filters}, func(page *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, networkInterface := range page.NetworkInterfaces {
+			// Network interfaces in use are deleted with the resources using them.
+			if aws.StringValue(networkInterface.Status) != ec2.NetworkInterfaceStatusAvailable {
+				continue
+			}
+
+			r := ResourceNetworkInterface()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(networkInterface.NetworkInterfaceId))
+
+			sweepResource := sweep.NewSweepResource("aws_network_interface", r, d, client).PartOf(vpc)
+			subnetID := aws.StringValue(networkInterface.SubnetId)
+
+			networkInterfaces = append(networkInterfaces, sweepResource)
+			networkInterfacesBySubnet[subnetID] = append(networkInterfacesBySubnet[subnetID], sweepResource)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sweepResources = append(sweepResources, networkInterfaces...)
+
+	err = conn.DescribeSubnetsPages(&ec2.DescribeSubnetsInput{Filters: filters}, func(page *ec2.DescribeSubnetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, subnet := range page.Subnets {
+			if aws.BoolValue(subnet.DefaultForAz) {
+				continue
+			}
+
+			id := aws.StringValue(subnet.SubnetId)
+
+			r := ResourceSubnet()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			sweepResource := sweep.NewSweepResource("aws_subnet", r, d, client).PartOf(vpc).DependsOn(networkInterfacesBySubnet[id]...)
+
+			sweepResources = append(sweepResources, sweepResource)
+			vpcDependencies = append(vpcDependencies, sweepResource)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = conn.DescribeSecurityGroupsPages(&ec2.DescribeSecurityGroupsInput{Filters: filters}, func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, sg := range page.SecurityGroups {
+			if aws.StringValue(sg.GroupName) == "default" {
+				continue
+			}
+
+			r := ResourceSecurityGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(sg.GroupId))
+			// Rules referencing other security groups would otherwise cause dependency violations.
+			d.Set("revoke_rules_on_delete", true)
+
+			sweepResource := sweep.NewSweepResource("aws_security_group", r, d, client).PartOf(vpc).DependsOn(networkInterfaces...)
+
+			sweepResources = append(sweepResources, sweepResource)
+			vpcDependencies = append(vpcDependencies, sweepResource)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sweepResources, vpcDependencies, nil
+}
+
 func sweepVPNConnections(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
@@ -5,14 +5,13 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 )
 
 func TestAccEC2Tag_basic(t *testing.T) {
-	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	rBgpAsn := acctest.RandIntRange(64512, 65534)
 	resourceName := "aws_ec2_tag.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -39,7 +38,7 @@ func TestAccEC2Tag_basic(t *testing.T) {
 }
 
 func TestAccEC2Tag_disappears(t *testing.T) {
-	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	rBgpAsn := acctest.RandIntRange(64512, 65534)
 	resourceName := "aws_ec2_tag.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -61,7 +60,7 @@ func TestAccEC2Tag_disappears(t *testing.T) {
 }
 
 func TestAccEC2Tag_value(t *testing.T) {
-	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	rBgpAsn := acctest.RandIntRange(64512, 65534)
 	resourceName := "aws_ec2_tag.test"
 
 	resource.ParallelTest(t, resource.TestCase{
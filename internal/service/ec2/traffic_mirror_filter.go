@@ -19,6 +19,7 @@ import (
 func ResourceTrafficMirrorFilter() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTrafficMirrorFilterCreate,
+		//lintignore:AWSR004
 		Read:   resourceTrafficMirrorFilterRead,
 		Update: resourceTrafficMirrorFilterUpdate,
 		Delete: resourceTrafficMirrorFilterDelete,
@@ -17,6 +17,7 @@ import (
 func ResourceTrafficMirrorFilterRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTrafficMirrorFilterRuleCreate,
+		//lintignore:AWSR004
 		Read:   resourceTrafficMirrorFilterRuleRead,
 		Update: resourceTrafficMirrorFilterRuleUpdate,
 		Delete: resourceTrafficMirrorFilterRuleDelete,
@@ -19,6 +19,7 @@ func ResourceTrafficMirrorSession() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTrafficMirrorSessionCreate,
 		Update: resourceTrafficMirrorSessionUpdate,
+		//lintignore:AWSR004
 		Read:   resourceTrafficMirrorSessionRead,
 		Delete: resourceTrafficMirrorSessionDelete,
 		Importer: &schema.ResourceImporter{
@@ -17,6 +17,7 @@ import (
 func ResourceTrafficMirrorTarget() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTrafficMirrorTargetCreate,
+		//lintignore:AWSR004
 		Read:   resourceTrafficMirrorTargetRead,
 		Update: resourceTrafficMirrorTargetUpdate,
 		Delete: resourceTrafficMirrorTargetDelete,
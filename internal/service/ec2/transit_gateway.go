@@ -23,6 +23,7 @@ import (
 func ResourceTransitGateway() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTransitGatewayCreate,
+		//lintignore:AWSR004
 		Read:   resourceTransitGatewayRead,
 		Update: resourceTransitGatewayUpdate,
 		Delete: resourceTransitGatewayDelete,
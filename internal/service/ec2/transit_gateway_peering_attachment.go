@@ -16,6 +16,7 @@ import (
 func ResourceTransitGatewayPeeringAttachment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTransitGatewayPeeringAttachmentCreate,
+		//lintignore:AWSR004
 		Read:   resourceTransitGatewayPeeringAttachmentRead,
 		Update: resourceTransitGatewayPeeringAttachmentUpdate,
 		Delete: resourceTransitGatewayPeeringAttachmentDelete,
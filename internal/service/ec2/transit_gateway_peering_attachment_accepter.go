@@ -16,6 +16,7 @@ import (
 func ResourceTransitGatewayPeeringAttachmentAccepter() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTransitGatewayPeeringAttachmentAccepterCreate,
+		//lintignore:AWSR004
 		Read:   resourceTransitGatewayPeeringAttachmentAccepterRead,
 		Update: resourceTransitGatewayPeeringAttachmentAccepterUpdate,
 		Delete: resourceTransitGatewayPeeringAttachmentAccepterDelete,
@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ func testAccTransitGatewayPeeringAttachmentAccepter_basic_sameAccount(t *testing
 	peeringAttachmentName := "aws_ec2_transit_gateway_peering_attachment.test"
 	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
 	transitGatewayResourceNamePeer := "aws_ec2_transit_gateway.peer"
-	rName := fmt.Sprintf("tf-testacc-tgwpeerattach-%s", sdkacctest.RandString(8))
+	rName := fmt.Sprintf("tf-testacc-tgwpeerattach-%s", acctest.RandString(8))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -56,7 +55,7 @@ func testAccTransitGatewayPeeringAttachmentAccepter_Tags_sameAccount(t *testing.
 	var providers []*schema.Provider
 	var transitGatewayPeeringAttachment ec2.TransitGatewayPeeringAttachment
 	resourceName := "aws_ec2_transit_gateway_peering_attachment_accepter.test"
-	rName := fmt.Sprintf("tf-testacc-tgwpeerattach-%s", sdkacctest.RandString(8))
+	rName := fmt.Sprintf("tf-testacc-tgwpeerattach-%s", acctest.RandString(8))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -107,7 +106,7 @@ func testAccTransitGatewayPeeringAttachmentAccepter_basic_differentAccount(t *te
 	peeringAttachmentName := "aws_ec2_transit_gateway_peering_attachment.test"
 	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
 	transitGatewayResourceNamePeer := "aws_ec2_transit_gateway.peer"
-	rName := fmt.Sprintf("tf-testacc-tgwpeerattach-%s", sdkacctest.RandString(8))
+	rName := fmt.Sprintf("tf-testacc-tgwpeerattach-%s", acctest.RandString(8))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -15,6 +15,7 @@ import (
 func ResourceTransitGatewayPrefixListReference() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTransitGatewayPrefixListReferenceCreate,
+		//lintignore:AWSR004
 		Read:   resourceTransitGatewayPrefixListReferenceRead,
 		Update: resourceTransitGatewayPrefixListReferenceUpdate,
 		Delete: resourceTransitGatewayPrefixListReferenceDelete,
@@ -19,6 +19,7 @@ import (
 func ResourceTransitGatewayRoute() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTransitGatewayRouteCreate,
+		//lintignore:AWSR004
 		Read:   resourceTransitGatewayRouteRead,
 		Delete: resourceTransitGatewayRouteDelete,
 		Importer: &schema.ResourceImporter{
@@ -18,6 +18,7 @@ import (
 func ResourceTransitGatewayRouteTable() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTransitGatewayRouteTableCreate,
+		//lintignore:AWSR004
 		Read:   resourceTransitGatewayRouteTableRead,
 		Update: resourceTransitGatewayRouteTableUpdate,
 		Delete: resourceTransitGatewayRouteTableDelete,
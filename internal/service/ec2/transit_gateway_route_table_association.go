@@ -15,6 +15,7 @@ import (
 func ResourceTransitGatewayRouteTableAssociation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTransitGatewayRouteTableAssociationCreate,
+		//lintignore:AWSR004
 		Read:   resourceTransitGatewayRouteTableAssociationRead,
 		Delete: resourceTransitGatewayRouteTableAssociationDelete,
 		Importer: &schema.ResourceImporter{
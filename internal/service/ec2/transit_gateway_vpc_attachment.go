@@ -18,6 +18,7 @@ import (
 func ResourceTransitGatewayVPCAttachment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTransitGatewayVPCAttachmentCreate,
+		//lintignore:AWSR004
 		Read:   resourceTransitGatewayVPCAttachmentRead,
 		Update: resourceTransitGatewayVPCAttachmentUpdate,
 		Delete: resourceTransitGatewayVPCAttachmentDelete,
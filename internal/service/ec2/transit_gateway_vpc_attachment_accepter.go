@@ -16,6 +16,7 @@ import (
 func ResourceTransitGatewayVPCAttachmentAccepter() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTransitGatewayVPCAttachmentAccepterCreate,
+		//lintignore:AWSR004
 		Read:   resourceTransitGatewayVPCAttachmentAccepterRead,
 		Update: resourceTransitGatewayVPCAttachmentAccepterUpdate,
 		Delete: resourceTransitGatewayVPCAttachmentAccepterDelete,
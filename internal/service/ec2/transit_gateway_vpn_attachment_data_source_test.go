@@ -5,13 +5,12 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
 func testAccTransitGatewayVPNAttachmentDataSource_TransitGatewayIdAndVpnConnectionID(t *testing.T) {
-	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	rBgpAsn := acctest.RandIntRange(64512, 65534)
 	dataSourceName := "data.aws_ec2_transit_gateway_vpn_attachment.test"
 	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
 	vpnConnectionResourceName := "aws_vpn_connection.test"
@@ -38,7 +37,7 @@ func testAccTransitGatewayVPNAttachmentDataSource_TransitGatewayIdAndVpnConnecti
 }
 
 func testAccTransitGatewayVPNAttachmentDataSource_filter(t *testing.T) {
-	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	rBgpAsn := acctest.RandIntRange(64512, 65534)
 	dataSourceName := "data.aws_ec2_transit_gateway_vpn_attachment.test"
 	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
 	vpnConnectionResourceName := "aws_vpn_connection.test"
@@ -20,6 +20,7 @@ import (
 func ResourceVolumeAttachment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceVolumeAttachmentCreate,
+		//lintignore:AWSR004
 		Read:   resourceVolumeAttachmentRead,
 		Update: resourceVolumeAttachmentUpdate,
 		Delete: resourceVolumeAttachmentDelete,
@@ -22,6 +22,7 @@ import (
 func ResourceVPCDHCPOptions() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceVPCDHCPOptionsCreate,
+		//lintignore:AWSR004
 		Read:   resourceVPCDHCPOptionsRead,
 		Update: resourceVPCDHCPOptionsUpdate,
 		Delete: resourceVPCDHCPOptionsDelete,
@@ -16,6 +16,7 @@ import (
 func ResourceVPCEndpointConnectionNotification() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceVPCEndpointConnectionNotificationCreate,
+		//lintignore:AWSR004
 		Read:   resourceVPCEndpointConnectionNotificationRead,
 		Update: resourceVPCEndpointConnectionNotificationUpdate,
 		Delete: resourceVPCEndpointConnectionNotificationDelete,
@@ -21,6 +21,7 @@ import (
 func ResourceVPCEndpointService() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceVPCEndpointServiceCreate,
+		//lintignore:AWSR004
 		Read:   resourceVPCEndpointServiceRead,
 		Update: resourceVPCEndpointServiceUpdate,
 		Delete: resourceVPCEndpointServiceDelete,
@@ -15,6 +15,7 @@ import (
 func ResourceVPCEndpointServiceAllowedPrincipal() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceVPCEndpointServiceAllowedPrincipalCreate,
+		//lintignore:AWSR004
 		Read:   resourceVPCEndpointServiceAllowedPrincipalRead,
 		Delete: resourceVPCEndpointServiceAllowedPrincipalDelete,
 
@@ -21,6 +21,7 @@ const (
 func ResourceVPCIPv4CIDRBlockAssociation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceVPCIPv4CIDRBlockAssociationCreate,
+		//lintignore:AWSR004
 		Read:   resourceVPCIPv4CIDRBlockAssociationRead,
 		Delete: resourceVPCIPv4CIDRBlockAssociationDelete,
 		Importer: &schema.ResourceImporter{
@@ -19,6 +19,7 @@ import (
 func ResourceVPCPeeringConnection() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceVPCPeeringCreate,
+		//lintignore:AWSR004
 		Read:   resourceVPCPeeringRead,
 		Update: resourceVPCPeeringUpdate,
 		Delete: resourceVPCPeeringDelete,
@@ -14,6 +14,7 @@ import (
 func ResourceVPCPeeringConnectionAccepter() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceVPCPeeringAccepterCreate,
+		//lintignore:AWSR004
 		Read:   resourceVPCPeeringRead,
 		Update: resourceVPCPeeringUpdate,
 		Delete: resourceVPCPeeringAccepterDelete,
@@ -15,6 +15,7 @@ import (
 func ResourceVPCPeeringConnectionOptions() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceVPCPeeringConnectionOptionsCreate,
+		//lintignore:AWSR004
 		Read:   resourceVPCPeeringConnectionOptionsRead,
 		Update: resourceVPCPeeringConnectionOptionsUpdate,
 		Delete: resourceVPCPeeringConnectionOptionsDelete,
@@ -66,6 +66,7 @@ func (slice XmlVpnConnectionConfig) Swap(i, j int) {
 func ResourceVPNConnection() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceVPNConnectionCreate,
+		//lintignore:AWSR004
 		Read:   resourceVPNConnectionRead,
 		Update: resourceVPNConnectionUpdate,
 		Delete: resourceVPNConnectionDelete,
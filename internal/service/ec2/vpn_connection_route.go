@@ -19,6 +19,7 @@ func ResourceVPNConnectionRoute() *schema.Resource {
 		// You can't update a route. You can just delete one and make
 		// a new one.
 		Create: resourceVPNConnectionRouteCreate,
+		//lintignore:AWSR004
 		Read:   resourceVPNConnectionRouteRead,
 		Delete: resourceVPNConnectionRouteDelete,
 
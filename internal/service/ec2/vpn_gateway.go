@@ -21,6 +21,7 @@ import (
 func ResourceVPNGateway() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceVPNGatewayCreate,
+		//lintignore:AWSR004
 		Read:   resourceVPNGatewayRead,
 		Update: resourceVPNGatewayUpdate,
 		Delete: resourceVPNGatewayDelete,
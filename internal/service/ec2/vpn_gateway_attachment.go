@@ -14,6 +14,7 @@ import (
 func ResourceVPNGatewayAttachment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceVPNGatewayAttachmentCreate,
+		//lintignore:AWSR004
 		Read:   resourceVPNGatewayAttachmentRead,
 		Delete: resourceVPNGatewayAttachmentDelete,
 
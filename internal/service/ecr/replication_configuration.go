@@ -14,6 +14,7 @@ import (
 func ResourceReplicationConfiguration() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceReplicationConfigurationPut,
+		//lintignore:AWSR004
 		Read:   resourceReplicationConfigurationRead,
 		Update: resourceReplicationConfigurationPut,
 		Delete: resourceReplicationConfigurationDelete,
@@ -21,6 +22,8 @@ func ResourceReplicationConfiguration() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: verify.RegionsDiff(verify.BlockRegions("replication_configuration.0.rule.0.destination", "region")),
+
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Type:     schema.TypeString,
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func TestAccECRRepository_basic(t *testing.T) {
 	var v ecr.Repository
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ecr_repository.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -51,7 +50,7 @@ func TestAccECRRepository_basic(t *testing.T) {
 
 func TestAccECRRepository_tags(t *testing.T) {
 	var v1, v2 ecr.Repository
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ecr_repository.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -84,7 +83,7 @@ func TestAccECRRepository_tags(t *testing.T) {
 
 func TestAccECRRepository_immutability(t *testing.T) {
 	var v ecr.Repository
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ecr_repository.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -112,7 +111,7 @@ func TestAccECRRepository_immutability(t *testing.T) {
 
 func TestAccECRRepository_Image_scanning(t *testing.T) {
 	var v1, v2 ecr.Repository
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ecr_repository.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -162,7 +161,7 @@ func TestAccECRRepository_Image_scanning(t *testing.T) {
 
 func TestAccECRRepository_Encryption_kms(t *testing.T) {
 	var v1, v2 ecr.Repository
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ecr_repository.test"
 	kmsKeyDataSourceName := "aws_kms_key.test"
 
@@ -208,7 +207,7 @@ func TestAccECRRepository_Encryption_kms(t *testing.T) {
 
 func TestAccECRRepository_Encryption_aes256(t *testing.T) {
 	var v1, v2 ecr.Repository
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ecr_repository.test"
 
 	resource.ParallelTest(t, resource.TestCase{
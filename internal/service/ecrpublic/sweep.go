@@ -42,7 +42,7 @@ func sweepRepositories(region string) error {
 			d.Set("registry_id", repository.RegistryId)
 			d.Set("force_destroy", true)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_ecrpublic_repository", r, d, client))
 		}
 
 		return !lastPage
@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 
 func TestAccECSCluster_basic(t *testing.T) {
 	var cluster1 ecs.Cluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ecs_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -46,7 +45,7 @@ func TestAccECSCluster_basic(t *testing.T) {
 
 func TestAccECSCluster_disappears(t *testing.T) {
 	var cluster1 ecs.Cluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ecs_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -69,7 +68,7 @@ func TestAccECSCluster_disappears(t *testing.T) {
 
 func TestAccECSCluster_tags(t *testing.T) {
 	var cluster1 ecs.Cluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ecs_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -115,8 +114,8 @@ func TestAccECSCluster_tags(t *testing.T) {
 
 func TestAccECSCluster_singleCapacityProvider(t *testing.T) {
 	var cluster1 ecs.Cluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	providerName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	providerName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ecs_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -143,7 +142,7 @@ func TestAccECSCluster_singleCapacityProvider(t *testing.T) {
 
 func TestAccECSCluster_capacityProviders(t *testing.T) {
 	var cluster ecs.Cluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ecs_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -174,7 +173,7 @@ func TestAccECSCluster_capacityProviders(t *testing.T) {
 
 func TestAccECSCluster_capacityProvidersUpdate(t *testing.T) {
 	var cluster1 ecs.Cluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ecs_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -213,7 +212,7 @@ func TestAccECSCluster_capacityProvidersUpdate(t *testing.T) {
 
 func TestAccECSCluster_capacityProvidersNoStrategy(t *testing.T) {
 	var cluster1 ecs.Cluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ecs_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -246,7 +245,7 @@ func TestAccECSCluster_capacityProvidersNoStrategy(t *testing.T) {
 
 func TestAccECSCluster_containerInsights(t *testing.T) {
 	var cluster1 ecs.Cluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ecs_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -292,7 +291,7 @@ func TestAccECSCluster_containerInsights(t *testing.T) {
 
 func TestAccECSCluster(t *testing.T) {
 	var cluster1 ecs.Cluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ecs_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -312,19 +313,13 @@ func ResourceService() *schema.Resource {
 				Computed: true,
 			},
 			"propagate_tags": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					if old == "NONE" && new == "" {
-						return true
-					}
-					return false
-				},
-				ValidateFunc: validation.StringInSlice([]string{
+				Type:             nullable.TypeNullableString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: nullable.DiffSuppressNullableStringValueAsNull("NONE"),
+				ValidateFunc: nullable.ValidateTypeStringNullableStringInSlice([]string{
 					ecs.PropagateTagsService,
 					ecs.PropagateTagsTaskDefinition,
-					"",
 				}, false),
 			},
 			"scheduling_strategy": {
@@ -462,8 +457,8 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if v, ok := d.GetOk("propagate_tags"); ok {
-		input.PropagateTags = aws.String(v.(string))
+	if v, null := nullable.String(d.Get("propagate_tags").(string)).Value(); !null {
+		input.PropagateTags = aws.String(v)
 	}
 
 	if v, ok := d.GetOk("platform_version"); ok {
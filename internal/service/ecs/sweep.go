@@ -75,7 +75,7 @@ func sweepCapacityProviders(region string) error {
 			d := r.Data(nil)
 			d.SetId(arn)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_ecs_capacity_provider", r, d, client))
 		}
 
 		return !lastPage
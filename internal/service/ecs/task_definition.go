@@ -25,6 +25,7 @@ func ResourceTaskDefinition() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
 		Create: resourceTaskDefinitionCreate,
+		//lintignore:AWSR004
 		Read:   resourceTaskDefinitionRead,
 		Update: resourceTaskDefinitionUpdate,
 		Delete: resourceTaskDefinitionDelete,
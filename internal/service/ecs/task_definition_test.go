@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -29,7 +28,7 @@ func testAccErrorCheckSkipECS(t *testing.T) resource.ErrorCheckFunc {
 func TestAccECSTaskDefinition_basic(t *testing.T) {
 	var def ecs.TaskDefinition
 
-	tdName := sdkacctest.RandomWithPrefix("tf-acc-td-basic")
+	tdName := acctest.RandomWithPrefix("tf-acc-td-basic")
 	resourceName := "aws_ecs_task_definition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -66,7 +65,7 @@ func TestAccECSTaskDefinition_basic(t *testing.T) {
 func TestAccECSTaskDefinition_withScratchVolume(t *testing.T) {
 	var def ecs.TaskDefinition
 
-	tdName := sdkacctest.RandomWithPrefix("tf-acc-td-with-scratch-volume")
+	tdName := acctest.RandomWithPrefix("tf-acc-td-with-scratch-volume")
 	resourceName := "aws_ecs_task_definition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -94,7 +93,7 @@ func TestAccECSTaskDefinition_withScratchVolume(t *testing.T) {
 func TestAccECSTaskDefinition_withDockerVolume(t *testing.T) {
 	var def ecs.TaskDefinition
 
-	tdName := sdkacctest.RandomWithPrefix("tf-acc-td-with-docker-volume")
+	tdName := acctest.RandomWithPrefix("tf-acc-td-with-docker-volume")
 	resourceName := "aws_ecs_task_definition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -136,7 +135,7 @@ func TestAccECSTaskDefinition_withDockerVolume(t *testing.T) {
 func TestAccECSTaskDefinition_withDockerVolumeMinimal(t *testing.T) {
 	var def ecs.TaskDefinition
 
-	tdName := sdkacctest.RandomWithPrefix("tf-acc-td-with-docker-volume")
+	tdName := acctest.RandomWithPrefix("tf-acc-td-with-docker-volume")
 	resourceName := "aws_ecs_task_definition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -170,7 +169,7 @@ func TestAccECSTaskDefinition_withDockerVolumeMinimal(t *testing.T) {
 func TestAccECSTaskDefinition_withEFSVolumeMinimal(t *testing.T) {
 	var def ecs.TaskDefinition
 
-	tdName := sdkacctest.RandomWithPrefix("tf-acc-td-with-efs-volume-min")
+	tdName := acctest.RandomWithPrefix("tf-acc-td-with-efs-volume-min")
 	resourceName := "aws_ecs_task_definition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -204,7 +203,7 @@ func TestAccECSTaskDefinition_withEFSVolumeMinimal(t *testing.T) {
 func TestAccECSTaskDefinition_withEFSVolume(t *testing.T) {
 	var def ecs.TaskDefinition
 
-	tdName := sdkacctest.RandomWithPrefix("tf-acc-td-with-efs-volume")
+	tdName := acctest.RandomWithPrefix("tf-acc-td-with-efs-volume")
 	resourceName := "aws_ecs_task_definition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -239,7 +238,7 @@ func TestAccECSTaskDefinition_withEFSVolume(t *testing.T) {
 func TestAccECSTaskDefinition_withTransitEncryptionEFSVolume(t *testing.T) {
 	var def ecs.TaskDefinition
 
-	tdName := sdkacctest.RandomWithPrefix("tf-acc-td-with-efs-volume")
+	tdName := acctest.RandomWithPrefix("tf-acc-td-with-efs-volume")
 	resourceName := "aws_ecs_task_definition.test"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -275,7 +274,7 @@ func TestAccECSTaskDefinition_withTransitEncryptionEFSVolume(t *testing.T) {
 func TestAccECSTaskDefinition_withEFSAccessPoint(t *testing.T) {
 	var def ecs.TaskDefinition
 
-	tdName := sdkacctest.RandomWithPrefix("tf-acc-td-with-efs-volume")
+	tdName := acctest.RandomWithPrefix("tf-acc-td-with-efs-volume")
 	resourceName := "aws_ecs_task_definition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -315,7 +314,7 @@ func TestAccECSTaskDefinition_withEFSAccessPoint(t *testing.T) {
 func TestAccECSTaskDefinition_withFSxWinFileSystem(t *testing.T) {
 	var def ecs.TaskDefinition
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ecs_task_definition.test"
 
 	if acctest.Partition() == "aws-us-gov" {
@@ -357,7 +356,7 @@ func TestAccECSTaskDefinition_withFSxWinFileSystem(t *testing.T) {
 func TestAccECSTaskDefinition_withTaskScopedDockerVolume(t *testing.T) {
 	var def ecs.TaskDefinition
 
-	tdName := sdkacctest.RandomWithPrefix("tf-acc-td-with-docker-volume")
+	tdName := acctest.RandomWithPrefix("tf-acc-td-with-docker-volume")
 	resourceName := "aws_ecs_task_definition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -389,9 +388,9 @@ func TestAccECSTaskDefinition_withECSService(t *testing.T) {
 	var def ecs.TaskDefinition
 	var service ecs.Service
 
-	clusterName := sdkacctest.RandomWithPrefix("tf-acc-cluster-with-ecs-service")
-	svcName := sdkacctest.RandomWithPrefix("tf-acc-td-with-ecs-service")
-	tdName := sdkacctest.RandomWithPrefix("tf-acc-td-with-ecs-service")
+	clusterName := acctest.RandomWithPrefix("tf-acc-cluster-with-ecs-service")
+	svcName := acctest.RandomWithPrefix("tf-acc-td-with-ecs-service")
+	tdName := acctest.RandomWithPrefix("tf-acc-td-with-ecs-service")
 	resourceName := "aws_ecs_task_definition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -427,9 +426,9 @@ func TestAccECSTaskDefinition_withECSService(t *testing.T) {
 func TestAccECSTaskDefinition_withTaskRoleARN(t *testing.T) {
 	var def ecs.TaskDefinition
 
-	roleName := sdkacctest.RandomWithPrefix("tf-acc-role-ecs-td-with-task-role-arn")
-	policyName := sdkacctest.RandomWithPrefix("tf-acc-policy-ecs-td-with-task-role-arn")
-	tdName := sdkacctest.RandomWithPrefix("tf-acc-td-with-task-role-arn")
+	roleName := acctest.RandomWithPrefix("tf-acc-role-ecs-td-with-task-role-arn")
+	policyName := acctest.RandomWithPrefix("tf-acc-policy-ecs-td-with-task-role-arn")
+	tdName := acctest.RandomWithPrefix("tf-acc-td-with-task-role-arn")
 	resourceName := "aws_ecs_task_definition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -457,9 +456,9 @@ func TestAccECSTaskDefinition_withTaskRoleARN(t *testing.T) {
 func TestAccECSTaskDefinition_withNetworkMode(t *testing.T) {
 	var def ecs.TaskDefinition
 
-	roleName := sdkacctest.RandomWithPrefix("tf-acc-ecs-td-with-network-mode")
-	policyName := sdkacctest.RandomWithPrefix("tf-acc-ecs-td-with-network-mode")
-	tdName := sdkacctest.RandomWithPrefix("tf-acc-td-with-network-mode")
+	roleName := acctest.RandomWithPrefix("tf-acc-ecs-td-with-network-mode")
+	policyName := acctest.RandomWithPrefix("tf-acc-ecs-td-with-network-mode")
+	tdName := acctest.RandomWithPrefix("tf-acc-td-with-network-mode")
 	resourceName := "aws_ecs_task_definition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -488,9 +487,9 @@ func TestAccECSTaskDefinition_withNetworkMode(t *testing.T) {
 func TestAccECSTaskDefinition_withIPCMode(t *testing.T) {
 	var def ecs.TaskDefinition
 
-	roleName := sdkacctest.RandomWithPrefix("tf-acc-ecs-td-with-ipc-mode")
-	policyName := sdkacctest.RandomWithPrefix("tf-acc-ecs-td-with-ipc-mode")
-	tdName := sdkacctest.RandomWithPrefix("tf-acc-td-with-ipc-mode")
+	roleName := acctest.RandomWithPrefix("tf-acc-ecs-td-with-ipc-mode")
+	policyName := acctest.RandomWithPrefix("tf-acc-ecs-td-with-ipc-mode")
+	tdName := acctest.RandomWithPrefix("tf-acc-td-with-ipc-mode")
 	resourceName := "aws_ecs_task_definition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -519,9 +518,9 @@ func TestAccECSTaskDefinition_withIPCMode(t *testing.T) {
 func TestAccECSTaskDefinition_withPidMode(t *testing.T) {
 	var def ecs.TaskDefinition
 
-	roleName := sdkacctest.RandomWithPrefix("tf-acc-ecs-td-with-pid-mode")
-	policyName := sdkacctest.RandomWithPrefix("tf-acc-ecs-td-with-pid-mode")
-	tdName := sdkacctest.RandomWithPrefix("tf-acc-td-with-pid-mode")
+	roleName := acctest.RandomWithPrefix("tf-acc-ecs-td-with-pid-mode")
+	policyName := acctest.RandomWithPrefix("tf-acc-ecs-td-with-pid-mode")
+	tdName := acctest.RandomWithPrefix("tf-acc-td-with-pid-mode")
 	resourceName := "aws_ecs_task_definition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -550,7 +549,7 @@ func TestAccECSTaskDefinition_withPidMode(t *testing.T) {
 func TestAccECSTaskDefinition_constraint(t *testing.T) {
 	var def ecs.TaskDefinition
 
-	tdName := sdkacctest.RandomWithPrefix("tf-acc-td-constraint")
+	tdName := acctest.RandomWithPrefix("tf-acc-td-constraint")
 	resourceName := "aws_ecs_task_definition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -581,7 +580,7 @@ func TestAccECSTaskDefinition_changeVolumesForcesNewResource(t *testing.T) {
 	var before ecs.TaskDefinition
 	var after ecs.TaskDefinition
 
-	tdName := sdkacctest.RandomWithPrefix("tf-acc-td-change-vol-forces-new-resource")
+	tdName := acctest.RandomWithPrefix("tf-acc-td-change-vol-forces-new-resource")
 	resourceName := "aws_ecs_task_definition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -618,7 +617,7 @@ func TestAccECSTaskDefinition_arrays(t *testing.T) {
 	var conf ecs.TaskDefinition
 	resourceName := "aws_ecs_task_definition.test"
 
-	tdName := sdkacctest.RandomWithPrefix("tf-acc-td-arrays")
+	tdName := acctest.RandomWithPrefix("tf-acc-td-arrays")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -645,7 +644,7 @@ func TestAccECSTaskDefinition_arrays(t *testing.T) {
 func TestAccECSTaskDefinition_fargate(t *testing.T) {
 	var conf ecs.TaskDefinition
 
-	tdName := sdkacctest.RandomWithPrefix("tf-acc-td-fargate")
+	tdName := acctest.RandomWithPrefix("tf-acc-td-fargate")
 	resourceName := "aws_ecs_task_definition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -681,7 +680,7 @@ func TestAccECSTaskDefinition_fargate(t *testing.T) {
 func TestAccECSTaskDefinition_Fargate_ephemeralStorage(t *testing.T) {
 	var conf ecs.TaskDefinition
 
-	tdName := sdkacctest.RandomWithPrefix("tf-acc-td-fargate")
+	tdName := acctest.RandomWithPrefix("tf-acc-td-fargate")
 	resourceName := "aws_ecs_task_definition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -714,9 +713,9 @@ func TestAccECSTaskDefinition_Fargate_ephemeralStorage(t *testing.T) {
 func TestAccECSTaskDefinition_executionRole(t *testing.T) {
 	var conf ecs.TaskDefinition
 
-	roleName := sdkacctest.RandomWithPrefix("tf-acc-role-ecs-td-execution-role")
-	policyName := sdkacctest.RandomWithPrefix("tf-acc-policy-ecs-td-execution-role")
-	tdName := sdkacctest.RandomWithPrefix("tf-acc-td-execution-role")
+	roleName := acctest.RandomWithPrefix("tf-acc-role-ecs-td-execution-role")
+	policyName := acctest.RandomWithPrefix("tf-acc-policy-ecs-td-execution-role")
+	tdName := acctest.RandomWithPrefix("tf-acc-td-execution-role")
 	resourceName := "aws_ecs_task_definition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -745,7 +744,7 @@ func TestAccECSTaskDefinition_executionRole(t *testing.T) {
 func TestAccECSTaskDefinition_disappears(t *testing.T) {
 	var def ecs.TaskDefinition
 
-	tdName := sdkacctest.RandomWithPrefix("tf-acc-td-basic")
+	tdName := acctest.RandomWithPrefix("tf-acc-td-basic")
 	resourceName := "aws_ecs_task_definition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -772,7 +771,7 @@ func TestAccECSTaskDefinition_disappears(t *testing.T) {
 
 func TestAccECSTaskDefinition_tags(t *testing.T) {
 	var taskDefinition ecs.TaskDefinition
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ecs_task_definition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -818,7 +817,7 @@ func TestAccECSTaskDefinition_tags(t *testing.T) {
 
 func TestAccECSTaskDefinition_proxy(t *testing.T) {
 	var taskDefinition ecs.TaskDefinition
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ecs_task_definition.test"
 
 	containerName := "web"
@@ -857,7 +856,7 @@ func TestAccECSTaskDefinition_proxy(t *testing.T) {
 func TestAccECSTaskDefinition_inferenceAccelerator(t *testing.T) {
 	var def ecs.TaskDefinition
 
-	tdName := sdkacctest.RandomWithPrefix("tf-acc-td-basic")
+	tdName := acctest.RandomWithPrefix("tf-acc-td-basic")
 	resourceName := "aws_ecs_task_definition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -18,6 +18,7 @@ import (
 func ResourceAccessPoint() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAccessPointCreate,
+		//lintignore:AWSR004
 		Read:   resourceAccessPointRead,
 		Update: resourceAccessPointUpdate,
 		Delete: resourceAccessPointDelete,
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/efs"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func TestAccEFSAccessPoint_basic(t *testing.T) {
 	var ap efs.AccessPointDescription
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_efs_access_point.test"
 	fsResourceName := "aws_efs_file_system.test"
 
@@ -53,7 +52,7 @@ func TestAccEFSAccessPoint_basic(t *testing.T) {
 
 func TestAccEFSAccessPoint_Root_directory(t *testing.T) {
 	var ap efs.AccessPointDescription
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_efs_access_point.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -82,7 +81,7 @@ func TestAccEFSAccessPoint_Root_directory(t *testing.T) {
 
 func TestAccEFSAccessPoint_RootDirectoryCreation_info(t *testing.T) {
 	var ap efs.AccessPointDescription
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_efs_access_point.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -114,7 +113,7 @@ func TestAccEFSAccessPoint_RootDirectoryCreation_info(t *testing.T) {
 
 func TestAccEFSAccessPoint_POSIX_user(t *testing.T) {
 	var ap efs.AccessPointDescription
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_efs_access_point.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -144,7 +143,7 @@ func TestAccEFSAccessPoint_POSIX_user(t *testing.T) {
 
 func TestAccEFSAccessPoint_POSIXUserSecondary_gids(t *testing.T) {
 	var ap efs.AccessPointDescription
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_efs_access_point.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -173,7 +172,7 @@ func TestAccEFSAccessPoint_POSIXUserSecondary_gids(t *testing.T) {
 
 func TestAccEFSAccessPoint_tags(t *testing.T) {
 	var ap efs.AccessPointDescription
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_efs_access_point.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -219,7 +218,7 @@ func TestAccEFSAccessPoint_tags(t *testing.T) {
 func TestAccEFSAccessPoint_disappears(t *testing.T) {
 	var ap efs.AccessPointDescription
 	resourceName := "aws_efs_access_point.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/efs"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 func TestAccEFSFileSystem_basic(t *testing.T) {
 	var desc efs.FileSystemDescription
 	resourceName := "aws_efs_file_system.test"
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix("tf-acc")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -66,7 +65,7 @@ func TestAccEFSFileSystem_basic(t *testing.T) {
 func TestAccEFSFileSystem_availabilityZoneName(t *testing.T) {
 	var desc efs.FileSystemDescription
 	resourceName := "aws_efs_file_system.test"
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix("tf-acc")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -93,7 +92,7 @@ func TestAccEFSFileSystem_availabilityZoneName(t *testing.T) {
 
 func TestAccEFSFileSystem_tags(t *testing.T) {
 	var desc efs.FileSystemDescription
-	rName := sdkacctest.RandomWithPrefix("tf-acc-tags")
+	rName := acctest.RandomWithPrefix("tf-acc-tags")
 	resourceName := "aws_efs_file_system.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -148,7 +147,7 @@ func TestAccEFSFileSystem_tags(t *testing.T) {
 
 func TestAccEFSFileSystem_pagedTags(t *testing.T) {
 	var desc efs.FileSystemDescription
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_efs_file_system.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -175,7 +174,7 @@ func TestAccEFSFileSystem_pagedTags(t *testing.T) {
 
 func TestAccEFSFileSystem_kmsKey(t *testing.T) {
 	var desc efs.FileSystemDescription
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	kmsKeyResourceName := "aws_kms_key.test"
 	resourceName := "aws_efs_file_system.test"
 
@@ -203,7 +202,7 @@ func TestAccEFSFileSystem_kmsKey(t *testing.T) {
 }
 
 func TestAccEFSFileSystem_kmsWithoutEncryption(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -361,7 +360,7 @@ func TestAccEFSFileSystem_lifecyclePolicy(t *testing.T) {
 func TestAccEFSFileSystem_disappears(t *testing.T) {
 	var desc efs.FileSystemDescription
 	resourceName := "aws_efs_file_system.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
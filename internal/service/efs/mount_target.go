@@ -20,6 +20,7 @@ import (
 func ResourceMountTarget() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceMountTargetCreate,
+		//lintignore:AWSR004
 		Read:   resourceMountTargetRead,
 		Update: resourceMountTargetUpdate,
 		Delete: resourceMountTargetDelete,
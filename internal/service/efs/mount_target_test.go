@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/efs"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -20,7 +19,7 @@ import (
 
 func TestAccEFSMountTarget_basic(t *testing.T) {
 	var mount efs.MountTargetDescription
-	ct := fmt.Sprintf("createtoken-%d", sdkacctest.RandInt())
+	ct := fmt.Sprintf("createtoken-%d", acctest.RandInt())
 	resourceName := "aws_efs_mount_target.test"
 	resourceName2 := "aws_efs_mount_target.test2"
 
@@ -65,7 +64,7 @@ func TestAccEFSMountTarget_basic(t *testing.T) {
 func TestAccEFSMountTarget_disappears(t *testing.T) {
 	var mount efs.MountTargetDescription
 	resourceName := "aws_efs_mount_target.test"
-	ct := fmt.Sprintf("createtoken-%d", sdkacctest.RandInt())
+	ct := fmt.Sprintf("createtoken-%d", acctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
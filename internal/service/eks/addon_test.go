@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -20,7 +19,7 @@ import (
 
 func TestAccEKSAddon_basic(t *testing.T) {
 	var addon eks.Addon
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	clusterResourceName := "aws_eks_cluster.test"
 	addonResourceName := "aws_eks_addon.test"
 	addonName := "vpc-cni"
@@ -54,7 +53,7 @@ func TestAccEKSAddon_basic(t *testing.T) {
 
 func TestAccEKSAddon_disappears(t *testing.T) {
 	var addon eks.Addon
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_addon.test"
 	addonName := "vpc-cni"
 	ctx := context.TODO()
@@ -79,7 +78,7 @@ func TestAccEKSAddon_disappears(t *testing.T) {
 
 func TestAccEKSAddon_Disappears_cluster(t *testing.T) {
 	var addon eks.Addon
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_addon.test"
 	clusterResourceName := "aws_eks_cluster.test"
 	addonName := "vpc-cni"
@@ -105,7 +104,7 @@ func TestAccEKSAddon_Disappears_cluster(t *testing.T) {
 
 func TestAccEKSAddon_addonVersion(t *testing.T) {
 	var addon1, addon2 eks.Addon
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_addon.test"
 	addonName := "vpc-cni"
 	addonVersion1 := "v1.8.0-eksbuild.1"
@@ -144,7 +143,7 @@ func TestAccEKSAddon_addonVersion(t *testing.T) {
 
 func TestAccEKSAddon_resolveConflicts(t *testing.T) {
 	var addon1, addon2 eks.Addon
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_addon.test"
 	addonName := "vpc-cni"
 	ctx := context.TODO()
@@ -181,7 +180,7 @@ func TestAccEKSAddon_resolveConflicts(t *testing.T) {
 
 func TestAccEKSAddon_serviceAccountRoleARN(t *testing.T) {
 	var addon eks.Addon
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_addon.test"
 	serviceRoleResourceName := "aws_iam_role.test-service-role"
 	addonName := "vpc-cni"
@@ -211,7 +210,7 @@ func TestAccEKSAddon_serviceAccountRoleARN(t *testing.T) {
 
 func TestAccEKSAddon_tags(t *testing.T) {
 	var addon1, addon2, addon3 eks.Addon
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_addon.test"
 	addonName := "vpc-cni"
 	ctx := context.TODO()
@@ -260,7 +259,7 @@ func TestAccEKSAddon_DefaultTags_providerOnly(t *testing.T) {
 	var providers []*schema.Provider
 	var addon eks.Addon
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_addon.test"
 	addonName := "vpc-cni"
 	ctx := context.TODO()
@@ -321,7 +320,7 @@ func TestAccEKSAddon_DefaultTags_updateToProviderOnly(t *testing.T) {
 	var providers []*schema.Provider
 	var addon eks.Addon
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_addon.test"
 	addonName := "vpc-cni"
 	ctx := context.TODO()
@@ -367,7 +366,7 @@ func TestAccEKSAddon_DefaultTags_updateToResourceOnly(t *testing.T) {
 	var providers []*schema.Provider
 	var addon eks.Addon
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_addon.test"
 	addonName := "vpc-cni"
 	ctx := context.TODO()
@@ -413,7 +412,7 @@ func TestAccEKSAddon_DefaultTagsProviderAndResource_nonOverlappingTag(t *testing
 	var providers []*schema.Provider
 	var addon eks.Addon
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_addon.test"
 	addonName := "vpc-cni"
 	ctx := context.TODO()
@@ -481,7 +480,7 @@ func TestAccEKSAddon_DefaultTagsProviderAndResource_overlappingTag(t *testing.T)
 	var providers []*schema.Provider
 	var addon eks.Addon
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_addon.test"
 	addonName := "vpc-cni"
 	ctx := context.TODO()
@@ -544,7 +543,7 @@ func TestAccEKSAddon_DefaultTagsProviderAndResource_overlappingTag(t *testing.T)
 func TestAccEKSAddon_DefaultTagsProviderAndResource_duplicateTag(t *testing.T) {
 	var providers []*schema.Provider
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	addonName := "vpc-cni"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -569,7 +568,7 @@ func TestAccEKSAddon_defaultAndIgnoreTags(t *testing.T) {
 	var providers []*schema.Provider
 	var addon eks.Addon
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_addon.test"
 	addonName := "vpc-cni"
 	ctx := context.TODO()
@@ -610,7 +609,7 @@ func TestAccEKSAddon_ignoreTags(t *testing.T) {
 	var providers []*schema.Provider
 	var addon eks.Addon
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_addon.test"
 	addonName := "vpc-cni"
 	ctx := context.TODO()
@@ -9,7 +9,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -20,7 +19,7 @@ import (
 
 func TestAccEKSCluster_basic(t *testing.T) {
 	var cluster eks.Cluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -67,7 +66,7 @@ func TestAccEKSCluster_basic(t *testing.T) {
 
 func TestAccEKSCluster_disappears(t *testing.T) {
 	var cluster eks.Cluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -90,7 +89,7 @@ func TestAccEKSCluster_disappears(t *testing.T) {
 
 func TestAccEKSCluster_Encryption_create(t *testing.T) {
 	var cluster eks.Cluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_cluster.test"
 	kmsKeyResourceName := "aws_kms_key.test"
 
@@ -121,7 +120,7 @@ func TestAccEKSCluster_Encryption_create(t *testing.T) {
 
 func TestAccEKSCluster_Encryption_update(t *testing.T) {
 	var cluster1, cluster2 eks.Cluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_cluster.test"
 	kmsKeyResourceName := "aws_kms_key.test"
 
@@ -161,7 +160,7 @@ func TestAccEKSCluster_Encryption_update(t *testing.T) {
 // https://github.com/hashicorp/terraform-provider-aws/issues/19968.
 func TestAccEKSCluster_Encryption_versionUpdate(t *testing.T) {
 	var cluster1, cluster2 eks.Cluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_cluster.test"
 	kmsKeyResourceName := "aws_kms_key.test"
 
@@ -205,7 +204,7 @@ func TestAccEKSCluster_Encryption_versionUpdate(t *testing.T) {
 
 func TestAccEKSCluster_version(t *testing.T) {
 	var cluster1, cluster2 eks.Cluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -240,7 +239,7 @@ func TestAccEKSCluster_version(t *testing.T) {
 
 func TestAccEKSCluster_logging(t *testing.T) {
 	var cluster1, cluster2 eks.Cluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -287,7 +286,7 @@ func TestAccEKSCluster_logging(t *testing.T) {
 
 func TestAccEKSCluster_tags(t *testing.T) {
 	var cluster1, cluster2, cluster3 eks.Cluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -332,7 +331,7 @@ func TestAccEKSCluster_tags(t *testing.T) {
 
 func TestAccEKSCluster_VPC_securityGroupIDs(t *testing.T) {
 	var cluster eks.Cluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -360,7 +359,7 @@ func TestAccEKSCluster_VPC_securityGroupIDs(t *testing.T) {
 
 func TestAccEKSCluster_VPC_endpointPrivateAccess(t *testing.T) {
 	var cluster1, cluster2, cluster3 eks.Cluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -406,7 +405,7 @@ func TestAccEKSCluster_VPC_endpointPrivateAccess(t *testing.T) {
 
 func TestAccEKSCluster_VPC_endpointPublicAccess(t *testing.T) {
 	var cluster1, cluster2, cluster3 eks.Cluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -452,7 +451,7 @@ func TestAccEKSCluster_VPC_endpointPublicAccess(t *testing.T) {
 
 func TestAccEKSCluster_VPC_publicAccessCIDRs(t *testing.T) {
 	var cluster eks.Cluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -488,7 +487,7 @@ func TestAccEKSCluster_VPC_publicAccessCIDRs(t *testing.T) {
 
 func TestAccEKSCluster_Network_serviceIPv4CIDR(t *testing.T) {
 	var cluster1, cluster2 eks.Cluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/eks"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func TestAccEKSFargateProfile_basic(t *testing.T) {
 	var fargateProfile eks.FargateProfile
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	eksClusterResourceName := "aws_eks_cluster.test"
 	iamRoleResourceName := "aws_iam_role.pod"
 	resourceName := "aws_eks_fargate_profile.test"
@@ -54,7 +53,7 @@ func TestAccEKSFargateProfile_basic(t *testing.T) {
 
 func TestAccEKSFargateProfile_disappears(t *testing.T) {
 	var fargateProfile eks.FargateProfile
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_fargate_profile.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -77,7 +76,7 @@ func TestAccEKSFargateProfile_disappears(t *testing.T) {
 
 func TestAccEKSFargateProfile_Multi_profile(t *testing.T) {
 	var fargateProfile eks.FargateProfile
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName1 := "aws_eks_fargate_profile.test.0"
 	resourceName2 := "aws_eks_fargate_profile.test.1"
 
@@ -100,7 +99,7 @@ func TestAccEKSFargateProfile_Multi_profile(t *testing.T) {
 
 func TestAccEKSFargateProfile_Selector_labels(t *testing.T) {
 	var fargateProfile1 eks.FargateProfile
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_fargate_profile.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -126,7 +125,7 @@ func TestAccEKSFargateProfile_Selector_labels(t *testing.T) {
 
 func TestAccEKSFargateProfile_tags(t *testing.T) {
 	var fargateProfile1, fargateProfile2, fargateProfile3 eks.FargateProfile
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_fargate_profile.test"
 
 	resource.ParallelTest(t, resource.TestCase{
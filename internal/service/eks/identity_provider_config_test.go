@@ -7,7 +7,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/eks"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func TestAccEKSIdentityProviderConfig_basic(t *testing.T) {
 	var config eks.OidcIdentityProviderConfig
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	eksClusterResourceName := "aws_eks_cluster.test"
 	resourceName := "aws_eks_identity_provider_config.test"
 	ctx := context.TODO()
@@ -62,7 +61,7 @@ func TestAccEKSIdentityProviderConfig_basic(t *testing.T) {
 
 func TestAccEKSIdentityProviderConfig_disappears(t *testing.T) {
 	var config eks.OidcIdentityProviderConfig
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_identity_provider_config.test"
 	ctx := context.TODO()
 
@@ -86,7 +85,7 @@ func TestAccEKSIdentityProviderConfig_disappears(t *testing.T) {
 
 func TestAccEKSIdentityProviderConfig_allOIDCOptions(t *testing.T) {
 	var config eks.OidcIdentityProviderConfig
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_identity_provider_config.test"
 	ctx := context.TODO()
 
@@ -124,7 +123,7 @@ func TestAccEKSIdentityProviderConfig_allOIDCOptions(t *testing.T) {
 
 func TestAccEKSIdentityProviderConfig_tags(t *testing.T) {
 	var config eks.OidcIdentityProviderConfig
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_eks_identity_provider_config.test"
 	ctx := context.TODO()
 
@@ -16,6 +16,13 @@ const (
 	addonDeletedTimeout = 40 * time.Minute
 )
 
+// clusterStatusBackoff spaces out the status checks of clusters, which take 10 minutes or more to create or delete.
+var clusterStatusBackoff = tfresource.Backoff{
+	InitialInterval: 10 * time.Second,
+	MaxInterval:     1 * time.Minute,
+	Jitter:          0.2,
+}
+
 func waitAddonCreated(ctx context.Context, conn *eks.EKS, clusterName, addonName string) (*eks.Addon, error) {
 	stateConf := resource.StateChangeConf{
 		Pending: []string{eks.AddonStatusCreating, eks.AddonStatusDegraded},
@@ -80,14 +87,13 @@ func waitAddonUpdateSuccessful(ctx context.Context, conn *eks.EKS, clusterName,
 }
 
 func waitClusterCreated(conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
-	stateConf := &resource.StateChangeConf{
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), tfresource.WaitForStateOpts{
 		Pending: []string{eks.ClusterStatusCreating},
 		Target:  []string{eks.ClusterStatusActive},
 		Refresh: statusCluster(conn, name),
 		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForState()
+		Backoff: clusterStatusBackoff,
+	})
 
 	if output, ok := outputRaw.(*eks.Cluster); ok {
 		return output, err
@@ -97,14 +103,13 @@ func waitClusterCreated(conn *eks.EKS, name string, timeout time.Duration) (*eks
 }
 
 func waitClusterDeleted(conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
-	stateConf := &resource.StateChangeConf{
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), tfresource.WaitForStateOpts{
 		Pending: []string{eks.ClusterStatusActive, eks.ClusterStatusDeleting},
 		Target:  []string{},
 		Refresh: statusCluster(conn, name),
 		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForState()
+		Backoff: clusterStatusBackoff,
+	})
 
 	if output, ok := outputRaw.(*eks.Cluster); ok {
 		return output, err
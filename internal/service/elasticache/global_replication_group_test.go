@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -22,8 +21,8 @@ func TestAccElastiCacheGlobalReplicationGroup_basic(t *testing.T) {
 	var globalReplicationGroup elasticache.GlobalReplicationGroup
 	var primaryReplicationGroup elasticache.ReplicationGroup
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	primaryReplicationGroupId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	primaryReplicationGroupId := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resourceName := "aws_elasticache_global_replication_group.test"
 	primaryReplicationGroupResourceName := "aws_elasticache_replication_group.test"
@@ -65,10 +64,10 @@ func TestAccElastiCacheGlobalReplicationGroup_basic(t *testing.T) {
 
 func TestAccElastiCacheGlobalReplicationGroup_description(t *testing.T) {
 	var globalReplicationGroup elasticache.GlobalReplicationGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	primaryReplicationGroupId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	description1 := sdkacctest.RandString(10)
-	description2 := sdkacctest.RandString(10)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	primaryReplicationGroupId := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	description1 := acctest.RandString(10)
+	description2 := acctest.RandString(10)
 	resourceName := "aws_elasticache_global_replication_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -102,8 +101,8 @@ func TestAccElastiCacheGlobalReplicationGroup_description(t *testing.T) {
 
 func TestAccElastiCacheGlobalReplicationGroup_disappears(t *testing.T) {
 	var globalReplicationGroup elasticache.GlobalReplicationGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	primaryReplicationGroupId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	primaryReplicationGroupId := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_elasticache_global_replication_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -127,7 +126,7 @@ func TestAccElastiCacheGlobalReplicationGroup_disappears(t *testing.T) {
 func TestAccElastiCacheGlobalReplicationGroup_multipleSecondaries(t *testing.T) {
 	var providers []*schema.Provider
 	var globalReplcationGroup elasticache.GlobalReplicationGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_elasticache_global_replication_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -152,7 +151,7 @@ func TestAccElastiCacheGlobalReplicationGroup_multipleSecondaries(t *testing.T)
 func TestAccElastiCacheGlobalReplicationGroup_ReplaceSecondary_differentRegion(t *testing.T) {
 	var providers []*schema.Provider
 	var globalReplcationGroup elasticache.GlobalReplicationGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_elasticache_global_replication_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -184,7 +183,7 @@ func TestAccElastiCacheGlobalReplicationGroup_clusterMode(t *testing.T) {
 	var globalReplicationGroup elasticache.GlobalReplicationGroup
 	var primaryReplicationGroup elasticache.ReplicationGroup
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resourceName := "aws_elasticache_global_replication_group.test"
 	primaryReplicationGroupResourceName := "aws_elasticache_replication_group.test"
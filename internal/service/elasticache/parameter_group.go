@@ -23,6 +23,7 @@ import (
 func ResourceParameterGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceParameterGroupCreate,
+		//lintignore:AWSR004
 		Read:   resourceParameterGroupRead,
 		Update: resourceParameterGroupUpdate,
 		Delete: resourceParameterGroupDelete,
@@ -17,6 +17,7 @@ import (
 func ResourceSecurityGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSecurityGroupCreate,
+		//lintignore:AWSR004
 		Read:   resourceSecurityGroupRead,
 		Delete: resourceSecurityGroupDelete,
 		Importer: &schema.ResourceImporter{
@@ -21,8 +21,10 @@ import (
 )
 
 func ResourceSubnetGroup() *schema.Resource {
+	//lintignore:AWSR006 // resourceSubnetGroupDiff calls verify.SetTagsDiff
 	return &schema.Resource{
 		Create: resourceSubnetGroupCreate,
+		//lintignore:AWSR004
 		Read:   resourceSubnetGroupRead,
 		Update: resourceSubnetGroupUpdate,
 		Delete: resourceSubnetGroupDelete,
@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -17,7 +16,7 @@ import (
 
 func TestAccElastiCacheUserGroup_basic(t *testing.T) {
 	var userGroup elasticache.UserGroup
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_elasticache_user_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -46,7 +45,7 @@ func TestAccElastiCacheUserGroup_basic(t *testing.T) {
 
 func TestAccElastiCacheUserGroup_update(t *testing.T) {
 	var userGroup elasticache.UserGroup
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_elasticache_user_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -88,7 +87,7 @@ func TestAccElastiCacheUserGroup_update(t *testing.T) {
 
 func TestAccElastiCacheUserGroup_tags(t *testing.T) {
 	var userGroup elasticache.UserGroup
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_elasticache_user_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -129,7 +128,7 @@ func TestAccElastiCacheUserGroup_tags(t *testing.T) {
 
 func TestAccElastiCacheUserGroup_disappears(t *testing.T) {
 	var userGroup elasticache.UserGroup
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_elasticache_user_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -18,7 +17,7 @@ import (
 
 func TestAccElastiCacheUser_basic(t *testing.T) {
 	var user elasticache.User
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_elasticache_user.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -52,7 +51,7 @@ func TestAccElastiCacheUser_basic(t *testing.T) {
 
 func TestAccElastiCacheUser_update(t *testing.T) {
 	var user elasticache.User
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_elasticache_user.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -89,7 +88,7 @@ func TestAccElastiCacheUser_update(t *testing.T) {
 
 func TestAccElastiCacheUser_tags(t *testing.T) {
 	var user elasticache.User
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_elasticache_user.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -139,7 +138,7 @@ func TestAccElastiCacheUser_tags(t *testing.T) {
 
 func TestAccElastiCacheUser_disappears(t *testing.T) {
 	var user elasticache.User
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_elasticache_user.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -17,6 +17,7 @@ import (
 func ResourceApplicationVersion() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceApplicationVersionCreate,
+		//lintignore:AWSR004
 		Read:   resourceApplicationVersionRead,
 		Update: resourceApplicationVersionUpdate,
 		Delete: resourceApplicationVersionDelete,
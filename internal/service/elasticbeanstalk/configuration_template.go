@@ -15,6 +15,7 @@ import (
 func ResourceConfigurationTemplate() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceConfigurationTemplateCreate,
+		//lintignore:AWSR004
 		Read:   resourceConfigurationTemplateRead,
 		Update: resourceConfigurationTemplateUpdate,
 		Delete: resourceConfigurationTemplateDelete,
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -49,6 +50,7 @@ func ResourceEnvironment() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
 		Create: resourceEnvironmentCreate,
+		//lintignore:AWSR004
 		Read:   resourceEnvironmentRead,
 		Update: resourceEnvironmentUpdate,
 		Delete: resourceEnvironmentDelete,
@@ -163,21 +165,10 @@ func ResourceEnvironment() *schema.Resource {
 				},
 			},
 			"poll_interval": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
-					value := v.(string)
-					duration, err := time.ParseDuration(value)
-					if err != nil {
-						errors = append(errors, fmt.Errorf(
-							"%q cannot be parsed as a duration: %s", k, err))
-					}
-					if duration < 10*time.Second || duration > 60*time.Second {
-						errors = append(errors, fmt.Errorf(
-							"%q must be between 10s and 180s", k))
-					}
-					return
-				},
+				Type:             nullable.TypeNullableDuration,
+				Optional:         true,
+				ValidateFunc:     nullable.ValidateTypeStringNullableDurationBetween(10*time.Second, 60*time.Second),
+				DiffSuppressFunc: nullable.DiffSuppressNullableDurationEquivalent,
 			},
 			"autoscaling_groups": {
 				Type:     schema.TypeList,
@@ -299,10 +290,10 @@ func resourceEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	pollInterval, err := time.ParseDuration(d.Get("poll_interval").(string))
+	// A null poll interval uses the default backoff.
+	pollInterval, _, err := nullable.Duration(d.Get("poll_interval").(string)).Value()
 	if err != nil {
-		pollInterval = 0
-		log.Printf("[WARN] Error parsing poll_interval, using default backoff")
+		return fmt.Errorf("error parsing poll_interval: %w", err)
 	}
 
 	err = waitForElasticBeanstalkEnvironmentReady(conn, d.Id(), waitForReadyTimeOut, pollInterval, t)
@@ -441,10 +432,10 @@ func resourceEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return err
 		}
-		pollInterval, err := time.ParseDuration(d.Get("poll_interval").(string))
+		// A null poll interval uses the default backoff.
+		pollInterval, _, err := nullable.Duration(d.Get("poll_interval").(string)).Value()
 		if err != nil {
-			pollInterval = 0
-			log.Printf("[WARN] Error parsing poll_interval, using default backoff")
+			return fmt.Errorf("error parsing poll_interval: %w", err)
 		}
 
 		err = waitForElasticBeanstalkEnvironmentReady(conn, d.Id(), waitForReadyTimeOut, pollInterval, t)
@@ -477,10 +468,10 @@ func resourceEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return err
 		}
-		pollInterval, err := time.ParseDuration(d.Get("poll_interval").(string))
+		// A null poll interval uses the default backoff.
+		pollInterval, _, err := nullable.Duration(d.Get("poll_interval").(string)).Value()
 		if err != nil {
-			pollInterval = 0
-			log.Printf("[WARN] Error parsing poll_interval, using default backoff")
+			return fmt.Errorf("error parsing poll_interval: %w", err)
 		}
 
 		err = waitForElasticBeanstalkEnvironmentReady(conn, d.Id(), waitForReadyTimeOut, pollInterval, t)
@@ -744,10 +735,10 @@ func resourceEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
-	pollInterval, err := time.ParseDuration(d.Get("poll_interval").(string))
+	// A null poll interval uses the default backoff.
+	pollInterval, _, err := nullable.Duration(d.Get("poll_interval").(string)).Value()
 	if err != nil {
-		pollInterval = 0
-		log.Printf("[WARN] Error parsing poll_interval, using default backoff")
+		return fmt.Errorf("error parsing poll_interval: %w", err)
 	}
 
 	// The Environment needs to be in a Ready state before it can be terminated
@@ -18,6 +18,7 @@ import (
 func ResourceDomainPolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDomainPolicyUpsert,
+		//lintignore:AWSR004
 		Read:   resourceDomainPolicyRead,
 		Update: resourceDomainPolicyUpsert,
 		Delete: resourceDomainPolicyDelete,
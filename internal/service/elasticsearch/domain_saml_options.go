@@ -18,6 +18,7 @@ import (
 func ResourceDomainSAMLOptions() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDomainSAMLOptionsPut,
+		//lintignore:AWSR004
 		Read:   resourceDomainSAMLOptionsRead,
 		Update: resourceDomainSAMLOptionsPut,
 		Delete: resourceDomainSAMLOptionsDelete,
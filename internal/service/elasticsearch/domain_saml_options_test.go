@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,8 +17,8 @@ import (
 func TestAccElasticSearchDomainSamlOptions_SAML_basic(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
 
-	rName := sdkacctest.RandomWithPrefix("acc-test")
-	rUserName := sdkacctest.RandomWithPrefix("es-master-user")
+	rName := acctest.RandomWithPrefix("acc-test")
+	rUserName := acctest.RandomWithPrefix("es-master-user")
 	resourceName := "aws_elasticsearch_domain_saml_options.main"
 	esDomainResourceName := "aws_elasticsearch_domain.example"
 
@@ -50,8 +49,8 @@ func TestAccElasticSearchDomainSamlOptions_SAML_basic(t *testing.T) {
 }
 
 func TestAccElasticSearchDomainSamlOptions_SAML_disappears(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix("acc-test")
-	rUserName := sdkacctest.RandomWithPrefix("es-master-user")
+	rName := acctest.RandomWithPrefix("acc-test")
+	rUserName := acctest.RandomWithPrefix("es-master-user")
 	resourceName := "aws_elasticsearch_domain_saml_options.main"
 	esDomainResourceName := "aws_elasticsearch_domain.example"
 
@@ -73,8 +72,8 @@ func TestAccElasticSearchDomainSamlOptions_SAML_disappears(t *testing.T) {
 }
 
 func TestAccElasticSearchDomainSamlOptions_SAMLDisappears_domain(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix("acc-test")
-	rUserName := sdkacctest.RandomWithPrefix("es-master-user")
+	rName := acctest.RandomWithPrefix("acc-test")
+	rUserName := acctest.RandomWithPrefix("es-master-user")
 	resourceName := "aws_elasticsearch_domain_saml_options.main"
 	esDomainResourceName := "aws_elasticsearch_domain.example"
 
@@ -97,8 +96,8 @@ func TestAccElasticSearchDomainSamlOptions_SAMLDisappears_domain(t *testing.T) {
 }
 
 func TestAccElasticSearchDomainSamlOptions_SAML_update(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix("acc-test")
-	rUserName := sdkacctest.RandomWithPrefix("es-master-user")
+	rName := acctest.RandomWithPrefix("acc-test")
+	rUserName := acctest.RandomWithPrefix("es-master-user")
 	resourceName := "aws_elasticsearch_domain_saml_options.main"
 	esDomainResourceName := "aws_elasticsearch_domain.example"
 
@@ -129,8 +128,8 @@ func TestAccElasticSearchDomainSamlOptions_SAML_update(t *testing.T) {
 }
 
 func TestAccElasticSearchDomainSamlOptions_SAML_disabled(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix("acc-test")
-	rUserName := sdkacctest.RandomWithPrefix("es-master-user")
+	rName := acctest.RandomWithPrefix("acc-test")
+	rUserName := acctest.RandomWithPrefix("es-master-user")
 	resourceName := "aws_elasticsearch_domain_saml_options.main"
 	esDomainResourceName := "aws_elasticsearch_domain.example"
 
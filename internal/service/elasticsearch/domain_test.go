@@ -12,7 +12,6 @@ import (
 	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/iam"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -22,7 +21,7 @@ import (
 
 func TestAccElasticSearchDomain_basic(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	resourceName := "aws_elasticsearch_domain.test"
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 
@@ -53,7 +52,7 @@ func TestAccElasticSearchDomain_basic(t *testing.T) {
 
 func TestAccElasticSearchDomain_requireHTTPS(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -88,7 +87,7 @@ func TestAccElasticSearchDomain_requireHTTPS(t *testing.T) {
 
 func TestAccElasticSearchDomain_customEndpoint(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.example"
 	customEndpoint := fmt.Sprintf("%s.example.com", resourceId)
@@ -140,7 +139,7 @@ func TestAccElasticSearchDomain_customEndpoint(t *testing.T) {
 
 func TestAccElasticSearchDomain_Cluster_zoneAwareness(t *testing.T) {
 	var domain1, domain2, domain3, domain4 elasticsearch.ElasticsearchDomainStatus
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(16)) // len = 28
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(16)) // len = 28
 	resourceName := "aws_elasticsearch_domain.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -199,7 +198,7 @@ func TestAccElasticSearchDomain_Cluster_zoneAwareness(t *testing.T) {
 
 func TestAccElasticSearchDomain_warm(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(16)) // len = 28
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(16)) // len = 28
 	resourceName := "aws_elasticsearch_domain.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -256,7 +255,7 @@ func TestAccElasticSearchDomain_warm(t *testing.T) {
 
 func TestAccElasticSearchDomain_withDedicatedMaster(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	resourceName := "aws_elasticsearch_domain.test"
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 
@@ -296,7 +295,7 @@ func TestAccElasticSearchDomain_withDedicatedMaster(t *testing.T) {
 
 func TestAccElasticSearchDomain_duplicate(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -346,7 +345,7 @@ func TestAccElasticSearchDomain_duplicate(t *testing.T) {
 
 func TestAccElasticSearchDomain_v23(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -376,7 +375,7 @@ func TestAccElasticSearchDomain_v23(t *testing.T) {
 
 func TestAccElasticSearchDomain_complex(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -404,7 +403,7 @@ func TestAccElasticSearchDomain_complex(t *testing.T) {
 
 func TestAccElasticSearchDomain_vpc(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -432,7 +431,7 @@ func TestAccElasticSearchDomain_vpc(t *testing.T) {
 
 func TestAccElasticSearchDomain_VPC_update(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -468,7 +467,7 @@ func TestAccElasticSearchDomain_VPC_update(t *testing.T) {
 
 func TestAccElasticSearchDomain_internetToVPCEndpoint(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -502,7 +501,7 @@ func TestAccElasticSearchDomain_internetToVPCEndpoint(t *testing.T) {
 
 func TestAccElasticSearchDomain_AdvancedSecurityOptions_userDB(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	domainName := sdkacctest.RandomWithPrefix("tf-test")
+	domainName := acctest.RandomWithPrefix("tf-test")
 	resourceName := "aws_elasticsearch_domain.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -535,7 +534,7 @@ func TestAccElasticSearchDomain_AdvancedSecurityOptions_userDB(t *testing.T) {
 
 func TestAccElasticSearchDomain_AdvancedSecurityOptions_iam(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	domainName := sdkacctest.RandomWithPrefix("tf-test")
+	domainName := acctest.RandomWithPrefix("tf-test")
 	resourceName := "aws_elasticsearch_domain.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -568,7 +567,7 @@ func TestAccElasticSearchDomain_AdvancedSecurityOptions_iam(t *testing.T) {
 
 func TestAccElasticSearchDomain_AdvancedSecurityOptions_disabled(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	domainName := sdkacctest.RandomWithPrefix("tf-test")
+	domainName := acctest.RandomWithPrefix("tf-test")
 	resourceName := "aws_elasticsearch_domain.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -601,7 +600,7 @@ func TestAccElasticSearchDomain_AdvancedSecurityOptions_disabled(t *testing.T) {
 
 func TestAccElasticSearchDomain_LogPublishingOptions_indexSlowLogs(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -633,7 +632,7 @@ func TestAccElasticSearchDomain_LogPublishingOptions_indexSlowLogs(t *testing.T)
 
 func TestAccElasticSearchDomain_LogPublishingOptions_searchSlowLogs(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -665,7 +664,7 @@ func TestAccElasticSearchDomain_LogPublishingOptions_searchSlowLogs(t *testing.T
 
 func TestAccElasticSearchDomain_LogPublishingOptions_esApplicationLogs(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -697,7 +696,7 @@ func TestAccElasticSearchDomain_LogPublishingOptions_esApplicationLogs(t *testin
 
 func TestAccElasticSearchDomain_LogPublishingOptions_auditLogs(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -731,7 +730,7 @@ func TestAccElasticSearchDomain_LogPublishingOptions_auditLogs(t *testing.T) {
 
 func TestAccElasticSearchDomain_cognitoOptionsCreateAndRemove(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	resourceName := "aws_elasticsearch_domain.test"
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 
@@ -771,7 +770,7 @@ func TestAccElasticSearchDomain_cognitoOptionsCreateAndRemove(t *testing.T) {
 
 func TestAccElasticSearchDomain_cognitoOptionsUpdate(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -812,7 +811,7 @@ func TestAccElasticSearchDomain_cognitoOptionsUpdate(t *testing.T) {
 func TestAccElasticSearchDomain_policy(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
 	resourceName := "aws_elasticsearch_domain.test"
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -840,7 +839,7 @@ func TestAccElasticSearchDomain_policy(t *testing.T) {
 func TestAccElasticSearchDomain_EncryptAtRestDefault_key(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
 	resourceName := "aws_elasticsearch_domain.test"
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -869,7 +868,7 @@ func TestAccElasticSearchDomain_EncryptAtRestDefault_key(t *testing.T) {
 func TestAccElasticSearchDomain_EncryptAtRestSpecify_key(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
 	resourceName := "aws_elasticsearch_domain.test"
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -898,7 +897,7 @@ func TestAccElasticSearchDomain_EncryptAtRestSpecify_key(t *testing.T) {
 func TestAccElasticSearchDomain_nodeToNodeEncryption(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
 	resourceName := "aws_elasticsearch_domain.test"
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -926,7 +925,7 @@ func TestAccElasticSearchDomain_nodeToNodeEncryption(t *testing.T) {
 
 func TestAccElasticSearchDomain_tags(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -964,7 +963,7 @@ func TestAccElasticSearchDomain_tags(t *testing.T) {
 
 func TestAccElasticSearchDomain_update(t *testing.T) {
 	var input elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -1001,7 +1000,7 @@ func TestAccElasticSearchDomain_update(t *testing.T) {
 
 func TestAccElasticSearchDomain_UpdateVolume_type(t *testing.T) {
 	var input elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -1047,7 +1046,7 @@ func TestAccElasticSearchDomain_UpdateVolume_type(t *testing.T) {
 func TestAccElasticSearchDomain_WithVolumeType_missing(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
 	resourceName := "aws_elasticsearch_domain.test"
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(16))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(16))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckIamServiceLinkedRoleEs(t) },
@@ -1080,7 +1079,7 @@ func TestAccElasticSearchDomain_WithVolumeType_missing(t *testing.T) {
 
 func TestAccElasticSearchDomain_Update_version(t *testing.T) {
 	var domain1, domain2, domain3 elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -2221,7 +2220,7 @@ resource "aws_elasticsearch_domain" "test" {
     volume_size = 10
   }
 }
-`, sdkacctest.RandomWithPrefix("es-master-user"), domainName)
+`, acctest.RandomWithPrefix("es-master-user"), domainName)
 }
 
 func testAccESDomainConfig_AdvancedSecurityOptionsDisabled(domainName string) string {
@@ -15,6 +15,7 @@ import (
 func ResourcePreset() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePresetCreate,
+		//lintignore:AWSR004
 		Read:   resourcePresetRead,
 		Delete: resourcePresetDelete,
 		Importer: &schema.ResourceImporter{
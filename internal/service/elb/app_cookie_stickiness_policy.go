@@ -19,6 +19,7 @@ func ResourceAppCookieStickinessPolicy() *schema.Resource {
 		// There is no concept of "updating" an App Stickiness policy in
 		// the AWS API.
 		Create: resourceAppCookieStickinessPolicyCreate,
+		//lintignore:AWSR004
 		Read:   resourceAppCookieStickinessPolicyRead,
 		Delete: resourceAppCookieStickinessPolicyDelete,
 		Importer: &schema.ResourceImporter{
@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/elb"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 )
 
 func TestAccELBAppCookieStickinessPolicy_basic(t *testing.T) {
-	lbName := fmt.Sprintf("tf-test-lb-%s", sdkacctest.RandString(5))
+	lbName := fmt.Sprintf("tf-test-lb-%s", acctest.RandString(5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -52,7 +51,7 @@ func TestAccELBAppCookieStickinessPolicy_basic(t *testing.T) {
 }
 
 func TestAccELBAppCookieStickinessPolicy_Disappears_elb(t *testing.T) {
-	lbName := fmt.Sprintf("tf-test-lb-%s", sdkacctest.RandString(5))
+	lbName := fmt.Sprintf("tf-test-lb-%s", acctest.RandString(5))
 	elbResourceName := "aws_elb.lb"
 	resourceName := "aws_app_cookie_stickiness_policy.foo"
 
@@ -131,7 +130,7 @@ func testAccCheckAppCookieStickinessPolicy(elbResource string, policyResource st
 }
 
 func TestAccELBAppCookieStickinessPolicy_disappears(t *testing.T) {
-	lbName := fmt.Sprintf("tf-test-lb-%s", sdkacctest.RandString(5))
+	lbName := fmt.Sprintf("tf-test-lb-%s", acctest.RandString(5))
 	elbResourceName := "aws_elb.lb"
 	resourceName := "aws_app_cookie_stickiness_policy.foo"
 
@@ -17,6 +17,7 @@ import (
 func ResourceAttachment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAttachmentCreate,
+		//lintignore:AWSR004
 		Read:   resourceAttachmentRead,
 		Delete: resourceAttachmentDelete,
 
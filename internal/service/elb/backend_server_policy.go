@@ -16,6 +16,7 @@ import (
 func ResourceBackendServerPolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBackendServerPolicyCreate,
+		//lintignore:AWSR004
 		Read:   resourceBackendServerPolicyRead,
 		Update: resourceBackendServerPolicyCreate,
 		Delete: resourceBackendServerPolicyDelete,
@@ -19,6 +19,7 @@ func ResourceCookieStickinessPolicy() *schema.Resource {
 		// There is no concept of "updating" an LB Stickiness policy in
 		// the AWS API.
 		Create: resourceCookieStickinessPolicyCreate,
+		//lintignore:AWSR004
 		Read:   resourceCookieStickinessPolicyRead,
 		Delete: resourceCookieStickinessPolicyDelete,
 
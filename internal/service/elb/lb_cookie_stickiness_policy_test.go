@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/elb"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 )
 
 func TestAccELBCookieStickinessPolicy_basic(t *testing.T) {
-	lbName := fmt.Sprintf("tf-test-lb-%s", sdkacctest.RandString(5))
+	lbName := fmt.Sprintf("tf-test-lb-%s", acctest.RandString(5))
 	resourceName := "aws_lb_cookie_stickiness_policy.foo"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -105,7 +104,7 @@ func testAccCheckLBCookieStickinessPolicy(elbResource string, policyResource str
 }
 
 func TestAccELBCookieStickinessPolicy_disappears(t *testing.T) {
-	lbName := fmt.Sprintf("tf-test-lb-%s", sdkacctest.RandString(5))
+	lbName := fmt.Sprintf("tf-test-lb-%s", acctest.RandString(5))
 	elbResourceName := "aws_elb.lb"
 	resourceName := "aws_lb_cookie_stickiness_policy.foo"
 
@@ -128,7 +127,7 @@ func TestAccELBCookieStickinessPolicy_disappears(t *testing.T) {
 }
 
 func TestAccELBCookieStickinessPolicy_Disappears_elb(t *testing.T) {
-	lbName := fmt.Sprintf("tf-test-lb-%s", sdkacctest.RandString(5))
+	lbName := fmt.Sprintf("tf-test-lb-%s", acctest.RandString(5))
 	elbResourceName := "aws_elb.lb"
 	resourceName := "aws_lb_cookie_stickiness_policy.foo"
 
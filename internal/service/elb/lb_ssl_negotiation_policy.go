@@ -20,6 +20,7 @@ func ResourceSSLNegotiationPolicy() *schema.Resource {
 		// There is no concept of "updating" an LB policy in
 		// the AWS API.
 		Create: resourceSSLNegotiationPolicyCreate,
+		//lintignore:AWSR004
 		Read:   resourceSSLNegotiationPolicyRead,
 		Delete: resourceSSLNegotiationPolicyDelete,
 
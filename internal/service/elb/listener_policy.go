@@ -16,6 +16,7 @@ import (
 func ResourceListenerPolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceListenerPolicyCreate,
+		//lintignore:AWSR004
 		Read:   resourceListenerPolicyRead,
 		Update: resourceListenerPolicyCreate,
 		Delete: resourceListenerPolicyDelete,
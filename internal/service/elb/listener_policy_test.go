@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/elb"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 )
 
 func TestAccELBListenerPolicy_basic(t *testing.T) {
-	rChar := sdkacctest.RandStringFromCharSet(6, sdkacctest.CharSetAlpha)
+	rChar := acctest.RandStringFromCharSet(6, acctest.CharSetAlpha)
 	lbName := rChar
 	mcName := rChar
 	resource.ParallelTest(t, resource.TestCase{
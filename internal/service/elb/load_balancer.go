@@ -30,6 +30,7 @@ import (
 func ResourceLoadBalancer() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceLoadBalancerCreate,
+		//lintignore:AWSR004
 		Read:   resourceLoadBalancerRead,
 		Update: resourceLoadBalancerUpdate,
 		Delete: resourceLoadBalancerDelete,
@@ -11,7 +11,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/elb"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -81,7 +80,7 @@ func TestAccELBLoadBalancer_disappears(t *testing.T) {
 func TestAccELBLoadBalancer_fullCharacterRange(t *testing.T) {
 	var conf elb.LoadBalancerDescription
 	resourceName := "aws_elb.test"
-	lbName := fmt.Sprintf("Tf-%d", sdkacctest.RandInt())
+	lbName := fmt.Sprintf("Tf-%d", acctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -103,7 +102,7 @@ func TestAccELBLoadBalancer_fullCharacterRange(t *testing.T) {
 func TestAccELBLoadBalancer_AccessLogs_enabled(t *testing.T) {
 	var conf elb.LoadBalancerDescription
 	resourceName := "aws_elb.test"
-	rName := fmt.Sprintf("tf-test-access-logs-%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tf-test-access-logs-%d", acctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -143,7 +142,7 @@ func TestAccELBLoadBalancer_AccessLogs_enabled(t *testing.T) {
 func TestAccELBLoadBalancer_AccessLogs_disabled(t *testing.T) {
 	var conf elb.LoadBalancerDescription
 	resourceName := "aws_elb.test"
-	rName := fmt.Sprintf("tf-test-access-logs-%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tf-test-access-logs-%d", acctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -325,7 +324,7 @@ func TestAccELBLoadBalancer_ListenerSSLCertificateID_iamServerCertificate(t *tes
 	var conf elb.LoadBalancerDescription
 	key := acctest.TLSRSAPrivateKeyPEM(2048)
 	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(key, "example.com")
-	rName := fmt.Sprintf("tf-acctest-%s", sdkacctest.RandString(10))
+	rName := fmt.Sprintf("tf-acctest-%s", acctest.RandString(10))
 	resourceName := "aws_elb.test"
 
 	testCheck := func(*terraform.State) error {
@@ -859,7 +858,7 @@ func TestValidLoadBalancerHealthCheckTarget(t *testing.T) {
 		},
 		{
 			Value: fmt.Sprintf("HTTP:8080/%s%s",
-				sdkacctest.RandStringFromCharSet(512, sdkacctest.CharSetAlpha), randomRunes(512)),
+				acctest.RandStringFromCharSet(512, acctest.CharSetAlpha), randomRunes(512)),
 			ErrCount: 1,
 		},
 	}
@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/elb"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -21,7 +20,7 @@ func TestAccELBPolicy_basic(t *testing.T) {
 	var policy elb.PolicyDescription
 	loadBalancerResourceName := "aws_elb.test-lb"
 	resourceName := "aws_load_balancer_policy.test-policy"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -45,7 +44,7 @@ func TestAccELBPolicy_disappears(t *testing.T) {
 	var policy elb.PolicyDescription
 	loadBalancerResourceName := "aws_elb.test-lb"
 	resourceName := "aws_load_balancer_policy.test-policy"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -79,7 +78,7 @@ func TestAccELBPolicy_updateWhileAssigned(t *testing.T) {
 	var policy elb.PolicyDescription
 	loadBalancerResourceName := "aws_elb.test-lb"
 	resourceName := "aws_load_balancer_policy.test-policy"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
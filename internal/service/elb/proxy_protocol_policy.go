@@ -15,6 +15,7 @@ import (
 func ResourceProxyProtocolPolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceProxyProtocolPolicyCreate,
+		//lintignore:AWSR004
 		Read:   resourceProxyProtocolPolicyRead,
 		Update: resourceProxyProtocolPolicyUpdate,
 		Delete: resourceProxyProtocolPolicyDelete,
@@ -474,7 +474,7 @@ func resourceListenerRead(d *schema.ResourceData, meta interface{}) error {
 
 	var listener *elbv2.Listener
 
-	err := resource.Retry(loadBalancerListenerReadTimeout, func() *resource.RetryError {
+	err := resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
 		var err error
 		listener, err = FindListenerByARN(conn, d.Id())
 
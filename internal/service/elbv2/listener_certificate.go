@@ -18,6 +18,7 @@ import (
 func ResourceListenerCertificate() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceListenerCertificateCreate,
+		//lintignore:AWSR004
 		Read:   resourceListenerCertificateRead,
 		Delete: resourceListenerCertificateDelete,
 		Importer: &schema.ResourceImporter{
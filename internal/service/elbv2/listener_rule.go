@@ -27,6 +27,7 @@ import (
 func ResourceListenerRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceListenerRuleCreate,
+		//lintignore:AWSR004
 		Read:   resourceListenerRuleRead,
 		Update: resourceListenerRuleUpdate,
 		Delete: resourceListenerRuleDelete,
@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -61,8 +60,8 @@ func TestLBListenerARNFromRuleARN(t *testing.T) {
 
 func TestAccELBV2ListenerRule_basic(t *testing.T) {
 	var conf elbv2.Rule
-	lbName := fmt.Sprintf("testrule-basic-%s", sdkacctest.RandString(13))
-	targetGroupName := fmt.Sprintf("testtargetgroup-%s", sdkacctest.RandString(10))
+	lbName := fmt.Sprintf("testrule-basic-%s", acctest.RandString(13))
+	targetGroupName := fmt.Sprintf("testtargetgroup-%s", acctest.RandString(10))
 
 	resourceName := "aws_lb_listener_rule.static"
 	frontEndListenerResourceName := "aws_lb_listener.front_end"
@@ -109,8 +108,8 @@ func TestAccELBV2ListenerRule_basic(t *testing.T) {
 
 func TestAccELBV2ListenerRule_tags(t *testing.T) {
 	var conf elbv2.Rule
-	lbName := fmt.Sprintf("testrule-basic-%s", sdkacctest.RandString(13))
-	targetGroupName := fmt.Sprintf("testtargetgroup-%s", sdkacctest.RandString(10))
+	lbName := fmt.Sprintf("testrule-basic-%s", acctest.RandString(13))
+	targetGroupName := fmt.Sprintf("testtargetgroup-%s", acctest.RandString(10))
 
 	resourceName := "aws_lb_listener_rule.static"
 
@@ -151,9 +150,9 @@ func TestAccELBV2ListenerRule_tags(t *testing.T) {
 
 func TestAccELBV2ListenerRule_forwardWeighted(t *testing.T) {
 	var conf elbv2.Rule
-	lbName := fmt.Sprintf("testrule-weighted-%s", sdkacctest.RandString(13))
-	targetGroupName1 := fmt.Sprintf("testtargetgroup-%s", sdkacctest.RandString(10))
-	targetGroupName2 := fmt.Sprintf("testtargetgroup-%s", sdkacctest.RandString(10))
+	lbName := fmt.Sprintf("testrule-weighted-%s", acctest.RandString(13))
+	targetGroupName1 := fmt.Sprintf("testtargetgroup-%s", acctest.RandString(10))
+	targetGroupName2 := fmt.Sprintf("testtargetgroup-%s", acctest.RandString(10))
 
 	resourceName := "aws_lb_listener_rule.weighted"
 	frontEndListenerResourceName := "aws_lb_listener.front_end"
@@ -231,8 +230,8 @@ func TestAccELBV2ListenerRule_forwardWeighted(t *testing.T) {
 
 func TestAccELBV2ListenerRule_backwardsCompatibility(t *testing.T) {
 	var conf elbv2.Rule
-	lbName := fmt.Sprintf("testrule-basic-%s", sdkacctest.RandString(13))
-	targetGroupName := fmt.Sprintf("testtargetgroup-%s", sdkacctest.RandString(10))
+	lbName := fmt.Sprintf("testrule-basic-%s", acctest.RandString(13))
+	targetGroupName := fmt.Sprintf("testtargetgroup-%s", acctest.RandString(10))
 
 	resourceName := "aws_alb_listener_rule.static"
 	frontEndListenerResourceName := "aws_alb_listener.front_end"
@@ -278,7 +277,7 @@ func TestAccELBV2ListenerRule_backwardsCompatibility(t *testing.T) {
 
 func TestAccELBV2ListenerRule_redirect(t *testing.T) {
 	var conf elbv2.Rule
-	lbName := fmt.Sprintf("testrule-redirect-%s", sdkacctest.RandString(14))
+	lbName := fmt.Sprintf("testrule-redirect-%s", acctest.RandString(14))
 
 	resourceName := "aws_lb_listener_rule.static"
 	frontEndListenerResourceName := "aws_lb_listener.front_end"
@@ -367,7 +366,7 @@ func TestAccELBV2ListenerRule_redirect(t *testing.T) {
 
 func TestAccELBV2ListenerRule_fixedResponse(t *testing.T) {
 	var conf elbv2.Rule
-	lbName := fmt.Sprintf("testrule-fixedresponse-%s", sdkacctest.RandString(9))
+	lbName := fmt.Sprintf("testrule-fixedresponse-%s", acctest.RandString(9))
 
 	resourceName := "aws_lb_listener_rule.static"
 	frontEndListenerResourceName := "aws_lb_listener.front_end"
@@ -406,7 +405,7 @@ func TestAccELBV2ListenerRule_fixedResponse(t *testing.T) {
 // Updating Action breaks Condition change logic GH-11323 and GH-11362
 func TestAccELBV2ListenerRule_updateFixedResponse(t *testing.T) {
 	var rule elbv2.Rule
-	lbName := fmt.Sprintf("testrule-basic-%s", sdkacctest.RandString(13))
+	lbName := fmt.Sprintf("testrule-basic-%s", acctest.RandString(13))
 
 	resourceName := "aws_lb_listener_rule.static"
 
@@ -436,8 +435,8 @@ func TestAccELBV2ListenerRule_updateFixedResponse(t *testing.T) {
 
 func TestAccELBV2ListenerRule_updateRulePriority(t *testing.T) {
 	var rule elbv2.Rule
-	lbName := fmt.Sprintf("testrule-basic-%s", sdkacctest.RandString(13))
-	targetGroupName := fmt.Sprintf("testtargetgroup-%s", sdkacctest.RandString(10))
+	lbName := fmt.Sprintf("testrule-basic-%s", acctest.RandString(13))
+	targetGroupName := fmt.Sprintf("testtargetgroup-%s", acctest.RandString(10))
 
 	resourceName := "aws_lb_listener_rule.static"
 
@@ -467,8 +466,8 @@ func TestAccELBV2ListenerRule_updateRulePriority(t *testing.T) {
 
 func TestAccELBV2ListenerRule_changeListenerRuleARNForcesNew(t *testing.T) {
 	var before, after elbv2.Rule
-	lbName := fmt.Sprintf("testrule-basic-%s", sdkacctest.RandString(13))
-	targetGroupName := fmt.Sprintf("testtargetgroup-%s", sdkacctest.RandString(10))
+	lbName := fmt.Sprintf("testrule-basic-%s", acctest.RandString(13))
+	targetGroupName := fmt.Sprintf("testtargetgroup-%s", acctest.RandString(10))
 
 	resourceName := "aws_lb_listener_rule.static"
 
@@ -497,8 +496,8 @@ func TestAccELBV2ListenerRule_changeListenerRuleARNForcesNew(t *testing.T) {
 
 func TestAccELBV2ListenerRule_priority(t *testing.T) {
 	var rule elbv2.Rule
-	lbName := fmt.Sprintf("testrule-basic-%s", sdkacctest.RandString(13))
-	targetGroupName := fmt.Sprintf("testtargetgroup-%s", sdkacctest.RandString(10))
+	lbName := fmt.Sprintf("testrule-basic-%s", acctest.RandString(13))
+	targetGroupName := fmt.Sprintf("testtargetgroup-%s", acctest.RandString(10))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -573,7 +572,7 @@ func TestAccELBV2ListenerRule_cognito(t *testing.T) {
 	var conf elbv2.Rule
 	key := acctest.TLSRSAPrivateKeyPEM(2048)
 	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(key, "example.com")
-	lbName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	lbName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resourceName := "aws_lb_listener_rule.cognito"
 	frontEndListenerResourceName := "aws_lb_listener.front_end"
@@ -617,7 +616,7 @@ func TestAccELBV2ListenerRule_oidc(t *testing.T) {
 	var conf elbv2.Rule
 	key := acctest.TLSRSAPrivateKeyPEM(2048)
 	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(key, "example.com")
-	lbName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	lbName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resourceName := "aws_lb_listener_rule.oidc"
 	frontEndListenerResourceName := "aws_lb_listener.front_end"
@@ -661,7 +660,7 @@ func TestAccELBV2ListenerRule_Action_order(t *testing.T) {
 	var rule elbv2.Rule
 	key := acctest.TLSRSAPrivateKeyPEM(2048)
 	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(key, "example.com")
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_lb_listener_rule.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -688,7 +687,7 @@ func TestAccELBV2ListenerRule_ActionOrder_recreates(t *testing.T) {
 	var rule elbv2.Rule
 	key := acctest.TLSRSAPrivateKeyPEM(2048)
 	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(key, "example.com")
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_lb_listener_rule.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -747,7 +746,7 @@ func TestAccELBV2ListenerRule_conditionAttributesCount(t *testing.T) {
 
 func TestAccELBV2ListenerRule_conditionHostHeader(t *testing.T) {
 	var conf elbv2.Rule
-	lbName := fmt.Sprintf("testrule-hostHeader-%s", sdkacctest.RandString(12))
+	lbName := fmt.Sprintf("testrule-hostHeader-%s", acctest.RandString(12))
 
 	resourceName := "aws_lb_listener_rule.static"
 	frontEndListenerResourceName := "aws_lb_listener.front_end"
@@ -786,7 +785,7 @@ func TestAccELBV2ListenerRule_conditionHostHeader(t *testing.T) {
 
 func TestAccELBV2ListenerRule_conditionHTTPHeader(t *testing.T) {
 	var conf elbv2.Rule
-	lbName := fmt.Sprintf("testrule-httpHeader-%s", sdkacctest.RandString(12))
+	lbName := fmt.Sprintf("testrule-httpHeader-%s", acctest.RandString(12))
 
 	resourceName := "aws_lb_listener_rule.static"
 	frontEndListenerResourceName := "aws_lb_listener.front_end"
@@ -852,7 +851,7 @@ func TestAccELBV2ListenerRule_ConditionHTTPHeader_invalid(t *testing.T) {
 
 func TestAccELBV2ListenerRule_conditionHTTPRequestMethod(t *testing.T) {
 	var conf elbv2.Rule
-	lbName := fmt.Sprintf("testrule-httpRequest-%s", sdkacctest.RandString(11))
+	lbName := fmt.Sprintf("testrule-httpRequest-%s", acctest.RandString(11))
 
 	resourceName := "aws_lb_listener_rule.static"
 	frontEndListenerResourceName := "aws_lb_listener.front_end"
@@ -891,7 +890,7 @@ func TestAccELBV2ListenerRule_conditionHTTPRequestMethod(t *testing.T) {
 
 func TestAccELBV2ListenerRule_conditionPathPattern(t *testing.T) {
 	var conf elbv2.Rule
-	lbName := fmt.Sprintf("testrule-pathPattern-%s", sdkacctest.RandString(11))
+	lbName := fmt.Sprintf("testrule-pathPattern-%s", acctest.RandString(11))
 
 	resourceName := "aws_lb_listener_rule.static"
 	frontEndListenerResourceName := "aws_lb_listener.front_end"
@@ -930,7 +929,7 @@ func TestAccELBV2ListenerRule_conditionPathPattern(t *testing.T) {
 
 func TestAccELBV2ListenerRule_conditionQueryString(t *testing.T) {
 	var conf elbv2.Rule
-	lbName := fmt.Sprintf("testrule-queryString-%s", sdkacctest.RandString(11))
+	lbName := fmt.Sprintf("testrule-queryString-%s", acctest.RandString(11))
 
 	resourceName := "aws_lb_listener_rule.static"
 	frontEndListenerResourceName := "aws_lb_listener.front_end"
@@ -993,7 +992,7 @@ func TestAccELBV2ListenerRule_conditionQueryString(t *testing.T) {
 
 func TestAccELBV2ListenerRule_conditionSourceIP(t *testing.T) {
 	var conf elbv2.Rule
-	lbName := fmt.Sprintf("testrule-sourceIp-%s", sdkacctest.RandString(14))
+	lbName := fmt.Sprintf("testrule-sourceIp-%s", acctest.RandString(14))
 
 	resourceName := "aws_lb_listener_rule.static"
 	frontEndListenerResourceName := "aws_lb_listener.front_end"
@@ -1032,7 +1031,7 @@ func TestAccELBV2ListenerRule_conditionSourceIP(t *testing.T) {
 
 func TestAccELBV2ListenerRule_conditionUpdateMixed(t *testing.T) {
 	var conf elbv2.Rule
-	lbName := fmt.Sprintf("testrule-mixed-%s", sdkacctest.RandString(17))
+	lbName := fmt.Sprintf("testrule-mixed-%s", acctest.RandString(17))
 
 	resourceName := "aws_lb_listener_rule.static"
 	frontEndListenerResourceName := "aws_lb_listener.front_end"
@@ -1106,7 +1105,7 @@ func TestAccELBV2ListenerRule_conditionUpdateMixed(t *testing.T) {
 
 func TestAccELBV2ListenerRule_conditionMultiple(t *testing.T) {
 	var conf elbv2.Rule
-	lbName := fmt.Sprintf("testrule-condMulti-%s", sdkacctest.RandString(13))
+	lbName := fmt.Sprintf("testrule-condMulti-%s", acctest.RandString(13))
 
 	resourceName := "aws_lb_listener_rule.static"
 	frontEndListenerResourceName := "aws_lb_listener.front_end"
@@ -1189,7 +1188,7 @@ func TestAccELBV2ListenerRule_conditionMultiple(t *testing.T) {
 
 func TestAccELBV2ListenerRule_conditionUpdateMultiple(t *testing.T) {
 	var conf elbv2.Rule
-	lbName := fmt.Sprintf("testrule-condMulti-%s", sdkacctest.RandString(13))
+	lbName := fmt.Sprintf("testrule-condMulti-%s", acctest.RandString(13))
 
 	resourceName := "aws_lb_listener_rule.static"
 	frontEndListenerResourceName := "aws_lb_listener.front_end"
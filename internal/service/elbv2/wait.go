@@ -27,7 +27,6 @@ const (
 	loadBalancerNetworkInterfaceDetachTimeout = 5 * time.Minute
 
 	loadBalancerListenerCreateTimeout = 5 * time.Minute
-	loadBalancerListenerReadTimeout   = 2 * time.Minute
 	loadBalancerListenerUpdateTimeout = 5 * time.Minute
 
 	propagationTimeout = 2 * time.Minute
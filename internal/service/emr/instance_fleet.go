@@ -18,6 +18,7 @@ import (
 func ResourceInstanceFleet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceInstanceFleetCreate,
+		//lintignore:AWSR004
 		Read:   resourceInstanceFleetRead,
 		Update: resourceInstanceFleetUpdate,
 		Delete: resourceInstanceFleetDelete,
@@ -26,6 +26,7 @@ const (
 func ResourceInstanceGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceInstanceGroupCreate,
+		//lintignore:AWSR004
 		Read:   resourceInstanceGroupRead,
 		Update: resourceInstanceGroupUpdate,
 		Delete: resourceInstanceGroupDelete,
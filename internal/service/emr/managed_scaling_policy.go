@@ -15,6 +15,7 @@ import (
 func ResourceManagedScalingPolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceManagedScalingPolicyCreate,
+		//lintignore:AWSR004
 		Read:   resourceManagedScalingPolicyRead,
 		Delete: resourceManagedScalingPolicyDelete,
 		Importer: &schema.ResourceImporter{
@@ -16,6 +16,7 @@ import (
 func ResourceSecurityConfiguration() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSecurityConfigurationCreate,
+		//lintignore:AWSR004
 		Read:   resourceSecurityConfigurationRead,
 		Delete: resourceSecurityConfigurationDelete,
 		Importer: &schema.ResourceImporter{
@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/fms"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -15,8 +14,8 @@ import (
 )
 
 func testAccPolicy_basic(t *testing.T) {
-	fmsPolicyName := fmt.Sprintf("tf-fms-%s", sdkacctest.RandString(5))
-	wafRuleGroupName := fmt.Sprintf("tf-waf-rg-%s", sdkacctest.RandString(5))
+	fmsPolicyName := fmt.Sprintf("tf-fms-%s", acctest.RandString(5))
+	wafRuleGroupName := fmt.Sprintf("tf-waf-rg-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -48,7 +47,7 @@ func testAccPolicy_basic(t *testing.T) {
 }
 
 func testAccPolicy_cloudFrontDistribution(t *testing.T) {
-	fmsPolicyName := fmt.Sprintf("tf-fms-%s", sdkacctest.RandString(5))
+	fmsPolicyName := fmt.Sprintf("tf-fms-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -80,8 +79,8 @@ func testAccPolicy_cloudFrontDistribution(t *testing.T) {
 }
 
 func testAccPolicy_includeMap(t *testing.T) {
-	fmsPolicyName := fmt.Sprintf("tf-fms-%s", sdkacctest.RandString(5))
-	wafRuleGroupName := fmt.Sprintf("tf-waf-rg-%s", sdkacctest.RandString(5))
+	fmsPolicyName := fmt.Sprintf("tf-fms-%s", acctest.RandString(5))
+	wafRuleGroupName := fmt.Sprintf("tf-waf-rg-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -113,9 +112,9 @@ func testAccPolicy_includeMap(t *testing.T) {
 }
 
 func testAccPolicy_update(t *testing.T) {
-	fmsPolicyName := fmt.Sprintf("tf-fms-%s", sdkacctest.RandString(5))
-	fmsPolicyName2 := fmt.Sprintf("tf-fms-%s2", sdkacctest.RandString(5))
-	wafRuleGroupName := fmt.Sprintf("tf-waf-rg-%s", sdkacctest.RandString(5))
+	fmsPolicyName := fmt.Sprintf("tf-fms-%s", acctest.RandString(5))
+	fmsPolicyName2 := fmt.Sprintf("tf-fms-%s2", acctest.RandString(5))
+	wafRuleGroupName := fmt.Sprintf("tf-waf-rg-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -144,8 +143,8 @@ func testAccPolicy_update(t *testing.T) {
 }
 
 func testAccPolicy_tags(t *testing.T) {
-	fmsPolicyName := fmt.Sprintf("tf-fms-%s", sdkacctest.RandString(5))
-	wafRuleGroupName := fmt.Sprintf("tf-waf-rg-%s", sdkacctest.RandString(5))
+	fmsPolicyName := fmt.Sprintf("tf-fms-%s", acctest.RandString(5))
+	wafRuleGroupName := fmt.Sprintf("tf-waf-rg-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
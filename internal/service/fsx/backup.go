@@ -86,7 +86,7 @@ func resourceBackupCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(aws.StringValue(result.Backup.BackupId))
 
 	log.Println("[DEBUG] Waiting for FSx backup to become available")
-	if _, err := waitBackupAvailable(conn, d.Id()); err != nil {
+	if _, err := waitBackupAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return fmt.Errorf("error waiting for FSx Backup (%s) to be available: %w", d.Id(), err)
 	}
 
@@ -165,7 +165,7 @@ func resourceBackupDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Println("[DEBUG] Waiting for backup to delete")
-	if _, err := waitBackupDeleted(conn, d.Id()); err != nil {
+	if _, err := waitBackupDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return fmt.Errorf("error waiting for FSx Backup (%s) to deleted: %w", d.Id(), err)
 	}
 
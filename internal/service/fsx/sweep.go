@@ -59,7 +59,7 @@ func sweepFSXBackups(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(fs.BackupId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_fsx_backup", r, d, client))
 		}
 
 		return !lastPage
@@ -107,7 +107,7 @@ func sweepFSXLustreFileSystems(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(fs.FileSystemId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_fsx_lustre_file_system", r, d, client))
 		}
 
 		return !lastPage
@@ -155,7 +155,7 @@ func sweepFSXOntapFileSystems(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(fs.FileSystemId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_fsx_ontap_file_system", r, d, client))
 		}
 
 		return !lastPage
@@ -204,7 +204,7 @@ func sweepFSXWindowsFileSystems(region string) error {
 			d.SetId(aws.StringValue(fs.FileSystemId))
 			d.Set("skip_final_backup", true)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_fsx_windows_file_system", r, d, client))
 		}
 
 		return !lastPage
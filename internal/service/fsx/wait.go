@@ -10,11 +10,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
-const (
-	backupAvailableTimeout = 10 * time.Minute
-	backupDeletedTimeout   = 10 * time.Minute
-)
-
 func waitAdministrativeActionCompleted(conn *fsx.FSx, fsID, actionType string, timeout time.Duration) (*fsx.AdministrativeAction, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{fsx.StatusInProgress, fsx.StatusPending},
@@ -37,12 +32,12 @@ func waitAdministrativeActionCompleted(conn *fsx.FSx, fsID, actionType string, t
 	return nil, err
 }
 
-func waitBackupAvailable(conn *fsx.FSx, id string) (*fsx.Backup, error) {
+func waitBackupAvailable(conn *fsx.FSx, id string, timeout time.Duration) (*fsx.Backup, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{fsx.BackupLifecycleCreating, fsx.BackupLifecyclePending, fsx.BackupLifecycleTransferring},
 		Target:  []string{fsx.BackupLifecycleAvailable},
 		Refresh: statusBackup(conn, id),
-		Timeout: backupAvailableTimeout,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -54,12 +49,12 @@ func waitBackupAvailable(conn *fsx.FSx, id string) (*fsx.Backup, error) {
 	return nil, err
 }
 
-func waitBackupDeleted(conn *fsx.FSx, id string) (*fsx.Backup, error) {
+func waitBackupDeleted(conn *fsx.FSx, id string, timeout time.Duration) (*fsx.Backup, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{fsx.FileSystemLifecycleDeleting},
 		Target:  []string{},
 		Refresh: statusBackup(conn, id),
-		Timeout: backupDeletedTimeout,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -20,6 +20,7 @@ import (
 func ResourceBuild() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBuildCreate,
+		//lintignore:AWSR004
 		Read:   resourceBuildRead,
 		Update: resourceBuildUpdate,
 		Delete: resourceBuildDelete,
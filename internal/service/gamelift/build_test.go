@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/gamelift"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -21,7 +20,7 @@ const testAccGameliftBuildPrefix = "tf_acc_build_"
 func TestAccGameLiftBuild_basic(t *testing.T) {
 	var conf gamelift.Build
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	resourceName := "aws_gamelift_build.test"
 
 	buildName := fmt.Sprintf("%s_%s", testAccGameliftBuildPrefix, rString)
@@ -88,7 +87,7 @@ func TestAccGameLiftBuild_basic(t *testing.T) {
 func TestAccGameLiftBuild_tags(t *testing.T) {
 	var conf gamelift.Build
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	resourceName := "aws_gamelift_build.test"
 
 	buildName := fmt.Sprintf("%s_%s", testAccGameliftBuildPrefix, rString)
@@ -150,7 +149,7 @@ func TestAccGameLiftBuild_tags(t *testing.T) {
 func TestAccGameLiftBuild_disappears(t *testing.T) {
 	var conf gamelift.Build
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	resourceName := "aws_gamelift_build.test"
 
 	buildName := fmt.Sprintf("%s_%s", testAccGameliftBuildPrefix, rString)
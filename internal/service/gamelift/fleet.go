@@ -23,6 +23,7 @@ import (
 func ResourceFleet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceFleetCreate,
+		//lintignore:AWSR004
 		Read:   resourceFleetRead,
 		Update: resourceFleetUpdate,
 		Delete: resourceFleetDelete,
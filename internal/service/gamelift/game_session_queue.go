@@ -17,6 +17,7 @@ import (
 func ResourceGameSessionQueue() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceGameSessionQueueCreate,
+		//lintignore:AWSR004
 		Read:   resourceGameSessionQueueRead,
 		Update: resourceGameSessionQueueUpdate,
 		Delete: resourceGameSessionQueueDelete,
@@ -18,7 +18,8 @@ import (
 func ResourceVaultLock() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceVaultLockCreate,
-		Read:   resourceVaultLockRead,
+		//lintignore:AWSR004
+		Read: resourceVaultLockRead,
 		// Allow ignore_deletion_error update
 		Update: schema.Noop,
 		Delete: resourceVaultLockDelete,
@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/globalaccelerator"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 
 func TestAccGlobalAcceleratorListener_basic(t *testing.T) {
 	resourceName := "aws_globalaccelerator_listener.example"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckGlobalAccelerator(t) },
@@ -48,7 +47,7 @@ func TestAccGlobalAcceleratorListener_basic(t *testing.T) {
 
 func TestAccGlobalAcceleratorListener_disappears(t *testing.T) {
 	resourceName := "aws_globalaccelerator_listener.example"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckGlobalAccelerator(t) },
@@ -70,7 +69,7 @@ func TestAccGlobalAcceleratorListener_disappears(t *testing.T) {
 
 func TestAccGlobalAcceleratorListener_update(t *testing.T) {
 	resourceName := "aws_globalaccelerator_listener.example"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckGlobalAccelerator(t) },
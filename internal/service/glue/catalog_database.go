@@ -19,6 +19,7 @@ import (
 func ResourceCatalogDatabase() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCatalogDatabaseCreate,
+		//lintignore:AWSR004
 		Read:   resourceCatalogDatabaseRead,
 		Update: resourceCatalogDatabaseUpdate,
 		Delete: resourceCatalogDatabaseDelete,
@@ -20,6 +20,7 @@ import (
 func ResourceCatalogTable() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCatalogTableCreate,
+		//lintignore:AWSR004
 		Read:   resourceCatalogTableRead,
 		Update: resourceCatalogTableUpdate,
 		Delete: resourceCatalogTableDelete,
@@ -18,6 +18,7 @@ import (
 func ResourceClassifier() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceClassifierCreate,
+		//lintignore:AWSR004
 		Read:   resourceClassifierRead,
 		Update: resourceClassifierUpdate,
 		Delete: resourceClassifierDelete,
@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 func TestAccGlueClassifier_csvClassifier(t *testing.T) {
 	var classifier glue.Classifier
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_classifier.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -73,7 +72,7 @@ func TestAccGlueClassifier_csvClassifier(t *testing.T) {
 func TestAccGlueClassifier_CSVClassifier_quoteSymbol(t *testing.T) {
 	var classifier glue.Classifier
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_classifier.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -110,7 +109,7 @@ func TestAccGlueClassifier_CSVClassifier_quoteSymbol(t *testing.T) {
 func TestAccGlueClassifier_grokClassifier(t *testing.T) {
 	var classifier glue.Classifier
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_classifier.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -159,7 +158,7 @@ func TestAccGlueClassifier_grokClassifier(t *testing.T) {
 func TestAccGlueClassifier_GrokClassifier_customPatterns(t *testing.T) {
 	var classifier glue.Classifier
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_classifier.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -208,7 +207,7 @@ func TestAccGlueClassifier_GrokClassifier_customPatterns(t *testing.T) {
 func TestAccGlueClassifier_jsonClassifier(t *testing.T) {
 	var classifier glue.Classifier
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_classifier.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -253,7 +252,7 @@ func TestAccGlueClassifier_jsonClassifier(t *testing.T) {
 func TestAccGlueClassifier_typeChange(t *testing.T) {
 	var classifier glue.Classifier
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_classifier.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -322,7 +321,7 @@ func TestAccGlueClassifier_typeChange(t *testing.T) {
 func TestAccGlueClassifier_xmlClassifier(t *testing.T) {
 	var classifier glue.Classifier
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_classifier.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -369,7 +368,7 @@ func TestAccGlueClassifier_xmlClassifier(t *testing.T) {
 func TestAccGlueClassifier_disappears(t *testing.T) {
 	var classifier glue.Classifier
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_classifier.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/glue"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 func TestAccGlueConnection_basic(t *testing.T) {
 	var connection glue.Connection
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_connection.test"
 
 	jdbcConnectionUrl := fmt.Sprintf("jdbc:mysql://%s/testdatabase", acctest.RandomDomainName())
@@ -54,7 +53,7 @@ func TestAccGlueConnection_basic(t *testing.T) {
 func TestAccGlueConnection_tags(t *testing.T) {
 	var connection glue.Connection
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_connection.test"
 
 	jdbcConnectionUrl := fmt.Sprintf("jdbc:mysql://%s/testdatabase", acctest.RandomDomainName())
@@ -102,7 +101,7 @@ func TestAccGlueConnection_tags(t *testing.T) {
 func TestAccGlueConnection_mongoDB(t *testing.T) {
 	var connection glue.Connection
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_connection.test"
 
 	connectionUrl := fmt.Sprintf("mongodb://%s:27017/testdatabase", acctest.RandomDomainName())
@@ -138,7 +137,7 @@ func TestAccGlueConnection_mongoDB(t *testing.T) {
 func TestAccGlueConnection_kafka(t *testing.T) {
 	var connection glue.Connection
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_connection.test"
 
 	bootstrapServers := fmt.Sprintf("%s:9094,%s:9094", acctest.RandomDomainName(), acctest.RandomDomainName())
@@ -172,7 +171,7 @@ func TestAccGlueConnection_kafka(t *testing.T) {
 func TestAccGlueConnection_network(t *testing.T) {
 	var connection glue.Connection
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_connection.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -206,7 +205,7 @@ func TestAccGlueConnection_network(t *testing.T) {
 func TestAccGlueConnection_description(t *testing.T) {
 	var connection glue.Connection
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_connection.test"
 
 	jdbcConnectionUrl := fmt.Sprintf("jdbc:mysql://%s/testdatabase", acctest.RandomDomainName())
@@ -243,7 +242,7 @@ func TestAccGlueConnection_description(t *testing.T) {
 func TestAccGlueConnection_matchCriteria(t *testing.T) {
 	var connection glue.Connection
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_connection.test"
 
 	jdbcConnectionUrl := fmt.Sprintf("jdbc:mysql://%s/testdatabase", acctest.RandomDomainName())
@@ -295,7 +294,7 @@ func TestAccGlueConnection_matchCriteria(t *testing.T) {
 func TestAccGlueConnection_physicalConnectionRequirements(t *testing.T) {
 	var connection glue.Connection
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_connection.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -332,7 +331,7 @@ func TestAccGlueConnection_physicalConnectionRequirements(t *testing.T) {
 func TestAccGlueConnection_disappears(t *testing.T) {
 	var connection glue.Connection
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_connection.test"
 
 	jdbcConnectionUrl := fmt.Sprintf("jdbc:mysql://%s/testdatabase", acctest.RandomDomainName())
@@ -25,6 +25,7 @@ import (
 func ResourceCrawler() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCrawlerCreate,
+		//lintignore:AWSR004
 		Read:   resourceCrawlerRead,
 		Update: resourceCrawlerUpdate,
 		Delete: resourceCrawlerDelete,
@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -21,7 +20,7 @@ import (
 
 func TestAccGlueCrawler_dynamoDBTarget(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -89,7 +88,7 @@ func TestAccGlueCrawler_dynamoDBTarget(t *testing.T) {
 
 func TestAccGlueCrawler_DynamoDBTarget_scanAll(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -133,7 +132,7 @@ func TestAccGlueCrawler_DynamoDBTarget_scanAll(t *testing.T) {
 
 func TestAccGlueCrawler_DynamoDBTarget_scanRate(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -177,7 +176,7 @@ func TestAccGlueCrawler_DynamoDBTarget_scanRate(t *testing.T) {
 
 func TestAccGlueCrawler_jdbcTarget(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	jdbcConnectionUrl := fmt.Sprintf("jdbc:mysql://%s/testdatabase", acctest.RandomDomainName())
@@ -249,7 +248,7 @@ func TestAccGlueCrawler_jdbcTarget(t *testing.T) {
 
 func TestAccGlueCrawler_JDBCTarget_exclusions(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	jdbcConnectionUrl := fmt.Sprintf("jdbc:mysql://%s/testdatabase", acctest.RandomDomainName())
@@ -292,7 +291,7 @@ func TestAccGlueCrawler_JDBCTarget_exclusions(t *testing.T) {
 
 func TestAccGlueCrawler_JDBCTarget_multiple(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	jdbcConnectionUrl := fmt.Sprintf("jdbc:mysql://%s/testdatabase", acctest.RandomDomainName())
@@ -353,7 +352,7 @@ func TestAccGlueCrawler_JDBCTarget_multiple(t *testing.T) {
 
 func TestAccGlueCrawler_mongoDBTarget(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	connectionUrl := fmt.Sprintf("mongodb://%s:27017/testdatabase", acctest.RandomDomainName())
@@ -395,7 +394,7 @@ func TestAccGlueCrawler_mongoDBTarget(t *testing.T) {
 
 func TestAccGlueCrawler_MongoDBTargetScan_all(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	connectionUrl := fmt.Sprintf("mongodb://%s:27017/testdatabase", acctest.RandomDomainName())
@@ -447,7 +446,7 @@ func TestAccGlueCrawler_MongoDBTargetScan_all(t *testing.T) {
 
 func TestAccGlueCrawler_MongoDBTarget_multiple(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	connectionUrl := fmt.Sprintf("mongodb://%s:27017/testdatabase", acctest.RandomDomainName())
@@ -505,7 +504,7 @@ func TestAccGlueCrawler_MongoDBTarget_multiple(t *testing.T) {
 
 func TestAccGlueCrawler_s3Target(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -573,7 +572,7 @@ func TestAccGlueCrawler_s3Target(t *testing.T) {
 
 func TestAccGlueCrawler_S3Target_connectionName(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 	connectionName := "aws_glue_connection.test"
 
@@ -603,7 +602,7 @@ func TestAccGlueCrawler_S3Target_connectionName(t *testing.T) {
 
 func TestAccGlueCrawler_S3Target_sampleSize(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -639,7 +638,7 @@ func TestAccGlueCrawler_S3Target_sampleSize(t *testing.T) {
 
 func TestAccGlueCrawler_S3Target_exclusions(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -680,7 +679,7 @@ func TestAccGlueCrawler_S3Target_exclusions(t *testing.T) {
 
 func TestAccGlueCrawler_S3Target_multiple(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -734,7 +733,7 @@ func TestAccGlueCrawler_S3Target_multiple(t *testing.T) {
 
 func TestAccGlueCrawler_catalogTarget(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -807,7 +806,7 @@ func TestAccGlueCrawler_catalogTarget(t *testing.T) {
 
 func TestAccGlueCrawler_CatalogTarget_multiple(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -863,7 +862,7 @@ func TestAccGlueCrawler_CatalogTarget_multiple(t *testing.T) {
 
 func TestAccGlueCrawler_disappears(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -886,7 +885,7 @@ func TestAccGlueCrawler_disappears(t *testing.T) {
 
 func TestAccGlueCrawler_classifiers(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -933,7 +932,7 @@ func TestAccGlueCrawler(t *testing.T) {
 	var crawler glue.Crawler
 	configuration1 := `{"Version": 1.0, "CrawlerOutput": {"Tables": { "AddOrUpdateBehavior": "MergeNewColumns" }}}`
 	configuration2 := `{"Version": 1.0, "CrawlerOutput": {"Partitions": { "AddOrUpdateBehavior": "InheritFromTable" }}}`
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -974,7 +973,7 @@ func TestAccGlueCrawler(t *testing.T) {
 
 func TestAccGlueCrawler_description(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1009,7 +1008,7 @@ func TestAccGlueCrawler_description(t *testing.T) {
 func TestAccGlueCrawler_RoleARN_noPath(t *testing.T) {
 	var crawler glue.Crawler
 	iamRoleResourceName := "aws_iam_role.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1036,7 +1035,7 @@ func TestAccGlueCrawler_RoleARN_noPath(t *testing.T) {
 
 func TestAccGlueCrawler_RoleARN_path(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1063,7 +1062,7 @@ func TestAccGlueCrawler_RoleARN_path(t *testing.T) {
 
 func TestAccGlueCrawler_RoleName_path(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1090,7 +1089,7 @@ func TestAccGlueCrawler_RoleName_path(t *testing.T) {
 
 func TestAccGlueCrawler_schedule(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1131,7 +1130,7 @@ func TestAccGlueCrawler_schedule(t *testing.T) {
 
 func TestAccGlueCrawler_schemaChangePolicy(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1169,7 +1168,7 @@ func TestAccGlueCrawler_schemaChangePolicy(t *testing.T) {
 
 func TestAccGlueCrawler_tablePrefix(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1203,7 +1202,7 @@ func TestAccGlueCrawler_tablePrefix(t *testing.T) {
 
 func TestAccGlueCrawler_removeTablePrefix(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1237,7 +1236,7 @@ func TestAccGlueCrawler_removeTablePrefix(t *testing.T) {
 
 func TestAccGlueCrawler_tags(t *testing.T) {
 	var crawler1, crawler2, crawler3 glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1282,7 +1281,7 @@ func TestAccGlueCrawler_tags(t *testing.T) {
 
 func TestAccGlueCrawler_security(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1316,7 +1315,7 @@ func TestAccGlueCrawler_security(t *testing.T) {
 
 func TestAccGlueCrawler_lineage(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1359,7 +1358,7 @@ func TestAccGlueCrawler_lineage(t *testing.T) {
 
 func TestAccGlueCrawler_reCrawlPolicy(t *testing.T) {
 	var crawler glue.Crawler
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_crawler.test"
 
 	resource.ParallelTest(t, resource.TestCase{
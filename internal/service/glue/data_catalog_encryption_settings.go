@@ -14,6 +14,7 @@ import (
 func ResourceDataCatalogEncryptionSettings() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDataCatalogEncryptionSettingsPut,
+		//lintignore:AWSR004
 		Read:   resourceDataCatalogEncryptionSettingsRead,
 		Update: resourceDataCatalogEncryptionSettingsPut,
 		Delete: schema.Noop,
@@ -19,6 +19,7 @@ import (
 func ResourceJob() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceJobCreate,
+		//lintignore:AWSR004
 		Read:   resourceJobRead,
 		Update: resourceJobUpdate,
 		Delete: resourceJobDelete,
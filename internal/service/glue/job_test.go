@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,7 +18,7 @@ import (
 func TestAccGlueJob_basic(t *testing.T) {
 	var job glue.Job
 
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
 	resourceName := "aws_glue_job.test"
 	roleResourceName := "aws_iam_role.test"
 
@@ -56,7 +55,7 @@ func TestAccGlueJob_basic(t *testing.T) {
 func TestAccGlueJob_command(t *testing.T) {
 	var job glue.Job
 
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
 	resourceName := "aws_glue_job.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -93,7 +92,7 @@ func TestAccGlueJob_command(t *testing.T) {
 func TestAccGlueJob_defaultArguments(t *testing.T) {
 	var job glue.Job
 
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
 	resourceName := "aws_glue_job.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -132,7 +131,7 @@ func TestAccGlueJob_defaultArguments(t *testing.T) {
 func TestAccGlueJob_nonOverridableArguments(t *testing.T) {
 	var job glue.Job
 
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
 	resourceName := "aws_glue_job.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -171,7 +170,7 @@ func TestAccGlueJob_nonOverridableArguments(t *testing.T) {
 func TestAccGlueJob_description(t *testing.T) {
 	var job glue.Job
 
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
 	resourceName := "aws_glue_job.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -206,7 +205,7 @@ func TestAccGlueJob_description(t *testing.T) {
 func TestAccGlueJob_glueVersion(t *testing.T) {
 	var job glue.Job
 
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
 	resourceName := "aws_glue_job.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -248,7 +247,7 @@ func TestAccGlueJob_glueVersion(t *testing.T) {
 func TestAccGlueJob_executionProperty(t *testing.T) {
 	var job glue.Job
 
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
 	resourceName := "aws_glue_job.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -289,7 +288,7 @@ func TestAccGlueJob_executionProperty(t *testing.T) {
 func TestAccGlueJob_maxRetries(t *testing.T) {
 	var job glue.Job
 
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
 	resourceName := "aws_glue_job.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -328,7 +327,7 @@ func TestAccGlueJob_maxRetries(t *testing.T) {
 func TestAccGlueJob_notificationProperty(t *testing.T) {
 	var job glue.Job
 
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
 	resourceName := "aws_glue_job.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -369,7 +368,7 @@ func TestAccGlueJob_notificationProperty(t *testing.T) {
 func TestAccGlueJob_tags(t *testing.T) {
 	var job1, job2, job3 glue.Job
 
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
 	resourceName := "aws_glue_job.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -415,7 +414,7 @@ func TestAccGlueJob_tags(t *testing.T) {
 func TestAccGlueJob_timeout(t *testing.T) {
 	var job glue.Job
 
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
 	resourceName := "aws_glue_job.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -450,7 +449,7 @@ func TestAccGlueJob_timeout(t *testing.T) {
 func TestAccGlueJob_security(t *testing.T) {
 	var job glue.Job
 
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
 	resourceName := "aws_glue_job.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -485,7 +484,7 @@ func TestAccGlueJob_security(t *testing.T) {
 func TestAccGlueJob_workerType(t *testing.T) {
 	var job glue.Job
 
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
 	resourceName := "aws_glue_job.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -527,7 +526,7 @@ func TestAccGlueJob_workerType(t *testing.T) {
 func TestAccGlueJob_pythonShell(t *testing.T) {
 	var job glue.Job
 
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
 	resourceName := "aws_glue_job.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -583,7 +582,7 @@ func TestAccGlueJob_pythonShell(t *testing.T) {
 func TestAccGlueJob_maxCapacity(t *testing.T) {
 	var job glue.Job
 
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
 	resourceName := "aws_glue_job.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -621,7 +620,7 @@ func TestAccGlueJob_maxCapacity(t *testing.T) {
 func TestAccGlueJob_disappears(t *testing.T) {
 	var job glue.Job
 
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
 	resourceName := "aws_glue_job.test"
 
 	resource.ParallelTest(t, resource.TestCase{
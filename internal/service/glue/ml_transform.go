@@ -18,6 +18,7 @@ import (
 func ResourceMLTransform() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceMLTransformCreate,
+		//lintignore:AWSR004
 		Read:   resourceMLTransformRead,
 		Update: resourceMLTransformUpdate,
 		Delete: resourceMLTransformDelete,
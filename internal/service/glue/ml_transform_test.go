@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,7 +18,7 @@ import (
 func TestAccGlueMlTransform_ML_basic(t *testing.T) {
 	var transform glue.GetMLTransformOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_ml_transform.test"
 	roleResourceName := "aws_iam_role.test"
 	tableResourceName := "aws_glue_catalog_table.test"
@@ -69,7 +68,7 @@ func TestAccGlueMlTransform_ML_basic(t *testing.T) {
 func TestAccGlueMlTransform_ML_typeFindMatchesFull(t *testing.T) {
 	var transform glue.GetMLTransformOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_ml_transform.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -129,7 +128,7 @@ func TestAccGlueMlTransform_ML_typeFindMatchesFull(t *testing.T) {
 func TestAccGlueMlTransform_ML_description(t *testing.T) {
 	var transform glue.GetMLTransformOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_ml_transform.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -164,7 +163,7 @@ func TestAccGlueMlTransform_ML_description(t *testing.T) {
 func TestAccGlueMlTransform_ML_glueVersion(t *testing.T) {
 	var transform glue.GetMLTransformOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_ml_transform.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -199,7 +198,7 @@ func TestAccGlueMlTransform_ML_glueVersion(t *testing.T) {
 func TestAccGlueMlTransform_ML_maxRetries(t *testing.T) {
 	var transform glue.GetMLTransformOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_ml_transform.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -238,7 +237,7 @@ func TestAccGlueMlTransform_ML_maxRetries(t *testing.T) {
 func TestAccGlueMlTransform_ML_tags(t *testing.T) {
 	var transform1, transform2, transform3 glue.GetMLTransformOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_ml_transform.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -284,7 +283,7 @@ func TestAccGlueMlTransform_ML_tags(t *testing.T) {
 func TestAccGlueMlTransform_ML_timeout(t *testing.T) {
 	var transform glue.GetMLTransformOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_ml_transform.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -319,7 +318,7 @@ func TestAccGlueMlTransform_ML_timeout(t *testing.T) {
 func TestAccGlueMlTransform_ML_workerType(t *testing.T) {
 	var transform glue.GetMLTransformOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_ml_transform.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -356,7 +355,7 @@ func TestAccGlueMlTransform_ML_workerType(t *testing.T) {
 func TestAccGlueMlTransform_ML_maxCapacity(t *testing.T) {
 	var transform glue.GetMLTransformOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_ml_transform.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -391,7 +390,7 @@ func TestAccGlueMlTransform_ML_maxCapacity(t *testing.T) {
 func TestAccGlueMlTransform_ML_disappears(t *testing.T) {
 	var transform glue.GetMLTransformOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_ml_transform.test"
 
 	resource.ParallelTest(t, resource.TestCase{
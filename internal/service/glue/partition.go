@@ -17,6 +17,7 @@ import (
 func ResourcePartition() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePartitionCreate,
+		//lintignore:AWSR004
 		Read:   resourcePartitionRead,
 		Update: resourcePartitionUpdate,
 		Delete: resourcePartitionDelete,
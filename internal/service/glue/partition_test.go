@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -15,8 +14,8 @@ import (
 )
 
 func TestAccGluePartition_basic(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	parValue := sdkacctest.RandString(10)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	parValue := acctest.RandString(10)
 	resourceName := "aws_glue_partition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -47,9 +46,9 @@ func TestAccGluePartition_basic(t *testing.T) {
 }
 
 func TestAccGluePartition_multipleValues(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	parValue := sdkacctest.RandString(10)
-	parValue2 := sdkacctest.RandString(11)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	parValue := acctest.RandString(10)
+	parValue2 := acctest.RandString(11)
 	resourceName := "aws_glue_partition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -77,8 +76,8 @@ func TestAccGluePartition_multipleValues(t *testing.T) {
 }
 
 func TestAccGluePartition_parameters(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	parValue := sdkacctest.RandString(10)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	parValue := acctest.RandString(10)
 	resourceName := "aws_glue_partition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -122,8 +121,8 @@ func TestAccGluePartition_parameters(t *testing.T) {
 }
 
 func TestAccGluePartition_disappears(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	parValue := sdkacctest.RandString(10)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	parValue := acctest.RandString(10)
 	resourceName := "aws_glue_partition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -145,8 +144,8 @@ func TestAccGluePartition_disappears(t *testing.T) {
 }
 
 func TestAccGluePartition_Disappears_table(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	parValue := sdkacctest.RandString(10)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	parValue := acctest.RandString(10)
 	resourceName := "aws_glue_partition.test"
 
 	resource.ParallelTest(t, resource.TestCase{
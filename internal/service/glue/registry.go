@@ -18,6 +18,7 @@ import (
 func ResourceRegistry() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRegistryCreate,
+		//lintignore:AWSR004
 		Read:   resourceRegistryRead,
 		Update: resourceRegistryUpdate,
 		Delete: resourceRegistryDelete,
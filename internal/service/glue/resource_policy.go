@@ -16,6 +16,7 @@ import (
 func ResourceResourcePolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceResourcePolicyPut(glue.ExistConditionNotExist),
+		//lintignore:AWSR004
 		Read:   resourceResourcePolicyRead,
 		Update: resourceResourcePolicyPut(glue.ExistConditionMustExist),
 		Delete: resourceResourcePolicyDelete,
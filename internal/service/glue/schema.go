@@ -18,6 +18,7 @@ import (
 func ResourceSchema() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSchemaCreate,
+		//lintignore:AWSR004
 		Read:   resourceSchemaRead,
 		Update: resourceSchemaUpdate,
 		Delete: resourceSchemaDelete,
@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 func TestAccGlueSchema_basic(t *testing.T) {
 	var schema glue.GetSchemaOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_schema.test"
 	registryResourceName := "aws_glue_registry.test"
 
@@ -58,7 +57,7 @@ func TestAccGlueSchema_basic(t *testing.T) {
 func TestAccGlueSchema_description(t *testing.T) {
 	var schema glue.GetSchemaOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_schema.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -93,7 +92,7 @@ func TestAccGlueSchema_description(t *testing.T) {
 func TestAccGlueSchema_compatibility(t *testing.T) {
 	var schema glue.GetSchemaOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_schema.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -127,7 +126,7 @@ func TestAccGlueSchema_compatibility(t *testing.T) {
 
 func TestAccGlueSchema_tags(t *testing.T) {
 	var schema glue.GetSchemaOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_schema.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -173,7 +172,7 @@ func TestAccGlueSchema_tags(t *testing.T) {
 func TestAccGlueSchema_schemaDefUpdated(t *testing.T) {
 	var schema glue.GetSchemaOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_schema.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -212,7 +211,7 @@ func TestAccGlueSchema_schemaDefUpdated(t *testing.T) {
 func TestAccGlueSchema_disappears(t *testing.T) {
 	var schema glue.GetSchemaOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_schema.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -236,7 +235,7 @@ func TestAccGlueSchema_disappears(t *testing.T) {
 func TestAccGlueSchema_Disappears_registry(t *testing.T) {
 	var schema glue.GetSchemaOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_schema.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -15,6 +15,7 @@ import (
 func ResourceSecurityConfiguration() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSecurityConfigurationCreate,
+		//lintignore:AWSR004
 		Read:   resourceSecurityConfigurationRead,
 		Delete: resourceSecurityConfigurationDelete,
 		Importer: &schema.ResourceImporter{
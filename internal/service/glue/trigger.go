@@ -22,6 +22,7 @@ import (
 func ResourceTrigger() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTriggerCreate,
+		//lintignore:AWSR004
 		Read:   resourceTriggerRead,
 		Update: resourceTriggerUpdate,
 		Delete: resourceTriggerDelete,
@@ -234,7 +235,7 @@ func resourceTriggerCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(name)
 
 	log.Printf("[DEBUG] Waiting for Glue Trigger (%s) to create", d.Id())
-	if _, err := waitTriggerCreated(conn, d.Id()); err != nil {
+	if _, err := waitTriggerCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 			return nil
 		}
@@ -364,7 +365,7 @@ func resourceTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error updating Glue Trigger (%s): %w", d.Id(), err)
 		}
 
-		if _, err := waitTriggerCreated(conn, d.Id()); err != nil {
+		if _, err := waitTriggerCreated(conn, d.Id(), triggerCreateTimeout); err != nil {
 			return fmt.Errorf("error waiting for Glue Trigger (%s) to be Update: %w", d.Id(), err)
 		}
 	}
@@ -416,7 +417,7 @@ func resourceTriggerDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Waiting for Glue Trigger (%s) to delete", d.Id())
-	if _, err := waitTriggerDeleted(conn, d.Id()); err != nil {
+	if _, err := waitTriggerDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 			return nil
 		}
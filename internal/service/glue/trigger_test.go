@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 func TestAccGlueTrigger_basic(t *testing.T) {
 	var trigger glue.Trigger
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_trigger.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -58,7 +57,7 @@ func TestAccGlueTrigger_basic(t *testing.T) {
 func TestAccGlueTrigger_crawler(t *testing.T) {
 	var trigger glue.Trigger
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_trigger.test_trigger"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -106,7 +105,7 @@ func TestAccGlueTrigger_crawler(t *testing.T) {
 func TestAccGlueTrigger_description(t *testing.T) {
 	var trigger glue.Trigger
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_trigger.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -142,7 +141,7 @@ func TestAccGlueTrigger_description(t *testing.T) {
 func TestAccGlueTrigger_enabled(t *testing.T) {
 	var trigger glue.Trigger
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_trigger.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -185,7 +184,7 @@ func TestAccGlueTrigger_enabled(t *testing.T) {
 func TestAccGlueTrigger_predicate(t *testing.T) {
 	var trigger glue.Trigger
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_trigger.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -229,7 +228,7 @@ func TestAccGlueTrigger_predicate(t *testing.T) {
 func TestAccGlueTrigger_schedule(t *testing.T) {
 	var trigger glue.Trigger
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_trigger.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -265,7 +264,7 @@ func TestAccGlueTrigger_schedule(t *testing.T) {
 func TestAccGlueTrigger_tags(t *testing.T) {
 	var trigger1, trigger2, trigger3 glue.Trigger
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_trigger.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -312,7 +311,7 @@ func TestAccGlueTrigger_tags(t *testing.T) {
 func TestAccGlueTrigger_workflowName(t *testing.T) {
 	var trigger glue.Trigger
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_trigger.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -341,7 +340,7 @@ func TestAccGlueTrigger_workflowName(t *testing.T) {
 func TestAccGlueTrigger_Actions_notify(t *testing.T) {
 	var trigger glue.Trigger
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_trigger.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -393,7 +392,7 @@ func TestAccGlueTrigger_Actions_notify(t *testing.T) {
 func TestAccGlueTrigger_Actions_security(t *testing.T) {
 	var trigger glue.Trigger
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_trigger.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -424,7 +423,7 @@ func TestAccGlueTrigger_Actions_security(t *testing.T) {
 func TestAccGlueTrigger_onDemandDisable(t *testing.T) {
 	var trigger glue.Trigger
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_trigger.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -470,7 +469,7 @@ func TestAccGlueTrigger_onDemandDisable(t *testing.T) {
 func TestAccGlueTrigger_disappears(t *testing.T) {
 	var trigger glue.Trigger
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_glue_trigger.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -18,6 +18,7 @@ import (
 func ResourceUserDefinedFunction() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceUserDefinedFunctionCreate,
+		//lintignore:AWSR004
 		Read:   resourceUserDefinedFunctionRead,
 		Update: resourceUserDefinedFunctionUpdate,
 		Delete: resourceUserDefinedFunctionDelete,
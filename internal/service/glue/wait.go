@@ -18,7 +18,6 @@ const (
 	schemaDeleteTimeout           = 2 * time.Minute
 	schemaVersionAvailableTimeout = 2 * time.Minute
 	triggerCreateTimeout          = 5 * time.Minute
-	triggerDeleteTimeout          = 5 * time.Minute
 )
 
 // waitMLTransformDeleted waits for an MLTransform to return Deleted
@@ -112,7 +111,7 @@ func waitSchemaVersionAvailable(conn *glue.Glue, registryID string) (*glue.GetSc
 }
 
 // waitTriggerCreated waits for a Trigger to return Created
-func waitTriggerCreated(conn *glue.Glue, triggerName string) (*glue.GetTriggerOutput, error) {
+func waitTriggerCreated(conn *glue.Glue, triggerName string, timeout time.Duration) (*glue.GetTriggerOutput, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
 			glue.TriggerStateActivating,
@@ -124,7 +123,7 @@ func waitTriggerCreated(conn *glue.Glue, triggerName string) (*glue.GetTriggerOu
 			glue.TriggerStateCreated,
 		},
 		Refresh: statusTrigger(conn, triggerName),
-		Timeout: triggerCreateTimeout,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -137,12 +136,12 @@ func waitTriggerCreated(conn *glue.Glue, triggerName string) (*glue.GetTriggerOu
 }
 
 // waitTriggerDeleted waits for a Trigger to return Deleted
-func waitTriggerDeleted(conn *glue.Glue, triggerName string) (*glue.GetTriggerOutput, error) {
+func waitTriggerDeleted(conn *glue.Glue, triggerName string, timeout time.Duration) (*glue.GetTriggerOutput, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{glue.TriggerStateDeleting},
 		Target:  []string{},
 		Refresh: statusTrigger(conn, triggerName),
-		Timeout: triggerDeleteTimeout,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -19,6 +19,7 @@ import (
 func ResourceWorkflow() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceWorkflowCreate,
+		//lintignore:AWSR004
 		Read:   resourceWorkflowRead,
 		Update: resourceWorkflowUpdate,
 		Delete: resourceWorkflowDelete,
@@ -19,6 +19,7 @@ import (
 func ResourceDetector() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDetectorCreate,
+		//lintignore:AWSR004
 		Read:   resourceDetectorRead,
 		Update: resourceDetectorUpdate,
 		Delete: resourceDetectorDelete,
@@ -21,6 +21,7 @@ import (
 func ResourceFilter() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceFilterCreate,
+		//lintignore:AWSR004
 		Read:   resourceFilterRead,
 		Update: resourceFilterUpdate,
 		Delete: resourceFilterDelete,
@@ -18,6 +18,7 @@ import (
 func ResourceInviteAccepter() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceInviteAccepterCreate,
+		//lintignore:AWSR004
 		Read:   resourceInviteAccepterRead,
 		Delete: resourceInviteAccepterDelete,
 
@@ -21,6 +21,7 @@ import (
 func ResourceIPSet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceIPSetCreate,
+		//lintignore:AWSR004
 		Read:   resourceIPSetRead,
 		Update: resourceIPSetUpdate,
 		Delete: resourceIPSetDelete,
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,11 +16,11 @@ import (
 )
 
 func testAccIPSet_basic(t *testing.T) {
-	bucketName := fmt.Sprintf("tf-test-%s", sdkacctest.RandString(5))
-	keyName1 := fmt.Sprintf("tf-%s", sdkacctest.RandString(5))
-	keyName2 := fmt.Sprintf("tf-%s", sdkacctest.RandString(5))
-	ipsetName1 := fmt.Sprintf("tf-%s", sdkacctest.RandString(5))
-	ipsetName2 := fmt.Sprintf("tf-%s", sdkacctest.RandString(5))
+	bucketName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	keyName1 := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	keyName2 := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	ipsetName1 := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	ipsetName2 := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	resourceName := "aws_guardduty_ipset.test"
 
 	resource.Test(t, resource.TestCase{
@@ -60,7 +59,7 @@ func testAccIPSet_basic(t *testing.T) {
 }
 
 func testAccIPSet_tags(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_guardduty_ipset.test"
 
 	resource.Test(t, resource.TestCase{
@@ -19,6 +19,7 @@ import (
 func ResourceMember() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceMemberCreate,
+		//lintignore:AWSR004
 		Read:   resourceMemberRead,
 		Update: resourceMemberUpdate,
 		Delete: resourceMemberDelete,
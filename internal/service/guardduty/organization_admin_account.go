@@ -14,6 +14,7 @@ import (
 func ResourceOrganizationAdminAccount() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceOrganizationAdminAccountCreate,
+		//lintignore:AWSR004
 		Read:   resourceOrganizationAdminAccountRead,
 		Delete: resourceOrganizationAdminAccountDelete,
 
@@ -17,6 +17,7 @@ import (
 func ResourcePublishingDestination() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePublishingDestinationCreate,
+		//lintignore:AWSR004
 		Read:   resourcePublishingDestinationRead,
 		Update: resourcePublishingDestinationUpdate,
 		Delete: resourcePublishingDestinationDelete,
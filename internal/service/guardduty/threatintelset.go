@@ -21,6 +21,7 @@ import (
 func ResourceThreatintelset() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceThreatintelsetCreate,
+		//lintignore:AWSR004
 		Read:   resourceThreatintelsetRead,
 		Update: resourceThreatintelsetUpdate,
 		Delete: resourceThreatintelsetDelete,
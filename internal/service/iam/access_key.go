@@ -18,6 +18,7 @@ import (
 func ResourceAccessKey() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAccessKeyCreate,
+		//lintignore:AWSR004
 		Read:   resourceAccessKeyRead,
 		Update: resourceAccessKeyUpdate,
 		Delete: resourceAccessKeyDelete,
@@ -12,6 +12,7 @@ import (
 func ResourceAccountAlias() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAccountAliasCreate,
+		//lintignore:AWSR004
 		Read:   resourceAccountAliasRead,
 		Delete: resourceAccountAliasDelete,
 
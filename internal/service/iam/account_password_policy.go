@@ -14,6 +14,7 @@ import (
 func ResourceAccountPasswordPolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAccountPasswordPolicyUpdate,
+		//lintignore:AWSR004
 		Read:   resourceAccountPasswordPolicyRead,
 		Update: resourceAccountPasswordPolicyUpdate,
 		Delete: resourceAccountPasswordPolicyDelete,
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/iam"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 func TestAccIAMGroupMembership_basic(t *testing.T) {
 	var group iam.GetGroupOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	groupName := fmt.Sprintf("tf-acc-group-gm-basic-%s", rString)
 	userName := fmt.Sprintf("tf-acc-user-gm-basic-%s", rString)
 	userName2 := fmt.Sprintf("tf-acc-user-gm-basic-two-%s", rString)
@@ -61,7 +60,7 @@ func TestAccIAMGroupMembership_basic(t *testing.T) {
 func TestAccIAMGroupMembership_paginatedUserList(t *testing.T) {
 	var group iam.GetGroupOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	groupName := fmt.Sprintf("tf-acc-group-gm-pul-%s", rString)
 	membershipName := fmt.Sprintf("tf-acc-membership-gm-pul-%s", rString)
 	userNamePrefix := fmt.Sprintf("tf-acc-user-gm-pul-%s-", rString)
@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 func TestAccIAMGroupPolicyAttachment_basic(t *testing.T) {
 	var out iam.ListAttachedGroupPoliciesOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	groupName := fmt.Sprintf("tf-acc-group-gpa-basic-%s", rString)
 	policyName := fmt.Sprintf("tf-acc-policy-gpa-basic-%s", rString)
 	policyName2 := fmt.Sprintf("tf-acc-policy-gpa-basic-2-%s", rString)
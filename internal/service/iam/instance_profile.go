@@ -21,6 +21,7 @@ import (
 func ResourceInstanceProfile() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceInstanceProfileCreate,
+		//lintignore:AWSR004
 		Read:   resourceInstanceProfileRead,
 		Update: resourceInstanceProfileUpdate,
 		Delete: resourceInstanceProfileDelete,
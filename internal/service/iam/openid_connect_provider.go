@@ -18,6 +18,7 @@ import (
 func ResourceOpenIDConnectProvider() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceOpenIDConnectProviderCreate,
+		//lintignore:AWSR004
 		Read:   resourceOpenIDConnectProviderRead,
 		Update: resourceOpenIDConnectProviderUpdate,
 		Delete: resourceOpenIDConnectProviderDelete,
@@ -17,6 +17,7 @@ import (
 func ResourcePolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePolicyAttachmentCreate,
+		//lintignore:AWSR004
 		Read:   resourcePolicyAttachmentRead,
 		Update: resourcePolicyAttachmentUpdate,
 		Delete: resourcePolicyAttachmentDelete,
@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 func TestAccIAMPolicyAttachment_basic(t *testing.T) {
 	var out iam.ListEntitiesForPolicyOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	userName := fmt.Sprintf("tf-acc-user-pa-basic-%s", rString)
 	userName2 := fmt.Sprintf("tf-acc-user-pa-basic-2-%s", rString)
 	userName3 := fmt.Sprintf("tf-acc-user-pa-basic-3-%s", rString)
@@ -60,7 +59,7 @@ func TestAccIAMPolicyAttachment_basic(t *testing.T) {
 func TestAccIAMPolicyAttachment_paginatedEntities(t *testing.T) {
 	var out iam.ListEntitiesForPolicyOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	userNamePrefix := fmt.Sprintf("tf-acc-user-pa-pe-%s-", rString)
 	policyName := fmt.Sprintf("tf-acc-policy-pa-pe-%s-", rString)
 	attachmentName := fmt.Sprintf("tf-acc-attachment-pa-pe-%s-", rString)
@@ -84,7 +83,7 @@ func TestAccIAMPolicyAttachment_paginatedEntities(t *testing.T) {
 func TestAccIAMPolicyAttachment_Groups_renamedGroup(t *testing.T) {
 	var out iam.ListEntitiesForPolicyOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	groupName1 := fmt.Sprintf("%s-1", rName)
 	groupName2 := fmt.Sprintf("%s-2", rName)
 	resourceName := "aws_iam_policy_attachment.test"
@@ -116,7 +115,7 @@ func TestAccIAMPolicyAttachment_Groups_renamedGroup(t *testing.T) {
 func TestAccIAMPolicyAttachment_Roles_renamedRole(t *testing.T) {
 	var out iam.ListEntitiesForPolicyOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	roleName1 := fmt.Sprintf("%s-1", rName)
 	roleName2 := fmt.Sprintf("%s-2", rName)
 	resourceName := "aws_iam_policy_attachment.test"
@@ -148,7 +147,7 @@ func TestAccIAMPolicyAttachment_Roles_renamedRole(t *testing.T) {
 func TestAccIAMPolicyAttachment_Users_renamedUser(t *testing.T) {
 	var out iam.ListEntitiesForPolicyOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	userName1 := fmt.Sprintf("%s-1", rName)
 	userName2 := fmt.Sprintf("%s-2", rName)
 	resourceName := "aws_iam_policy_attachment.test"
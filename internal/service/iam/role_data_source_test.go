@@ -5,13 +5,12 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/iam"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
 func TestAccIAMRoleDataSource_basic(t *testing.T) {
-	roleName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	roleName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	dataSourceName := "data.aws_iam_role.test"
 	resourceName := "aws_iam_role.test"
 
@@ -39,7 +38,7 @@ func TestAccIAMRoleDataSource_basic(t *testing.T) {
 }
 
 func TestAccIAMRoleDataSource_tags(t *testing.T) {
-	roleName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	roleName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	dataSourceName := "data.aws_iam_role.test"
 	resourceName := "aws_iam_role.test"
 
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func TestAccIAMRolePolicyAttachment_basic(t *testing.T) {
 	var out iam.ListAttachedRolePoliciesOutput
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	testPolicy := fmt.Sprintf("tf-acctest-%d", rInt)
 	testPolicy2 := fmt.Sprintf("tf-acctest2-%d", rInt)
 	testPolicy3 := fmt.Sprintf("tf-acctest3-%d", rInt)
@@ -71,7 +70,7 @@ func TestAccIAMRolePolicyAttachment_basic(t *testing.T) {
 func TestAccIAMRolePolicyAttachment_disappears(t *testing.T) {
 	var attachedRolePolicies iam.ListAttachedRolePoliciesOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_iam_role_policy_attachment.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -96,7 +95,7 @@ func TestAccIAMRolePolicyAttachment_Disappears_role(t *testing.T) {
 	var attachedRolePolicies iam.ListAttachedRolePoliciesOutput
 	var role iam.Role
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	iamRoleResourceName := "aws_iam_role.test"
 	resourceName := "aws_iam_role_policy_attachment.test"
 
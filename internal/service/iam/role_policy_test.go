@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/iam"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,9 +18,9 @@ import (
 
 func TestAccIAMRolePolicy_basic(t *testing.T) {
 	var rolePolicy1, rolePolicy2, rolePolicy3 iam.GetRolePolicyOutput
-	role := sdkacctest.RandString(10)
-	policy1 := sdkacctest.RandString(10)
-	policy2 := sdkacctest.RandString(10)
+	role := acctest.RandString(10)
+	policy1 := acctest.RandString(10)
+	policy2 := acctest.RandString(10)
 	resourceName := "aws_iam_role_policy.test"
 	resourceName2 := "aws_iam_role_policy.test2"
 	roleName := "aws_iam_role.test"
@@ -70,7 +69,7 @@ func TestAccIAMRolePolicy_basic(t *testing.T) {
 
 func TestAccIAMRolePolicy_disappears(t *testing.T) {
 	var out iam.GetRolePolicyOutput
-	suffix := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+	suffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 	roleResourceName := fmt.Sprintf("aws_iam_role.role_%s", suffix)
 	rolePolicyResourceName := fmt.Sprintf("aws_iam_role_policy.test_%s", suffix)
 
@@ -98,7 +97,7 @@ func TestAccIAMRolePolicy_disappears(t *testing.T) {
 
 func TestAccIAMRolePolicy_namePrefix(t *testing.T) {
 	var rolePolicy1, rolePolicy2 iam.GetRolePolicyOutput
-	role := sdkacctest.RandString(10)
+	role := acctest.RandString(10)
 	resourceName := "aws_iam_role_policy.test"
 	roleName := "aws_iam_role.test"
 
@@ -143,7 +142,7 @@ func TestAccIAMRolePolicy_namePrefix(t *testing.T) {
 
 func TestAccIAMRolePolicy_generatedName(t *testing.T) {
 	var rolePolicy1, rolePolicy2 iam.GetRolePolicyOutput
-	role := sdkacctest.RandString(10)
+	role := acctest.RandString(10)
 	resourceName := "aws_iam_role_policy.test"
 	roleName := "aws_iam_role.test"
 
@@ -186,7 +185,7 @@ func TestAccIAMRolePolicy_generatedName(t *testing.T) {
 }
 
 func TestAccIAMRolePolicy_invalidJSON(t *testing.T) {
-	role := sdkacctest.RandString(10)
+	role := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -203,7 +202,7 @@ func TestAccIAMRolePolicy_invalidJSON(t *testing.T) {
 }
 
 func TestAccIAMRolePolicy_Policy_invalidResource(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -7,7 +7,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/iam"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
@@ -31,8 +30,8 @@ func TestAccIAMRolesDataSource_basic(t *testing.T) {
 }
 
 func TestAccIAMRolesDataSource_nameRegex(t *testing.T) {
-	rCount := strconv.Itoa(sdkacctest.RandIntRange(1, 4))
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rCount := strconv.Itoa(acctest.RandIntRange(1, 4))
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	dataSourceName := "data.aws_iam_roles.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -52,9 +51,9 @@ func TestAccIAMRolesDataSource_nameRegex(t *testing.T) {
 }
 
 func TestAccIAMRolesDataSource_pathPrefix(t *testing.T) {
-	rCount := strconv.Itoa(sdkacctest.RandIntRange(1, 4))
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rPathPrefix := sdkacctest.RandomWithPrefix("tf-acc-path")
+	rCount := strconv.Itoa(acctest.RandIntRange(1, 4))
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rPathPrefix := acctest.RandomWithPrefix("tf-acc-path")
 	dataSourceName := "data.aws_iam_roles.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -93,9 +92,9 @@ func TestAccIAMRolesDataSource_nonExistentPathPrefix(t *testing.T) {
 }
 
 func TestAccIAMRolesDataSource_nameRegexAndPathPrefix(t *testing.T) {
-	rCount := strconv.Itoa(sdkacctest.RandIntRange(1, 4))
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rPathPrefix := sdkacctest.RandomWithPrefix("tf-acc-path")
+	rCount := strconv.Itoa(acctest.RandIntRange(1, 4))
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rPathPrefix := acctest.RandomWithPrefix("tf-acc-path")
 	dataSourceName := "data.aws_iam_roles.test"
 
 	resource.ParallelTest(t, resource.TestCase{
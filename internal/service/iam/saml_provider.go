@@ -20,6 +20,7 @@ import (
 func ResourceSamlProvider() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSamlProviderCreate,
+		//lintignore:AWSR004
 		Read:   resourceSamlProviderRead,
 		Update: resourceSamlProviderUpdate,
 		Delete: resourceSamlProviderDelete,
@@ -26,6 +26,7 @@ import (
 func ResourceServerCertificate() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceServerCertificateCreate,
+		//lintignore:AWSR004
 		Read:   resourceServerCertificateRead,
 		Update: resourceServerCertificateUpdate,
 		Delete: resourceServerCertificateDelete,
@@ -20,6 +20,7 @@ import (
 func ResourceServiceLinkedRole() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceServiceLinkedRoleCreate,
+		//lintignore:AWSR004
 		Read:   resourceServiceLinkedRoleRead,
 		Update: resourceServiceLinkedRoleUpdate,
 		Delete: resourceServiceLinkedRoleDelete,
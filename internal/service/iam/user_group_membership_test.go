@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 )
 
 func TestAccIAMUserGroupMembership_basic(t *testing.T) {
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	userName1 := fmt.Sprintf("tf-acc-ugm-basic-user1-%s", rString)
 	userName2 := fmt.Sprintf("tf-acc-ugm-basic-user2-%s", rString)
 	groupName1 := fmt.Sprintf("tf-acc-ugm-basic-group1-%s", rString)
@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/iam"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ func TestAccIAMUserSSHKey_basic(t *testing.T) {
 	var conf iam.GetSSHPublicKeyOutput
 	resourceName := "aws_iam_user_ssh_key.user"
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	publicKey, _, err := RandSSHKeyPairSize(2048, acctest.DefaultEmailAddress)
 	if err != nil {
 		t.Fatalf("error generating random SSH key: %s", err)
@@ -49,7 +48,7 @@ func TestAccIAMUserSSHKey_basic(t *testing.T) {
 func TestAccIAMUserSSHKey_pemEncoding(t *testing.T) {
 	var conf iam.GetSSHPublicKeyOutput
 
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	config := fmt.Sprintf(testAccSSHKeyConfig_pemEncoding, ri)
 	resourceName := "aws_iam_user_ssh_key.user"
 
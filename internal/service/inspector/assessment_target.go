@@ -17,6 +17,7 @@ import (
 func ResourceAssessmentTarget() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAssessmentTargetCreate,
+		//lintignore:AWSR004
 		Read:   resourceAssessmentTargetRead,
 		Update: resourceAssessmentTargetUpdate,
 		Delete: resourceAssessmentTargetDelete,
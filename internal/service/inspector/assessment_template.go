@@ -17,6 +17,7 @@ import (
 func ResourceAssessmentTemplate() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAssessmentTemplateCreate,
+		//lintignore:AWSR004
 		Read:   resourceAssessmentTemplateRead,
 		Update: resourceAssessmentTemplateUpdate,
 		Delete: resourceAssessmentTemplateDelete,
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/inspector"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 func TestAccInspectorAssessmentTemplate_basic(t *testing.T) {
 	var v inspector.AssessmentTemplate
 	resourceName := "aws_inspector_assessment_template.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -50,7 +49,7 @@ func TestAccInspectorAssessmentTemplate_basic(t *testing.T) {
 func TestAccInspectorAssessmentTemplate_disappears(t *testing.T) {
 	var v inspector.AssessmentTemplate
 	resourceName := "aws_inspector_assessment_template.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -73,7 +72,7 @@ func TestAccInspectorAssessmentTemplate_disappears(t *testing.T) {
 func TestAccInspectorAssessmentTemplate_tags(t *testing.T) {
 	var v inspector.AssessmentTemplate
 	resourceName := "aws_inspector_assessment_template.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -11,8 +11,10 @@ import (
 )
 
 func ResourceResourceGroup() *schema.Resource {
+	//lintignore:AWSR006 // tags selects the EC2 instances in the group, it doesn't tag the group
 	return &schema.Resource{
 		Create: resourceResourceGroupCreate,
+		//lintignore:AWSR004
 		Read:   resourceResourceGroupRead,
 		Delete: resourceResourceGroupDelete,
 
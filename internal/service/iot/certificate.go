@@ -13,6 +13,7 @@ import (
 func ResourceCertificate() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCertificateCreate,
+		//lintignore:AWSR004
 		Read:   resourceCertificateRead,
 		Update: resourceCertificateUpdate,
 		Delete: resourceCertificateDelete,
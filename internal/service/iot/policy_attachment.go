@@ -14,6 +14,7 @@ import (
 func ResourcePolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePolicyAttachmentCreate,
+		//lintignore:AWSR004
 		Read:   resourcePolicyAttachmentRead,
 		Delete: resourcePolicyAttachmentDelete,
 		Schema: map[string]*schema.Schema{
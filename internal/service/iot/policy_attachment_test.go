@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iot"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,8 +15,8 @@ import (
 )
 
 func TestAccIoTPolicyAttachment_basic(t *testing.T) {
-	policyName := sdkacctest.RandomWithPrefix("PolicyName-")
-	policyName2 := sdkacctest.RandomWithPrefix("PolicyName2-")
+	policyName := acctest.RandomWithPrefix("PolicyName-")
+	policyName2 := acctest.RandomWithPrefix("PolicyName2-")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
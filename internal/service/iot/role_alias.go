@@ -14,6 +14,7 @@ import (
 func ResourceRoleAlias() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRoleAliasCreate,
+		//lintignore:AWSR004
 		Read:   resourceRoleAliasRead,
 		Update: resourceRoleAliasUpdate,
 		Delete: resourceRoleAliasDelete,
@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/iot"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,8 +15,8 @@ import (
 )
 
 func TestAccIoTRoleAlias_basic(t *testing.T) {
-	alias := sdkacctest.RandomWithPrefix("RoleAlias-")
-	alias2 := sdkacctest.RandomWithPrefix("RoleAlias2-")
+	alias := acctest.RandomWithPrefix("RoleAlias-")
+	alias2 := acctest.RandomWithPrefix("RoleAlias2-")
 
 	resourceName := "aws_iot_role_alias.ra"
 	resourceName2 := "aws_iot_role_alias.ra2"
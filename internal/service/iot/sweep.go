@@ -91,7 +91,7 @@ func sweepCertifcates(region string) error {
 
 			d.SetId(aws.StringValue(certificate.CertificateId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_iot_certificate", r, d, client))
 		}
 
 		return !lastPage
@@ -149,7 +149,7 @@ func sweepPolicyAttachments(region string) error {
 					d.Set("policy", policy.PolicyName)
 					d.Set("target", target)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_iot_policy_attachment", r, d, client))
 				}
 
 				return !lastPage
@@ -203,7 +203,7 @@ func sweepPolicies(region string) error {
 
 			d.SetId(aws.StringValue(policy.PolicyName))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_iot_policy", r, d, client))
 		}
 
 		return !lastPage
@@ -249,7 +249,7 @@ func sweepRoleAliases(region string) error {
 
 			d.SetId(aws.StringValue(roleAlias))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_iot_role_alias", r, d, client))
 		}
 
 		return !lastPage
@@ -307,7 +307,7 @@ func sweepThingPrincipalAttachments(region string) error {
 					d.Set("principal", principal)
 					d.Set("thing", thing.ThingName)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_iot_thing_principal_attachment", r, d, client))
 				}
 
 				return !lastPage
@@ -361,7 +361,7 @@ func sweepThings(region string) error {
 
 			d.SetId(aws.StringValue(thing.ThingName))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_iot_thing", r, d, client))
 		}
 
 		return !lastPage
@@ -407,7 +407,7 @@ func sweepThingTypes(region string) error {
 
 			d.SetId(aws.StringValue(thingTypes.ThingTypeName))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_iot_thing_type", r, d, client))
 		}
 
 		return !lastPage
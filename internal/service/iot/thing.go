@@ -15,6 +15,7 @@ import (
 func ResourceThing() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceThingCreate,
+		//lintignore:AWSR004
 		Read:   resourceThingRead,
 		Update: resourceThingUpdate,
 		Delete: resourceThingDelete,
@@ -14,6 +14,7 @@ import (
 func ResourceThingPrincipalAttachment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceThingPrincipalAttachmentCreate,
+		//lintignore:AWSR004
 		Read:   resourceThingPrincipalAttachmentRead,
 		Delete: resourceThingPrincipalAttachmentDelete,
 
@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iot"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,8 +15,8 @@ import (
 )
 
 func TestAccIoTThingPrincipalAttachment_basic(t *testing.T) {
-	thingName := sdkacctest.RandomWithPrefix("tf-acc")
-	thingName2 := sdkacctest.RandomWithPrefix("tf-acc2")
+	thingName := acctest.RandomWithPrefix("tf-acc")
+	thingName2 := acctest.RandomWithPrefix("tf-acc2")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
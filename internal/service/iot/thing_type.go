@@ -19,6 +19,7 @@ import (
 func ResourceThingType() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceThingTypeCreate,
+		//lintignore:AWSR004
 		Read:   resourceThingTypeRead,
 		Update: resourceThingTypeUpdate,
 		Delete: resourceThingTypeDelete,
@@ -19,6 +19,7 @@ import (
 func ResourceTopicRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTopicRuleCreate,
+		//lintignore:AWSR004
 		Read:   resourceTopicRuleRead,
 		Update: resourceTopicRuleUpdate,
 		Delete: resourceTopicRuleDelete,
@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iot"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -14,7 +13,7 @@ import (
 )
 
 func TestAccIoTTopicRule_basic(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 	resourceName := "aws_iot_topic_rule.rule"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -45,7 +44,7 @@ func TestAccIoTTopicRule_basic(t *testing.T) {
 }
 
 func TestAccIoTTopicRule_cloudWatchAlarm(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 	resourceName := "aws_iot_topic_rule.rule"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -70,7 +69,7 @@ func TestAccIoTTopicRule_cloudWatchAlarm(t *testing.T) {
 }
 
 func TestAccIoTTopicRule_cloudWatchMetric(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 	resourceName := "aws_iot_topic_rule.rule"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -95,7 +94,7 @@ func TestAccIoTTopicRule_cloudWatchMetric(t *testing.T) {
 }
 
 func TestAccIoTTopicRule_dynamoDB(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 	resourceName := "aws_iot_topic_rule.rule"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -126,7 +125,7 @@ func TestAccIoTTopicRule_dynamoDB(t *testing.T) {
 }
 
 func TestAccIoTTopicRule_dynamoDBv2(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -145,7 +144,7 @@ func TestAccIoTTopicRule_dynamoDBv2(t *testing.T) {
 }
 
 func TestAccIoTTopicRule_elasticSearch(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 	resourceName := "aws_iot_topic_rule.rule"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -170,7 +169,7 @@ func TestAccIoTTopicRule_elasticSearch(t *testing.T) {
 }
 
 func TestAccIoTTopicRule_firehose(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 	resourceName := "aws_iot_topic_rule.rule"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -195,7 +194,7 @@ func TestAccIoTTopicRule_firehose(t *testing.T) {
 }
 
 func TestAccIoTTopicRule_Firehose_separator(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 	resourceName := "aws_iot_topic_rule.rule"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -226,7 +225,7 @@ func TestAccIoTTopicRule_Firehose_separator(t *testing.T) {
 }
 
 func TestAccIoTTopicRule_kinesis(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 	resourceName := "aws_iot_topic_rule.rule"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -251,7 +250,7 @@ func TestAccIoTTopicRule_kinesis(t *testing.T) {
 }
 
 func TestAccIoTTopicRule_lambda(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 	resourceName := "aws_iot_topic_rule.rule"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -276,7 +275,7 @@ func TestAccIoTTopicRule_lambda(t *testing.T) {
 }
 
 func TestAccIoTTopicRule_republish(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 	resourceName := "aws_iot_topic_rule.rule"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -301,7 +300,7 @@ func TestAccIoTTopicRule_republish(t *testing.T) {
 }
 
 func TestAccIoTTopicRule_republishWithQos(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 	resourceName := "aws_iot_topic_rule.rule"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -326,7 +325,7 @@ func TestAccIoTTopicRule_republishWithQos(t *testing.T) {
 }
 
 func TestAccIoTTopicRule_s3(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 	resourceName := "aws_iot_topic_rule.rule"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -351,7 +350,7 @@ func TestAccIoTTopicRule_s3(t *testing.T) {
 }
 
 func TestAccIoTTopicRule_sns(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 	resourceName := "aws_iot_topic_rule.rule"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -376,7 +375,7 @@ func TestAccIoTTopicRule_sns(t *testing.T) {
 }
 
 func TestAccIoTTopicRule_sqs(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 	resourceName := "aws_iot_topic_rule.rule"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -401,7 +400,7 @@ func TestAccIoTTopicRule_sqs(t *testing.T) {
 }
 
 func TestAccIoTTopicRule_Step_functions(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 	resourceName := "aws_iot_topic_rule.rule"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -426,7 +425,7 @@ func TestAccIoTTopicRule_Step_functions(t *testing.T) {
 }
 
 func TestAccIoTTopicRule_IoT_analytics(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -445,7 +444,7 @@ func TestAccIoTTopicRule_IoT_analytics(t *testing.T) {
 }
 
 func TestAccIoTTopicRule_IoT_events(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -464,7 +463,7 @@ func TestAccIoTTopicRule_IoT_events(t *testing.T) {
 }
 
 func TestAccIoTTopicRule_tags(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 	resourceName := "aws_iot_topic_rule.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -508,7 +507,7 @@ func TestAccIoTTopicRule_tags(t *testing.T) {
 }
 
 func TestAccIoTTopicRule_errorAction(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 	resourceName := "aws_iot_topic_rule.rule"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -534,7 +533,7 @@ func TestAccIoTTopicRule_errorAction(t *testing.T) {
 
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/16115
 func TestAccIoTTopicRule_updateKinesisErrorAction(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 	resourceName := "aws_iot_topic_rule.rule"
 
 	resource.ParallelTest(t, resource.TestCase{
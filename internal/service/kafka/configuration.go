@@ -15,6 +15,7 @@ import (
 func ResourceConfiguration() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceConfigurationCreate,
+		//lintignore:AWSR004
 		Read:   resourceConfigurationRead,
 		Update: resourceConfigurationUpdate,
 		Delete: resourceConfigurationDelete,
@@ -25,6 +25,7 @@ const (
 func ResourceStream() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceStreamCreate,
+		//lintignore:AWSR004
 		Read:   resourceStreamRead,
 		Update: resourceStreamUpdate,
 		Delete: resourceStreamDelete,
@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/kinesis"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 func TestAccKinesisStreamConsumer_basic(t *testing.T) {
 	resourceName := "aws_kinesis_stream_consumer.test"
 	streamName := "aws_kinesis_stream.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -47,7 +46,7 @@ func TestAccKinesisStreamConsumer_basic(t *testing.T) {
 
 func TestAccKinesisStreamConsumer_disappears(t *testing.T) {
 	resourceName := "aws_kinesis_stream_consumer.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -69,7 +68,7 @@ func TestAccKinesisStreamConsumer_disappears(t *testing.T) {
 
 func TestAccKinesisStreamConsumer_maxConcurrentConsumers(t *testing.T) {
 	resourceName := "aws_kinesis_stream_consumer.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -94,7 +93,7 @@ func TestAccKinesisStreamConsumer_maxConcurrentConsumers(t *testing.T) {
 
 func TestAccKinesisStreamConsumer_exceedMaxConcurrentConsumers(t *testing.T) {
 	resourceName := "aws_kinesis_stream_consumer.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
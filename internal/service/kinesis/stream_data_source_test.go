@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kinesis"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -17,7 +16,7 @@ import (
 func TestAccKinesisStreamDataSource_basic(t *testing.T) {
 	var stream kinesis.StreamDescription
 
-	sn := fmt.Sprintf("terraform-kinesis-test-%d", sdkacctest.RandInt())
+	sn := fmt.Sprintf("terraform-kinesis-test-%d", acctest.RandInt())
 	config := fmt.Sprintf(testAccCheckStreamDataSourceConfig, sn)
 
 	updateShardCount := func() {
@@ -9,7 +9,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kinesis"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -20,7 +19,7 @@ import (
 func TestAccKinesisStream_basic(t *testing.T) {
 	var stream kinesis.StreamDescription
 	resourceName := "aws_kinesis_stream.test"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	streamName := fmt.Sprintf("terraform-kinesis-test-%d", rInt)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -50,7 +49,7 @@ func TestAccKinesisStream_basic(t *testing.T) {
 func TestAccKinesisStream_createMultipleConcurrentStreams(t *testing.T) {
 	var stream kinesis.StreamDescription
 	resourceName := "aws_kinesis_stream.test"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	streamName := fmt.Sprintf("terraform-kinesis-test-%d-0", rInt) // We can get away with just import testing one of them
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -96,7 +95,7 @@ func TestAccKinesisStream_createMultipleConcurrentStreams(t *testing.T) {
 }
 
 func TestAccKinesisStream_encryptionWithoutKMSKeyThrowsError(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -114,7 +113,7 @@ func TestAccKinesisStream_encryptionWithoutKMSKeyThrowsError(t *testing.T) {
 
 func TestAccKinesisStream_encryption(t *testing.T) {
 	var stream kinesis.StreamDescription
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_kinesis_stream.test"
 	streamName := fmt.Sprintf("terraform-kinesis-test-%d", rInt)
 
@@ -172,7 +171,7 @@ func TestAccKinesisStream_shardCount(t *testing.T) {
 		}
 	}
 
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_kinesis_stream.test"
 	streamName := fmt.Sprintf("terraform-kinesis-test-%d", rInt)
 
@@ -215,7 +214,7 @@ func TestAccKinesisStream_shardCount(t *testing.T) {
 func TestAccKinesisStream_retentionPeriod(t *testing.T) {
 	var stream kinesis.StreamDescription
 	resourceName := "aws_kinesis_stream.test"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	streamName := fmt.Sprintf("terraform-kinesis-test-%d", rInt)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -266,7 +265,7 @@ func TestAccKinesisStream_retentionPeriod(t *testing.T) {
 func TestAccKinesisStream_shardLevelMetrics(t *testing.T) {
 	var stream kinesis.StreamDescription
 	resourceName := "aws_kinesis_stream.test"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	streamName := fmt.Sprintf("terraform-kinesis-test-%d", rInt)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -317,7 +316,7 @@ func TestAccKinesisStream_shardLevelMetrics(t *testing.T) {
 func TestAccKinesisStream_enforceConsumerDeletion(t *testing.T) {
 	var stream kinesis.StreamDescription
 	resourceName := "aws_kinesis_stream.test"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	streamName := fmt.Sprintf("terraform-kinesis-test-%d", rInt)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -347,7 +346,7 @@ func TestAccKinesisStream_enforceConsumerDeletion(t *testing.T) {
 
 func TestAccKinesisStream_tags(t *testing.T) {
 	var stream kinesis.StreamDescription
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_kinesis_stream.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -501,7 +500,7 @@ func testAccCheckKinesisStreamTags(n string, tagCount int) resource.TestCheckFun
 
 func TestAccKinesisStream_updateKMSKeyID(t *testing.T) {
 	var stream kinesis.StreamDescription
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_kinesis_stream.test"
 
 	resource.ParallelTest(t, resource.TestCase{
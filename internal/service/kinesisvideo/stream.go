@@ -20,6 +20,7 @@ import (
 func ResourceStream() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceStreamCreate,
+		//lintignore:AWSR004
 		Read:   resourceStreamRead,
 		Update: resourceStreamUpdate,
 		Delete: resourceStreamDelete,
@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kinesisvideo"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -20,8 +19,8 @@ func TestAccKinesisVideoStream_basic(t *testing.T) {
 	var stream kinesisvideo.StreamInfo
 
 	resourceName := "aws_kinesis_video_stream.default"
-	rInt1 := sdkacctest.RandInt()
-	rInt2 := sdkacctest.RandInt()
+	rInt1 := acctest.RandInt()
+	rInt2 := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kinesisvideo.EndpointsID, t) },
@@ -61,9 +60,9 @@ func TestAccKinesisVideoStream_options(t *testing.T) {
 
 	resourceName := "aws_kinesis_video_stream.default"
 	kmsResourceName := "aws_kms_key.default"
-	rInt := sdkacctest.RandInt()
-	rName1 := sdkacctest.RandString(8)
-	rName2 := sdkacctest.RandString(8)
+	rInt := acctest.RandInt()
+	rName1 := acctest.RandString(8)
+	rName2 := acctest.RandString(8)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kinesisvideo.EndpointsID, t) },
@@ -105,7 +104,7 @@ func TestAccKinesisVideoStream_tags(t *testing.T) {
 	var stream kinesisvideo.StreamInfo
 
 	resourceName := "aws_kinesis_video_stream.default"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kinesisvideo.EndpointsID, t) },
@@ -151,7 +150,7 @@ func TestAccKinesisVideoStream_disappears(t *testing.T) {
 	var stream kinesisvideo.StreamInfo
 
 	resourceName := "aws_kinesis_video_stream.default"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kinesisvideo.EndpointsID, t) },
@@ -26,6 +26,7 @@ func ResourceGrant() *schema.Resource {
 		// There is no API for updating/modifying grants, hence no Update
 		// Instead changes to most fields will force a new resource
 		Create: resourceGrantCreate,
+		//lintignore:AWSR004
 		Read:   resourceGrantRead,
 		Delete: resourceGrantDelete,
 		Importer: &schema.ResourceImporter{
@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 
 func TestAccKMSGrant_basic(t *testing.T) {
 	resourceName := "aws_kms_grant.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -48,7 +47,7 @@ func TestAccKMSGrant_basic(t *testing.T) {
 
 func TestAccKMSGrant_withConstraints(t *testing.T) {
 	resourceName := "aws_kms_grant.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -96,7 +95,7 @@ func TestAccKMSGrant_withConstraints(t *testing.T) {
 
 func TestAccKMSGrant_withRetiringPrincipal(t *testing.T) {
 	resourceName := "aws_kms_grant.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -123,7 +122,7 @@ func TestAccKMSGrant_withRetiringPrincipal(t *testing.T) {
 
 func TestAccKMSGrant_bare(t *testing.T) {
 	resourceName := "aws_kms_grant.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -152,7 +151,7 @@ func TestAccKMSGrant_bare(t *testing.T) {
 
 func TestAccKMSGrant_arn(t *testing.T) {
 	resourceName := "aws_kms_grant.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -184,7 +183,7 @@ func TestAccKMSGrant_arn(t *testing.T) {
 
 func TestAccKMSGrant_asymmetricKey(t *testing.T) {
 	resourceName := "aws_kms_grant.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -210,7 +209,7 @@ func TestAccKMSGrant_asymmetricKey(t *testing.T) {
 
 func TestAccKMSGrant_disappears(t *testing.T) {
 	resourceName := "aws_kms_grant.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
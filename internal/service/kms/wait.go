@@ -10,7 +10,7 @@ import (
 	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
-	awspolicy "github.com/jen20/awspolicyequivalence"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 const (
@@ -103,7 +103,7 @@ func WaitKeyPolicyPropagated(conn *kms.KMS, id, policy string) error {
 			return false, err
 		}
 
-		equivalent, err := awspolicy.PoliciesAreEquivalent(aws.StringValue(output), policy)
+		equivalent, err := verify.PolicyStringsEquivalent(aws.StringValue(output), policy)
 
 		if err != nil {
 			return false, err
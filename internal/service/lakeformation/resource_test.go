@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lakeformation"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,8 +15,8 @@ import (
 )
 
 func TestAccLakeFormationResource_basic(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	roleName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	bucketName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	roleName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceAddr := "aws_lakeformation_resource.test"
 	bucketAddr := "aws_s3_bucket.test"
 	roleAddr := "aws_iam_role.test"
@@ -41,7 +40,7 @@ func TestAccLakeFormationResource_basic(t *testing.T) {
 }
 
 func TestAccLakeFormationResource_disappears(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_lakeformation_resource.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -63,7 +62,7 @@ func TestAccLakeFormationResource_disappears(t *testing.T) {
 }
 
 func TestAccLakeFormationResource_serviceLinkedRole(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceAddr := "aws_lakeformation_resource.test"
 	bucketAddr := "aws_s3_bucket.test"
 
@@ -90,9 +89,9 @@ func TestAccLakeFormationResource_serviceLinkedRole(t *testing.T) {
 }
 
 func TestAccLakeFormationResource_updateRoleToRole(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	roleName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	roleName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	bucketName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	roleName1 := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	roleName2 := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceAddr := "aws_lakeformation_resource.test"
 	bucketAddr := "aws_s3_bucket.test"
 	roleAddr := "aws_iam_role.test"
@@ -124,8 +123,8 @@ func TestAccLakeFormationResource_updateRoleToRole(t *testing.T) {
 }
 
 func TestAccLakeFormationResource_updateSLRToRole(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	roleName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	bucketName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	roleName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceAddr := "aws_lakeformation_resource.test"
 	bucketAddr := "aws_s3_bucket.test"
 	roleAddr := "aws_iam_role.test"
@@ -15,6 +15,7 @@ import (
 func ResourceAlias() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAliasCreate,
+		//lintignore:AWSR004
 		Read:   resourceAliasRead,
 		Update: resourceAliasUpdate,
 		Delete: resourceAliasDelete,
@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lambda"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ func TestAccLambdaAlias_basic(t *testing.T) {
 	var conf lambda.AliasConfiguration
 	resourceName := "aws_lambda_alias.test"
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_alias_basic_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_alias_basic_%s", rString)
 	attachmentName := fmt.Sprintf("tf_acc_attachment_%s", rString)
@@ -60,7 +59,7 @@ func TestAccLambdaAlias_FunctionName_name(t *testing.T) {
 	var conf lambda.AliasConfiguration
 
 	resourceName := "aws_lambda_alias.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -92,13 +91,13 @@ func TestAccLambdaAlias_nameUpdate(t *testing.T) {
 	var conf lambda.AliasConfiguration
 	resourceName := "aws_lambda_alias.test"
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_alias_basic_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_alias_basic_%s", rString)
 	attachmentName := fmt.Sprintf("tf_acc_attachment_%s", rString)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_alias_basic_%s", rString)
 	aliasName := fmt.Sprintf("tf_acc_lambda_alias_basic_%s", rString)
-	aliasNameUpdate := fmt.Sprintf("tf_acc_lambda_alias_basic_%s", sdkacctest.RandString(8))
+	aliasNameUpdate := fmt.Sprintf("tf_acc_lambda_alias_basic_%s", acctest.RandString(8))
 
 	functionArnResourcePart := fmt.Sprintf("function:%s:%s", funcName, aliasName)
 	functionArnResourcePartUpdate := fmt.Sprintf("function:%s:%s", funcName, aliasNameUpdate)
@@ -139,7 +138,7 @@ func TestAccLambdaAlias_routing(t *testing.T) {
 	var conf lambda.AliasConfiguration
 	resourceName := "aws_lambda_alias.test"
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_alias_basic_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_alias_basic_%s", rString)
 	attachmentName := fmt.Sprintf("tf_acc_attachment_%s", rString)
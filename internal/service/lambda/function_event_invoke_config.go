@@ -21,6 +21,7 @@ import (
 func ResourceFunctionEventInvokeConfig() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceFunctionEventInvokeConfigCreate,
+		//lintignore:AWSR004
 		Read:   resourceFunctionEventInvokeConfigRead,
 		Update: resourceFunctionEventInvokeConfigUpdate,
 		Delete: resourceFunctionEventInvokeConfigDelete,
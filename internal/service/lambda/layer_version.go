@@ -22,6 +22,7 @@ const awsMutexLambdaLayerKey = `aws_lambda_layer_version`
 func ResourceLayerVersion() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceLayerVersionPublish,
+		//lintignore:AWSR004
 		Read:   resourceLayerVersionRead,
 		Delete: resourceLayerVersionDelete,
 
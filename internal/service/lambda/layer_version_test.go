@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func TestAccLambdaLayerVersion_basic(t *testing.T) {
 	resourceName := "aws_lambda_layer_version.lambda_layer_test"
-	layerName := fmt.Sprintf("tf_acc_lambda_layer_basic_%s", sdkacctest.RandString(8))
+	layerName := fmt.Sprintf("tf_acc_lambda_layer_basic_%s", acctest.RandString(8))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -54,7 +53,7 @@ func TestAccLambdaLayerVersion_basic(t *testing.T) {
 
 func TestAccLambdaLayerVersion_update(t *testing.T) {
 	resourceName := "aws_lambda_layer_version.lambda_layer_test"
-	layerName := fmt.Sprintf("tf_acc_lambda_layer_basic_%s", sdkacctest.RandString(8))
+	layerName := fmt.Sprintf("tf_acc_lambda_layer_basic_%s", acctest.RandString(8))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -84,7 +83,7 @@ func TestAccLambdaLayerVersion_update(t *testing.T) {
 
 func TestAccLambdaLayerVersion_s3(t *testing.T) {
 	resourceName := "aws_lambda_layer_version.lambda_layer_test"
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	layerName := fmt.Sprintf("tf_acc_lambda_layer_s3_%s", rString)
 	bucketName := fmt.Sprintf("tf-acc-bucket-lambda-layer-s3-%s", rString)
 
@@ -111,7 +110,7 @@ func TestAccLambdaLayerVersion_s3(t *testing.T) {
 
 func TestAccLambdaLayerVersion_compatibleRuntimes(t *testing.T) {
 	resourceName := "aws_lambda_layer_version.lambda_layer_test"
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	layerName := fmt.Sprintf("tf_acc_lambda_layer_runtimes_%s", rString)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -140,7 +139,7 @@ func TestAccLambdaLayerVersion_compatibleRuntimes(t *testing.T) {
 
 func TestAccLambdaLayerVersion_compatibleArchitectures(t *testing.T) {
 	resourceName := "aws_lambda_layer_version.lambda_layer_test"
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	layerName := fmt.Sprintf("tf_acc_lambda_layer_architectures_%s", rString)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -191,7 +190,7 @@ func TestAccLambdaLayerVersion_compatibleArchitectures(t *testing.T) {
 
 func TestAccLambdaLayerVersion_description(t *testing.T) {
 	resourceName := "aws_lambda_layer_version.lambda_layer_test"
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	layerName := fmt.Sprintf("tf_acc_lambda_layer_description_%s", rString)
 	testDescription := "test description"
 
@@ -221,7 +220,7 @@ func TestAccLambdaLayerVersion_description(t *testing.T) {
 
 func TestAccLambdaLayerVersion_licenseInfo(t *testing.T) {
 	resourceName := "aws_lambda_layer_version.lambda_layer_test"
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	layerName := fmt.Sprintf("tf_acc_lambda_layer_license_info_%s", rString)
 	testLicenseInfo := "MIT"
 
@@ -1,6 +1,7 @@
 package lambda
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -24,6 +25,7 @@ var LambdaFunctionRegexp = `^(arn:[\w-]+:lambda:)?([a-z]{2}-(?:[a-z]+-){1,2}\d{1
 func ResourcePermission() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePermissionCreate,
+		//lintignore:AWSR004
 		Read:   resourcePermissionRead,
 		Delete: resourcePermissionDelete,
 		Importer: &schema.ResourceImporter{
@@ -91,6 +93,12 @@ func ResourcePermission() *schema.Resource {
 	}
 }
 
+// permissionIdentifier returns the identifier of the permission recorded as the holder of the lock
+// on its function, e.g. `aws_lambda_permission["my-function/AllowExecutionFromSNS"]`.
+func permissionIdentifier(functionName, statementID string) string {
+	return conns.ResourceIdentifier("aws_lambda_permission", fmt.Sprintf("%s/%s", functionName, statementID))
+}
+
 func resourcePermissionCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).LambdaConn
 
@@ -108,7 +116,12 @@ func resourcePermissionCreate(d *schema.ResourceData, meta interface{}) error {
 	// There is a bug in the API (reported and acknowledged by AWS)
 	// which causes some permissions to be ignored when API calls are sent in parallel
 	// We work around this bug via mutex
-	conns.GlobalMutexKV.Lock(functionName)
+	ctx, cancel := context.WithTimeout(conns.NewResourceContext(context.Background(), permissionIdentifier(functionName, statementId)), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	if err := conns.GlobalMutexKV.LockContext(ctx, functionName); err != nil {
+		return fmt.Errorf("error locking Lambda Function (%s): %w", functionName, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(functionName)
 
 	input := lambda.AddPermissionInput{
@@ -318,7 +331,12 @@ func resourcePermissionDelete(d *schema.ResourceData, meta interface{}) error {
 	// There is a bug in the API (reported and acknowledged by AWS)
 	// which causes some permissions to be ignored when API calls are sent in parallel
 	// We work around this bug via mutex
-	conns.GlobalMutexKV.Lock(functionName)
+	ctx, cancel := context.WithTimeout(conns.NewResourceContext(context.Background(), permissionIdentifier(functionName, d.Id())), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	if err := conns.GlobalMutexKV.LockContext(ctx, functionName); err != nil {
+		return fmt.Errorf("error locking Lambda Function (%s): %w", functionName, err)
+	}
 	defer conns.GlobalMutexKV.Unlock(functionName)
 
 	input := lambda.RemovePermissionInput{
@@ -12,7 +12,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/lambda"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -172,7 +171,7 @@ func TestLambdaPermissionGetFunctionNameFromGovCloudLambdaArn(t *testing.T) {
 func TestAccLambdaPermission_basic(t *testing.T) {
 	var statement tflambda.PolicyStatement
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	funcName := fmt.Sprintf("tf_acc_lambda_perm_basic_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_perm_basic_%s", rString)
 
@@ -208,7 +207,7 @@ func TestAccLambdaPermission_basic(t *testing.T) {
 }
 
 func TestAccLambdaPermission_StatementID_duplicate(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -227,7 +226,7 @@ func TestAccLambdaPermission_StatementID_duplicate(t *testing.T) {
 func TestAccLambdaPermission_withRawFunctionName(t *testing.T) {
 	var statement tflambda.PolicyStatement
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	funcName := fmt.Sprintf("tf_acc_lambda_perm_w_raw_fname_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_perm_w_raw_fname_%s", rString)
 
@@ -263,7 +262,7 @@ func TestAccLambdaPermission_withRawFunctionName(t *testing.T) {
 func TestAccLambdaPermission_withStatementIdPrefix(t *testing.T) {
 	var statement tflambda.PolicyStatement
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	startsWithPrefix := regexp.MustCompile("^AllowExecutionWithStatementIdPrefix-")
 
 	resourceName := "aws_lambda_permission.with_statement_id_prefix"
@@ -299,7 +298,7 @@ func TestAccLambdaPermission_withStatementIdPrefix(t *testing.T) {
 func TestAccLambdaPermission_withQualifier(t *testing.T) {
 	var statement tflambda.PolicyStatement
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	aliasName := fmt.Sprintf("tf_acc_lambda_perm_alias_w_qualifier_%s", rString)
 	funcName := fmt.Sprintf("tf_acc_lambda_perm_w_qualifier_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_perm_w_qualifier_%s", rString)
@@ -335,7 +334,7 @@ func TestAccLambdaPermission_withQualifier(t *testing.T) {
 }
 
 func TestAccLambdaPermission_disappears(t *testing.T) {
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	funcName := fmt.Sprintf("tf_acc_lambda_perm_multi_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_perm_multi_%s", rString)
 
@@ -367,7 +366,7 @@ func TestAccLambdaPermission_multiplePerms(t *testing.T) {
 	var secondStatementModified tflambda.PolicyStatement
 	var thirdStatement tflambda.PolicyStatement
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	funcName := fmt.Sprintf("tf_acc_lambda_perm_multi_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_perm_multi_%s", rString)
 
@@ -442,7 +441,7 @@ func TestAccLambdaPermission_multiplePerms(t *testing.T) {
 func TestAccLambdaPermission_withS3(t *testing.T) {
 	var statement tflambda.PolicyStatement
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	bucketName := fmt.Sprintf("tf-acc-bucket-lambda-perm-w-s3-%s", rString)
 	funcName := fmt.Sprintf("tf_acc_lambda_perm_w_s3_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_perm_w_s3_%s", rString)
@@ -481,7 +480,7 @@ func TestAccLambdaPermission_withS3(t *testing.T) {
 func TestAccLambdaPermission_withSNS(t *testing.T) {
 	var statement tflambda.PolicyStatement
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	topicName := fmt.Sprintf("tf_acc_topic_lambda_perm_w_sns_%s", rString)
 	funcName := fmt.Sprintf("tf_acc_lambda_perm_w_sns_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_perm_w_sns_%s", rString)
@@ -520,7 +519,7 @@ func TestAccLambdaPermission_withSNS(t *testing.T) {
 func TestAccLambdaPermission_withIAMRole(t *testing.T) {
 	var statement tflambda.PolicyStatement
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	funcName := fmt.Sprintf("tf_acc_lambda_perm_w_iam_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_perm_w_iam_%s", rString)
 
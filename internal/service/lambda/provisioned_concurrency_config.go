@@ -18,6 +18,7 @@ import (
 func ResourceProvisionedConcurrencyConfig() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceProvisionedConcurrencyConfigCreate,
+		//lintignore:AWSR004
 		Read:   resourceProvisionedConcurrencyConfigRead,
 		Update: resourceProvisionedConcurrencyConfigUpdate,
 		Delete: resourceProvisionedConcurrencyConfigDelete,
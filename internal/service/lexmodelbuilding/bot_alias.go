@@ -28,6 +28,7 @@ const (
 func ResourceBotAlias() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBotAliasCreate,
+		//lintignore:AWSR004
 		Read:   resourceBotAliasRead,
 		Update: resourceBotAliasUpdate,
 		Delete: resourceBotAliasDelete,
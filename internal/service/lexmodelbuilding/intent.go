@@ -29,6 +29,7 @@ const (
 func ResourceIntent() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceIntentCreate,
+		//lintignore:AWSR004
 		Read:   resourceIntentRead,
 		Update: resourceIntentUpdate,
 		Delete: resourceIntentDelete,
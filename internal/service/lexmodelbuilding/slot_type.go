@@ -28,6 +28,7 @@ const (
 func ResourceSlotType() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSlotTypeCreate,
+		//lintignore:AWSR004
 		Read:   resourceSlotTypeRead,
 		Update: resourceSlotTypeUpdate,
 		Delete: resourceSlotTypeDelete,
@@ -15,6 +15,7 @@ import (
 func ResourceAssociation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAssociationCreate,
+		//lintignore:AWSR004
 		Read:   resourceAssociationRead,
 		Delete: resourceAssociationDelete,
 		Importer: &schema.ResourceImporter{
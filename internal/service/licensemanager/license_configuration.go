@@ -19,6 +19,7 @@ import (
 func ResourceLicenseConfiguration() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceLicenseConfigurationCreate,
+		//lintignore:AWSR004
 		Read:   resourceLicenseConfigurationRead,
 		Update: resourceLicenseConfigurationUpdate,
 		Delete: resourceLicenseConfigurationDelete,
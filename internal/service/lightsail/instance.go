@@ -20,6 +20,7 @@ import (
 func ResourceInstance() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceInstanceCreate,
+		//lintignore:AWSR004
 		Read:   resourceInstanceRead,
 		Update: resourceInstanceUpdate,
 		Delete: resourceInstanceDelete,
@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/lightsail"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,7 +18,7 @@ import (
 
 func TestAccLightsailInstance_basic(t *testing.T) {
 	var conf lightsail.Instance
-	lightsailName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	lightsailName := fmt.Sprintf("tf-test-lightsail-%d", acctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -51,7 +50,7 @@ func TestAccLightsailInstance_basic(t *testing.T) {
 
 func TestAccLightsailInstance_name(t *testing.T) {
 	var conf lightsail.Instance
-	lightsailName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	lightsailName := fmt.Sprintf("tf-test-lightsail-%d", acctest.RandInt())
 	lightsailNameWithSpaces := fmt.Sprint(lightsailName, "string with spaces")
 	lightsailNameWithStartingDigit := fmt.Sprintf("01-%s", lightsailName)
 	lightsailNameWithUnderscore := fmt.Sprintf("%s_123456", lightsailName)
@@ -100,7 +99,7 @@ func TestAccLightsailInstance_name(t *testing.T) {
 
 func TestAccLightsailInstance_tags(t *testing.T) {
 	var conf lightsail.Instance
-	lightsailName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	lightsailName := fmt.Sprintf("tf-test-lightsail-%d", acctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -140,7 +139,7 @@ func TestAccLightsailInstance_tags(t *testing.T) {
 
 func TestAccLightsailInstance_disappears(t *testing.T) {
 	var conf lightsail.Instance
-	lightsailName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	lightsailName := fmt.Sprintf("tf-test-lightsail-%d", acctest.RandInt())
 
 	testDestroy := func(*terraform.State) error {
 		// reach out and DELETE the Instance
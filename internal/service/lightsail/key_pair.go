@@ -17,6 +17,7 @@ import (
 func ResourceKeyPair() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceKeyPairCreate,
+		//lintignore:AWSR004
 		Read:   resourceKeyPairRead,
 		Delete: resourceKeyPairDelete,
 
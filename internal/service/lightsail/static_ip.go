@@ -13,6 +13,7 @@ import (
 func ResourceStaticIP() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceStaticIPCreate,
+		//lintignore:AWSR004
 		Read:   resourceStaticIPRead,
 		Delete: resourceStaticIPDelete,
 
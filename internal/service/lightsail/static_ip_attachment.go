@@ -13,6 +13,7 @@ import (
 func ResourceStaticIPAttachment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceStaticIPAttachmentCreate,
+		//lintignore:AWSR004
 		Read:   resourceStaticIPAttachmentRead,
 		Delete: resourceStaticIPAttachmentDelete,
 
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/lightsail"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,9 +16,9 @@ import (
 
 func TestAccLightsailStaticIPAttachment_basic(t *testing.T) {
 	var staticIp lightsail.StaticIp
-	staticIpName := fmt.Sprintf("tf-test-lightsail-%s", sdkacctest.RandString(5))
-	instanceName := fmt.Sprintf("tf-test-lightsail-%s", sdkacctest.RandString(5))
-	keypairName := fmt.Sprintf("tf-test-lightsail-%s", sdkacctest.RandString(5))
+	staticIpName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
+	instanceName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
+	keypairName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
@@ -40,9 +39,9 @@ func TestAccLightsailStaticIPAttachment_basic(t *testing.T) {
 
 func TestAccLightsailStaticIPAttachment_disappears(t *testing.T) {
 	var staticIp lightsail.StaticIp
-	staticIpName := fmt.Sprintf("tf-test-lightsail-%s", sdkacctest.RandString(5))
-	instanceName := fmt.Sprintf("tf-test-lightsail-%s", sdkacctest.RandString(5))
-	keypairName := fmt.Sprintf("tf-test-lightsail-%s", sdkacctest.RandString(5))
+	staticIpName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
+	instanceName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
+	keypairName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
 
 	staticIpDestroy := func(*terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
@@ -15,6 +15,7 @@ import (
 func ResourceMemberAccountAssociation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceMemberAccountAssociationCreate,
+		//lintignore:AWSR004
 		Read:   resourceMemberAccountAssociationRead,
 		Delete: resourceMemberAccountAssociationDelete,
 
@@ -16,6 +16,7 @@ import (
 func ResourceS3BucketAssociation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceS3BucketAssociationCreate,
+		//lintignore:AWSR004
 		Read:   resourceS3BucketAssociationRead,
 		Update: resourceS3BucketAssociationUpdate,
 		Delete: resourceS3BucketAssociationDelete,
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func ResourceClassificationJob() *schema.Resource {
@@ -29,6 +30,7 @@ func ResourceClassificationJob() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: verify.SetTagsDiff,
 		Schema: map[string]*schema.Schema{
 			"custom_data_identifier_ids": {
 				Type:     schema.TypeList,
@@ -508,9 +510,19 @@ func resourceMacie2ClassificationJobUpdate(ctx context.Context, d *schema.Resour
 		input.JobStatus = aws.String(status)
 	}
 
-	_, err := conn.UpdateClassificationJobWithContext(ctx, input)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error updating Macie ClassificationJob (%s): %w", d.Id(), err))
+	if d.HasChangesExcept("tags", "tags_all") {
+		_, err := conn.UpdateClassificationJobWithContext(ctx, input)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Macie ClassificationJob (%s): %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := tftags.UpdateResourceTags(meta.(*conns.AWSClient).ResourceGroupsTaggingConn, d.Get("job_arn").(string), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Macie ClassificationJob (%s) tags: %w", d.Id(), err))
+		}
 	}
 
 	return resourceMacie2ClassificationJobRead(ctx, d, meta)
@@ -30,6 +30,7 @@ func ResourceFindingsFilter() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: verify.SetTagsDiff,
 		Schema: map[string]*schema.Schema{
 			"finding_criteria": {
 				Type:     schema.TypeList,
@@ -256,9 +257,19 @@ func resourceMacie2FindingsFilterUpdate(ctx context.Context, d *schema.ResourceD
 		input.Position = aws.Int64(int64(d.Get("position").(int)))
 	}
 
-	_, err = conn.UpdateFindingsFilterWithContext(ctx, input)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error updating Macie FindingsFilter (%s): %w", d.Id(), err))
+	if d.HasChangesExcept("tags", "tags_all") {
+		_, err = conn.UpdateFindingsFilterWithContext(ctx, input)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Macie FindingsFilter (%s): %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := tftags.UpdateResourceTags(meta.(*conns.AWSClient).ResourceGroupsTaggingConn, d.Get("arn").(string), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Macie FindingsFilter (%s) tags: %w", d.Id(), err))
+		}
 	}
 
 	return resourceMacie2FindingsFilterRead(ctx, d, meta)
@@ -1,4 +1,4 @@
-//go:generate go run ../../generate/tags/main.go -ServiceTagsMap
+//go:generate go run ../../generate/tags/main.go -ServiceTagsMap -Tests
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package macie2
@@ -18,6 +18,7 @@ import (
 func ResourceQueue() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceQueueCreate,
+		//lintignore:AWSR004
 		Read:   resourceQueueRead,
 		Update: resourceQueueUpdate,
 		Delete: resourceQueueDelete,
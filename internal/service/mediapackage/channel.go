@@ -20,6 +20,7 @@ import (
 func ResourceChannel() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceChannelCreate,
+		//lintignore:AWSR004
 		Read:   resourceChannelRead,
 		Update: resourceChannelUpdate,
 		Delete: resourceChannelDelete,
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mediapackage"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -25,7 +24,7 @@ func TestAccMediaPackageChannel_basic(t *testing.T) {
 		CheckDestroy: testAccCheckChannelDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccMediaPackageChannelConfig(sdkacctest.RandString(5)),
+				Config: testAccMediaPackageChannelConfig(acctest.RandString(5)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckChannelExists(resourceName),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mediapackage", regexp.MustCompile(`channels/.+`)),
@@ -48,7 +47,7 @@ func TestAccMediaPackageChannel_basic(t *testing.T) {
 
 func TestAccMediaPackageChannel_description(t *testing.T) {
 	resourceName := "aws_media_package_channel.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
@@ -81,7 +80,7 @@ func TestAccMediaPackageChannel_description(t *testing.T) {
 
 func TestAccMediaPackageChannel_tags(t *testing.T) {
 	resourceName := "aws_media_package_channel.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
@@ -21,6 +21,7 @@ import (
 func ResourceContainer() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceContainerCreate,
+		//lintignore:AWSR004
 		Read:   resourceContainerRead,
 		Update: resourceContainerUpdate,
 		Delete: resourceContainerDelete,
@@ -14,6 +14,7 @@ import (
 func ResourceContainerPolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceContainerPolicyPut,
+		//lintignore:AWSR004
 		Read:   resourceContainerPolicyRead,
 		Update: resourceContainerPolicyPut,
 		Delete: resourceContainerPolicyDelete,
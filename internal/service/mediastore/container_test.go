@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mediastore"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -24,7 +23,7 @@ func TestAccMediaStoreContainer_basic(t *testing.T) {
 		CheckDestroy: testAccCheckContainerDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccMediaStoreContainerConfig(sdkacctest.RandString(5)),
+				Config: testAccMediaStoreContainerConfig(acctest.RandString(5)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckContainerExists(resourceName),
 				),
@@ -39,7 +38,7 @@ func TestAccMediaStoreContainer_basic(t *testing.T) {
 }
 
 func TestAccMediaStoreContainer_tags(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(5)
 	resourceName := "aws_media_store_container.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -6,13 +6,14 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/aws/aws-sdk-go/service/mediastore/mediastoreiface"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 // ListTags lists mediastore service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func ListTags(conn *mediastore.MediaStore, identifier string) (tftags.KeyValueTags, error) {
+func ListTags(conn mediastoreiface.MediaStoreAPI, identifier string) (tftags.KeyValueTags, error) {
 	input := &mediastore.ListTagsForResourceInput{
 		Resource: aws.String(identifier),
 	}
@@ -58,7 +59,7 @@ func KeyValueTags(tags []*mediastore.Tag) tftags.KeyValueTags {
 // UpdateTags updates mediastore service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func UpdateTags(conn *mediastore.MediaStore, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+func UpdateTags(conn mediastoreiface.MediaStoreAPI, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
 	oldTags := tftags.New(oldTagsMap)
 	newTags := tftags.New(newTagsMap)
 
@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mq"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -190,7 +189,7 @@ func TestDiffUsers(t *testing.T) {
 
 func TestAccMQBroker_basic(t *testing.T) {
 	var broker mq.DescribeBrokerResponse
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_mq_broker.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -263,7 +262,7 @@ func TestAccMQBroker_basic(t *testing.T) {
 
 func TestAccMQBroker_throughputOptimized(t *testing.T) {
 	var broker mq.DescribeBrokerResponse
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_mq_broker.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -329,8 +328,8 @@ func TestAccMQBroker_throughputOptimized(t *testing.T) {
 
 func TestAccMQBroker_allFieldsDefaultVPC(t *testing.T) {
 	var broker mq.DescribeBrokerResponse
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rNameUpdated := sdkacctest.RandomWithPrefix("tf-acc-test-updated")
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := acctest.RandomWithPrefix("tf-acc-test-updated")
 	resourceName := "aws_mq_broker.test"
 
 	cfgBodyBefore := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
@@ -453,8 +452,8 @@ func TestAccMQBroker_allFieldsDefaultVPC(t *testing.T) {
 
 func TestAccMQBroker_allFieldsCustomVPC(t *testing.T) {
 	var broker mq.DescribeBrokerResponse
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rNameUpdated := sdkacctest.RandomWithPrefix("tf-acc-test-updated")
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := acctest.RandomWithPrefix("tf-acc-test-updated")
 	resourceName := "aws_mq_broker.test"
 
 	cfgBodyBefore := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
@@ -577,7 +576,7 @@ func TestAccMQBroker_allFieldsCustomVPC(t *testing.T) {
 
 func TestAccMQBroker_EncryptionOptions_kmsKeyID(t *testing.T) {
 	var broker mq.DescribeBrokerResponse
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	kmsKeyResourceName := "aws_kms_key.test"
 	resourceName := "aws_mq_broker.test"
 
@@ -612,7 +611,7 @@ func TestAccMQBroker_EncryptionOptions_kmsKeyID(t *testing.T) {
 
 func TestAccMQBroker_EncryptionOptionsUseAwsOwnedKey_disabled(t *testing.T) {
 	var broker mq.DescribeBrokerResponse
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_mq_broker.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -645,7 +644,7 @@ func TestAccMQBroker_EncryptionOptionsUseAwsOwnedKey_disabled(t *testing.T) {
 
 func TestAccMQBroker_EncryptionOptionsUseAwsOwnedKey_enabled(t *testing.T) {
 	var broker mq.DescribeBrokerResponse
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_mq_broker.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -678,7 +677,7 @@ func TestAccMQBroker_EncryptionOptionsUseAwsOwnedKey_enabled(t *testing.T) {
 
 func TestAccMQBroker_updateUsers(t *testing.T) {
 	var broker mq.DescribeBrokerResponse
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_mq_broker.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -751,7 +750,7 @@ func TestAccMQBroker_updateUsers(t *testing.T) {
 
 func TestAccMQBroker_tags(t *testing.T) {
 	var broker mq.DescribeBrokerResponse
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_mq_broker.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -801,7 +800,7 @@ func TestAccMQBroker_tags(t *testing.T) {
 
 func TestAccMQBroker_updateSecurityGroup(t *testing.T) {
 	var broker mq.DescribeBrokerResponse
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_mq_broker.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -854,7 +853,7 @@ func TestAccMQBroker_updateSecurityGroup(t *testing.T) {
 
 func TestAccMQBroker_updateEngineVersion(t *testing.T) {
 	var broker mq.DescribeBrokerResponse
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_mq_broker.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -893,7 +892,7 @@ func TestAccMQBroker_updateEngineVersion(t *testing.T) {
 
 func TestAccMQBroker_disappears(t *testing.T) {
 	var broker mq.DescribeBrokerResponse
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_mq_broker.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -920,7 +919,7 @@ func TestAccMQBroker_disappears(t *testing.T) {
 
 func TestAccMQBroker_rabbitMQ(t *testing.T) {
 	var broker mq.DescribeBrokerResponse
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_mq_broker.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -961,7 +960,7 @@ func TestAccMQBroker_rabbitMQ(t *testing.T) {
 
 func TestAccMQBroker_RabbitMQ_logs(t *testing.T) {
 	var broker mq.DescribeBrokerResponse
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_mq_broker.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1002,7 +1001,7 @@ func TestAccMQBroker_RabbitMQ_logs(t *testing.T) {
 
 func TestAccMQBroker_RabbitMQValidation_auditLog(t *testing.T) {
 	var broker mq.DescribeBrokerResponse
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_mq_broker.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1036,7 +1035,7 @@ func TestAccMQBroker_RabbitMQValidation_auditLog(t *testing.T) {
 
 func TestAccMQBroker_clusterRabbitMQ(t *testing.T) {
 	var broker mq.DescribeBrokerResponse
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_mq_broker.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1098,7 +1097,7 @@ func TestAccMQBroker_clusterRabbitMQ(t *testing.T) {
 
 func TestAccMQBroker_ldap(t *testing.T) {
 	var broker mq.DescribeBrokerResponse
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_mq_broker.test"
 
 	resource.ParallelTest(t, resource.TestCase{
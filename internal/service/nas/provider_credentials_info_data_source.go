@@ -0,0 +1,72 @@
+package nas
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceProviderCredentialsInfo() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceProviderCredentialsInfoRead,
+
+		Schema: map[string]*schema.Schema{
+			"assumed_role_chain": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"dns_suffix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"partition": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"provider_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceProviderCredentialsInfoRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*conns.AWSClient)
+
+	d.SetId(client.Partition)
+	d.Set("dns_suffix", client.DNSSuffix)
+	d.Set("partition", client.Partition)
+
+	info := client.CredentialsInfo
+
+	if info == nil {
+		info = &conns.CredentialsInfo{Source: conns.CredentialsSourceUnknown}
+	}
+
+	if err := d.Set("assumed_role_chain", info.AssumedRoleChain); err != nil {
+		return fmt.Errorf("error setting assumed_role_chain: %w", err)
+	}
+
+	if v := info.Expiration(); !v.IsZero() {
+		d.Set("expiration", v.Format(time.RFC3339))
+	} else {
+		d.Set("expiration", nil)
+	}
+
+	d.Set("provider_name", info.ProviderName)
+	d.Set("source", info.Source)
+
+	return nil
+}
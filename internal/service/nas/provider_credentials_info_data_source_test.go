@@ -0,0 +1,36 @@
+package nas_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccNASProviderCredentialsInfoDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_provider_credentials_info.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderCredentialsInfoDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPartition(dataSourceName),
+					testAccCheckDNSSuffix(dataSourceName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "provider_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "source"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "assumed_role_chain.#"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "access_key"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "secret_key"),
+				),
+			},
+		},
+	})
+}
+
+const testAccProviderCredentialsInfoDataSourceConfig = `
+data "aws_provider_credentials_info" "test" {}
+`
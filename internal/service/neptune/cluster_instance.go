@@ -21,6 +21,7 @@ import (
 func ResourceClusterInstance() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceClusterInstanceCreate,
+		//lintignore:AWSR004
 		Read:   resourceClusterInstanceRead,
 		Update: resourceClusterInstanceUpdate,
 		Delete: resourceClusterInstanceDelete,
@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,7 +18,7 @@ import (
 func TestAccNeptuneClusterParameterGroup_basic(t *testing.T) {
 	var v neptune.DBClusterParameterGroup
 
-	parameterGroupName := sdkacctest.RandomWithPrefix("cluster-parameter-group-test-terraform")
+	parameterGroupName := acctest.RandomWithPrefix("cluster-parameter-group-test-terraform")
 
 	resourceName := "aws_neptune_cluster_parameter_group.test"
 
@@ -110,7 +109,7 @@ func TestAccNeptuneClusterParameterGroup_description(t *testing.T) {
 
 	resourceName := "aws_neptune_cluster_parameter_group.test"
 
-	parameterGroupName := sdkacctest.RandomWithPrefix("cluster-parameter-group-test-terraform")
+	parameterGroupName := acctest.RandomWithPrefix("cluster-parameter-group-test-terraform")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -187,7 +186,7 @@ func TestAccNeptuneClusterParameterGroup_parameter(t *testing.T) {
 
 	resourceName := "aws_neptune_cluster_parameter_group.test"
 
-	parameterGroupName := sdkacctest.RandomWithPrefix("cluster-parameter-group-test-tf")
+	parameterGroupName := acctest.RandomWithPrefix("cluster-parameter-group-test-tf")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -235,7 +234,7 @@ func TestAccNeptuneClusterParameterGroup_tags(t *testing.T) {
 
 	resourceName := "aws_neptune_cluster_parameter_group.test"
 
-	parameterGroupName := sdkacctest.RandomWithPrefix("cluster-parameter-group-test-tf")
+	parameterGroupName := acctest.RandomWithPrefix("cluster-parameter-group-test-tf")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -17,6 +17,7 @@ import (
 func ResourceClusterSnapshot() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceClusterSnapshotCreate,
+		//lintignore:AWSR004
 		Read:   resourceClusterSnapshotRead,
 		Delete: resourceClusterSnapshotDelete,
 		Importer: &schema.ResourceImporter{
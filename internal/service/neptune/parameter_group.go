@@ -25,6 +25,7 @@ const maxParams = 20
 func ResourceParameterGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceParameterGroupCreate,
+		//lintignore:AWSR004
 		Read:   resourceParameterGroupRead,
 		Update: resourceParameterGroupUpdate,
 		Delete: resourceParameterGroupDelete,
@@ -19,6 +19,7 @@ func ResourceApplication() *schema.Resource {
 	return &schema.Resource{
 
 		Create: resourceApplicationCreate,
+		//lintignore:AWSR004
 		Read:   resourceApplicationRead,
 		Update: resourceApplicationUpdate,
 		Delete: resourceApplicationDelete,
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,7 +18,7 @@ import (
 // and `aws-opsworks-service-role`, and Opsworks stacks named `tf-acc`.
 
 func TestAccOpsWorksCustomLayer_basic(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	var opslayer opsworks.Layer
 	resourceName := "aws_opsworks_custom_layer.tf-acc"
 
@@ -62,7 +61,7 @@ func TestAccOpsWorksCustomLayer_basic(t *testing.T) {
 }
 
 func TestAccOpsWorksCustomLayer_tags(t *testing.T) {
-	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	name := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	var opslayer opsworks.Layer
 	resourceName := "aws_opsworks_custom_layer.test"
 
@@ -107,7 +106,7 @@ func TestAccOpsWorksCustomLayer_tags(t *testing.T) {
 }
 
 func TestAccOpsWorksCustomLayer_noVPC(t *testing.T) {
-	stackName := fmt.Sprintf("tf-%d", sdkacctest.RandInt())
+	stackName := fmt.Sprintf("tf-%d", acctest.RandInt())
 	var opslayer opsworks.Layer
 	resourceName := "aws_opsworks_custom_layer.tf-acc"
 
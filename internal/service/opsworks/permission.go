@@ -20,7 +20,8 @@ func ResourcePermission() *schema.Resource {
 		Create: resourceSetPermission,
 		Update: resourceSetPermission,
 		Delete: resourcePermissionDelete,
-		Read:   resourcePermissionRead,
+		//lintignore:AWSR004
+		Read: resourcePermissionRead,
 
 		Schema: map[string]*schema.Schema{
 			"allow_ssh": {
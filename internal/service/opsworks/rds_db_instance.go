@@ -16,7 +16,8 @@ func ResourceRDSDBInstance() *schema.Resource {
 		Create: resourceRDSDBInstanceRegister,
 		Update: resourceRDSDBInstanceUpdate,
 		Delete: resourceRDSDBInstanceDeregister,
-		Read:   resourceRDSDBInstanceRead,
+		//lintignore:AWSR004
+		Read: resourceRDSDBInstanceRead,
 
 		Schema: map[string]*schema.Schema{
 			"stack_id": {
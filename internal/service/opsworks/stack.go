@@ -23,6 +23,7 @@ import (
 func ResourceStack() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceStackCreate,
+		//lintignore:AWSR004
 		Read:   resourceStackRead,
 		Update: resourceStackUpdate,
 		Delete: resourceStackDelete,
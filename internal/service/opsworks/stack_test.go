@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/opsworks"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,7 +18,7 @@ import (
 ///////////////////////////////
 
 func TestAccOpsWorksStack_noVPCBasic(t *testing.T) {
-	stackName := fmt.Sprintf("tf-opsworks-acc-%d", sdkacctest.RandInt())
+	stackName := fmt.Sprintf("tf-opsworks-acc-%d", acctest.RandInt())
 	resourceName := "aws_opsworks_stack.tf-acc"
 	var opsstack opsworks.Stack
 
@@ -50,7 +49,7 @@ func TestAccOpsWorksStack_noVPCBasic(t *testing.T) {
 }
 
 func TestAccOpsWorksStack_noVPCChangeServiceRoleForceNew(t *testing.T) {
-	stackName := fmt.Sprintf("tf-opsworks-acc-%d", sdkacctest.RandInt())
+	stackName := fmt.Sprintf("tf-opsworks-acc-%d", acctest.RandInt())
 	resourceName := "aws_opsworks_stack.tf-acc"
 	var before, after opsworks.Stack
 
@@ -87,7 +86,7 @@ func TestAccOpsWorksStack_noVPCChangeServiceRoleForceNew(t *testing.T) {
 }
 
 func TestAccOpsWorksStack_vpc(t *testing.T) {
-	stackName := fmt.Sprintf("tf-opsworks-acc-%d", sdkacctest.RandInt())
+	stackName := fmt.Sprintf("tf-opsworks-acc-%d", acctest.RandInt())
 	resourceName := "aws_opsworks_stack.tf-acc"
 	var opsstack opsworks.Stack
 
@@ -136,7 +135,7 @@ func TestAccOpsWorksStack_vpc(t *testing.T) {
 }
 
 func TestAccOpsWorksStack_noVPCCreateTags(t *testing.T) {
-	stackName := fmt.Sprintf("tf-opsworks-acc-%d", sdkacctest.RandInt())
+	stackName := fmt.Sprintf("tf-opsworks-acc-%d", acctest.RandInt())
 	resourceName := "aws_opsworks_stack.tf-acc"
 	var opsstack opsworks.Stack
 
@@ -181,7 +180,7 @@ func TestAccOpsWorksStack_noVPCCreateTags(t *testing.T) {
 
 func TestAccOpsWorksStack_CustomCookbooks_setPrivateProperties(t *testing.T) {
 	resourceName := "aws_opsworks_stack.tf-acc"
-	stackName := fmt.Sprintf("tf-opsworks-acc-%d", sdkacctest.RandInt())
+	stackName := fmt.Sprintf("tf-opsworks-acc-%d", acctest.RandInt())
 	var opsstack opsworks.Stack
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -222,9 +221,9 @@ func TestAccOpsWorksStack_CustomCookbooks_setPrivateProperties(t *testing.T) {
 // to create Stack's prior to v0.9.0.
 // See https://github.com/hashicorp/terraform/issues/12842
 func TestAccOpsWorksStack_classicEndpoints(t *testing.T) {
-	stackName := fmt.Sprintf("tf-opsworks-acc-%d", sdkacctest.RandInt())
+	stackName := fmt.Sprintf("tf-opsworks-acc-%d", acctest.RandInt())
 	resourceName := "aws_opsworks_stack.main"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	var opsstack opsworks.Stack
 
 	resource.ParallelTest(t, resource.TestCase{
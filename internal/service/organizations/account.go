@@ -21,6 +21,7 @@ import (
 func ResourceAccount() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAccountCreate,
+		//lintignore:AWSR004
 		Read:   resourceAccountRead,
 		Update: resourceAccountUpdate,
 		Delete: resourceAccountDelete,
@@ -21,6 +21,7 @@ const organizationsPolicyTypeStatusDisabled = "DISABLED"
 func ResourceOrganization() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceOrganizationCreate,
+		//lintignore:AWSR004
 		Read:   resourceOrganizationRead,
 		Update: resourceOrganizationUpdate,
 		Delete: resourceOrganizationDelete,
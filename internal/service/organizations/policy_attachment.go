@@ -18,6 +18,7 @@ import (
 func ResourcePolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePolicyAttachmentCreate,
+		//lintignore:AWSR004
 		Read:   resourcePolicyAttachmentRead,
 		Delete: resourcePolicyAttachmentDelete,
 		Importer: &schema.ResourceImporter{
@@ -14,6 +14,7 @@ import (
 func ResourceADMChannel() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceADMChannelUpsert,
+		//lintignore:AWSR004
 		Read:   resourceADMChannelRead,
 		Update: resourceADMChannelUpsert,
 		Delete: resourceADMChannelDelete,
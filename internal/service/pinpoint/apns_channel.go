@@ -15,6 +15,7 @@ import (
 func ResourceAPNSChannel() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAPNSChannelUpsert,
+		//lintignore:AWSR004
 		Read:   resourceAPNSChannelRead,
 		Update: resourceAPNSChannelUpsert,
 		Delete: resourceAPNSChannelDelete,
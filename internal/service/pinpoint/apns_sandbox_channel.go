@@ -15,6 +15,7 @@ import (
 func ResourceAPNSSandboxChannel() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAPNSSandboxChannelUpsert,
+		//lintignore:AWSR004
 		Read:   resourceAPNSSandboxChannelRead,
 		Update: resourceAPNSSandboxChannelUpsert,
 		Delete: resourceAPNSSandboxChannelDelete,
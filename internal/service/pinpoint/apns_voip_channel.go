@@ -15,6 +15,7 @@ import (
 func ResourceAPNSVoIPChannel() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAPNSVoIPChannelUpsert,
+		//lintignore:AWSR004
 		Read:   resourceAPNSVoIPChannelRead,
 		Update: resourceAPNSVoIPChannelUpsert,
 		Delete: resourceAPNSVoIPChannelDelete,
@@ -15,6 +15,7 @@ import (
 func ResourceAPNSVoIPSandboxChannel() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAPNSVoIPSandboxChannelUpsert,
+		//lintignore:AWSR004
 		Read:   resourceAPNSVoIPSandboxChannelRead,
 		Update: resourceAPNSVoIPSandboxChannelUpsert,
 		Delete: resourceAPNSVoIPSandboxChannelDelete,
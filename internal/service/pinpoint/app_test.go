@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/pinpoint"
 	"github.com/aws/aws-sdk-go/service/ram"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -42,7 +41,7 @@ func TestAccPinpointApp_basic(t *testing.T) {
 
 func TestAccPinpointApp_campaignHookLambda(t *testing.T) {
 	var application pinpoint.ApplicationResponse
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_pinpoint_app.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -70,7 +69,7 @@ func TestAccPinpointApp_campaignHookLambda(t *testing.T) {
 
 func TestAccPinpointApp_limits(t *testing.T) {
 	var application pinpoint.ApplicationResponse
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_pinpoint_app.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -98,7 +97,7 @@ func TestAccPinpointApp_limits(t *testing.T) {
 
 func TestAccPinpointApp_quietTime(t *testing.T) {
 	var application pinpoint.ApplicationResponse
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_pinpoint_app.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -126,7 +125,7 @@ func TestAccPinpointApp_quietTime(t *testing.T) {
 
 func TestAccPinpointApp_tags(t *testing.T) {
 	var application pinpoint.ApplicationResponse
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_pinpoint_app.test"
 
 	resource.ParallelTest(t, resource.TestCase{
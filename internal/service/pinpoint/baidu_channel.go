@@ -14,6 +14,7 @@ import (
 func ResourceBaiduChannel() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBaiduChannelUpsert,
+		//lintignore:AWSR004
 		Read:   resourceBaiduChannelRead,
 		Update: resourceBaiduChannelUpsert,
 		Delete: resourceBaiduChannelDelete,
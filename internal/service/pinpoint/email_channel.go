@@ -15,6 +15,7 @@ import (
 func ResourceEmailChannel() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceEmailChannelUpsert,
+		//lintignore:AWSR004
 		Read:   resourceEmailChannelRead,
 		Update: resourceEmailChannelUpsert,
 		Delete: resourceEmailChannelDelete,
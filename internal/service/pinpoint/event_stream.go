@@ -18,6 +18,7 @@ import (
 func ResourceEventStream() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceEventStreamUpsert,
+		//lintignore:AWSR004
 		Read:   resourceEventStreamRead,
 		Update: resourceEventStreamUpsert,
 		Delete: resourceEventStreamDelete,
@@ -14,6 +14,7 @@ import (
 func ResourceGCMChannel() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceGCMChannelUpsert,
+		//lintignore:AWSR004
 		Read:   resourceGCMChannelRead,
 		Update: resourceGCMChannelUpsert,
 		Delete: resourceGCMChannelDelete,
@@ -14,6 +14,7 @@ import (
 func ResourceSMSChannel() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSMSChannelUpsert,
+		//lintignore:AWSR004
 		Read:   resourceSMSChannelRead,
 		Update: resourceSMSChannelUpsert,
 		Delete: resourceSMSChannelDelete,
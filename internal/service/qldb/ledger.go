@@ -21,6 +21,7 @@ import (
 func ResourceLedger() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceLedgerCreate,
+		//lintignore:AWSR004
 		Read:   resourceLedgerRead,
 		Update: resourceLedgerUpdate,
 		Delete: resourceLedgerDelete,
@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,8 +15,8 @@ import (
 )
 
 func TestAccQuickSightGroupMembership_basic(t *testing.T) {
-	groupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	memberName := "tfacctest" + sdkacctest.RandString(10)
+	groupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	memberName := "tfacctest" + acctest.RandString(10)
 	resourceName := "aws_quicksight_group_membership.default"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -42,8 +41,8 @@ func TestAccQuickSightGroupMembership_basic(t *testing.T) {
 }
 
 func TestAccQuickSightGroupMembership_disappears(t *testing.T) {
-	groupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	memberName := "tfacctest" + sdkacctest.RandString(10)
+	groupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	memberName := "tfacctest" + acctest.RandString(10)
 	resourceName := "aws_quicksight_group_membership.default"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -16,6 +16,7 @@ import (
 func ResourceUser() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceUserCreate,
+		//lintignore:AWSR004
 		Read:   resourceUserRead,
 		Update: resourceUserUpdate,
 		Delete: resourceUserDelete,
@@ -17,6 +17,7 @@ import (
 func ResourceResourceAssociation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceResourceAssociationCreate,
+		//lintignore:AWSR004
 		Read:   resourceResourceAssociationRead,
 		Delete: resourceResourceAssociationDelete,
 
@@ -17,6 +17,7 @@ import (
 func ResourceResourceShare() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceResourceShareCreate,
+		//lintignore:AWSR004
 		Read:   resourceResourceShareRead,
 		Update: resourceResourceShareUpdate,
 		Delete: resourceResourceShareDelete,
@@ -26,6 +26,7 @@ const (
 func ResourceClusterEndpoint() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceClusterEndpointCreate,
+		//lintignore:AWSR004
 		Read:   resourceClusterEndpointRead,
 		Update: resourceClusterEndpointUpdate,
 		Delete: resourceClusterEndpointDelete,
@@ -22,6 +22,7 @@ const rdsClusterParameterGroupMaxParamsBulkEdit = 20
 func ResourceClusterParameterGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceClusterParameterGroupCreate,
+		//lintignore:AWSR004
 		Read:   resourceClusterParameterGroupRead,
 		Update: resourceClusterParameterGroupUpdate,
 		Delete: resourceClusterParameterGroupDelete,
@@ -24,6 +24,7 @@ const rdsDbClusterSnapshotCreateTimeout = 2 * time.Minute
 func ResourceClusterSnapshot() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceClusterSnapshotCreate,
+		//lintignore:AWSR004
 		Read:   resourceClusterSnapshotRead,
 		Delete: resourceClusterSnapshotDelete,
 		Update: resourcedbClusterSnapshotUpdate,
@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/rds"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 
 func TestAccRDSEventSubscription_basic(t *testing.T) {
 	var v rds.EventSubscription
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_db_event_subscription.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -52,7 +51,7 @@ func TestAccRDSEventSubscription_basic(t *testing.T) {
 
 func TestAccRDSEventSubscription_disappears(t *testing.T) {
 	var eventSubscription rds.EventSubscription
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_db_event_subscription.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -75,7 +74,7 @@ func TestAccRDSEventSubscription_disappears(t *testing.T) {
 
 func TestAccRDSEventSubscription_Name_generated(t *testing.T) {
 	var v rds.EventSubscription
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_db_event_subscription.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -103,7 +102,7 @@ func TestAccRDSEventSubscription_Name_generated(t *testing.T) {
 
 func TestAccRDSEventSubscription_namePrefix(t *testing.T) {
 	var v rds.EventSubscription
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_db_event_subscription.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -131,7 +130,7 @@ func TestAccRDSEventSubscription_namePrefix(t *testing.T) {
 
 func TestAccRDSEventSubscription_tags(t *testing.T) {
 	var eventSubscription rds.EventSubscription
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_db_event_subscription.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -176,7 +175,7 @@ func TestAccRDSEventSubscription_tags(t *testing.T) {
 
 func TestAccRDSEventSubscription_categories(t *testing.T) {
 	var v rds.EventSubscription
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_db_event_subscription.test"
 	snsTopicResourceName := "aws_sns_topic.test"
 
@@ -253,7 +252,7 @@ func TestAccRDSEventSubscription_categories(t *testing.T) {
 
 func TestAccRDSEventSubscription_sourceIDs(t *testing.T) {
 	var v rds.EventSubscription
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_db_event_subscription.test"
 	paramGroup1ResourceName := "aws_db_parameter_group.test1"
 	paramGroup2ResourceName := "aws_db_parameter_group.test2"
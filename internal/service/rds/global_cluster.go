@@ -22,6 +22,7 @@ const (
 func ResourceGlobalCluster() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceGlobalClusterCreate,
+		//lintignore:AWSR004
 		Read:   resourceGlobalClusterRead,
 		Update: resourceGlobalClusterUpdate,
 		Delete: resourceGlobalClusterDelete,
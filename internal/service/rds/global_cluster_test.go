@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,7 +18,7 @@ import (
 
 func TestAccRDSGlobalCluster_basic(t *testing.T) {
 	var globalCluster1 rds.GlobalCluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_rds_global_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -53,7 +52,7 @@ func TestAccRDSGlobalCluster_basic(t *testing.T) {
 
 func TestAccRDSGlobalCluster_disappears(t *testing.T) {
 	var globalCluster1 rds.GlobalCluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_rds_global_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -76,7 +75,7 @@ func TestAccRDSGlobalCluster_disappears(t *testing.T) {
 
 func TestAccRDSGlobalCluster_databaseName(t *testing.T) {
 	var globalCluster1, globalCluster2 rds.GlobalCluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_rds_global_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -111,7 +110,7 @@ func TestAccRDSGlobalCluster_databaseName(t *testing.T) {
 
 func TestAccRDSGlobalCluster_deletionProtection(t *testing.T) {
 	var globalCluster1, globalCluster2 rds.GlobalCluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_rds_global_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -146,7 +145,7 @@ func TestAccRDSGlobalCluster_deletionProtection(t *testing.T) {
 
 func TestAccRDSGlobalCluster_Engine_aurora(t *testing.T) {
 	var globalCluster1 rds.GlobalCluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_rds_global_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -173,7 +172,7 @@ func TestAccRDSGlobalCluster_Engine_aurora(t *testing.T) {
 
 func TestAccRDSGlobalCluster_EngineVersion_aurora(t *testing.T) {
 	var globalCluster1 rds.GlobalCluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_rds_global_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -200,7 +199,7 @@ func TestAccRDSGlobalCluster_EngineVersion_aurora(t *testing.T) {
 
 func TestAccRDSGlobalCluster_engineVersionUpdateMinor(t *testing.T) {
 	var globalCluster1, globalCluster2 rds.GlobalCluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_rds_global_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -234,7 +233,7 @@ func TestAccRDSGlobalCluster_engineVersionUpdateMinor(t *testing.T) {
 
 func TestAccRDSGlobalCluster_engineVersionUpdateMajor(t *testing.T) {
 	var globalCluster1, globalCluster2 rds.GlobalCluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_rds_global_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -269,7 +268,7 @@ func TestAccRDSGlobalCluster_engineVersionUpdateMajor(t *testing.T) {
 
 func TestAccRDSGlobalCluster_EngineVersion_auroraMySQL(t *testing.T) {
 	var globalCluster1 rds.GlobalCluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_rds_global_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -296,7 +295,7 @@ func TestAccRDSGlobalCluster_EngineVersion_auroraMySQL(t *testing.T) {
 
 func TestAccRDSGlobalCluster_EngineVersion_auroraPostgresql(t *testing.T) {
 	var globalCluster1 rds.GlobalCluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_rds_global_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -323,7 +322,7 @@ func TestAccRDSGlobalCluster_EngineVersion_auroraPostgresql(t *testing.T) {
 
 func TestAccRDSGlobalCluster_sourceDBClusterIdentifier(t *testing.T) {
 	var globalCluster1 rds.GlobalCluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	clusterResourceName := "aws_rds_cluster.test"
 	resourceName := "aws_rds_global_cluster.test"
 
@@ -352,7 +351,7 @@ func TestAccRDSGlobalCluster_sourceDBClusterIdentifier(t *testing.T) {
 
 func TestAccRDSGlobalCluster_SourceDBClusterIdentifier_storageEncrypted(t *testing.T) {
 	var globalCluster1 rds.GlobalCluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	clusterResourceName := "aws_rds_cluster.test"
 	resourceName := "aws_rds_global_cluster.test"
 
@@ -381,7 +380,7 @@ func TestAccRDSGlobalCluster_SourceDBClusterIdentifier_storageEncrypted(t *testi
 
 func TestAccRDSGlobalCluster_storageEncrypted(t *testing.T) {
 	var globalCluster1, globalCluster2 rds.GlobalCluster
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_rds_global_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
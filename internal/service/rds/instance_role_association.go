@@ -25,6 +25,7 @@ const (
 func ResourceInstanceRoleAssociation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceInstanceRoleAssociationCreate,
+		//lintignore:AWSR004
 		Read:   resourceInstanceRoleAssociationRead,
 		Delete: resourceInstanceRoleAssociationDelete,
 
@@ -23,6 +23,7 @@ import (
 func ResourceOptionGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceOptionGroupCreate,
+		//lintignore:AWSR004
 		Read:   resourceOptionGroupRead,
 		Update: resourceOptionGroupUpdate,
 		Delete: resourceOptionGroupDelete,
@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/rds"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -20,7 +19,7 @@ import (
 func TestAccRDSParameterGroup_basic(t *testing.T) {
 	var v rds.DBParameterGroup
 	resourceName := "aws_db_parameter_group.test"
-	groupName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
+	groupName := fmt.Sprintf("parameter-group-test-terraform-%d", acctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -112,7 +111,7 @@ func TestAccRDSParameterGroup_basic(t *testing.T) {
 }
 
 func TestAccRDSParameterGroup_caseWithMixedParameters(t *testing.T) {
-	groupName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
+	groupName := fmt.Sprintf("parameter-group-test-terraform-%d", acctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -131,7 +130,7 @@ func TestAccRDSParameterGroup_caseWithMixedParameters(t *testing.T) {
 func TestAccRDSParameterGroup_limit(t *testing.T) {
 	var v rds.DBParameterGroup
 	resourceName := "aws_db_parameter_group.test"
-	groupName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
+	groupName := fmt.Sprintf("parameter-group-test-terraform-%d", acctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -499,7 +498,7 @@ func TestAccRDSParameterGroup_limit(t *testing.T) {
 func TestAccRDSParameterGroup_disappears(t *testing.T) {
 	var v rds.DBParameterGroup
 	resourceName := "aws_db_parameter_group.test"
-	groupName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
+	groupName := fmt.Sprintf("parameter-group-test-terraform-%d", acctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -561,7 +560,7 @@ func TestAccRDSParameterGroup_generatedName(t *testing.T) {
 func TestAccRDSParameterGroup_withApplyMethod(t *testing.T) {
 	var v rds.DBParameterGroup
 	resourceName := "aws_db_parameter_group.test"
-	groupName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
+	groupName := fmt.Sprintf("parameter-group-test-terraform-%d", acctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -601,7 +600,7 @@ func TestAccRDSParameterGroup_withApplyMethod(t *testing.T) {
 func TestAccRDSParameterGroup_only(t *testing.T) {
 	var v rds.DBParameterGroup
 	resourceName := "aws_db_parameter_group.test"
-	groupName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
+	groupName := fmt.Sprintf("parameter-group-test-terraform-%d", acctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -630,7 +629,7 @@ func TestAccRDSParameterGroup_only(t *testing.T) {
 func TestAccRDSParameterGroup_matchDefault(t *testing.T) {
 	var v rds.DBParameterGroup
 	resourceName := "aws_db_parameter_group.test"
-	groupName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
+	groupName := fmt.Sprintf("parameter-group-test-terraform-%d", acctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -659,7 +658,7 @@ func TestAccRDSParameterGroup_matchDefault(t *testing.T) {
 func TestAccRDSParameterGroup_updateParameters(t *testing.T) {
 	var v rds.DBParameterGroup
 	resourceName := "aws_db_parameter_group.test"
-	groupName := fmt.Sprintf("parameter-group-test-terraform-%d", sdkacctest.RandInt())
+	groupName := fmt.Sprintf("parameter-group-test-terraform-%d", acctest.RandInt())
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -719,7 +718,7 @@ func TestAccRDSParameterGroup_updateParameters(t *testing.T) {
 func TestAccRDSParameterGroup_caseParameters(t *testing.T) {
 	var v rds.DBParameterGroup
 	resourceName := "aws_db_parameter_group.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
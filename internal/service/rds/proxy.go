@@ -20,6 +20,7 @@ import (
 func ResourceProxy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceProxyCreate,
+		//lintignore:AWSR004
 		Read:   resourceProxyRead,
 		Update: resourceProxyUpdate,
 		Delete: resourceProxyDelete,
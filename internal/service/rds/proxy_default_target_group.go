@@ -18,6 +18,7 @@ import (
 func ResourceProxyDefaultTargetGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceProxyDefaultTargetGroupCreate,
+		//lintignore:AWSR004
 		Read:   resourceProxyDefaultTargetGroupRead,
 		Update: resourceProxyDefaultTargetGroupUpdate,
 		Delete: schema.Noop,
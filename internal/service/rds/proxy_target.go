@@ -15,6 +15,7 @@ import (
 func ResourceProxyTarget() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceProxyTargetCreate,
+		//lintignore:AWSR004
 		Read:   resourceProxyTargetRead,
 		Delete: resourceProxyTargetDelete,
 		Importer: &schema.ResourceImporter{
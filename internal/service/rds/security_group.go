@@ -21,6 +21,7 @@ import (
 func ResourceSecurityGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSecurityGroupCreate,
+		//lintignore:AWSR004
 		Read:   resourceSecurityGroupRead,
 		Update: resourceSecurityGroupUpdate,
 		Delete: resourceSecurityGroupDelete,
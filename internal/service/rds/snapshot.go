@@ -18,6 +18,7 @@ import (
 func ResourceSnapshot() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSnapshotCreate,
+		//lintignore:AWSR004
 		Read:   resourceSnapshotRead,
 		Update: resourceSnapshotUpdate,
 		Delete: resourceSnapshotDelete,
@@ -293,7 +293,7 @@ func sweepEventSubscriptions(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(eventSubscription.CustSubscriptionId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_db_event_subscription", r, d, client))
 		}
 
 		return !lastPage
@@ -377,7 +377,7 @@ func sweepInstances(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(dbi.DBInstanceIdentifier))
 			d.Set("skip_final_snapshot", true)
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_db_instance", r, d, client))
 		}
 		return !lastPage
 	})
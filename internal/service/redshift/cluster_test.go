@@ -9,7 +9,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/redshift"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -22,7 +21,7 @@ import (
 func TestAccRedshiftCluster_basic(t *testing.T) {
 	var v redshift.Cluster
 
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	config := testAccClusterConfig_basic(ri)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -59,7 +58,7 @@ func TestAccRedshiftCluster_basic(t *testing.T) {
 func TestAccRedshiftCluster_withFinalSnapshot(t *testing.T) {
 	var v redshift.Cluster
 
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -93,7 +92,7 @@ func TestAccRedshiftCluster_kmsKey(t *testing.T) {
 	resourceName := "aws_redshift_cluster.default"
 	kmsResourceName := "aws_kms_key.foo"
 
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -127,7 +126,7 @@ func TestAccRedshiftCluster_kmsKey(t *testing.T) {
 func TestAccRedshiftCluster_enhancedVPCRoutingEnabled(t *testing.T) {
 	var v redshift.Cluster
 
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	preConfig := testAccClusterConfig_enhancedVPCRoutingEnabled(ri)
 	postConfig := testAccClusterConfig_enhancedVPCRoutingDisabled(ri)
 
@@ -169,7 +168,7 @@ func TestAccRedshiftCluster_enhancedVPCRoutingEnabled(t *testing.T) {
 
 func TestAccRedshiftCluster_loggingEnabled(t *testing.T) {
 	var v redshift.Cluster
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -212,7 +211,7 @@ func TestAccRedshiftCluster_loggingEnabled(t *testing.T) {
 func TestAccRedshiftCluster_snapshotCopy(t *testing.T) {
 	var providers []*schema.Provider
 	var v redshift.Cluster
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -248,7 +247,7 @@ func TestAccRedshiftCluster_snapshotCopy(t *testing.T) {
 func TestAccRedshiftCluster_iamRoles(t *testing.T) {
 	var v redshift.Cluster
 
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	preConfig := testAccClusterConfig_iamRoles(ri)
 	postConfig := testAccClusterConfig_updateIAMRoles(ri)
 
@@ -281,7 +280,7 @@ func TestAccRedshiftCluster_iamRoles(t *testing.T) {
 
 func TestAccRedshiftCluster_publiclyAccessible(t *testing.T) {
 	var v redshift.Cluster
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -313,7 +312,7 @@ func TestAccRedshiftCluster_publiclyAccessible(t *testing.T) {
 func TestAccRedshiftCluster_updateNodeCount(t *testing.T) {
 	var v redshift.Cluster
 
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	preConfig := testAccClusterConfig_basic(ri)
 	postConfig := testAccClusterConfig_updateNodeCount(ri)
 
@@ -351,7 +350,7 @@ func TestAccRedshiftCluster_updateNodeCount(t *testing.T) {
 func TestAccRedshiftCluster_updateNodeType(t *testing.T) {
 	var v redshift.Cluster
 
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	preConfig := testAccClusterConfig_basic(ri)
 	postConfig := testAccClusterConfig_updateNodeType(ri)
 
@@ -389,7 +388,7 @@ func TestAccRedshiftCluster_updateNodeType(t *testing.T) {
 func TestAccRedshiftCluster_tags(t *testing.T) {
 	var v redshift.Cluster
 
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	preConfig := testAccClusterConfig_tags(ri)
 	postConfig := testAccClusterConfig_updatedTags(ri)
 
@@ -425,7 +424,7 @@ func TestAccRedshiftCluster_tags(t *testing.T) {
 func TestAccRedshiftCluster_forceNewUsername(t *testing.T) {
 	var first, second redshift.Cluster
 
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	preConfig := testAccClusterConfig_basic(ri)
 	postConfig := testAccClusterConfig_updatedUsername(ri)
 
@@ -459,7 +458,7 @@ func TestAccRedshiftCluster_forceNewUsername(t *testing.T) {
 func TestAccRedshiftCluster_changeAvailabilityZone(t *testing.T) {
 	var first, second redshift.Cluster
 
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	preConfig := testAccClusterConfig_basic(ri)
 	postConfig := testAccClusterConfig_updatedAvailabilityZone(ri)
 
@@ -491,7 +490,7 @@ func TestAccRedshiftCluster_changeAvailabilityZone(t *testing.T) {
 func TestAccRedshiftCluster_changeEncryption1(t *testing.T) {
 	var cluster1, cluster2 redshift.Cluster
 
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	preConfig := testAccClusterConfig_basic(ri)
 	postConfig := testAccClusterConfig_encrypted(ri)
 
@@ -524,7 +523,7 @@ func TestAccRedshiftCluster_changeEncryption1(t *testing.T) {
 func TestAccRedshiftCluster_changeEncryption2(t *testing.T) {
 	var cluster1, cluster2 redshift.Cluster
 
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt()
 	preConfig := testAccClusterConfig_encrypted(ri)
 	postConfig := testAccClusterConfig_unencrypted(ri)
 
@@ -19,12 +19,14 @@ import (
 func ResourceEventSubscription() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceEventSubscriptionCreate,
+		//lintignore:AWSR004
 		Read:   resourceEventSubscriptionRead,
 		Update: resourceEventSubscriptionUpdate,
 		Delete: resourceEventSubscriptionDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		//lintignore:AWSR005 // Event subscription changes are synchronous, the timeouts are kept for existing configurations
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(40 * time.Minute),
 			Delete: schema.DefaultTimeout(40 * time.Minute),
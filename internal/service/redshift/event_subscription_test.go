@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 
 func TestAccRedshiftEventSubscription_basicUpdate(t *testing.T) {
 	var v redshift.EventSubscription
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	rName := fmt.Sprintf("tf-acc-test-redshift-event-subs-%d", rInt)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -57,7 +56,7 @@ func TestAccRedshiftEventSubscription_basicUpdate(t *testing.T) {
 
 func TestAccRedshiftEventSubscription_withPrefix(t *testing.T) {
 	var v redshift.EventSubscription
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	rName := fmt.Sprintf("tf-acc-test-redshift-event-subs-%d", rInt)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -91,7 +90,7 @@ func TestAccRedshiftEventSubscription_withPrefix(t *testing.T) {
 
 func TestAccRedshiftEventSubscription_withSourceIDs(t *testing.T) {
 	var v redshift.EventSubscription
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	rName := fmt.Sprintf("tf-acc-test-redshift-event-subs-with-ids-%d", rInt)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -139,7 +138,7 @@ func TestAccRedshiftEventSubscription_withSourceIDs(t *testing.T) {
 
 func TestAccRedshiftEventSubscription_categoryUpdate(t *testing.T) {
 	var v redshift.EventSubscription
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	rName := fmt.Sprintf("tf-acc-test-redshift-event-subs-%d", rInt)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -181,7 +180,7 @@ func TestAccRedshiftEventSubscription_categoryUpdate(t *testing.T) {
 
 func TestAccRedshiftEventSubscription_tagsUpdate(t *testing.T) {
 	var v redshift.EventSubscription
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_redshift_event_subscription.bar"
 
 	resource.ParallelTest(t, resource.TestCase{
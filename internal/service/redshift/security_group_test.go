@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/redshift"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 
 func TestAccRedshiftSecurityGroup_basic(t *testing.T) {
 	var v redshift.ClusterSecurityGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_redshift_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -42,7 +41,7 @@ func TestAccRedshiftSecurityGroup_basic(t *testing.T) {
 
 func TestAccRedshiftSecurityGroup_ingressCIDR(t *testing.T) {
 	var v redshift.ClusterSecurityGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_redshift_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -77,7 +76,7 @@ func TestAccRedshiftSecurityGroup_ingressCIDR(t *testing.T) {
 
 func TestAccRedshiftSecurityGroup_updateIngressCIDR(t *testing.T) {
 	var v redshift.ClusterSecurityGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_redshift_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -121,7 +120,7 @@ func TestAccRedshiftSecurityGroup_updateIngressCIDR(t *testing.T) {
 
 func TestAccRedshiftSecurityGroup_ingressSecurityGroup(t *testing.T) {
 	var v redshift.ClusterSecurityGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_redshift_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -153,7 +152,7 @@ func TestAccRedshiftSecurityGroup_ingressSecurityGroup(t *testing.T) {
 
 func TestAccRedshiftSecurityGroup_updateIngressSecurityGroup(t *testing.T) {
 	var v redshift.ClusterSecurityGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_redshift_security_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
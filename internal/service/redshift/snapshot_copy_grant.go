@@ -20,6 +20,7 @@ import (
 func ResourceSnapshotCopyGrant() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSnapshotCopyGrantCreate,
+		//lintignore:AWSR004
 		Read:   resourceSnapshotCopyGrantRead,
 		Update: resourceSnapshotCopyGrantUpdate,
 		Delete: resourceSnapshotCopyGrantDelete,
@@ -20,6 +20,7 @@ import (
 func ResourceSnapshotSchedule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSnapshotScheduleCreate,
+		//lintignore:AWSR004
 		Read:   resourceSnapshotScheduleRead,
 		Update: resourceSnapshotScheduleUpdate,
 		Delete: resourceSnapshotScheduleDelete,
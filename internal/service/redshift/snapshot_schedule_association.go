@@ -18,6 +18,7 @@ func ResourceSnapshotScheduleAssociation() *schema.Resource {
 
 	return &schema.Resource{
 		Create: resourceSnapshotScheduleAssociationCreate,
+		//lintignore:AWSR004
 		Read:   resourceSnapshotScheduleAssociationRead,
 		Delete: resourceSnapshotScheduleAssociationDelete,
 		Importer: &schema.ResourceImporter{
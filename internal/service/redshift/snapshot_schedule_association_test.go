@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/redshift"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -15,8 +14,8 @@ import (
 )
 
 func TestAccRedshiftSnapshotScheduleAssociation_basic(t *testing.T) {
-	rInt := sdkacctest.RandInt()
-	rName := sdkacctest.RandString(8)
+	rInt := acctest.RandInt()
+	rName := acctest.RandString(8)
 	resourceName := "aws_redshift_snapshot_schedule_association.default"
 	snapshotScheduleResourceName := "aws_redshift_snapshot_schedule.default"
 	clusterResourceName := "aws_redshift_cluster.default"
@@ -118,7 +118,7 @@ func sweepClusters(region string) error {
 			d.Set("skip_final_snapshot", true)
 			d.SetId(aws.StringValue(c.ClusterIdentifier))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_redshift_cluster", r, d, client))
 		}
 
 		return !lastPage
@@ -162,7 +162,7 @@ func sweepEventSubscriptions(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(eventSubscription.CustSubscriptionId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_redshift_event_subscription", r, d, client))
 		}
 
 		return !lastPage
@@ -203,7 +203,7 @@ func sweepScheduledActions(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(scheduledAction.ScheduledActionName))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_redshift_scheduled_action", r, d, client))
 		}
 
 		return !lastPage
@@ -255,7 +255,7 @@ func sweepSnapshotSchedules(region string) error {
 					d := r.Data(nil)
 					d.SetId(id)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_redshift_snapshot_schedule", r, d, client))
 
 					break
 				}
@@ -314,7 +314,7 @@ func sweepSubnetGroups(region string) error {
 			d := r.Data(nil)
 			d.SetId(name)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_redshift_subnet_group", r, d, client))
 		}
 
 		return !lastPage
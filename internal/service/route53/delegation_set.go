@@ -18,6 +18,7 @@ import (
 func ResourceDelegationSet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDelegationSetCreate,
+		//lintignore:AWSR004
 		Read:   resourceDelegationSetRead,
 		Delete: resourceDelegationSetDelete,
 		Importer: &schema.ResourceImporter{
@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 )
 
 func TestAccRoute53DelegationSet_basic(t *testing.T) {
-	refName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	refName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_route53_delegation_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -46,7 +45,7 @@ func TestAccRoute53DelegationSet_basic(t *testing.T) {
 func TestAccRoute53DelegationSet_withZones(t *testing.T) {
 	var zone route53.GetHostedZoneOutput
 
-	refName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	refName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_route53_delegation_set.test"
 	primaryZoneResourceName := "aws_route53_zone.primary"
 	secondaryZoneResourceName := "aws_route53_zone.secondary"
@@ -82,7 +81,7 @@ func TestAccRoute53DelegationSet_withZones(t *testing.T) {
 }
 
 func TestAccRoute53DelegationSet_disappears(t *testing.T) {
-	refName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	refName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_route53_delegation_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
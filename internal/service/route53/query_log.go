@@ -16,6 +16,7 @@ import (
 func ResourceQueryLog() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceQueryLogCreate,
+		//lintignore:AWSR004
 		Read:   resourceQueryLogRead,
 		Delete: resourceQueryLogDelete,
 		Importer: &schema.ResourceImporter{
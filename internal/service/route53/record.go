@@ -30,6 +30,7 @@ func ResourceRecord() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
 		Create: resourceRecordCreate,
+		//lintignore:AWSR004
 		Read:   resourceRecordRead,
 		Update: resourceRecordUpdate,
 		Delete: resourceRecordDelete,
@@ -15,6 +15,7 @@ import (
 func ResourceVPCAssociationAuthorization() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceVPCAssociationAuthorizationCreate,
+		//lintignore:AWSR004
 		Read:   resourceVPCAssociationAuthorizationRead,
 		Delete: resourceVPCAssociationAuthorizationDelete,
 		Importer: &schema.ResourceImporter{
@@ -27,6 +27,7 @@ import (
 func ResourceZone() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceZoneCreate,
+		//lintignore:AWSR004
 		Read:   resourceZoneRead,
 		Update: resourceZoneUpdate,
 		Delete: resourceZoneDelete,
@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/route53"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
@@ -62,7 +61,7 @@ func TestAccRoute53ZoneDataSource_name(t *testing.T) {
 }
 
 func TestAccRoute53ZoneDataSource_tags(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_route53_zone.test"
 	dataSourceName := "data.aws_route53_zone.test"
 
@@ -88,7 +87,7 @@ func TestAccRoute53ZoneDataSource_tags(t *testing.T) {
 }
 
 func TestAccRoute53ZoneDataSource_vpc(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_route53_zone.test"
 	dataSourceName := "data.aws_route53_zone.test"
 
@@ -112,7 +111,7 @@ func TestAccRoute53ZoneDataSource_vpc(t *testing.T) {
 }
 
 func TestAccRoute53ZoneDataSource_serviceDiscovery(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_service_discovery_private_dns_namespace.test"
 	dataSourceName := "data.aws_route53_zone.test"
 
@@ -9,7 +9,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -332,7 +331,7 @@ func TestAccRoute53Zone_tags(t *testing.T) {
 func TestAccRoute53Zone_VPC_single(t *testing.T) {
 	var zone route53.GetHostedZoneOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_route53_zone.test"
 	vpcResourceName := "aws_vpc.test1"
 	zoneName := acctest.RandomDomainName()
@@ -364,7 +363,7 @@ func TestAccRoute53Zone_VPC_single(t *testing.T) {
 func TestAccRoute53Zone_VPC_multiple(t *testing.T) {
 	var zone route53.GetHostedZoneOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_route53_zone.test"
 	vpcResourceName1 := "aws_vpc.test1"
 	vpcResourceName2 := "aws_vpc.test2"
@@ -398,7 +397,7 @@ func TestAccRoute53Zone_VPC_multiple(t *testing.T) {
 func TestAccRoute53Zone_VPC_updates(t *testing.T) {
 	var zone route53.GetHostedZoneOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_route53_zone.test"
 	vpcResourceName1 := "aws_vpc.test1"
 	vpcResourceName2 := "aws_vpc.test2"
@@ -475,7 +474,7 @@ func testAccCreateRandomRoute53RecordsInZoneIdWithProvider(providerF func() *sch
 			changes = append(changes, &route53.Change{
 				Action: aws.String("UPSERT"),
 				ResourceRecordSet: &route53.ResourceRecordSet{
-					Name: aws.String(fmt.Sprintf("%d-tf-acc-random.%s", sdkacctest.RandInt(), *zone.HostedZone.Name)),
+					Name: aws.String(fmt.Sprintf("%d-tf-acc-random.%s", acctest.RandInt(), *zone.HostedZone.Name)),
 					Type: aws.String("CNAME"),
 					ResourceRecords: []*route53.ResourceRecord{
 						{Value: aws.String(fmt.Sprintf("random.%s", *zone.HostedZone.Name))},
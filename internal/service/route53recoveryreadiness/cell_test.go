@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 )
 
 func TestAccRoute53RecoveryReadinessCell_basic(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_route53recoveryreadiness_cell.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -45,7 +44,7 @@ func TestAccRoute53RecoveryReadinessCell_basic(t *testing.T) {
 }
 
 func TestAccRoute53RecoveryReadinessCell_disappears(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_route53recoveryreadiness_cell.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -67,8 +66,8 @@ func TestAccRoute53RecoveryReadinessCell_disappears(t *testing.T) {
 }
 
 func TestAccRoute53RecoveryReadinessCell_nestedCell(t *testing.T) {
-	rNameParent := sdkacctest.RandomWithPrefix("tf-acc-test-parent")
-	rNameChild := sdkacctest.RandomWithPrefix("tf-acc-test-child")
+	rNameParent := acctest.RandomWithPrefix("tf-acc-test-parent")
+	rNameChild := acctest.RandomWithPrefix("tf-acc-test-child")
 	resourceNameParent := "aws_route53recoveryreadiness_cell.test_parent"
 	resourceNameChild := "aws_route53recoveryreadiness_cell.test_child"
 
@@ -118,7 +117,7 @@ func TestAccRoute53RecoveryReadinessCell_nestedCell(t *testing.T) {
 }
 
 func TestAccRoute53RecoveryReadinessCell_tags(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_route53recoveryreadiness_cell.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -162,7 +161,7 @@ func TestAccRoute53RecoveryReadinessCell_tags(t *testing.T) {
 }
 
 func TestAccRoute53RecoveryReadinessCell_timeout(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_route53recoveryreadiness_cell.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,8 +17,8 @@ import (
 )
 
 func TestAccRoute53RecoveryReadinessReadinessCheck_basic(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rSetName := sdkacctest.RandomWithPrefix("tf-acc-test-set")
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rSetName := acctest.RandomWithPrefix("tf-acc-test-set")
 	resourceName := "aws_route53recoveryreadiness_readiness_check.test"
 	cwArn := arn.ARN{
 		AccountID: "123456789012",
@@ -53,8 +52,8 @@ func TestAccRoute53RecoveryReadinessReadinessCheck_basic(t *testing.T) {
 }
 
 func TestAccRoute53RecoveryReadinessReadinessCheck_disappears(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rSetName := sdkacctest.RandomWithPrefix("tf-acc-test-set")
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rSetName := acctest.RandomWithPrefix("tf-acc-test-set")
 	resourceName := "aws_route53recoveryreadiness_readiness_check.test"
 	cwArn := arn.ARN{
 		AccountID: "123456789012",
@@ -83,7 +82,7 @@ func TestAccRoute53RecoveryReadinessReadinessCheck_disappears(t *testing.T) {
 }
 
 func TestAccRoute53RecoveryReadinessReadinessCheck_tags(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_route53recoveryreadiness_readiness_check.test"
 	cwArn := arn.ARN{
 		AccountID: "123456789012",
@@ -134,8 +133,8 @@ func TestAccRoute53RecoveryReadinessReadinessCheck_tags(t *testing.T) {
 }
 
 func TestAccRoute53RecoveryReadinessReadinessCheck_timeout(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rSetName := sdkacctest.RandomWithPrefix("tf-acc-test-set")
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rSetName := acctest.RandomWithPrefix("tf-acc-test-set")
 	resourceName := "aws_route53recoveryreadiness_readiness_check.test"
 	cwArn := arn.ARN{
 		AccountID: "123456789012",
@@ -15,6 +15,7 @@ import (
 func ResourceDNSSECConfig() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDNSSECConfigCreate,
+		//lintignore:AWSR004
 		Read:   resourceDNSSECConfigRead,
 		Delete: resourceDNSSECConfigDelete,
 		Importer: &schema.ResourceImporter{
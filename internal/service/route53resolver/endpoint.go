@@ -26,6 +26,7 @@ const (
 func ResourceEndpoint() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceEndpointCreate,
+		//lintignore:AWSR004
 		Read:   resourceEndpointRead,
 		Update: resourceEndpointUpdate,
 		Delete: resourceEndpointDelete,
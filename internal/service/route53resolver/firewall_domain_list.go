@@ -18,6 +18,7 @@ import (
 func ResourceFirewallDomainList() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceFirewallDomainListCreate,
+		//lintignore:AWSR004
 		Read:   resourceFirewallDomainListRead,
 		Update: resourceFirewallDomainListUpdate,
 		Delete: resourceFirewallDomainListDelete,
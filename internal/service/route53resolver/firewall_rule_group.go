@@ -17,6 +17,7 @@ import (
 func ResourceFirewallRuleGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceFirewallRuleGroupCreate,
+		//lintignore:AWSR004
 		Read:   resourceFirewallRuleGroupRead,
 		Update: resourceFirewallRuleGroupUpdate,
 		Delete: resourceFirewallRuleGroupDelete,
@@ -17,6 +17,7 @@ import (
 func ResourceQueryLogConfig() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceQueryLogConfigCreate,
+		//lintignore:AWSR004
 		Read:   resourceQueryLogConfigRead,
 		Update: resourceQueryLogConfigUpdate,
 		Delete: resourceQueryLogConfigDelete,
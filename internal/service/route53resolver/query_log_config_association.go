@@ -14,6 +14,7 @@ import (
 func ResourceQueryLogConfigAssociation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceQueryLogConfigAssociationCreate,
+		//lintignore:AWSR004
 		Read:   resourceQueryLogConfigAssociationRead,
 		Delete: resourceQueryLogConfigAssociationDelete,
 		Importer: &schema.ResourceImporter{
@@ -28,6 +28,7 @@ const (
 func ResourceRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRuleCreate,
+		//lintignore:AWSR004
 		Read:   resourceRuleRead,
 		Update: resourceRuleUpdate,
 		Delete: resourceRuleDelete,
@@ -21,6 +21,7 @@ const (
 func ResourceRuleAssociation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRuleAssociationCreate,
+		//lintignore:AWSR004
 		Read:   resourceRuleAssociationRead,
 		Delete: resourceRuleAssociationDelete,
 		Importer: &schema.ResourceImporter{
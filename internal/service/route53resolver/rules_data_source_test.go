@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/route53resolver"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
@@ -30,8 +29,8 @@ func TestAccRoute53ResolverRulesDataSource_basic(t *testing.T) {
 }
 
 func TestAccRoute53ResolverRulesDataSource_resolverEndpointID(t *testing.T) {
-	rName1 := fmt.Sprintf("tf-testacc-r53-resolver-%s", sdkacctest.RandString(8))
-	rName2 := fmt.Sprintf("tf-testacc-r53-resolver-%s", sdkacctest.RandString(8))
+	rName1 := fmt.Sprintf("tf-testacc-r53-resolver-%s", acctest.RandString(8))
+	rName2 := fmt.Sprintf("tf-testacc-r53-resolver-%s", acctest.RandString(8))
 	ds1ResourceName := "data.aws_route53_resolver_rules.by_resolver_endpoint_id"
 	ds2ResourceName := "data.aws_route53_resolver_rules.by_resolver_endpoint_id_rule_type_share_status"
 	ds3ResourceName := "data.aws_route53_resolver_rules.by_invalid_owner_id"
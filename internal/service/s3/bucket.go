@@ -20,6 +20,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -77,8 +78,8 @@ func ResourceBucket() *schema.Resource {
 
 			"acl": {
 				Type:          schema.TypeString,
-				Default:       "private",
 				Optional:      true,
+				Computed:      true,
 				ConflictsWith: []string{"grant"},
 				ValidateFunc:  validation.StringInSlice(BucketCannedACL_Values(), false),
 			},
@@ -86,6 +87,7 @@ func ResourceBucket() *schema.Resource {
 			"grant": {
 				Type:          schema.TypeSet,
 				Optional:      true,
+				Computed:      true,
 				Set:           grantHash,
 				ConflictsWith: []string{"acl"},
 				Elem: &schema.Resource{
@@ -131,6 +133,7 @@ func ResourceBucket() *schema.Resource {
 			"cors_rule": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"allowed_headers": {
@@ -164,6 +167,7 @@ func ResourceBucket() *schema.Resource {
 			"website": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -245,6 +249,7 @@ func ResourceBucket() *schema.Resource {
 			"logging": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"target_bucket": {
@@ -269,6 +274,7 @@ func ResourceBucket() *schema.Resource {
 			"lifecycle_rule": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -397,6 +403,7 @@ func ResourceBucket() *schema.Resource {
 			"replication_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -529,6 +536,7 @@ func ResourceBucket() *schema.Resource {
 				Type:     schema.TypeList,
 				MaxItems: 1,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"rule": {
@@ -569,6 +577,7 @@ func ResourceBucket() *schema.Resource {
 			"object_lock_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -582,6 +591,7 @@ func ResourceBucket() *schema.Resource {
 						"rule": {
 							Type:     schema.TypeList,
 							Optional: true,
+							Computed: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -623,7 +633,10 @@ func ResourceBucket() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			replicationDestinationRegionsDiff("replication_configuration.0.rules"),
+		),
 	}
 }
 
@@ -717,78 +730,78 @@ func resourceBucketUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("policy") {
-		if err := resourceBucketPolicyUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalPolicyUpdate(conn, d); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("cors_rule") {
-		if err := resourceBucketCorsUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalCorsUpdate(conn, d); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("website") {
-		if err := resourceBucketWebsiteUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalWebsiteUpdate(conn, d); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("versioning") {
-		if err := resourceBucketVersioningUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalVersioningUpdate(conn, d); err != nil {
 			return err
 		}
 	}
 	if d.HasChange("acl") && !d.IsNewResource() {
-		if err := resourceBucketACLUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalACLUpdate(conn, d); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("grant") {
-		if err := resourceBucketGrantsUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalGrantsUpdate(conn, d); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("logging") {
-		if err := resourceBucketLoggingUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalLoggingUpdate(conn, d); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("lifecycle_rule") {
-		if err := resourceBucketLifecycleUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalLifecycleUpdate(conn, d); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("acceleration_status") {
-		if err := resourceBucketAccelerationUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalAccelerationUpdate(conn, d); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("request_payer") {
-		if err := resourceBucketRequestPayerUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalRequestPayerUpdate(conn, d); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("replication_configuration") {
-		if err := resourceBucketReplicationConfigurationUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalReplicationConfigurationUpdate(conn, d); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("server_side_encryption_configuration") {
-		if err := resourceBucketServerSideEncryptionConfigurationUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalServerSideEncryptionConfigurationUpdate(conn, d); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("object_lock_configuration") {
-		if err := resourceBucketObjectLockConfigurationUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalObjectLockConfigurationUpdate(conn, d); err != nil {
 			return err
 		}
 	}
@@ -878,25 +891,21 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	//Read the Grant ACL. Reset if `acl` (canned ACL) is set.
-	if acl, ok := d.GetOk("acl"); ok && acl.(string) != "private" {
-		if err := d.Set("grant", nil); err != nil {
-			return fmt.Errorf("error resetting grant %s", err)
-		}
-	} else {
-		apResponse, err := verify.RetryOnAWSCode("NoSuchBucket", func() (interface{}, error) {
-			return conn.GetBucketAcl(&s3.GetBucketAclInput{
-				Bucket: aws.String(d.Id()),
-			})
+	// Read the Grant ACL.
+	// The grants are always read, as "grant" is computed when the ACL is
+	// managed by a canned "acl" or by the aws_s3_bucket_acl resource.
+	apResponse, err := verify.RetryOnAWSCode("NoSuchBucket", func() (interface{}, error) {
+		return conn.GetBucketAcl(&s3.GetBucketAclInput{
+			Bucket: aws.String(d.Id()),
 		})
-		if err != nil {
-			return fmt.Errorf("error getting S3 Bucket (%s) ACL: %s", d.Id(), err)
-		}
-		log.Printf("[DEBUG] S3 bucket: %s, read ACL grants policy: %+v", d.Id(), apResponse)
-		grants := flattenGrants(apResponse.(*s3.GetBucketAclOutput))
-		if err := d.Set("grant", schema.NewSet(grantHash, grants)); err != nil {
-			return fmt.Errorf("error setting grant %s", err)
-		}
+	})
+	if err != nil {
+		return fmt.Errorf("error getting S3 Bucket (%s) ACL: %s", d.Id(), err)
+	}
+	log.Printf("[DEBUG] S3 bucket: %s, read ACL grants policy: %+v", d.Id(), apResponse)
+	grants := flattenGrants(apResponse.(*s3.GetBucketAclOutput))
+	if err := d.Set("grant", schema.NewSet(grantHash, grants)); err != nil {
+		return fmt.Errorf("error setting grant %s", err)
 	}
 
 	// Read the CORS
@@ -1315,6 +1324,9 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 		if err := d.Set("website_domain", websiteEndpoint.Domain); err != nil {
 			return err
 		}
+	} else {
+		d.Set("website_endpoint", "")
+		d.Set("website_domain", "")
 	}
 
 	// Retry due to S3 eventual consistency
@@ -1400,7 +1412,7 @@ func resourceBucketDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceBucketPolicyUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalPolicyUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	policy := d.Get("policy").(string)
 
@@ -1444,13 +1456,13 @@ func resourceBucketPolicyUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-func resourceBucketGrantsUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalGrantsUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	rawGrants := d.Get("grant").(*schema.Set).List()
 
 	if len(rawGrants) == 0 {
 		log.Printf("[DEBUG] S3 bucket: %s, Grants fallback to canned ACL", bucket)
-		if err := resourceBucketACLUpdate(conn, d); err != nil {
+		if err := resourceBucketInternalACLUpdate(conn, d); err != nil {
 			return fmt.Errorf("Error fallback to canned ACL, %s", err)
 		}
 	} else {
@@ -1512,7 +1524,7 @@ func resourceBucketGrantsUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-func resourceBucketCorsUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalCorsUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	rawCors := d.Get("cors_rule").([]interface{})
 
@@ -1578,11 +1590,11 @@ func resourceBucketCorsUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-func resourceBucketWebsiteUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalWebsiteUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	ws := d.Get("website").([]interface{})
 
 	if len(ws) == 0 {
-		return resourceBucketWebsiteDelete(conn, d)
+		return resourceBucketInternalWebsiteDelete(conn, d)
 	}
 
 	var w map[string]interface{}
@@ -1591,10 +1603,10 @@ func resourceBucketWebsiteUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	} else {
 		w = make(map[string]interface{})
 	}
-	return resourceBucketWebsitePut(conn, d, w)
+	return resourceBucketInternalWebsitePut(conn, d, w)
 }
 
-func resourceBucketWebsitePut(conn *s3.S3, d *schema.ResourceData, website map[string]interface{}) error {
+func resourceBucketInternalWebsitePut(conn *s3.S3, d *schema.ResourceData, website map[string]interface{}) error {
 	bucket := d.Get("bucket").(string)
 
 	var indexDocument, errorDocument, redirectAllRequestsTo, routingRules string
@@ -1668,7 +1680,7 @@ func resourceBucketWebsitePut(conn *s3.S3, d *schema.ResourceData, website map[s
 	return nil
 }
 
-func resourceBucketWebsiteDelete(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalWebsiteDelete(conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	deleteInput := &s3.DeleteBucketWebsiteInput{Bucket: aws.String(bucket)}
 
@@ -1694,8 +1706,12 @@ func websiteEndpoint(client *conns.AWSClient, d *schema.ResourceData) (*S3Websit
 		return nil, nil
 	}
 
-	bucket := d.Get("bucket").(string)
+	return bucketWebsiteEndpoint(client, d.Get("bucket").(string))
+}
 
+// bucketWebsiteEndpoint returns the website endpoint of the specified bucket,
+// looking up the bucket's region.
+func bucketWebsiteEndpoint(client *conns.AWSClient, bucket string) (*S3Website, error) {
 	// Lookup the region for this bucket
 
 	locationResponse, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
@@ -1768,10 +1784,14 @@ func isOldRegion(region string) bool {
 	return false
 }
 
-func resourceBucketACLUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalACLUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	acl := d.Get("acl").(string)
 	bucket := d.Get("bucket").(string)
 
+	if acl == "" {
+		acl = s3.BucketCannedACLPrivate
+	}
+
 	i := &s3.PutBucketAclInput{
 		Bucket: aws.String(bucket),
 		ACL:    aws.String(acl),
@@ -1788,7 +1808,7 @@ func resourceBucketACLUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-func resourceBucketVersioningUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalVersioningUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	v := d.Get("versioning").([]interface{})
 	bucket := d.Get("bucket").(string)
 	vc := &s3.VersioningConfiguration{}
@@ -1828,7 +1848,7 @@ func resourceBucketVersioningUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-func resourceBucketLoggingUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalLoggingUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	logging := d.Get("logging").(*schema.Set).List()
 	bucket := d.Get("bucket").(string)
 	loggingStatus := &s3.BucketLoggingStatus{}
@@ -1863,7 +1883,7 @@ func resourceBucketLoggingUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-func resourceBucketAccelerationUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalAccelerationUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	enableAcceleration := d.Get("acceleration_status").(string)
 
@@ -1885,7 +1905,7 @@ func resourceBucketAccelerationUpdate(conn *s3.S3, d *schema.ResourceData) error
 	return nil
 }
 
-func resourceBucketRequestPayerUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalRequestPayerUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	payer := d.Get("request_payer").(string)
 
@@ -1907,7 +1927,7 @@ func resourceBucketRequestPayerUpdate(conn *s3.S3, d *schema.ResourceData) error
 	return nil
 }
 
-func resourceBucketServerSideEncryptionConfigurationUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalServerSideEncryptionConfigurationUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	serverSideEncryptionConfiguration := d.Get("server_side_encryption_configuration").([]interface{})
 	if len(serverSideEncryptionConfiguration) == 0 {
@@ -1974,7 +1994,7 @@ func resourceBucketServerSideEncryptionConfigurationUpdate(conn *s3.S3, d *schem
 	return nil
 }
 
-func resourceBucketObjectLockConfigurationUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalObjectLockConfigurationUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	// S3 Object Lock configuration cannot be deleted, only updated.
 	req := &s3.PutObjectLockConfigurationInput{
 		Bucket:                  aws.String(d.Get("bucket").(string)),
@@ -1991,7 +2011,7 @@ func resourceBucketObjectLockConfigurationUpdate(conn *s3.S3, d *schema.Resource
 	return nil
 }
 
-func resourceBucketReplicationConfigurationUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalReplicationConfigurationUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	replicationConfiguration := d.Get("replication_configuration").([]interface{})
 
@@ -2152,7 +2172,7 @@ func resourceBucketReplicationConfigurationUpdate(conn *s3.S3, d *schema.Resourc
 	return nil
 }
 
-func resourceBucketLifecycleUpdate(conn *s3.S3, d *schema.ResourceData) error {
+func resourceBucketInternalLifecycleUpdate(conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 
 	lifecycleRules := d.Get("lifecycle_rule").([]interface{})
@@ -0,0 +1,148 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceBucketAccelerateConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketAccelerateConfigurationCreate,
+		Read:   resourceBucketAccelerateConfigurationRead,
+		Update: resourceBucketAccelerateConfigurationUpdate,
+		Delete: resourceBucketAccelerateConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(s3.BucketAccelerateStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceBucketAccelerateConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketAccelerateConfigurationInput{
+		Bucket: aws.String(bucket),
+		AccelerateConfiguration: &s3.AccelerateConfiguration{
+			Status: aws.String(d.Get("status").(string)),
+		},
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(propagationTimeout, func() (interface{}, error) {
+		return conn.PutBucketAccelerateConfiguration(input)
+	}, s3.ErrCodeNoSuchBucket)
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) Accelerate Configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketAccelerateConfigurationRead(d, meta)
+}
+
+func resourceBucketAccelerateConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.GetBucketAccelerateConfigurationInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetBucketAccelerateConfiguration(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket Accelerate Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Accelerate Configuration: %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Accelerate Configuration: empty response", d.Id())
+	}
+
+	if output.Status == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading S3 Bucket (%s) Accelerate Configuration: empty status", d.Id())
+		}
+
+		log.Printf("[WARN] S3 Bucket Accelerate Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("status", output.Status)
+
+	return nil
+}
+
+func resourceBucketAccelerateConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.PutBucketAccelerateConfigurationInput{
+		Bucket: aws.String(d.Id()),
+		AccelerateConfiguration: &s3.AccelerateConfiguration{
+			Status: aws.String(d.Get("status").(string)),
+		},
+	}
+
+	_, err := conn.PutBucketAccelerateConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) Accelerate Configuration: %w", d.Id(), err)
+	}
+
+	return resourceBucketAccelerateConfigurationRead(d, meta)
+}
+
+func resourceBucketAccelerateConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	// Transfer acceleration cannot be removed from a bucket, only suspended.
+	input := &s3.PutBucketAccelerateConfigurationInput{
+		Bucket: aws.String(d.Id()),
+		AccelerateConfiguration: &s3.AccelerateConfiguration{
+			Status: aws.String(s3.BucketAccelerateStatusSuspended),
+		},
+	}
+
+	_, err := conn.PutBucketAccelerateConfiguration(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Accelerate Configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,289 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceBucketACL() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketACLCreate,
+		Read:   resourceBucketACLRead,
+		Update: resourceBucketACLUpdate,
+		Delete: resourceBucketACLDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_control_policy": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"access_control_policy", "acl"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"grant": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"grantee": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"display_name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"email_address": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"id": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(s3.Type_Values(), false),
+												},
+												"uri": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"permission": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.Permission_Values(), false),
+									},
+								},
+							},
+						},
+						"owner": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"display_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"access_control_policy", "acl"},
+				ValidateFunc: validation.StringInSlice(BucketCannedACL_Values(), false),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+		},
+	}
+}
+
+func resourceBucketACLCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketAclInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if v, ok := d.GetOk("acl"); ok {
+		input.ACL = aws.String(v.(string))
+	} else {
+		input.AccessControlPolicy = expandBucketAccessControlPolicy(d.Get("access_control_policy").([]interface{}))
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(propagationTimeout, func() (interface{}, error) {
+		return conn.PutBucketAcl(input)
+	}, s3.ErrCodeNoSuchBucket)
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) ACL: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketACLRead(d, meta)
+}
+
+func resourceBucketACLRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.GetBucketAclInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetBucketAcl(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket ACL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) ACL: %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) ACL: empty response", d.Id())
+	}
+
+	d.Set("bucket", d.Id())
+
+	if err := d.Set("access_control_policy", flattenBucketAccessControlPolicy(output)); err != nil {
+		return fmt.Errorf("error setting access_control_policy: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBucketACLUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.PutBucketAclInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	if d.HasChange("acl") {
+		if v, ok := d.GetOk("acl"); ok {
+			input.ACL = aws.String(v.(string))
+		}
+	}
+
+	if input.ACL == nil {
+		input.AccessControlPolicy = expandBucketAccessControlPolicy(d.Get("access_control_policy").([]interface{}))
+	}
+
+	_, err := conn.PutBucketAcl(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) ACL: %w", d.Id(), err)
+	}
+
+	return resourceBucketACLRead(d, meta)
+}
+
+func resourceBucketACLDelete(d *schema.ResourceData, meta interface{}) error {
+	// A bucket always has an ACL, so deleting this resource only removes it from state.
+	log.Printf("[WARN] Cannot destroy S3 Bucket ACL (%s). Terraform will remove this resource from the state file, however the ACL will remain on the bucket.", d.Id())
+
+	return nil
+}
+
+func expandBucketAccessControlPolicy(tfList []interface{}) *s3.AccessControlPolicy {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	apiObject := &s3.AccessControlPolicy{}
+
+	if v, ok := tfMap["grant"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			grant := &s3.Grant{
+				Grantee: expandBucketGrantee(tfMap["grantee"].([]interface{})),
+			}
+
+			if v, ok := tfMap["permission"].(string); ok && v != "" {
+				grant.Permission = aws.String(v)
+			}
+
+			apiObject.Grants = append(apiObject.Grants, grant)
+		}
+	}
+
+	if v, ok := tfMap["owner"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Owner = &s3.Owner{
+			ID: aws.String(tfMap["id"].(string)),
+		}
+
+		if v, ok := tfMap["display_name"].(string); ok && v != "" {
+			apiObject.Owner.DisplayName = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenBucketAccessControlPolicy(output *s3.GetBucketAclOutput) []interface{} {
+	if output == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	var grants []interface{}
+
+	for _, apiObject := range output.Grants {
+		if apiObject == nil {
+			continue
+		}
+
+		grants = append(grants, map[string]interface{}{
+			"grantee":    flattenBucketGrantee(apiObject.Grantee),
+			"permission": aws.StringValue(apiObject.Permission),
+		})
+	}
+
+	tfMap["grant"] = grants
+
+	if v := output.Owner; v != nil {
+		tfMap["owner"] = []interface{}{
+			map[string]interface{}{
+				"display_name": aws.StringValue(v.DisplayName),
+				"id":           aws.StringValue(v.ID),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -13,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -263,17 +262,7 @@ func ExpandAnalyticsFilter(l []interface{}) *s3.AnalyticsFilter {
 		return nil
 	}
 
-	m := l[0].(map[string]interface{})
-
-	var prefix string
-	if v, ok := m["prefix"]; ok {
-		prefix = v.(string)
-	}
-
-	var tags []*s3.Tag
-	if v, ok := m["tags"]; ok {
-		tags = Tags(tftags.New(v).IgnoreAWS())
-	}
+	prefix, tags := expandFilterPrefixAndTags(l[0].(map[string]interface{}))
 
 	if prefix == "" && len(tags) == 0 {
 		return nil
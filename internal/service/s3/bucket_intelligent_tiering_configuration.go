@@ -0,0 +1,346 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceBucketIntelligentTieringConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketIntelligentTieringConfigurationPut,
+		Read:   resourceBucketIntelligentTieringConfigurationRead,
+		Update: resourceBucketIntelligentTieringConfigurationPut,
+		Delete: resourceBucketIntelligentTieringConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							AtLeastOneOf: filterAtLeastOneOfKeys,
+						},
+						"tags": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+							AtLeastOneOf: filterAtLeastOneOfKeys,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      s3.IntelligentTieringStatusEnabled,
+				ValidateFunc: validation.StringInSlice(s3.IntelligentTieringStatus_Values(), false),
+			},
+			"tiering": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_tier": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(s3.IntelligentTieringAccessTier_Values(), false),
+						},
+						"days": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBucketIntelligentTieringConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+	name := d.Get("name").(string)
+
+	intelligentTieringConfiguration := &s3.IntelligentTieringConfiguration{
+		Id:       aws.String(name),
+		Status:   aws.String(d.Get("status").(string)),
+		Tierings: expandTierings(d.Get("tiering").(*schema.Set).List()),
+	}
+
+	if v, ok := d.GetOk("filter"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		intelligentTieringConfiguration.Filter = expandIntelligentTieringFilter(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	input := &s3.PutBucketIntelligentTieringConfigurationInput{
+		Bucket:                          aws.String(bucket),
+		Id:                              aws.String(name),
+		IntelligentTieringConfiguration: intelligentTieringConfiguration,
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket Intelligent-Tiering Configuration: %s", input)
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(propagationTimeout, func() (interface{}, error) {
+		return conn.PutBucketIntelligentTieringConfiguration(input)
+	}, s3.ErrCodeNoSuchBucket)
+
+	if err != nil {
+		return fmt.Errorf("error putting S3 Bucket (%s) Intelligent-Tiering Configuration (%s): %w", bucket, name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", bucket, name))
+
+	return resourceBucketIntelligentTieringConfigurationRead(d, meta)
+}
+
+func resourceBucketIntelligentTieringConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket, name, err := BucketIntelligentTieringConfigurationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &s3.GetBucketIntelligentTieringConfigurationInput{
+		Bucket: aws.String(bucket),
+		Id:     aws.String(name),
+	}
+
+	output, err := conn.GetBucketIntelligentTieringConfiguration(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket Intelligent-Tiering Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeNoSuchConfiguration) {
+		log.Printf("[WARN] S3 Bucket Intelligent-Tiering Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket Intelligent-Tiering Configuration (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.IntelligentTieringConfiguration == nil {
+		return fmt.Errorf("error reading S3 Bucket Intelligent-Tiering Configuration (%s): empty response", d.Id())
+	}
+
+	intelligentTieringConfiguration := output.IntelligentTieringConfiguration
+
+	d.Set("bucket", bucket)
+	d.Set("name", name)
+	d.Set("status", intelligentTieringConfiguration.Status)
+
+	if err := d.Set("filter", flattenIntelligentTieringFilter(intelligentTieringConfiguration.Filter)); err != nil {
+		return fmt.Errorf("error setting filter: %w", err)
+	}
+
+	if err := d.Set("tiering", flattenTierings(intelligentTieringConfiguration.Tierings)); err != nil {
+		return fmt.Errorf("error setting tiering: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBucketIntelligentTieringConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket, name, err := BucketIntelligentTieringConfigurationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Intelligent-Tiering Configuration: %s", d.Id())
+	_, err = conn.DeleteBucketIntelligentTieringConfiguration(&s3.DeleteBucketIntelligentTieringConfigurationInput{
+		Bucket: aws.String(bucket),
+		Id:     aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeNoSuchConfiguration) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket Intelligent-Tiering Configuration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func BucketIntelligentTieringConfigurationParseID(id string) (string, string, error) {
+	idParts := strings.Split(id, ":")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BUCKET:NAME", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
+
+func expandIntelligentTieringFilter(tfMap map[string]interface{}) *s3.IntelligentTieringFilter {
+	prefix, tags := expandFilterPrefixAndTags(tfMap)
+
+	if prefix == "" && len(tags) == 0 {
+		return nil
+	}
+
+	apiObject := &s3.IntelligentTieringFilter{}
+
+	if prefix != "" && len(tags) > 0 {
+		apiObject.And = &s3.IntelligentTieringAndOperator{
+			Prefix: aws.String(prefix),
+			Tags:   tags,
+		}
+	} else if len(tags) > 1 {
+		apiObject.And = &s3.IntelligentTieringAndOperator{
+			Tags: tags,
+		}
+	} else if len(tags) == 1 {
+		apiObject.Tag = tags[0]
+	} else {
+		apiObject.Prefix = aws.String(prefix)
+	}
+
+	return apiObject
+}
+
+func expandTiering(tfMap map[string]interface{}) *s3.Tiering {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3.Tiering{}
+
+	if v, ok := tfMap["access_tier"].(string); ok && v != "" {
+		apiObject.AccessTier = aws.String(v)
+	}
+
+	if v, ok := tfMap["days"].(int); ok && v != 0 {
+		apiObject.Days = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandTierings(tfList []interface{}) []*s3.Tiering {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*s3.Tiering
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandTiering(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenIntelligentTieringFilter(apiObject *s3.IntelligentTieringFilter) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.And; v != nil {
+		if v := v.Prefix; v != nil {
+			tfMap["prefix"] = aws.StringValue(v)
+		}
+
+		if v := v.Tags; v != nil {
+			tfMap["tags"] = KeyValueTags(v).IgnoreAWS().Map()
+		}
+	} else if v := apiObject.Prefix; v != nil {
+		tfMap["prefix"] = aws.StringValue(v)
+	} else if v := apiObject.Tag; v != nil {
+		tfMap["tags"] = KeyValueTags([]*s3.Tag{v}).IgnoreAWS().Map()
+	} else {
+		return nil
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTiering(apiObject *s3.Tiering) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AccessTier; v != nil {
+		tfMap["access_tier"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Days; v != nil {
+		tfMap["days"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenTierings(apiObjects []*s3.Tiering) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenTiering(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,321 @@
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+)
+
+func TestAccS3BucketIntelligentTieringConfiguration_basic(t *testing.T) {
+	var itc s3.IntelligentTieringConfiguration
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_intelligent_tiering_configuration.test"
+	bucketResourceName := "aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketIntelligentTieringConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketIntelligentTieringConfigurationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketIntelligentTieringConfigurationExists(resourceName, &itc),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", bucketResourceName, "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", s3.IntelligentTieringStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "tiering.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tiering.*", map[string]string{
+						"access_tier": s3.IntelligentTieringAccessTierDeepArchiveAccess,
+						"days":        "180",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketIntelligentTieringConfiguration_disappears(t *testing.T) {
+	var itc s3.IntelligentTieringConfiguration
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_intelligent_tiering_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketIntelligentTieringConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketIntelligentTieringConfigurationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketIntelligentTieringConfigurationExists(resourceName, &itc),
+					acctest.CheckResourceDisappears(acctest.Provider, tfs3.ResourceBucketIntelligentTieringConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketIntelligentTieringConfiguration_filter(t *testing.T) {
+	var itc s3.IntelligentTieringConfiguration
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_intelligent_tiering_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketIntelligentTieringConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketIntelligentTieringConfigurationFilterPrefixConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketIntelligentTieringConfigurationExists(resourceName, &itc),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", "p1/"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "status", s3.IntelligentTieringStatusDisabled),
+					resource.TestCheckResourceAttr(resourceName, "tiering.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tiering.*", map[string]string{
+						"access_tier": s3.IntelligentTieringAccessTierArchiveAccess,
+						"days":        "90",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBucketIntelligentTieringConfigurationFilterPrefixAndTagsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketIntelligentTieringConfigurationExists(resourceName, &itc),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", "p2/"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.Environment", "test"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.Project", "tiering"),
+					resource.TestCheckResourceAttr(resourceName, "status", s3.IntelligentTieringStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "tiering.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tiering.*", map[string]string{
+						"access_tier": s3.IntelligentTieringAccessTierArchiveAccess,
+						"days":        "125",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tiering.*", map[string]string{
+						"access_tier": s3.IntelligentTieringAccessTierDeepArchiveAccess,
+						"days":        "270",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBucketIntelligentTieringConfigurationFilterTagConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketIntelligentTieringConfigurationExists(resourceName, &itc),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.Environment", "test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketIntelligentTieringConfigurationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_intelligent_tiering_configuration" {
+			continue
+		}
+
+		bucket, name, err := tfs3.BucketIntelligentTieringConfigurationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		input := &s3.GetBucketIntelligentTieringConfigurationInput{
+			Bucket: aws.String(bucket),
+			Id:     aws.String(name),
+		}
+
+		_, err = conn.GetBucketIntelligentTieringConfiguration(input)
+
+		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+			continue
+		}
+
+		if tfawserr.ErrCodeEquals(err, tfs3.ErrCodeNoSuchConfiguration) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Bucket Intelligent-Tiering Configuration (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckBucketIntelligentTieringConfigurationExists(resourceName string, v *s3.IntelligentTieringConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+
+		bucket, name, err := tfs3.BucketIntelligentTieringConfigurationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		input := &s3.GetBucketIntelligentTieringConfigurationInput{
+			Bucket: aws.String(bucket),
+			Id:     aws.String(name),
+		}
+
+		output, err := conn.GetBucketIntelligentTieringConfiguration(input)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.IntelligentTieringConfiguration == nil {
+			return fmt.Errorf("S3 Bucket Intelligent-Tiering Configuration (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output.IntelligentTieringConfiguration
+
+		return nil
+	}
+}
+
+func testAccBucketIntelligentTieringConfigurationConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+
+  tiering {
+    access_tier = "DEEP_ARCHIVE_ACCESS"
+    days        = 180
+  }
+}
+`, rName)
+}
+
+func testAccBucketIntelligentTieringConfigurationFilterPrefixConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+  status = "Disabled"
+
+  filter {
+    prefix = "p1/"
+  }
+
+  tiering {
+    access_tier = "ARCHIVE_ACCESS"
+    days        = 90
+  }
+}
+`, rName)
+}
+
+func testAccBucketIntelligentTieringConfigurationFilterPrefixAndTagsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+
+  filter {
+    prefix = "p2/"
+
+    tags = {
+      Environment = "test"
+      Project     = "tiering"
+    }
+  }
+
+  tiering {
+    access_tier = "ARCHIVE_ACCESS"
+    days        = 125
+  }
+
+  tiering {
+    access_tier = "DEEP_ARCHIVE_ACCESS"
+    days        = 270
+  }
+}
+`, rName)
+}
+
+func testAccBucketIntelligentTieringConfigurationFilterTagConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_intelligent_tiering_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+
+  filter {
+    tags = {
+      Environment = "test"
+    }
+  }
+
+  tiering {
+    access_tier = "DEEP_ARCHIVE_ACCESS"
+    days        = 180
+  }
+}
+`, rName)
+}
@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -20,7 +19,7 @@ import (
 
 func TestAccS3BucketInventory_basic(t *testing.T) {
 	var conf s3.InventoryConfiguration
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	resourceName := "aws_s3_bucket_inventory.test"
 
 	bucketName := fmt.Sprintf("tf-acc-bucket-inventory-%s", rString)
@@ -66,7 +65,7 @@ func TestAccS3BucketInventory_basic(t *testing.T) {
 
 func TestAccS3BucketInventory_encryptWithSSES3(t *testing.T) {
 	var conf s3.InventoryConfiguration
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	resourceName := "aws_s3_bucket_inventory.test"
 
 	bucketName := fmt.Sprintf("tf-acc-bucket-inventory-%s", rString)
@@ -96,7 +95,7 @@ func TestAccS3BucketInventory_encryptWithSSES3(t *testing.T) {
 
 func TestAccS3BucketInventory_encryptWithSSEKMS(t *testing.T) {
 	var conf s3.InventoryConfiguration
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(8)
 	resourceName := "aws_s3_bucket_inventory.test"
 
 	bucketName := fmt.Sprintf("tf-acc-bucket-inventory-%s", rString)
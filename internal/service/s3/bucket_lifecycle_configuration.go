@@ -0,0 +1,604 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceBucketLifecycleConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketLifecycleConfigurationCreate,
+		Read:   resourceBucketLifecycleConfigurationRead,
+		Update: resourceBucketLifecycleConfigurationUpdate,
+		Delete: resourceBucketLifecycleConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"abort_incomplete_multipart_upload": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days_after_initiation": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"expiration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validBucketLifecycleTimestamp,
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"expired_object_delete_marker": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"and": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"tags": tftags.TagsSchema(),
+											},
+										},
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"tag": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"noncurrent_version_expiration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"noncurrent_days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"noncurrent_version_transition": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"noncurrent_days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.TransitionStorageClass_Values(), false),
+									},
+								},
+							},
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(s3.ExpirationStatus_Values(), false),
+						},
+						"transition": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validBucketLifecycleTimestamp,
+									},
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.TransitionStorageClass_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBucketLifecycleConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+
+	rules, err := expandBucketLifecycleRules(d.Get("rule").([]interface{}))
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) Lifecycle Configuration: %w", bucket, err)
+	}
+
+	input := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: rules,
+		},
+	}
+
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(propagationTimeout, func() (interface{}, error) {
+		return conn.PutBucketLifecycleConfiguration(input)
+	}, s3.ErrCodeNoSuchBucket)
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) Lifecycle Configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketLifecycleConfigurationRead(d, meta)
+}
+
+func resourceBucketLifecycleConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	var output *s3.GetBucketLifecycleConfigurationOutput
+
+	// The lifecycle configuration may not be visible immediately after creation.
+	outputRaw, err := tfresource.RetryWhen(propagationTimeout, func() (interface{}, error) {
+		return conn.GetBucketLifecycleConfiguration(input)
+	}, func(err error) (bool, error) {
+		if d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeNoSuchLifecycleConfiguration) {
+			return true, err
+		}
+
+		return false, err
+	})
+
+	if err == nil {
+		output = outputRaw.(*s3.GetBucketLifecycleConfigurationOutput)
+	}
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket Lifecycle Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeNoSuchLifecycleConfiguration) {
+		log.Printf("[WARN] S3 Bucket Lifecycle Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Lifecycle Configuration: %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Lifecycle Configuration: empty response", d.Id())
+	}
+
+	d.Set("bucket", d.Id())
+
+	if err := d.Set("rule", flattenBucketLifecycleRules(output.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBucketLifecycleConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	rules, err := expandBucketLifecycleRules(d.Get("rule").([]interface{}))
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) Lifecycle Configuration: %w", d.Id(), err)
+	}
+
+	input := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(d.Id()),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: rules,
+		},
+	}
+
+	_, err = conn.PutBucketLifecycleConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) Lifecycle Configuration: %w", d.Id(), err)
+	}
+
+	return resourceBucketLifecycleConfigurationRead(d, meta)
+}
+
+func resourceBucketLifecycleConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.DeleteBucketLifecycleInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteBucketLifecycle(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeNoSuchLifecycleConfiguration) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Lifecycle Configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandBucketLifecycleRules(tfList []interface{}) ([]*s3.LifecycleRule, error) {
+	if len(tfList) == 0 {
+		return nil, nil
+	}
+
+	var apiObjects []*s3.LifecycleRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &s3.LifecycleRule{
+			Filter: expandBucketLifecycleRuleFilter(tfMap["filter"].([]interface{})),
+		}
+
+		if v, ok := tfMap["abort_incomplete_multipart_upload"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.AbortIncompleteMultipartUpload = &s3.AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: aws.Int64(int64(v[0].(map[string]interface{})["days_after_initiation"].(int))),
+			}
+		}
+
+		if v, ok := tfMap["expiration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			expiration, err := expandBucketLifecycleExpiration(v[0].(map[string]interface{}))
+
+			if err != nil {
+				return nil, err
+			}
+
+			apiObject.Expiration = expiration
+		}
+
+		if v, ok := tfMap["id"].(string); ok && v != "" {
+			apiObject.ID = aws.String(v)
+		}
+
+		if v, ok := tfMap["noncurrent_version_expiration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.NoncurrentVersionExpiration = &s3.NoncurrentVersionExpiration{
+				NoncurrentDays: aws.Int64(int64(v[0].(map[string]interface{})["noncurrent_days"].(int))),
+			}
+		}
+
+		if v, ok := tfMap["noncurrent_version_transition"].(*schema.Set); ok && v.Len() > 0 {
+			for _, tfMapRaw := range v.List() {
+				tfMap := tfMapRaw.(map[string]interface{})
+
+				apiObject.NoncurrentVersionTransitions = append(apiObject.NoncurrentVersionTransitions, &s3.NoncurrentVersionTransition{
+					NoncurrentDays: aws.Int64(int64(tfMap["noncurrent_days"].(int))),
+					StorageClass:   aws.String(tfMap["storage_class"].(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["status"].(string); ok && v != "" {
+			apiObject.Status = aws.String(v)
+		}
+
+		if v, ok := tfMap["transition"].(*schema.Set); ok && v.Len() > 0 {
+			for _, tfMapRaw := range v.List() {
+				transition, err := expandBucketLifecycleTransition(tfMapRaw.(map[string]interface{}))
+
+				if err != nil {
+					return nil, err
+				}
+
+				apiObject.Transitions = append(apiObject.Transitions, transition)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+func expandBucketLifecycleRuleFilter(tfList []interface{}) *s3.LifecycleRuleFilter {
+	// A rule without a filter applies to all objects in the bucket.
+	apiObject := &s3.LifecycleRuleFilter{
+		Prefix: aws.String(""),
+	}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["and"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		and := v[0].(map[string]interface{})
+
+		return &s3.LifecycleRuleFilter{
+			And: &s3.LifecycleRuleAndOperator{
+				Prefix: aws.String(and["prefix"].(string)),
+				Tags:   Tags(tftags.New(and["tags"]).IgnoreAWS()),
+			},
+		}
+	}
+
+	if v, ok := tfMap["tag"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tag := v[0].(map[string]interface{})
+
+		return &s3.LifecycleRuleFilter{
+			Tag: &s3.Tag{
+				Key:   aws.String(tag["key"].(string)),
+				Value: aws.String(tag["value"].(string)),
+			},
+		}
+	}
+
+	if v, ok := tfMap["prefix"].(string); ok {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandBucketLifecycleExpiration(tfMap map[string]interface{}) (*s3.LifecycleExpiration, error) {
+	apiObject := &s3.LifecycleExpiration{}
+
+	if v, ok := tfMap["date"].(string); ok && v != "" {
+		t, err := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", v))
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing expiration date (%s): %w", v, err)
+		}
+
+		apiObject.Date = aws.Time(t)
+	} else if v, ok := tfMap["days"].(int); ok && v > 0 {
+		apiObject.Days = aws.Int64(int64(v))
+	} else if v, ok := tfMap["expired_object_delete_marker"].(bool); ok {
+		apiObject.ExpiredObjectDeleteMarker = aws.Bool(v)
+	}
+
+	return apiObject, nil
+}
+
+func expandBucketLifecycleTransition(tfMap map[string]interface{}) (*s3.Transition, error) {
+	apiObject := &s3.Transition{}
+
+	if v, ok := tfMap["date"].(string); ok && v != "" {
+		t, err := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", v))
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing transition date (%s): %w", v, err)
+		}
+
+		apiObject.Date = aws.Time(t)
+	} else if v, ok := tfMap["days"].(int); ok && v >= 0 {
+		apiObject.Days = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+		apiObject.StorageClass = aws.String(v)
+	}
+
+	return apiObject, nil
+}
+
+func flattenBucketLifecycleRules(apiObjects []*s3.LifecycleRule) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"filter": flattenBucketLifecycleRuleFilter(apiObject.Filter),
+			"id":     aws.StringValue(apiObject.ID),
+			"status": aws.StringValue(apiObject.Status),
+		}
+
+		if v := apiObject.AbortIncompleteMultipartUpload; v != nil {
+			tfMap["abort_incomplete_multipart_upload"] = []interface{}{
+				map[string]interface{}{
+					"days_after_initiation": int(aws.Int64Value(v.DaysAfterInitiation)),
+				},
+			}
+		}
+
+		if v := apiObject.Expiration; v != nil {
+			m := map[string]interface{}{
+				"days":                         int(aws.Int64Value(v.Days)),
+				"expired_object_delete_marker": aws.BoolValue(v.ExpiredObjectDeleteMarker),
+			}
+
+			if v.Date != nil {
+				m["date"] = aws.TimeValue(v.Date).Format("2006-01-02")
+			}
+
+			tfMap["expiration"] = []interface{}{m}
+		}
+
+		if v := apiObject.NoncurrentVersionExpiration; v != nil {
+			tfMap["noncurrent_version_expiration"] = []interface{}{
+				map[string]interface{}{
+					"noncurrent_days": int(aws.Int64Value(v.NoncurrentDays)),
+				},
+			}
+		}
+
+		if len(apiObject.NoncurrentVersionTransitions) > 0 {
+			var transitions []interface{}
+
+			for _, v := range apiObject.NoncurrentVersionTransitions {
+				transitions = append(transitions, map[string]interface{}{
+					"noncurrent_days": int(aws.Int64Value(v.NoncurrentDays)),
+					"storage_class":   aws.StringValue(v.StorageClass),
+				})
+			}
+
+			tfMap["noncurrent_version_transition"] = transitions
+		}
+
+		if len(apiObject.Transitions) > 0 {
+			var transitions []interface{}
+
+			for _, v := range apiObject.Transitions {
+				m := map[string]interface{}{
+					"days":          int(aws.Int64Value(v.Days)),
+					"storage_class": aws.StringValue(v.StorageClass),
+				}
+
+				if v.Date != nil {
+					m["date"] = aws.TimeValue(v.Date).Format("2006-01-02")
+				}
+
+				transitions = append(transitions, m)
+			}
+
+			tfMap["transition"] = transitions
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenBucketLifecycleRuleFilter(apiObject *s3.LifecycleRuleFilter) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.And; v != nil {
+		tfMap["and"] = []interface{}{
+			map[string]interface{}{
+				"prefix": aws.StringValue(v.Prefix),
+				"tags":   KeyValueTags(v.Tags).IgnoreAWS().Map(),
+			},
+		}
+	}
+
+	if v := apiObject.Prefix; v != nil {
+		tfMap["prefix"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Tag; v != nil {
+		tfMap["tag"] = []interface{}{
+			map[string]interface{}{
+				"key":   aws.StringValue(v.Key),
+				"value": aws.StringValue(v.Value),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
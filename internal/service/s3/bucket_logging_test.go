@@ -0,0 +1,155 @@
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+)
+
+func TestAccS3BucketLogging_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_logging.test"
+	targetBucketResourceName := "aws_s3_bucket.log_bucket"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketLoggingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketLoggingConfig(rName, "log/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketLoggingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bucket", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "target_bucket", targetBucketResourceName, "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "target_prefix", "log/"),
+					resource.TestCheckResourceAttr(resourceName, "target_grant.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBucketLoggingConfig(rName, "tmp/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketLoggingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_prefix", "tmp/"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketLogging_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_logging.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketLoggingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketLoggingConfig(rName, "log/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketLoggingExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfs3.ResourceBucketLogging(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBucketLoggingDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_logging" {
+			continue
+		}
+
+		input := &s3.GetBucketLoggingInput{
+			Bucket: aws.String(rs.Primary.ID),
+		}
+
+		output, err := conn.GetBucketLogging(input)
+
+		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && output.LoggingEnabled != nil {
+			return fmt.Errorf("S3 Bucket Logging (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBucketLoggingExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		input := &s3.GetBucketLoggingInput{
+			Bucket: aws.String(rs.Primary.ID),
+		}
+
+		output, err := conn.GetBucketLogging(input)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.LoggingEnabled == nil {
+			return fmt.Errorf("S3 Bucket Logging (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccBucketLoggingConfig(rName, targetPrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "log_bucket" {
+  bucket = "%[1]s-log"
+  acl    = "log-delivery-write"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_logging" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  target_bucket = aws_s3_bucket.log_bucket.id
+  target_prefix = %[2]q
+}
+`, rName, targetPrefix)
+}
@@ -190,15 +190,7 @@ func resourceBucketMetricRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func ExpandMetricsFilter(m map[string]interface{}) *s3.MetricsFilter {
-	var prefix string
-	if v, ok := m["prefix"]; ok {
-		prefix = v.(string)
-	}
-
-	var tags []*s3.Tag
-	if v, ok := m["tags"]; ok {
-		tags = Tags(tftags.New(v).IgnoreAWS())
-	}
+	prefix, tags := expandFilterPrefixAndTags(m)
 
 	metricsFilter := &s3.MetricsFilter{}
 	if prefix != "" && len(tags) > 0 {
@@ -240,6 +232,22 @@ func FlattenMetricsFilter(metricsFilter *s3.MetricsFilter) map[string]interface{
 	return m
 }
 
+// expandFilterPrefixAndTags returns the prefix and tags of a "filter" block
+// shared by the bucket metric, analytics and Intelligent-Tiering configurations.
+func expandFilterPrefixAndTags(m map[string]interface{}) (string, []*s3.Tag) {
+	var prefix string
+	if v, ok := m["prefix"]; ok {
+		prefix = v.(string)
+	}
+
+	var tags []*s3.Tag
+	if v, ok := m["tags"]; ok {
+		tags = Tags(tftags.New(v).IgnoreAWS())
+	}
+
+	return prefix, tags
+}
+
 func BucketMetricParseID(id string) (string, string, error) {
 	idParts := strings.Split(id, ":")
 	if len(idParts) != 2 {
@@ -12,7 +12,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -267,7 +266,7 @@ func TestBucketMetricParseID(t *testing.T) {
 
 func TestAccS3BucketMetric_basic(t *testing.T) {
 	var conf s3.MetricsConfiguration
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_s3_bucket_metric.test"
 
 	bucketName := fmt.Sprintf("tf-acc-%d", rInt)
@@ -301,7 +300,7 @@ func TestAccS3BucketMetric_basic(t *testing.T) {
 // Disallow Empty filter block
 func TestAccS3BucketMetric_withEmptyFilter(t *testing.T) {
 	var conf s3.MetricsConfiguration
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_s3_bucket_metric.test"
 
 	bucketName := fmt.Sprintf("tf-acc-%d", rInt)
@@ -326,7 +325,7 @@ func TestAccS3BucketMetric_withEmptyFilter(t *testing.T) {
 
 func TestAccS3BucketMetric_withFilterPrefix(t *testing.T) {
 	var conf s3.MetricsConfiguration
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_s3_bucket_metric.test"
 
 	bucketName := fmt.Sprintf("tf-acc-%d", rInt)
@@ -369,7 +368,7 @@ func TestAccS3BucketMetric_withFilterPrefix(t *testing.T) {
 
 func TestAccS3BucketMetric_withFilterPrefixAndMultipleTags(t *testing.T) {
 	var conf s3.MetricsConfiguration
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_s3_bucket_metric.test"
 
 	bucketName := fmt.Sprintf("tf-acc-%d", rInt)
@@ -420,7 +419,7 @@ func TestAccS3BucketMetric_withFilterPrefixAndMultipleTags(t *testing.T) {
 
 func TestAccS3BucketMetric_withFilterPrefixAndSingleTag(t *testing.T) {
 	var conf s3.MetricsConfiguration
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_s3_bucket_metric.test"
 
 	bucketName := fmt.Sprintf("tf-acc-%d", rInt)
@@ -467,7 +466,7 @@ func TestAccS3BucketMetric_withFilterPrefixAndSingleTag(t *testing.T) {
 
 func TestAccS3BucketMetric_withFilterMultipleTags(t *testing.T) {
 	var conf s3.MetricsConfiguration
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_s3_bucket_metric.test"
 
 	bucketName := fmt.Sprintf("tf-acc-%d", rInt)
@@ -516,7 +515,7 @@ func TestAccS3BucketMetric_withFilterMultipleTags(t *testing.T) {
 
 func TestAccS3BucketMetric_withFilterSingleTag(t *testing.T) {
 	var conf s3.MetricsConfiguration
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_s3_bucket_metric.test"
 
 	bucketName := fmt.Sprintf("tf-acc-%d", rInt)
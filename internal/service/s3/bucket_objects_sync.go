@@ -0,0 +1,585 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+const (
+	// Maximum number of keys in a DeleteObjects request.
+	bucketObjectsSyncDeleteBatchSize = 1000
+)
+
+func ResourceBucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketObjectsSyncCreate,
+		Read:   resourceBucketObjectsSyncRead,
+		Update: resourceBucketObjectsSyncUpdate,
+		Delete: resourceBucketObjectsSyncDelete,
+
+		CustomizeDiff: resourceBucketObjectsSyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      s3.ObjectCannedACLPrivate,
+				ValidateFunc: validation.StringInSlice(s3.ObjectCannedACL_Values(), false),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cache_control": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validBucketObjectsSyncPattern,
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"content_types": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"delete_stray_keys": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"etags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"exclude": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validBucketObjectsSyncPattern,
+				},
+			},
+			"include": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validBucketObjectsSyncPattern,
+				},
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(s3manager.DefaultUploadPartSize),
+				ValidateFunc: validation.IntAtLeast(int(s3manager.MinUploadPartSize)),
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"source_hashes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceBucketObjectsSyncCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(bucketObjectsSyncCreateID(d.Get("bucket").(string), d.Get("key_prefix").(string)))
+
+	return resourceBucketObjectsSyncUpdate(d, meta)
+}
+
+func resourceBucketObjectsSyncRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	objects, err := FindObjectETagsByBucketAndKeyPrefix(conn, bucket, keyPrefix)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing S3 objects sync (%s) from state", bucket, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing S3 objects (%s): %w", d.Id(), err)
+	}
+
+	oldHashes := d.Get("source_hashes").(map[string]interface{})
+	oldETags := d.Get("etags").(map[string]interface{})
+	hashes := make(map[string]interface{}, len(oldHashes))
+	etags := make(map[string]interface{}, len(oldETags))
+
+	for key := range oldETags {
+		etag, ok := objects[key]
+
+		// Objects deleted outside Terraform are uploaded again.
+		if !ok {
+			continue
+		}
+
+		hash := oldHashes[key]
+
+		// Objects modified outside Terraform are uploaded again.
+		if etag != oldETags[key] {
+			hash = ""
+		}
+
+		hashes[key] = hash
+		etags[key] = etag
+	}
+
+	if d.Get("delete_stray_keys").(bool) {
+		include := aws.StringValueSlice(flex.ExpandStringList(d.Get("include").([]interface{})))
+		exclude := aws.StringValueSlice(flex.ExpandStringList(d.Get("exclude").([]interface{})))
+
+		for key := range objects {
+			if _, ok := hashes[key]; ok {
+				continue
+			}
+
+			// As with the source files, objects not selected by the include and exclude patterns are ignored.
+			selected, err := bucketObjectsSyncSelected(strings.TrimPrefix(key, keyPrefix), include, exclude)
+
+			if err != nil {
+				return err
+			}
+
+			// An empty hash marks the stray object for deletion.
+			if selected {
+				hashes[key] = ""
+			}
+		}
+	}
+
+	if err := d.Set("etags", etags); err != nil {
+		return fmt.Errorf("error setting etags: %w", err)
+	}
+
+	if err := d.Set("source_hashes", hashes); err != nil {
+		return fmt.Errorf("error setting source_hashes: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBucketObjectsSyncUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	files, err := bucketObjectsSyncSourceFiles(
+		d.Get("source").(string),
+		aws.StringValueSlice(flex.ExpandStringList(d.Get("include").([]interface{}))),
+		aws.StringValueSlice(flex.ExpandStringList(d.Get("exclude").([]interface{}))),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	o, n := d.GetChange("source_hashes")
+	oldHashes := o.(map[string]interface{})
+	newHashes, err := bucketObjectsSyncPlannedHashes(n.(map[string]interface{}), files, keyPrefix)
+
+	if err != nil {
+		return err
+	}
+
+	oldETags, _ := d.GetChange("etags")
+	// Object metadata changes apply to all objects.
+	uploadAll := d.HasChanges("acl", "cache_control", "content_types")
+	contentTypes := d.Get("content_types").(map[string]interface{})
+	cacheControlRules := d.Get("cache_control").([]interface{})
+
+	var uploads []*bucketObjectsSyncUpload
+	var deletes []string
+
+	for key, hash := range newHashes {
+		if !uploadAll && oldHashes[key] == hash {
+			continue
+		}
+
+		relPath := strings.TrimPrefix(key, keyPrefix)
+		filePath, ok := files[relPath]
+
+		if !ok {
+			return fmt.Errorf("error uploading S3 object (%s): source file (%s) not found", key, relPath)
+		}
+
+		cacheControl, err := bucketObjectsSyncCacheControl(relPath, cacheControlRules)
+
+		if err != nil {
+			return err
+		}
+
+		uploads = append(uploads, &bucketObjectsSyncUpload{
+			cacheControl: cacheControl,
+			contentType:  bucketObjectsSyncContentType(relPath, contentTypes),
+			key:          key,
+			path:         filePath,
+		})
+	}
+
+	for key := range oldHashes {
+		if _, ok := newHashes[key]; !ok {
+			deletes = append(deletes, key)
+		}
+	}
+
+	sort.Slice(uploads, func(i, j int) bool { return uploads[i].key < uploads[j].key })
+	sort.Strings(deletes)
+
+	log.Printf("[INFO] Syncing S3 objects (%s): %d to upload, %d to delete", d.Id(), len(uploads), len(deletes))
+
+	uploader := s3manager.NewUploaderWithClient(conn, func(u *s3manager.Uploader) {
+		u.PartSize = int64(d.Get("part_size").(int))
+	})
+
+	uploadedETags, uploadErr := bucketObjectsSyncUploadFiles(uploader, bucket, d.Get("acl").(string), uploads, d.Get("concurrency").(int))
+
+	var deleted []string
+	var deleteErr error
+
+	// Stray objects are only deleted once all uploads succeed.
+	if uploadErr == nil {
+		deleted, deleteErr = bucketObjectsSyncDeleteObjects(conn, bucket, deletes)
+	}
+
+	// Record the objects actually written so that failed uploads and deletions are retried.
+	hashes := make(map[string]interface{}, len(newHashes))
+	etags := make(map[string]interface{}, len(newHashes))
+
+	for key, etag := range oldETags.(map[string]interface{}) {
+		etags[key] = etag
+	}
+
+	for key, hash := range oldHashes {
+		hashes[key] = hash
+	}
+
+	for _, upload := range uploads {
+		if etag, ok := uploadedETags[upload.key]; ok {
+			hashes[upload.key] = newHashes[upload.key]
+			etags[upload.key] = etag
+		} else if _, ok := hashes[upload.key]; ok {
+			hashes[upload.key] = ""
+		}
+	}
+
+	for _, key := range deleted {
+		delete(hashes, key)
+		delete(etags, key)
+	}
+
+	if err := d.Set("etags", etags); err != nil {
+		return fmt.Errorf("error setting etags: %w", err)
+	}
+
+	if err := d.Set("source_hashes", hashes); err != nil {
+		return fmt.Errorf("error setting source_hashes: %w", err)
+	}
+
+	if uploadErr != nil {
+		return fmt.Errorf("error syncing S3 objects (%s): %w", d.Id(), uploadErr)
+	}
+
+	if deleteErr != nil {
+		return fmt.Errorf("error syncing S3 objects (%s): %w", d.Id(), deleteErr)
+	}
+
+	return resourceBucketObjectsSyncRead(d, meta)
+}
+
+func resourceBucketObjectsSyncDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	var keys []string
+
+	for key := range d.Get("etags").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	log.Printf("[DEBUG] Deleting S3 objects (%s): %d objects", d.Id(), len(keys))
+	_, err := bucketObjectsSyncDeleteObjects(conn, d.Get("bucket").(string), keys)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 objects (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceBucketObjectsSyncCustomizeDiff hashes the source files so that the plan shows which keys are uploaded or deleted.
+func resourceBucketObjectsSyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"exclude", "include", "key_prefix", "source"} {
+		if !d.NewValueKnown(key) {
+			if err := d.SetNewComputed("source_hashes"); err != nil {
+				return err
+			}
+
+			return d.SetNewComputed("etags")
+		}
+	}
+
+	keyPrefix := d.Get("key_prefix").(string)
+
+	files, err := bucketObjectsSyncSourceFiles(
+		d.Get("source").(string),
+		aws.StringValueSlice(flex.ExpandStringList(d.Get("include").([]interface{}))),
+		aws.StringValueSlice(flex.ExpandStringList(d.Get("exclude").([]interface{}))),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	hashes, err := bucketObjectsSyncSourceHashes(files, keyPrefix)
+
+	if err != nil {
+		return err
+	}
+
+	oldHashes := d.Get("source_hashes").(map[string]interface{})
+
+	if !bucketObjectsSyncHashesEqual(oldHashes, hashes) {
+		if err := d.SetNew("source_hashes", hashes); err != nil {
+			return err
+		}
+
+		return d.SetNewComputed("etags")
+	}
+
+	for _, key := range []string{"acl", "cache_control", "content_types"} {
+		if d.HasChange(key) {
+			return d.SetNewComputed("etags")
+		}
+	}
+
+	return nil
+}
+
+const bucketObjectsSyncIDSeparator = "/"
+
+// bucketObjectsSyncCreateID returns the resource ID for an S3 objects sync
+func bucketObjectsSyncCreateID(bucket, keyPrefix string) string {
+	return strings.TrimSuffix(strings.Join([]string{bucket, keyPrefix}, bucketObjectsSyncIDSeparator), bucketObjectsSyncIDSeparator)
+}
+
+// FindObjectETagsByBucketAndKeyPrefix returns the ETag of each object in the bucket with the key prefix
+func FindObjectETagsByBucketAndKeyPrefix(conn *s3.S3, bucket, keyPrefix string) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+
+	if keyPrefix != "" {
+		input.Prefix = aws.String(keyPrefix)
+	}
+
+	etags := make(map[string]string)
+
+	err := conn.ListObjectsV2Pages(input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, object := range page.Contents {
+			etags[aws.StringValue(object.Key)] = strings.Trim(aws.StringValue(object.ETag), `"`)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return etags, nil
+}
+
+type bucketObjectsSyncUpload struct {
+	cacheControl string
+	contentType  string
+	key          string
+	path         string
+}
+
+// bucketObjectsSyncUploadFiles uploads the files, at most concurrency at a time, and returns the ETag of each uploaded object by key.
+// Files larger than the uploader's part size are uploaded in parts.
+func bucketObjectsSyncUploadFiles(uploader *s3manager.Uploader, bucket, acl string, uploads []*bucketObjectsSyncUpload, concurrency int) (map[string]string, error) {
+	etags := make(map[string]string, len(uploads))
+	var etagsLock sync.Mutex
+
+	sem := make(chan struct{}, concurrency)
+	var g multierror.Group
+
+	for _, upload := range uploads {
+		upload := upload
+
+		g.Go(func() error {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			file, err := os.Open(upload.path)
+
+			if err != nil {
+				return fmt.Errorf("error opening S3 object (%s) source (%s): %w", upload.key, upload.path, err)
+			}
+
+			defer func() {
+				if err := file.Close(); err != nil {
+					log.Printf("[WARN] Error closing S3 object (%s) source (%s): %s", upload.key, upload.path, err)
+				}
+			}()
+
+			input := &s3manager.UploadInput{
+				ACL:    aws.String(acl),
+				Body:   file,
+				Bucket: aws.String(bucket),
+				Key:    aws.String(upload.key),
+			}
+
+			if upload.cacheControl != "" {
+				input.CacheControl = aws.String(upload.cacheControl)
+			}
+
+			if upload.contentType != "" {
+				input.ContentType = aws.String(upload.contentType)
+			}
+
+			log.Printf("[DEBUG] Uploading S3 object: %s", upload.key)
+			output, err := uploader.Upload(input)
+
+			if err != nil {
+				return fmt.Errorf("error uploading S3 object (%s): %w", upload.key, err)
+			}
+
+			etagsLock.Lock()
+			etags[upload.key] = strings.Trim(aws.StringValue(output.ETag), `"`)
+			etagsLock.Unlock()
+
+			return nil
+		})
+	}
+
+	return etags, g.Wait().ErrorOrNil()
+}
+
+// bucketObjectsSyncDeleteObjects deletes the objects in batches and returns the keys of the deleted objects.
+func bucketObjectsSyncDeleteObjects(conn *s3.S3, bucket string, keys []string) ([]string, error) {
+	var deleted []string
+	var errs *multierror.Error
+
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > bucketObjectsSyncDeleteBatchSize {
+			n = bucketObjectsSyncDeleteBatchSize
+		}
+
+		batch := keys[:n]
+		keys = keys[n:]
+
+		objects := make([]*s3.ObjectIdentifier, 0, len(batch))
+
+		for _, key := range batch {
+			objects = append(objects, &s3.ObjectIdentifier{
+				Key: aws.String(key),
+			})
+		}
+
+		output, err := conn.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+
+		if err != nil {
+			return deleted, err
+		}
+
+		failed := make(map[string]bool, len(output.Errors))
+
+		for _, v := range output.Errors {
+			key := aws.StringValue(v.Key)
+			failed[key] = true
+			errs = multierror.Append(errs, fmt.Errorf("error deleting S3 object (%s): %s: %s", key, aws.StringValue(v.Code), aws.StringValue(v.Message)))
+		}
+
+		for _, key := range batch {
+			if !failed[key] {
+				deleted = append(deleted, key)
+			}
+		}
+	}
+
+	return deleted, errs.ErrorOrNil()
+}
+
+func bucketObjectsSyncHashesEqual(a, b map[string]interface{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for k, v := range a {
+		if w, ok := b[k]; !ok || v != w {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,245 @@
+package s3
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/mitchellh/go-homedir"
+)
+
+// bucketObjectsSyncSourceFiles returns the path of each file below the source directory that is
+// selected by the include and exclude patterns, keyed by its slash-separated relative path.
+func bucketObjectsSyncSourceFiles(source string, include, exclude []string) (map[string]string, error) {
+	root, err := homedir.Expand(source)
+
+	if err != nil {
+		return nil, fmt.Errorf("error expanding homedir in source (%s): %w", source, err)
+	}
+
+	files := make(map[string]string)
+
+	err = filepath.WalkDir(root, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		// Symbolic links are followed to regular files.
+		if entry.Type()&fs.ModeSymlink != 0 {
+			info, err := os.Stat(filePath)
+
+			if err != nil {
+				return err
+			}
+
+			if !info.Mode().IsRegular() {
+				return nil
+			}
+		} else if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(root, filePath)
+
+		if err != nil {
+			return err
+		}
+
+		relPath = filepath.ToSlash(relPath)
+		selected, err := bucketObjectsSyncSelected(relPath, include, exclude)
+
+		if err != nil {
+			return err
+		}
+
+		if selected {
+			files[relPath] = filePath
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading source (%s): %w", source, err)
+	}
+
+	return files, nil
+}
+
+// bucketObjectsSyncSelected returns whether the relative path matches any include pattern, or there are none, and no exclude pattern.
+func bucketObjectsSyncSelected(relPath string, include, exclude []string) (bool, error) {
+	if len(include) > 0 {
+		included := false
+
+		for _, pattern := range include {
+			matched, err := bucketObjectsSyncMatch(pattern, relPath)
+
+			if err != nil {
+				return false, err
+			}
+
+			if matched {
+				included = true
+				break
+			}
+		}
+
+		if !included {
+			return false, nil
+		}
+	}
+
+	for _, pattern := range exclude {
+		matched, err := bucketObjectsSyncMatch(pattern, relPath)
+
+		if err != nil {
+			return false, err
+		}
+
+		if matched {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// bucketObjectsSyncMatch returns whether the slash-separated relative path matches the pattern.
+// Each path segment is matched using path.Match syntax and a "**" segment matches zero or more segments.
+func bucketObjectsSyncMatch(pattern, relPath string) (bool, error) {
+	return bucketObjectsSyncMatchSegments(strings.Split(pattern, "/"), strings.Split(relPath, "/"))
+}
+
+func bucketObjectsSyncMatchSegments(patterns, names []string) (bool, error) {
+	for len(patterns) > 0 {
+		if patterns[0] == "**" {
+			patterns = patterns[1:]
+
+			if len(patterns) == 0 {
+				return true, nil
+			}
+
+			for i := 0; i <= len(names); i++ {
+				matched, err := bucketObjectsSyncMatchSegments(patterns, names[i:])
+
+				if err != nil || matched {
+					return matched, err
+				}
+			}
+
+			return false, nil
+		}
+
+		if len(names) == 0 {
+			return false, nil
+		}
+
+		matched, err := path.Match(patterns[0], names[0])
+
+		if err != nil || !matched {
+			return false, err
+		}
+
+		patterns, names = patterns[1:], names[1:]
+	}
+
+	return len(names) == 0, nil
+}
+
+// bucketObjectsSyncContentType returns the content type for the relative path's extension.
+// Configured content types take precedence over the system MIME types.
+func bucketObjectsSyncContentType(relPath string, contentTypes map[string]interface{}) string {
+	ext := path.Ext(relPath)
+
+	if ext == "" {
+		return ""
+	}
+
+	for _, v := range []string{ext, strings.ToLower(ext)} {
+		if contentType, ok := contentTypes[v].(string); ok {
+			return contentType
+		}
+	}
+
+	return mime.TypeByExtension(ext)
+}
+
+// bucketObjectsSyncCacheControl returns the value of the first cache control rule whose pattern matches the relative path.
+func bucketObjectsSyncCacheControl(relPath string, rules []interface{}) (string, error) {
+	for _, v := range rules {
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		matched, err := bucketObjectsSyncMatch(tfMap["pattern"].(string), relPath)
+
+		if err != nil {
+			return "", err
+		}
+
+		if matched {
+			return tfMap["value"].(string), nil
+		}
+	}
+
+	return "", nil
+}
+
+func bucketObjectsSyncFileMD5(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer file.Close()
+
+	hash := md5.New()
+
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("error hashing %s: %w", filePath, err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// bucketObjectsSyncSourceHashes returns the MD5 hash of each file, keyed by its object key.
+func bucketObjectsSyncSourceHashes(files map[string]string, keyPrefix string) (map[string]interface{}, error) {
+	hashes := make(map[string]interface{}, len(files))
+
+	for relPath, filePath := range files {
+		hash, err := bucketObjectsSyncFileMD5(filePath)
+
+		if err != nil {
+			return nil, err
+		}
+
+		hashes[keyPrefix+relPath] = hash
+	}
+
+	return hashes, nil
+}
+
+// bucketObjectsSyncPlannedHashes returns the planned source hashes.
+// The hashes are unknown at plan time when the source, include or exclude patterns or key prefix are unknown,
+// and are then read as empty, so they are computed from the source files instead.
+func bucketObjectsSyncPlannedHashes(planned map[string]interface{}, files map[string]string, keyPrefix string) (map[string]interface{}, error) {
+	if len(planned) > 0 {
+		return planned, nil
+	}
+
+	return bucketObjectsSyncSourceHashes(files, keyPrefix)
+}
@@ -0,0 +1,227 @@
+package s3
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestBucketObjectsSyncMatch(t *testing.T) {
+	testCases := []struct {
+		pattern  string
+		relPath  string
+		expected bool
+	}{
+		{pattern: "*.html", relPath: "index.html", expected: true},
+		{pattern: "*.html", relPath: "docs/index.html", expected: false},
+		{pattern: "**/*.html", relPath: "index.html", expected: true},
+		{pattern: "**/*.html", relPath: "docs/guide/index.html", expected: true},
+		{pattern: "**/*.html", relPath: "docs/style.css", expected: false},
+		{pattern: "docs/**", relPath: "docs/guide/index.html", expected: true},
+		{pattern: "docs/**", relPath: "blog/index.html", expected: false},
+		{pattern: "docs/**/index.html", relPath: "docs/index.html", expected: true},
+		{pattern: "docs/**/index.html", relPath: "docs/a/b/index.html", expected: true},
+		{pattern: "docs/*/index.html", relPath: "docs/a/b/index.html", expected: false},
+		{pattern: "**", relPath: "a/b/c", expected: true},
+		{pattern: "img/?.png", relPath: "img/a.png", expected: true},
+		{pattern: "img/[ab].png", relPath: "img/c.png", expected: false},
+	}
+
+	for _, testCase := range testCases {
+		got, err := bucketObjectsSyncMatch(testCase.pattern, testCase.relPath)
+
+		if err != nil {
+			t.Errorf("bucketObjectsSyncMatch(%q, %q) unexpected error: %s", testCase.pattern, testCase.relPath, err)
+			continue
+		}
+
+		if got != testCase.expected {
+			t.Errorf("bucketObjectsSyncMatch(%q, %q) = %t, expected %t", testCase.pattern, testCase.relPath, got, testCase.expected)
+		}
+	}
+
+	if _, err := bucketObjectsSyncMatch("img/[a.png", "img/a.png"); err == nil {
+		t.Errorf("bucketObjectsSyncMatch with malformed pattern expected error")
+	}
+}
+
+func TestBucketObjectsSyncSelected(t *testing.T) {
+	testCases := []struct {
+		relPath  string
+		include  []string
+		exclude  []string
+		expected bool
+	}{
+		{relPath: "index.html", expected: true},
+		{relPath: "index.html", include: []string{"**/*.css"}, expected: false},
+		{relPath: "index.html", include: []string{"**/*.css", "*.html"}, expected: true},
+		{relPath: ".git/config", exclude: []string{".git/**"}, expected: false},
+		{relPath: "docs/index.html", include: []string{"docs/**"}, exclude: []string{"**/*.md"}, expected: true},
+		{relPath: "docs/README.md", include: []string{"docs/**"}, exclude: []string{"**/*.md"}, expected: false},
+	}
+
+	for _, testCase := range testCases {
+		got, err := bucketObjectsSyncSelected(testCase.relPath, testCase.include, testCase.exclude)
+
+		if err != nil {
+			t.Errorf("bucketObjectsSyncSelected(%q) unexpected error: %s", testCase.relPath, err)
+			continue
+		}
+
+		if got != testCase.expected {
+			t.Errorf("bucketObjectsSyncSelected(%q, %q, %q) = %t, expected %t", testCase.relPath, testCase.include, testCase.exclude, got, testCase.expected)
+		}
+	}
+}
+
+func TestBucketObjectsSyncContentType(t *testing.T) {
+	contentTypes := map[string]interface{}{
+		".md":   "text/markdown",
+		".html": "text/html; charset=utf-8",
+	}
+
+	testCases := []struct {
+		relPath  string
+		expected string
+	}{
+		{relPath: "README.md", expected: "text/markdown"},
+		{relPath: "docs/INDEX.HTML", expected: "text/html; charset=utf-8"},
+		{relPath: "image.png", expected: "image/png"},
+		{relPath: "LICENSE", expected: ""},
+	}
+
+	for _, testCase := range testCases {
+		if got := bucketObjectsSyncContentType(testCase.relPath, contentTypes); got != testCase.expected {
+			t.Errorf("bucketObjectsSyncContentType(%q) = %q, expected %q", testCase.relPath, got, testCase.expected)
+		}
+	}
+}
+
+func TestBucketObjectsSyncCacheControl(t *testing.T) {
+	rules := []interface{}{
+		map[string]interface{}{
+			"pattern": "**/*.html",
+			"value":   "no-cache",
+		},
+		map[string]interface{}{
+			"pattern": "assets/**",
+			"value":   "max-age=31536000, immutable",
+		},
+		map[string]interface{}{
+			"pattern": "**",
+			"value":   "max-age=3600",
+		},
+	}
+
+	testCases := []struct {
+		relPath  string
+		expected string
+	}{
+		{relPath: "index.html", expected: "no-cache"},
+		{relPath: "assets/index.html", expected: "no-cache"},
+		{relPath: "assets/app.js", expected: "max-age=31536000, immutable"},
+		{relPath: "robots.txt", expected: "max-age=3600"},
+	}
+
+	for _, testCase := range testCases {
+		got, err := bucketObjectsSyncCacheControl(testCase.relPath, rules)
+
+		if err != nil {
+			t.Errorf("bucketObjectsSyncCacheControl(%q) unexpected error: %s", testCase.relPath, err)
+			continue
+		}
+
+		if got != testCase.expected {
+			t.Errorf("bucketObjectsSyncCacheControl(%q) = %q, expected %q", testCase.relPath, got, testCase.expected)
+		}
+	}
+
+	if got, _ := bucketObjectsSyncCacheControl("index.html", nil); got != "" {
+		t.Errorf("bucketObjectsSyncCacheControl without rules = %q, expected empty", got)
+	}
+}
+
+func TestBucketObjectsSyncSourceFiles(t *testing.T) {
+	root := t.TempDir()
+
+	for relPath, content := range map[string]string{
+		"index.html":         "<html></html>",
+		"docs/guide.html":    "<html>guide</html>",
+		"docs/README.md":     "# README",
+		"assets/css/app.css": "body {}",
+	} {
+		filePath := filepath.Join(root, filepath.FromSlash(relPath))
+
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	files, err := bucketObjectsSyncSourceFiles(root, nil, []string{"**/*.md"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]string{
+		"assets/css/app.css": filepath.Join(root, "assets", "css", "app.css"),
+		"docs/guide.html":    filepath.Join(root, "docs", "guide.html"),
+		"index.html":         filepath.Join(root, "index.html"),
+	}
+
+	if !reflect.DeepEqual(files, expected) {
+		t.Errorf("bucketObjectsSyncSourceFiles = %v, expected %v", files, expected)
+	}
+
+	hash, err := bucketObjectsSyncFileMD5(files["index.html"])
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := "c83301425b2ad1d496473a5ff3d9ecca"; hash != expected {
+		t.Errorf("bucketObjectsSyncFileMD5 = %q, expected %q", hash, expected)
+	}
+
+	if _, err := bucketObjectsSyncSourceFiles(filepath.Join(root, "missing"), nil, nil); err == nil {
+		t.Errorf("bucketObjectsSyncSourceFiles with missing source expected error")
+	}
+}
+
+func TestBucketObjectsSyncPlannedHashes(t *testing.T) {
+	root := t.TempDir()
+	filePath := filepath.Join(root, "index.html")
+
+	if err := os.WriteFile(filePath, []byte("<html></html>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	files := map[string]string{"index.html": filePath}
+
+	// Hashes unknown at plan time are read as empty.
+	hashes, err := bucketObjectsSyncPlannedHashes(map[string]interface{}{}, files, "site/")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := map[string]interface{}{"site/index.html": "c83301425b2ad1d496473a5ff3d9ecca"}; !reflect.DeepEqual(hashes, expected) {
+		t.Errorf("bucketObjectsSyncPlannedHashes with unknown hashes = %v, expected %v", hashes, expected)
+	}
+
+	planned := map[string]interface{}{"site/index.html": "planned"}
+	hashes, err = bucketObjectsSyncPlannedHashes(planned, files, "site/")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(hashes, planned) {
+		t.Errorf("bucketObjectsSyncPlannedHashes with known hashes = %v, expected %v", hashes, planned)
+	}
+}
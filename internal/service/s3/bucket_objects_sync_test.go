@@ -0,0 +1,473 @@
+package s3_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+)
+
+func TestAccS3BucketObjectsSync_basic(t *testing.T) {
+	resourceName := "aws_s3_bucket_objects_sync.test"
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := t.TempDir()
+
+	testAccBucketObjectsSyncWriteFiles(t, source, map[string]string{
+		"index.html":      "<html>index</html>",
+		"css/site.css":    "body {}",
+		"docs/guide.html": "<html>guide</html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketObjectsSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketObjectsSyncConfig(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketObjectsSyncExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "acl", "private"),
+					resource.TestCheckResourceAttr(resourceName, "bucket", rName),
+					resource.TestCheckResourceAttr(resourceName, "delete_stray_keys", "false"),
+					resource.TestCheckResourceAttr(resourceName, "etags.%", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "etags.index.html"),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "source", source),
+					resource.TestCheckResourceAttr(resourceName, "source_hashes.%", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "source_hashes.css/site.css"),
+					resource.TestCheckResourceAttrSet(resourceName, "source_hashes.docs/guide.html"),
+					testAccCheckBucketObjectsSyncObjectHeaders(resourceName, "index.html", "text/html; charset=utf-8", ""),
+					testAccCheckBucketObjectsSyncObjectHeaders(resourceName, "css/site.css", "text/css; charset=utf-8", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketObjectsSync_disappears(t *testing.T) {
+	resourceName := "aws_s3_bucket_objects_sync.test"
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := t.TempDir()
+
+	testAccBucketObjectsSyncWriteFiles(t, source, map[string]string{
+		"index.html": "<html>index</html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketObjectsSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketObjectsSyncConfig(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketObjectsSyncExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfs3.ResourceBucketObjectsSync(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketObjectsSync_update(t *testing.T) {
+	resourceName := "aws_s3_bucket_objects_sync.test"
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := t.TempDir()
+
+	testAccBucketObjectsSyncWriteFiles(t, source, map[string]string{
+		"index.html":   "<html>index</html>",
+		"removed.html": "<html>removed</html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketObjectsSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketObjectsSyncKeyPrefixConfig(rName, source, "site/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketObjectsSyncExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "etags.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "etags.site/index.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "etags.site/removed.html"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccBucketObjectsSyncWriteFiles(t, source, map[string]string{
+						"index.html": "<html>updated</html>",
+						"added.html": "<html>added</html>",
+					})
+
+					if err := os.Remove(filepath.Join(source, "removed.html")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccBucketObjectsSyncKeyPrefixConfig(rName, source, "site/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketObjectsSyncExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "etags.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "etags.site/added.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "etags.site/index.html"),
+					resource.TestCheckResourceAttr(resourceName, "source_hashes.%", "2"),
+					testAccCheckBucketObjectsSyncKeyNotExists(resourceName, "site/removed.html"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketObjectsSync_includeExclude(t *testing.T) {
+	resourceName := "aws_s3_bucket_objects_sync.test"
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := t.TempDir()
+
+	testAccBucketObjectsSyncWriteFiles(t, source, map[string]string{
+		"index.html":       "<html>index</html>",
+		"docs/guide.html":  "<html>guide</html>",
+		"docs/README.md":   "# README",
+		"drafts/next.html": "<html>next</html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketObjectsSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketObjectsSyncIncludeExcludeConfig(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketObjectsSyncExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "etags.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "etags.index.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "etags.docs/guide.html"),
+					resource.TestCheckResourceAttr(resourceName, "exclude.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "include.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketObjectsSync_deleteStrayKeys(t *testing.T) {
+	resourceName := "aws_s3_bucket_objects_sync.test"
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := t.TempDir()
+
+	testAccBucketObjectsSyncWriteFiles(t, source, map[string]string{
+		"index.html": "<html>index</html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketObjectsSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketObjectsSyncDeleteStrayKeysConfig(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketObjectsSyncExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delete_stray_keys", "true"),
+					resource.TestCheckResourceAttr(resourceName, "etags.%", "1"),
+					testAccCheckBucketObjectsSyncPutObject(resourceName, "stray.html"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccBucketObjectsSyncDeleteStrayKeysConfig(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketObjectsSyncExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "etags.%", "1"),
+					testAccCheckBucketObjectsSyncKeyNotExists(resourceName, "stray.html"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketObjectsSync_contentTypesAndCacheControl(t *testing.T) {
+	resourceName := "aws_s3_bucket_objects_sync.test"
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := t.TempDir()
+
+	testAccBucketObjectsSyncWriteFiles(t, source, map[string]string{
+		"index.html":    "<html>index</html>",
+		"assets/app.js": "console.log('app');",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketObjectsSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketObjectsSyncContentTypesAndCacheControlConfig(rName, source, "max-age=3600"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketObjectsSyncExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cache_control.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "content_types.%", "1"),
+					testAccCheckBucketObjectsSyncObjectHeaders(resourceName, "index.html", "text/html; charset=utf-8", "no-cache"),
+					testAccCheckBucketObjectsSyncObjectHeaders(resourceName, "assets/app.js", "application/javascript", "max-age=3600"),
+				),
+			},
+			{
+				Config: testAccBucketObjectsSyncContentTypesAndCacheControlConfig(rName, source, "max-age=31536000, immutable"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketObjectsSyncExists(resourceName),
+					testAccCheckBucketObjectsSyncObjectHeaders(resourceName, "index.html", "text/html; charset=utf-8", "no-cache"),
+					testAccCheckBucketObjectsSyncObjectHeaders(resourceName, "assets/app.js", "application/javascript", "max-age=31536000, immutable"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketObjectsSyncDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_objects_sync" {
+			continue
+		}
+
+		objects, err := tfs3.FindObjectETagsByBucketAndKeyPrefix(conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key_prefix"])
+
+		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for k := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "etags.") || k == "etags.%" {
+				continue
+			}
+
+			if key := strings.TrimPrefix(k, "etags."); objects[key] != "" {
+				return fmt.Errorf("S3 Object (%s) still exists in %s", key, rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBucketObjectsSyncExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Bucket Objects Sync ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		objects, err := tfs3.FindObjectETagsByBucketAndKeyPrefix(conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key_prefix"])
+
+		if err != nil {
+			return err
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "etags.") || k == "etags.%" {
+				continue
+			}
+
+			key := strings.TrimPrefix(k, "etags.")
+
+			if got, ok := objects[key]; !ok {
+				return fmt.Errorf("S3 Object (%s) not found in %s", key, rs.Primary.ID)
+			} else if got != v {
+				return fmt.Errorf("S3 Object (%s) ETag = %s, expected %s", key, got, v)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketObjectsSyncKeyNotExists(n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		objects, err := tfs3.FindObjectETagsByBucketAndKeyPrefix(conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key_prefix"])
+
+		if err != nil {
+			return err
+		}
+
+		if _, ok := objects[key]; ok {
+			return fmt.Errorf("S3 Object (%s) still exists in %s", key, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketObjectsSyncObjectHeaders(n, key, contentType, cacheControl string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		output, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(rs.Primary.Attributes["key_prefix"] + key),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error getting S3 Object (%s) metadata: %w", key, err)
+		}
+
+		if got := aws.StringValue(output.ContentType); got != contentType {
+			return fmt.Errorf("S3 Object (%s) Content-Type = %q, expected %q", key, got, contentType)
+		}
+
+		if got := aws.StringValue(output.CacheControl); got != cacheControl {
+			return fmt.Errorf("S3 Object (%s) Cache-Control = %q, expected %q", key, got, cacheControl)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketObjectsSyncPutObject(n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		_, err := conn.PutObject(&s3.PutObjectInput{
+			Body:   strings.NewReader("stray"),
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(rs.Primary.Attributes["key_prefix"] + key),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error putting S3 Object (%s): %w", key, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccBucketObjectsSyncWriteFiles(t *testing.T, dir string, files map[string]string) {
+	for relPath, content := range files {
+		filePath := filepath.Join(dir, filepath.FromSlash(relPath))
+
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testAccBucketObjectsSyncBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccBucketObjectsSyncConfig(rName, source string) string {
+	return acctest.ConfigCompose(testAccBucketObjectsSyncBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_objects_sync" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  source = %[1]q
+}
+`, source))
+}
+
+func testAccBucketObjectsSyncKeyPrefixConfig(rName, source, keyPrefix string) string {
+	return acctest.ConfigCompose(testAccBucketObjectsSyncBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_objects_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = %[2]q
+  source     = %[1]q
+}
+`, source, keyPrefix))
+}
+
+func testAccBucketObjectsSyncIncludeExcludeConfig(rName, source string) string {
+	return acctest.ConfigCompose(testAccBucketObjectsSyncBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_objects_sync" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  exclude = ["drafts/**"]
+  include = ["**/*.html"]
+  source  = %[1]q
+}
+`, source))
+}
+
+func testAccBucketObjectsSyncDeleteStrayKeysConfig(rName, source string) string {
+	return acctest.ConfigCompose(testAccBucketObjectsSyncBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_objects_sync" "test" {
+  bucket            = aws_s3_bucket.test.bucket
+  delete_stray_keys = true
+  source            = %[1]q
+}
+`, source))
+}
+
+func testAccBucketObjectsSyncContentTypesAndCacheControlConfig(rName, source, defaultCacheControl string) string {
+	return acctest.ConfigCompose(testAccBucketObjectsSyncBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_objects_sync" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  source = %[1]q
+
+  cache_control {
+    pattern = "**/*.html"
+    value   = "no-cache"
+  }
+
+  cache_control {
+    pattern = "**"
+    value   = %[2]q
+  }
+
+  content_types = {
+    ".js" = "application/javascript"
+  }
+}
+`, source, defaultCacheControl))
+}
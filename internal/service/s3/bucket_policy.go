@@ -20,6 +20,7 @@ import (
 func ResourceBucketPolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBucketPolicyPut,
+		//lintignore:AWSR004
 		Read:   resourceBucketPolicyRead,
 		Update: resourceBucketPolicyPut,
 		Delete: resourceBucketPolicyDelete,
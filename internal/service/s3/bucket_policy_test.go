@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -15,7 +14,7 @@ import (
 )
 
 func TestAccS3BucketPolicy_basic(t *testing.T) {
-	name := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
+	name := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
 	partition := acctest.Partition()
 
 	expectedPolicyText := fmt.Sprintf(`{
@@ -59,7 +58,7 @@ func TestAccS3BucketPolicy_basic(t *testing.T) {
 }
 
 func TestAccS3BucketPolicy_policyUpdate(t *testing.T) {
-	name := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
+	name := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
 	partition := acctest.Partition()
 
 	expectedPolicyText1 := fmt.Sprintf(`{
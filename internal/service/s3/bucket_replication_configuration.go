@@ -0,0 +1,809 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceBucketReplicationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketReplicationConfigurationCreate,
+		Read:   resourceBucketReplicationConfigurationRead,
+		Update: resourceBucketReplicationConfigurationUpdate,
+		Delete: resourceBucketReplicationConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: replicationDestinationRegionsDiff("rule"),
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1000,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delete_marker_replication": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.DeleteMarkerReplicationStatus_Values(), false),
+									},
+								},
+							},
+						},
+						"destination": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_control_translation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"owner": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(s3.OwnerOverride_Values(), false),
+												},
+											},
+										},
+									},
+									"account": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"bucket": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"encryption_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"replica_kms_key_id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"metrics": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event_threshold": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"minutes": {
+																Type:         schema.TypeInt,
+																Required:     true,
+																ValidateFunc: validation.IntAtLeast(0),
+															},
+														},
+													},
+												},
+												"status": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(s3.MetricsStatus_Values(), false),
+												},
+											},
+										},
+									},
+									"replication_time": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"status": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(s3.ReplicationTimeStatus_Values(), false),
+												},
+												"time": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"minutes": {
+																Type:         schema.TypeInt,
+																Required:     true,
+																ValidateFunc: validation.IntAtLeast(0),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(s3.StorageClass_Values(), false),
+									},
+								},
+							},
+						},
+						"existing_object_replication": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.ExistingObjectReplicationStatus_Values(), false),
+									},
+								},
+							},
+						},
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"and": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"prefix": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(0, 1024),
+												},
+												"tags": tftags.TagsSchema(),
+											},
+										},
+									},
+									"prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 1024),
+									},
+									"tag": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+						"prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1024),
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"source_selection_criteria": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"replica_modifications": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"status": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(s3.ReplicaModificationsStatus_Values(), false),
+												},
+											},
+										},
+									},
+									"sse_kms_encrypted_objects": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"status": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(s3.SseKmsEncryptedObjectsStatus_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(s3.ReplicationRuleStatus_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBucketReplicationConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketReplicationInput{
+		Bucket: aws.String(bucket),
+		ReplicationConfiguration: &s3.ReplicationConfiguration{
+			Role:  aws.String(d.Get("role").(string)),
+			Rules: expandBucketReplicationRules(d.Get("rule").([]interface{})),
+		},
+	}
+
+	err := putBucketReplication(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) Replication Configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketReplicationConfigurationRead(d, meta)
+}
+
+func resourceBucketReplicationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.GetBucketReplicationInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetBucketReplication(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket Replication Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeReplicationConfigurationNotFound) {
+		log.Printf("[WARN] S3 Bucket Replication Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Replication Configuration: %w", d.Id(), err)
+	}
+
+	if output == nil || output.ReplicationConfiguration == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Replication Configuration: empty response", d.Id())
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("role", output.ReplicationConfiguration.Role)
+
+	if err := d.Set("rule", flattenBucketReplicationRules(output.ReplicationConfiguration.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBucketReplicationConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.PutBucketReplicationInput{
+		Bucket: aws.String(d.Id()),
+		ReplicationConfiguration: &s3.ReplicationConfiguration{
+			Role:  aws.String(d.Get("role").(string)),
+			Rules: expandBucketReplicationRules(d.Get("rule").([]interface{})),
+		},
+	}
+
+	err := putBucketReplication(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) Replication Configuration: %w", d.Id(), err)
+	}
+
+	return resourceBucketReplicationConfigurationRead(d, meta)
+}
+
+func resourceBucketReplicationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.DeleteBucketReplicationInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteBucketReplication(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeReplicationConfigurationNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Replication Configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// putBucketReplication retries while the bucket, or the versioning on it, is not yet visible.
+func putBucketReplication(conn *s3.S3, input *s3.PutBucketReplicationInput) error {
+	_, err := tfresource.RetryWhen(propagationTimeout, func() (interface{}, error) {
+		return conn.PutBucketReplication(input)
+	}, func(err error) (bool, error) {
+		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+			return true, err
+		}
+
+		if tfawserr.ErrMessageContains(err, "InvalidRequest", "Versioning must be 'Enabled' on the bucket") {
+			return true, err
+		}
+
+		return false, err
+	})
+
+	return err
+}
+
+// replicationDestinationRegionsDiff returns a CustomizeDiffFunc that returns an error if the Region of the
+// destination bucket of any of the replication rules at the specified key is not permitted by the
+// provider-level allowed_regions or forbidden_regions configuration. Destination buckets which are
+// not yet known or don't yet exist are ignored.
+func replicationDestinationRegionsDiff(rulesKey string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		client := meta.(*conns.AWSClient)
+
+		// Avoid looking up the destination bucket Regions when no Region is restricted.
+		if len(client.AllowedRegions) == 0 && len(client.ForbiddenRegions) == 0 {
+			return nil
+		}
+
+		var rules []interface{}
+
+		switch v := diff.Get(rulesKey).(type) {
+		case *schema.Set:
+			rules = v.List()
+		case []interface{}:
+			rules = v
+		}
+
+		for _, rule := range rules {
+			tfMap, ok := rule.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			destinations, ok := tfMap["destination"].([]interface{})
+
+			if !ok || len(destinations) == 0 || destinations[0] == nil {
+				continue
+			}
+
+			bucketARN, ok := destinations[0].(map[string]interface{})["bucket"].(string)
+
+			if !ok || bucketARN == "" {
+				continue
+			}
+
+			parsedARN, err := arn.Parse(bucketARN)
+
+			if err != nil {
+				continue
+			}
+
+			bucket := parsedARN.Resource
+			region, err := s3manager.GetBucketRegionWithClient(ctx, client.S3Conn, bucket, func(r *request.Request) {
+				r.Config.S3ForcePathStyle = client.S3Conn.Config.S3ForcePathStyle
+				r.Config.Credentials = client.S3Conn.Config.Credentials
+			})
+
+			if tfawserr.ErrCodeEquals(err, "NotFound") {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("error getting replication destination S3 Bucket (%s) Region: %w", bucket, err)
+			}
+
+			if err := client.ValidateRegion(region); err != nil {
+				return fmt.Errorf("replication destination S3 Bucket (%s): %w", bucket, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func expandBucketReplicationRules(tfList []interface{}) []*s3.ReplicationRule {
+	var apiObjects []*s3.ReplicationRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &s3.ReplicationRule{
+			Destination: expandBucketReplicationDestination(tfMap["destination"].([]interface{})),
+			Status:      aws.String(tfMap["status"].(string)),
+		}
+
+		if v, ok := tfMap["existing_object_replication"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ExistingObjectReplication = &s3.ExistingObjectReplication{
+				Status: aws.String(v[0].(map[string]interface{})["status"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["id"].(string); ok && v != "" {
+			apiObject.ID = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_selection_criteria"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.SourceSelectionCriteria = expandBucketReplicationSourceSelectionCriteria(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			// XML schema V2.
+			apiObject.Filter = expandBucketReplicationRuleFilter(v[0].(map[string]interface{}))
+			apiObject.Priority = aws.Int64(int64(tfMap["priority"].(int)))
+			apiObject.DeleteMarkerReplication = &s3.DeleteMarkerReplication{
+				Status: aws.String(s3.DeleteMarkerReplicationStatusDisabled),
+			}
+
+			if v, ok := tfMap["delete_marker_replication"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				apiObject.DeleteMarkerReplication.Status = aws.String(v[0].(map[string]interface{})["status"].(string))
+			}
+		} else {
+			// XML schema V1.
+			apiObject.Prefix = aws.String(tfMap["prefix"].(string))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandBucketReplicationDestination(tfList []interface{}) *s3.Destination {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &s3.Destination{
+		Bucket: aws.String(tfMap["bucket"].(string)),
+	}
+
+	if v, ok := tfMap["access_control_translation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AccessControlTranslation = &s3.AccessControlTranslation{
+			Owner: aws.String(v[0].(map[string]interface{})["owner"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["account"].(string); ok && v != "" {
+		apiObject.Account = aws.String(v)
+	}
+
+	if v, ok := tfMap["encryption_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EncryptionConfiguration = &s3.EncryptionConfiguration{
+			ReplicaKmsKeyID: aws.String(v[0].(map[string]interface{})["replica_kms_key_id"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["metrics"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Metrics = &s3.Metrics{
+			Status: aws.String(tfMap["status"].(string)),
+		}
+
+		if v, ok := tfMap["event_threshold"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Metrics.EventThreshold = &s3.ReplicationTimeValue{
+				Minutes: aws.Int64(int64(v[0].(map[string]interface{})["minutes"].(int))),
+			}
+		}
+	}
+
+	if v, ok := tfMap["replication_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.ReplicationTime = &s3.ReplicationTime{
+			Status: aws.String(tfMap["status"].(string)),
+		}
+
+		if v, ok := tfMap["time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ReplicationTime.Time = &s3.ReplicationTimeValue{
+				Minutes: aws.Int64(int64(v[0].(map[string]interface{})["minutes"].(int))),
+			}
+		}
+	}
+
+	if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+		apiObject.StorageClass = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandBucketReplicationSourceSelectionCriteria(tfMap map[string]interface{}) *s3.SourceSelectionCriteria {
+	apiObject := &s3.SourceSelectionCriteria{}
+
+	if v, ok := tfMap["replica_modifications"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ReplicaModifications = &s3.ReplicaModifications{
+			Status: aws.String(v[0].(map[string]interface{})["status"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["sse_kms_encrypted_objects"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SseKmsEncryptedObjects = &s3.SseKmsEncryptedObjects{
+			Status: aws.String(v[0].(map[string]interface{})["status"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandBucketReplicationRuleFilter(tfMap map[string]interface{}) *s3.ReplicationRuleFilter {
+	if v, ok := tfMap["and"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		and := v[0].(map[string]interface{})
+
+		return &s3.ReplicationRuleFilter{
+			And: &s3.ReplicationRuleAndOperator{
+				Prefix: aws.String(and["prefix"].(string)),
+				Tags:   Tags(tftags.New(and["tags"]).IgnoreAWS()),
+			},
+		}
+	}
+
+	if v, ok := tfMap["tag"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tag := v[0].(map[string]interface{})
+
+		return &s3.ReplicationRuleFilter{
+			Tag: &s3.Tag{
+				Key:   aws.String(tag["key"].(string)),
+				Value: aws.String(tag["value"].(string)),
+			},
+		}
+	}
+
+	return &s3.ReplicationRuleFilter{
+		Prefix: aws.String(tfMap["prefix"].(string)),
+	}
+}
+
+func flattenBucketReplicationRules(apiObjects []*s3.ReplicationRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"destination": flattenBucketReplicationDestination(apiObject.Destination),
+			"id":          aws.StringValue(apiObject.ID),
+			"prefix":      aws.StringValue(apiObject.Prefix),
+			"priority":    int(aws.Int64Value(apiObject.Priority)),
+			"status":      aws.StringValue(apiObject.Status),
+		}
+
+		if v := apiObject.DeleteMarkerReplication; v != nil {
+			tfMap["delete_marker_replication"] = []interface{}{
+				map[string]interface{}{
+					"status": aws.StringValue(v.Status),
+				},
+			}
+		}
+
+		if v := apiObject.ExistingObjectReplication; v != nil {
+			tfMap["existing_object_replication"] = []interface{}{
+				map[string]interface{}{
+					"status": aws.StringValue(v.Status),
+				},
+			}
+		}
+
+		if v := apiObject.Filter; v != nil {
+			tfMap["filter"] = flattenBucketReplicationRuleFilter(v)
+		}
+
+		if v := apiObject.SourceSelectionCriteria; v != nil {
+			m := map[string]interface{}{}
+
+			if v := v.ReplicaModifications; v != nil {
+				m["replica_modifications"] = []interface{}{
+					map[string]interface{}{
+						"status": aws.StringValue(v.Status),
+					},
+				}
+			}
+
+			if v := v.SseKmsEncryptedObjects; v != nil {
+				m["sse_kms_encrypted_objects"] = []interface{}{
+					map[string]interface{}{
+						"status": aws.StringValue(v.Status),
+					},
+				}
+			}
+
+			tfMap["source_selection_criteria"] = []interface{}{m}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenBucketReplicationDestination(apiObject *s3.Destination) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"account":       aws.StringValue(apiObject.Account),
+		"bucket":        aws.StringValue(apiObject.Bucket),
+		"storage_class": aws.StringValue(apiObject.StorageClass),
+	}
+
+	if v := apiObject.AccessControlTranslation; v != nil {
+		tfMap["access_control_translation"] = []interface{}{
+			map[string]interface{}{
+				"owner": aws.StringValue(v.Owner),
+			},
+		}
+	}
+
+	if v := apiObject.EncryptionConfiguration; v != nil {
+		tfMap["encryption_configuration"] = []interface{}{
+			map[string]interface{}{
+				"replica_kms_key_id": aws.StringValue(v.ReplicaKmsKeyID),
+			},
+		}
+	}
+
+	if v := apiObject.Metrics; v != nil {
+		m := map[string]interface{}{
+			"status": aws.StringValue(v.Status),
+		}
+
+		if v := v.EventThreshold; v != nil {
+			m["event_threshold"] = []interface{}{
+				map[string]interface{}{
+					"minutes": int(aws.Int64Value(v.Minutes)),
+				},
+			}
+		}
+
+		tfMap["metrics"] = []interface{}{m}
+	}
+
+	if v := apiObject.ReplicationTime; v != nil {
+		m := map[string]interface{}{
+			"status": aws.StringValue(v.Status),
+		}
+
+		if v := v.Time; v != nil {
+			m["time"] = []interface{}{
+				map[string]interface{}{
+					"minutes": int(aws.Int64Value(v.Minutes)),
+				},
+			}
+		}
+
+		tfMap["replication_time"] = []interface{}{m}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenBucketReplicationRuleFilter(apiObject *s3.ReplicationRuleFilter) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.And; v != nil {
+		tfMap["and"] = []interface{}{
+			map[string]interface{}{
+				"prefix": aws.StringValue(v.Prefix),
+				"tags":   KeyValueTags(v.Tags).IgnoreAWS().Map(),
+			},
+		}
+	}
+
+	if v := apiObject.Prefix; v != nil {
+		tfMap["prefix"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Tag; v != nil {
+		tfMap["tag"] = []interface{}{
+			map[string]interface{}{
+				"key":   aws.StringValue(v.Key),
+				"value": aws.StringValue(v.Value),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
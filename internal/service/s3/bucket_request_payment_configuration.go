@@ -0,0 +1,138 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceBucketRequestPaymentConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketRequestPaymentConfigurationCreate,
+		Read:   resourceBucketRequestPaymentConfigurationRead,
+		Update: resourceBucketRequestPaymentConfigurationUpdate,
+		Delete: resourceBucketRequestPaymentConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"payer": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(s3.Payer_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceBucketRequestPaymentConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketRequestPaymentInput{
+		Bucket: aws.String(bucket),
+		RequestPaymentConfiguration: &s3.RequestPaymentConfiguration{
+			Payer: aws.String(d.Get("payer").(string)),
+		},
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(propagationTimeout, func() (interface{}, error) {
+		return conn.PutBucketRequestPayment(input)
+	}, s3.ErrCodeNoSuchBucket)
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) Request Payment Configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketRequestPaymentConfigurationRead(d, meta)
+}
+
+func resourceBucketRequestPaymentConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.GetBucketRequestPaymentInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetBucketRequestPayment(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket Request Payment Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Request Payment Configuration: %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Request Payment Configuration: empty response", d.Id())
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("payer", output.Payer)
+
+	return nil
+}
+
+func resourceBucketRequestPaymentConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.PutBucketRequestPaymentInput{
+		Bucket: aws.String(d.Id()),
+		RequestPaymentConfiguration: &s3.RequestPaymentConfiguration{
+			Payer: aws.String(d.Get("payer").(string)),
+		},
+	}
+
+	_, err := conn.PutBucketRequestPayment(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) Request Payment Configuration: %w", d.Id(), err)
+	}
+
+	return resourceBucketRequestPaymentConfigurationRead(d, meta)
+}
+
+func resourceBucketRequestPaymentConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	// The request payment configuration cannot be removed, only reset to the bucket owner.
+	input := &s3.PutBucketRequestPaymentInput{
+		Bucket: aws.String(d.Id()),
+		RequestPaymentConfiguration: &s3.RequestPaymentConfiguration{
+			Payer: aws.String(s3.PayerBucketOwner),
+		},
+	}
+
+	_, err := conn.PutBucketRequestPayment(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Request Payment Configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,235 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceBucketServerSideEncryptionConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketServerSideEncryptionConfigurationCreate,
+		Read:   resourceBucketServerSideEncryptionConfigurationRead,
+		Update: resourceBucketServerSideEncryptionConfigurationUpdate,
+		Delete: resourceBucketServerSideEncryptionConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"rule": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_server_side_encryption_by_default": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"kms_master_key_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"sse_algorithm": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.ServerSideEncryption_Values(), false),
+									},
+								},
+							},
+						},
+						"bucket_key_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBucketServerSideEncryptionConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketEncryptionInput{
+		Bucket: aws.String(bucket),
+		ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+			Rules: expandBucketServerSideEncryptionRules(d.Get("rule").(*schema.Set).List()),
+		},
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(propagationTimeout, func() (interface{}, error) {
+		return conn.PutBucketEncryption(input)
+	}, s3.ErrCodeNoSuchBucket, ErrCodeOperationAborted)
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) Server-side Encryption Configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketServerSideEncryptionConfigurationRead(d, meta)
+}
+
+func resourceBucketServerSideEncryptionConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.GetBucketEncryptionInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetBucketEncryption(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket Server-side Encryption Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeServerSideEncryptionConfigurationNotFound) {
+		log.Printf("[WARN] S3 Bucket Server-side Encryption Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Server-side Encryption Configuration: %w", d.Id(), err)
+	}
+
+	if output == nil || output.ServerSideEncryptionConfiguration == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Server-side Encryption Configuration: empty response", d.Id())
+	}
+
+	d.Set("bucket", d.Id())
+
+	if err := d.Set("rule", flattenBucketServerSideEncryptionRules(output.ServerSideEncryptionConfiguration.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBucketServerSideEncryptionConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.PutBucketEncryptionInput{
+		Bucket: aws.String(d.Id()),
+		ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+			Rules: expandBucketServerSideEncryptionRules(d.Get("rule").(*schema.Set).List()),
+		},
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(propagationTimeout, func() (interface{}, error) {
+		return conn.PutBucketEncryption(input)
+	}, ErrCodeOperationAborted)
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) Server-side Encryption Configuration: %w", d.Id(), err)
+	}
+
+	return resourceBucketServerSideEncryptionConfigurationRead(d, meta)
+}
+
+func resourceBucketServerSideEncryptionConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.DeleteBucketEncryptionInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteBucketEncryption(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeServerSideEncryptionConfigurationNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Server-side Encryption Configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandBucketServerSideEncryptionRules(tfList []interface{}) []*s3.ServerSideEncryptionRule {
+	var apiObjects []*s3.ServerSideEncryptionRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &s3.ServerSideEncryptionRule{}
+
+		if v, ok := tfMap["apply_server_side_encryption_by_default"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.ApplyServerSideEncryptionByDefault = &s3.ServerSideEncryptionByDefault{
+				SSEAlgorithm: aws.String(tfMap["sse_algorithm"].(string)),
+			}
+
+			if v, ok := tfMap["kms_master_key_id"].(string); ok && v != "" {
+				apiObject.ApplyServerSideEncryptionByDefault.KMSMasterKeyID = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["bucket_key_enabled"].(bool); ok {
+			apiObject.BucketKeyEnabled = aws.Bool(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenBucketServerSideEncryptionRules(apiObjects []*s3.ServerSideEncryptionRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"bucket_key_enabled": aws.BoolValue(apiObject.BucketKeyEnabled),
+		}
+
+		if v := apiObject.ApplyServerSideEncryptionByDefault; v != nil {
+			tfMap["apply_server_side_encryption_by_default"] = []interface{}{
+				map[string]interface{}{
+					"kms_master_key_id": aws.StringValue(v.KMSMasterKeyID),
+					"sse_algorithm":     aws.StringValue(v.SSEAlgorithm),
+				},
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
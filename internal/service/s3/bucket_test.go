@@ -19,7 +19,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -32,7 +31,7 @@ import (
 )
 
 func TestAccS3Bucket_Basic_basic(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	region := acctest.Region()
 	hostedZoneID, _ := tfs3.HostedZoneIDForRegion(region)
 	resourceName := "aws_s3_bucket.bucket"
@@ -96,7 +95,7 @@ func TestAccS3Bucket_Basic_emptyString(t *testing.T) {
 
 func TestAccS3Bucket_Tags_withNoSystemTags(t *testing.T) {
 	resourceName := "aws_s3_bucket.bucket"
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -155,7 +154,7 @@ func TestAccS3Bucket_Tags_withNoSystemTags(t *testing.T) {
 
 func TestAccS3Bucket_Tags_withSystemTags(t *testing.T) {
 	resourceName := "aws_s3_bucket.bucket"
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 
 	var stackID string
 
@@ -240,7 +239,7 @@ func TestAccS3Bucket_Tags_withSystemTags(t *testing.T) {
 
 func TestAccS3Bucket_Tags_ignoreTags(t *testing.T) {
 	resourceName := "aws_s3_bucket.bucket"
-	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	bucketName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -284,7 +283,7 @@ func TestAccS3Bucket_Tags_ignoreTags(t *testing.T) {
 }
 
 func TestAccS3Bucket_Tags_basic(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	resourceName := "aws_s3_bucket.bucket1"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -358,7 +357,7 @@ func TestAccS3Bucket_Basic_generatedName(t *testing.T) {
 }
 
 func TestAccS3Bucket_Basic_acceleration(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	resourceName := "aws_s3_bucket.bucket"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -395,7 +394,7 @@ func TestAccS3Bucket_Basic_acceleration(t *testing.T) {
 }
 
 func TestAccS3Bucket_Basic_requestPayer(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	resourceName := "aws_s3_bucket.bucket"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -431,7 +430,7 @@ func TestAccS3Bucket_Basic_requestPayer(t *testing.T) {
 }
 
 func TestAccS3Bucket_Security_policy(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	partition := acctest.Partition()
 	resourceName := "aws_s3_bucket.bucket"
 
@@ -483,7 +482,7 @@ func TestAccS3Bucket_Security_policy(t *testing.T) {
 }
 
 func TestAccS3Bucket_Security_updateACL(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	resourceName := "aws_s3_bucket.bucket"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -517,7 +516,7 @@ func TestAccS3Bucket_Security_updateACL(t *testing.T) {
 }
 
 func TestAccS3Bucket_Security_updateGrant(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	resourceName := "aws_s3_bucket.bucket"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -564,7 +563,7 @@ func TestAccS3Bucket_Security_updateGrant(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccBucketConfig_Basic(bucketName),
+				Config: testAccBucketWithACLUpdateConfig(bucketName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBucketExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "grant.#", "0"),
@@ -575,7 +574,7 @@ func TestAccS3Bucket_Security_updateGrant(t *testing.T) {
 }
 
 func TestAccS3Bucket_Security_aclToGrant(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	resourceName := "aws_s3_bucket.bucket"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -589,7 +588,8 @@ func TestAccS3Bucket_Security_aclToGrant(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBucketExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "acl", "public-read"),
-					resource.TestCheckResourceAttr(resourceName, "grant.#", "0"),
+					// Canned ACL grants: bucket owner FULL_CONTROL and AllUsers READ.
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "2"),
 				),
 			},
 			{
@@ -605,7 +605,7 @@ func TestAccS3Bucket_Security_aclToGrant(t *testing.T) {
 }
 
 func TestAccS3Bucket_Security_grantToACL(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	resourceName := "aws_s3_bucket.bucket"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -626,8 +626,8 @@ func TestAccS3Bucket_Security_grantToACL(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBucketExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "acl", "public-read"),
-					resource.TestCheckResourceAttr(resourceName, "grant.#", "0"),
-					// check removed grants
+					// Canned ACL grants: bucket owner FULL_CONTROL and AllUsers READ.
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "2"),
 				),
 			},
 		},
@@ -635,7 +635,7 @@ func TestAccS3Bucket_Security_grantToACL(t *testing.T) {
 }
 
 func TestAccS3Bucket_Web_simple(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	region := acctest.Region()
 	resourceName := "aws_s3_bucket.bucket"
 
@@ -668,11 +668,12 @@ func TestAccS3Bucket_Web_simple(t *testing.T) {
 				),
 			},
 			{
+				// "website" is computed, so removing the block retains the configuration.
 				Config: testAccBucketConfig_Basic(bucketName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBucketExists(resourceName),
-					testAccCheckBucketWebsite(resourceName, "", "", "", ""),
-					resource.TestCheckResourceAttr(resourceName, "website_endpoint", ""),
+					testAccCheckBucketWebsite(resourceName, "index.html", "error.html", "", ""),
+					testAccCheckS3BucketWebsiteEndpoint(resourceName, "website_endpoint", bucketName, region),
 				),
 			},
 		},
@@ -680,7 +681,7 @@ func TestAccS3Bucket_Web_simple(t *testing.T) {
 }
 
 func TestAccS3Bucket_Web_redirect(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	region := acctest.Region()
 	resourceName := "aws_s3_bucket.bucket"
 
@@ -713,11 +714,12 @@ func TestAccS3Bucket_Web_redirect(t *testing.T) {
 				),
 			},
 			{
+				// "website" is computed, so removing the block retains the configuration.
 				Config: testAccBucketConfig_Basic(bucketName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBucketExists(resourceName),
-					testAccCheckBucketWebsite(resourceName, "", "", "", ""),
-					resource.TestCheckResourceAttr(resourceName, "website_endpoint", ""),
+					testAccCheckBucketWebsite(resourceName, "", "", "https", "hashicorp.com?my=query"),
+					testAccCheckS3BucketWebsiteEndpoint(resourceName, "website_endpoint", bucketName, region),
 				),
 			},
 		},
@@ -725,7 +727,7 @@ func TestAccS3Bucket_Web_redirect(t *testing.T) {
 }
 
 func TestAccS3Bucket_Web_routingRules(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	region := acctest.Region()
 	resourceName := "aws_s3_bucket.bucket"
 
@@ -764,12 +766,12 @@ func TestAccS3Bucket_Web_routingRules(t *testing.T) {
 				ImportStateVerifyIgnore: []string{"force_destroy", "acl", "grant"},
 			},
 			{
+				// "website" is computed, so removing the block retains the configuration.
 				Config: testAccBucketConfig_Basic(bucketName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBucketExists(resourceName),
-					testAccCheckBucketWebsite(resourceName, "", "", "", ""),
-					testAccCheckBucketWebsiteRoutingRules(resourceName, nil),
-					resource.TestCheckResourceAttr(resourceName, "website_endpoint", ""),
+					testAccCheckBucketWebsite(resourceName, "index.html", "error.html", "", ""),
+					testAccCheckS3BucketWebsiteEndpoint(resourceName, "website_endpoint", bucketName, region),
 				),
 			},
 		},
@@ -777,7 +779,7 @@ func TestAccS3Bucket_Web_routingRules(t *testing.T) {
 }
 
 func TestAccS3Bucket_Security_enableDefaultEncryptionWhenTypical(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	resourceName := "aws_s3_bucket.arbitrary"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -808,7 +810,7 @@ func TestAccS3Bucket_Security_enableDefaultEncryptionWhenTypical(t *testing.T) {
 }
 
 func TestAccS3Bucket_Security_enableDefaultEncryptionWhenAES256IsUsed(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	resourceName := "aws_s3_bucket.arbitrary"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -838,8 +840,8 @@ func TestAccS3Bucket_Security_enableDefaultEncryptionWhenAES256IsUsed(t *testing
 	})
 }
 
-func TestAccS3Bucket_Security_disableDefaultEncryptionWhenDefaultEncryptionIsEnabled(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+func TestAccS3Bucket_Security_defaultEncryptionRetainedWhenBlockRemoved(t *testing.T) {
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	resourceName := "aws_s3_bucket.arbitrary"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -864,6 +866,42 @@ func TestAccS3Bucket_Security_disableDefaultEncryptionWhenDefaultEncryptionIsEna
 				Config: testAccBucketDisableDefaultEncryption(bucketName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBucketExists(resourceName),
+					// "server_side_encryption_configuration" is computed, so removing the block retains the configuration.
+					resource.TestCheckResourceAttr(resourceName, "server_side_encryption_configuration.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Bucket_Security_disableDefaultEncryptionWithStandaloneResource(t *testing.T) {
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
+	resourceName := "aws_s3_bucket.arbitrary"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketEnableDefaultEncryptionWithStandaloneResource(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(resourceName),
+					testAccCheckBucketServerSideEncryptionConfigurationExists("aws_s3_bucket_server_side_encryption_configuration.arbitrary"),
+				),
+			},
+			{
+				// Destroying the standalone resource is the supported way to disable default encryption.
+				Config: testAccBucketDisableDefaultEncryption(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(resourceName),
+					testAccCheckBucketDefaultEncryptionDisabled(resourceName),
+				),
+			},
+			{
+				Config: testAccBucketDisableDefaultEncryption(bucketName),
+				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "server_side_encryption_configuration.#", "0"),
 				),
 			},
@@ -872,7 +910,7 @@ func TestAccS3Bucket_Security_disableDefaultEncryptionWhenDefaultEncryptionIsEna
 }
 
 func TestAccS3Bucket_Basic_keyEnabled(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	resourceName := "aws_s3_bucket.arbitrary"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -907,7 +945,7 @@ func TestAccS3Bucket_Basic_keyEnabled(t *testing.T) {
 // not empty" error in Terraform, to check against regresssions.
 // See https://github.com/hashicorp/terraform/pull/2925
 func TestAccS3Bucket_Basic_shouldFailNotFound(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	resourceName := "aws_s3_bucket.bucket"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -929,7 +967,7 @@ func TestAccS3Bucket_Basic_shouldFailNotFound(t *testing.T) {
 }
 
 func TestAccS3Bucket_Manage_versioning(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	resourceName := "aws_s3_bucket.bucket"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -970,7 +1008,7 @@ func TestAccS3Bucket_Manage_versioning(t *testing.T) {
 }
 
 func TestAccS3Bucket_Security_corsUpdate(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	resourceName := "aws_s3_bucket.bucket"
 
 	updateBucketCors := func(n string) resource.TestCheckFunc {
@@ -1055,7 +1093,7 @@ func TestAccS3Bucket_Security_corsUpdate(t *testing.T) {
 }
 
 func TestAccS3Bucket_Security_corsDelete(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	resourceName := "aws_s3_bucket.bucket"
 
 	deleteBucketCors := func(n string) resource.TestCheckFunc {
@@ -1095,7 +1133,7 @@ func TestAccS3Bucket_Security_corsDelete(t *testing.T) {
 }
 
 func TestAccS3Bucket_Security_corsEmptyOrigin(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	resourceName := "aws_s3_bucket.bucket"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1133,7 +1171,7 @@ func TestAccS3Bucket_Security_corsEmptyOrigin(t *testing.T) {
 }
 
 func TestAccS3Bucket_Security_logging(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	resourceName := "aws_s3_bucket.bucket"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1160,7 +1198,7 @@ func TestAccS3Bucket_Security_logging(t *testing.T) {
 }
 
 func TestAccS3Bucket_Manage_lifecycleBasic(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	resourceName := "aws_s3_bucket.bucket"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1277,7 +1315,7 @@ func TestAccS3Bucket_Manage_lifecycleBasic(t *testing.T) {
 }
 
 func TestAccS3Bucket_Manage_lifecycleExpireMarkerOnly(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	resourceName := "aws_s3_bucket.bucket"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1315,7 +1353,7 @@ func TestAccS3Bucket_Manage_lifecycleExpireMarkerOnly(t *testing.T) {
 
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/11420
 func TestAccS3Bucket_Manage_lifecycleRuleExpirationEmptyBlock(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_s3_bucket.bucket"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1336,7 +1374,7 @@ func TestAccS3Bucket_Manage_lifecycleRuleExpirationEmptyBlock(t *testing.T) {
 
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/15138
 func TestAccS3Bucket_Manage_lifecycleRuleAbortIncompleteMultipartUploadDaysNoExpiration(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_s3_bucket.bucket"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1362,7 +1400,7 @@ func TestAccS3Bucket_Manage_lifecycleRuleAbortIncompleteMultipartUploadDaysNoExp
 }
 
 func TestAccS3Bucket_Replication_basic(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	alternateRegion := acctest.AlternateRegion()
 	region := acctest.Region()
 	partition := acctest.Partition()
@@ -1480,7 +1518,7 @@ func TestAccS3Bucket_Replication_basic(t *testing.T) {
 }
 
 func TestAccS3Bucket_Replication_multipleDestinationsEmptyFilter(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	alternateRegion := acctest.AlternateRegion()
 	region := acctest.Region()
 	resourceName := "aws_s3_bucket.bucket"
@@ -1547,7 +1585,7 @@ func TestAccS3Bucket_Replication_multipleDestinationsEmptyFilter(t *testing.T) {
 }
 
 func TestAccS3Bucket_Replication_multipleDestinationsNonEmptyFilter(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	alternateRegion := acctest.AlternateRegion()
 	region := acctest.Region()
 	resourceName := "aws_s3_bucket.bucket"
@@ -1618,7 +1656,7 @@ func TestAccS3Bucket_Replication_multipleDestinationsNonEmptyFilter(t *testing.T
 
 func TestAccS3Bucket_Replication_twoDestination(t *testing.T) {
 	// This tests 2 destinations since GovCloud and possibly other non-standard partitions allow a max of 2
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	alternateRegion := acctest.AlternateRegion()
 	region := acctest.Region()
 	resourceName := "aws_s3_bucket.bucket"
@@ -1676,7 +1714,7 @@ func TestAccS3Bucket_Replication_twoDestination(t *testing.T) {
 }
 
 func TestAccS3Bucket_Replication_ruleDestinationAccessControlTranslation(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	region := acctest.Region()
 	partition := acctest.Partition()
 	iamRoleResourceName := "aws_iam_role.role"
@@ -1769,7 +1807,7 @@ func TestAccS3Bucket_Replication_ruleDestinationAccessControlTranslation(t *test
 
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/12480
 func TestAccS3Bucket_Replication_ruleDestinationAddAccessControlTranslation(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	region := acctest.Region()
 	partition := acctest.Partition()
 	iamRoleResourceName := "aws_iam_role.role"
@@ -1851,7 +1889,7 @@ func TestAccS3Bucket_Replication_ruleDestinationAddAccessControlTranslation(t *t
 
 // StorageClass issue: https://github.com/hashicorp/terraform/issues/10909
 func TestAccS3Bucket_Replication_withoutStorageClass(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	alternateRegion := acctest.AlternateRegion()
 	region := acctest.Region()
 	resourceName := "aws_s3_bucket.bucket"
@@ -1887,7 +1925,7 @@ func TestAccS3Bucket_Replication_withoutStorageClass(t *testing.T) {
 }
 
 func TestAccS3Bucket_Replication_expectVersioningValidationError(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 
 	// record the initialized providers so that we can use them to check for the instances in each region
 	var providers []*schema.Provider
@@ -1911,7 +1949,7 @@ func TestAccS3Bucket_Replication_expectVersioningValidationError(t *testing.T) {
 
 // Prefix issue: https://github.com/hashicorp/terraform-provider-aws/issues/6340
 func TestAccS3Bucket_Replication_withoutPrefix(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	alternateRegion := acctest.AlternateRegion()
 	region := acctest.Region()
 	resourceName := "aws_s3_bucket.bucket"
@@ -1947,7 +1985,7 @@ func TestAccS3Bucket_Replication_withoutPrefix(t *testing.T) {
 }
 
 func TestAccS3Bucket_Replication_schemaV2(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt()
 	alternateRegion := acctest.AlternateRegion()
 	region := acctest.Region()
 	partition := acctest.Partition()
@@ -2165,9 +2203,9 @@ func TestAccS3Bucket_Replication_schemaV2(t *testing.T) {
 
 func TestAccS3Bucket_Replication_schemaV2SameRegion(t *testing.T) {
 	resourceName := "aws_s3_bucket.bucket"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	destinationResourceName := "aws_s3_bucket.destination"
-	rNameDestination := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameDestination := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -2218,7 +2256,7 @@ func TestAccS3Bucket_Replication_schemaV2SameRegion(t *testing.T) {
 }
 
 func TestAccS3Bucket_Manage_objectLock(t *testing.T) {
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 	resourceName := "aws_s3_bucket.arbitrary"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -2259,7 +2297,7 @@ func TestAccS3Bucket_Manage_objectLock(t *testing.T) {
 
 func TestAccS3Bucket_Basic_forceDestroy(t *testing.T) {
 	resourceName := "aws_s3_bucket.bucket"
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -2285,7 +2323,7 @@ func TestAccS3Bucket_Basic_forceDestroy(t *testing.T) {
 // services may create keys with extra slashes (empty "directory" prefixes).
 func TestAccS3Bucket_Basic_forceDestroyWithEmptyPrefixes(t *testing.T) {
 	resourceName := "aws_s3_bucket.bucket"
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -2306,7 +2344,7 @@ func TestAccS3Bucket_Basic_forceDestroyWithEmptyPrefixes(t *testing.T) {
 
 func TestAccS3Bucket_Basic_forceDestroyWithObjectLockEnabled(t *testing.T) {
 	resourceName := "aws_s3_bucket.bucket"
-	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+	bucketName := acctest.RandomWithPrefix("tf-test-bucket")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -2684,6 +2722,35 @@ func testAccCheckBucketExistsWithProvider(n string, providerF func() *schema.Pro
 	}
 }
 
+func testAccCheckBucketDefaultEncryptionDisabled(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Bucket ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		_, err := conn.GetBucketEncryption(&s3.GetBucketEncryptionInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, tfs3.ErrCodeServerSideEncryptionConfigurationNotFound) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Bucket (%s) default encryption still enabled", rs.Primary.ID)
+	}
+}
+
 func testAccCheckDestroyBucket(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -2752,7 +2819,7 @@ func testAccCheckBucketAddObjectsWithLegalHold(n string, keys ...string) resourc
 func testAccCheckBucketCreateViaCloudFormation(n string, stackID *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationConn
-		stackName := sdkacctest.RandomWithPrefix("tf-acc-test-s3tags")
+		stackName := acctest.RandomWithPrefix("tf-acc-test-s3tags")
 		templateBody := fmt.Sprintf(`{
   "Resources": {
     "TfTestBucket": {
@@ -3527,6 +3594,24 @@ resource "aws_s3_bucket" "arbitrary" {
 `, bucketName)
 }
 
+func testAccBucketEnableDefaultEncryptionWithStandaloneResource(bucketName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "arbitrary" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_server_side_encryption_configuration" "arbitrary" {
+  bucket = aws_s3_bucket.arbitrary.id
+
+  rule {
+    apply_server_side_encryption_by_default {
+      sse_algorithm = "AES256"
+    }
+  }
+}
+`, bucketName)
+}
+
 func testAccBucketDisableDefaultEncryption(bucketName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "arbitrary" {
@@ -16,22 +16,40 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
+// sweepBucketNamePrefixes are the prefixes of the names of S3 Buckets created by acceptance tests.
+var sweepBucketNamePrefixes = []string{
+	"mybucket.",
+	"mylogs.",
+	"terraform-remote-s3-test",
+	"tf-acc",
+	"tf-emr-bootstrap",
+	"tf-object-test",
+	"tf-test",
+}
+
 func init() {
 	resource.AddTestSweepers("aws_s3_bucket_object", &resource.Sweeper{
 		Name: "aws_s3_bucket_object",
 		F:    sweepBucketObjects,
 	})
 
+	resource.AddTestSweepers("aws_s3_bucket_intelligent_tiering_configuration", &resource.Sweeper{
+		Name: "aws_s3_bucket_intelligent_tiering_configuration",
+		F:    sweepBucketIntelligentTieringConfigurations,
+	})
+
 	resource.AddTestSweepers("aws_s3_bucket", &resource.Sweeper{
 		Name: "aws_s3_bucket",
 		F:    sweepBuckets,
 		Dependencies: []string{
 			"aws_s3_access_point",
+			"aws_s3_bucket_intelligent_tiering_configuration",
 			"aws_s3_bucket_object",
 		},
 	})
@@ -66,9 +84,8 @@ func sweepBucketObjects(region string) error {
 		bucketName := aws.StringValue(bucket.Name)
 
 		hasPrefix := false
-		prefixes := []string{"mybucket.", "mylogs.", "tf-acc", "tf-object-test", "tf-test", "tf-emr-bootstrap"}
 
-		for _, prefix := range prefixes {
+		for _, prefix := range sweepBucketNamePrefixes {
 			if strings.HasPrefix(bucketName, prefix) {
 				hasPrefix = true
 				break
@@ -99,15 +116,105 @@ func sweepBucketObjects(region string) error {
 			continue
 		}
 
-		// Delete everything including locked objects. Ignore any object errors.
-		err = DeleteAllObjectVersions(conn, bucketName, "", objectLockEnabled, true)
+		candidate := sweep.Candidate{ID: bucketName, Name: bucketName, CreationTime: aws.TimeValue(bucket.CreationDate)}
+
+		err = sweep.Delete(region, "aws_s3_bucket_object", candidate, func() error {
+			// Delete everything including locked objects. Ignore any object errors.
+			return DeleteAllObjectVersions(conn, bucketName, "", objectLockEnabled, true)
+		})
 
 		if err != nil {
-			return fmt.Errorf("error listing S3 Bucket (%s) Objects: %s", bucketName, err)
+			return multierror.Append(fmt.Errorf("error listing S3 Bucket (%s) Objects: %s", bucketName, err), sweep.WriteReports())
 		}
 	}
 
-	return nil
+	return sweep.WriteReports()
+}
+
+func sweepBucketIntelligentTieringConfigurations(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).S3Conn
+	input := &s3.ListBucketsInput{}
+
+	output, err := conn.ListBuckets(input)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping S3 Bucket Intelligent-Tiering Configuration sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing S3 Buckets: %s", err)
+	}
+
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	for _, bucket := range output.Buckets {
+		bucketName := aws.StringValue(bucket.Name)
+
+		hasPrefix := false
+
+		for _, prefix := range sweepBucketNamePrefixes {
+			if strings.HasPrefix(bucketName, prefix) {
+				hasPrefix = true
+				break
+			}
+		}
+
+		if !hasPrefix {
+			log.Printf("[INFO] Skipping S3 Bucket: %s", bucketName)
+			continue
+		}
+
+		bucketRegion, err := bucketRegion(conn, bucketName)
+
+		if err != nil {
+			log.Printf("[ERROR] Error getting S3 Bucket (%s) Location: %s", bucketName, err)
+			continue
+		}
+
+		if bucketRegion != region {
+			continue
+		}
+
+		input := &s3.ListBucketIntelligentTieringConfigurationsInput{
+			Bucket: aws.String(bucketName),
+		}
+
+		for {
+			output, err := conn.ListBucketIntelligentTieringConfigurations(input)
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing S3 Bucket (%s) Intelligent-Tiering Configurations: %w", bucketName, err))
+				break
+			}
+
+			for _, v := range output.IntelligentTieringConfigurationList {
+				r := ResourceBucketIntelligentTieringConfiguration()
+				d := r.Data(nil)
+				d.SetId(fmt.Sprintf("%s:%s", bucketName, aws.StringValue(v.Id)))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource("aws_s3_bucket_intelligent_tiering_configuration", r, d, client))
+			}
+
+			if !aws.BoolValue(output.IsTruncated) {
+				break
+			}
+
+			input.ContinuationToken = output.NextContinuationToken
+		}
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping S3 Bucket Intelligent-Tiering Configurations (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepBuckets(region string) error {
@@ -140,9 +247,8 @@ func sweepBuckets(region string) error {
 		name := aws.StringValue(bucket.Name)
 
 		sweepable := false
-		prefixes := []string{"mybucket.", "mylogs.", "tf-acc", "tf-object-test", "tf-test", "tf-emr-bootstrap", "terraform-remote-s3-test"}
 
-		for _, prefix := range prefixes {
+		for _, prefix := range sweepBucketNamePrefixes {
 			if strings.HasPrefix(name, prefix) {
 				sweepable = true
 				break
@@ -174,31 +280,35 @@ func sweepBuckets(region string) error {
 			Bucket: bucket.Name,
 		}
 
-		log.Printf("[INFO] Deleting S3 Bucket: %s", name)
-		err = resource.Retry(1*time.Minute, func() *resource.RetryError {
-			_, err := conn.DeleteBucket(input)
+		candidate := sweep.Candidate{ID: name, Name: name, CreationTime: aws.TimeValue(bucket.CreationDate)}
 
-			if tfawserr.ErrMessageContains(err, s3.ErrCodeNoSuchBucket, "") {
-				return nil
-			}
+		err = sweep.Delete(region, "aws_s3_bucket", candidate, func() error {
+			log.Printf("[INFO] Deleting S3 Bucket: %s", name)
+			return resource.Retry(1*time.Minute, func() *resource.RetryError {
+				_, err := conn.DeleteBucket(input)
 
-			if tfawserr.ErrMessageContains(err, "BucketNotEmpty", "") {
-				return resource.RetryableError(err)
-			}
+				if tfawserr.ErrMessageContains(err, s3.ErrCodeNoSuchBucket, "") {
+					return nil
+				}
 
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
+				if tfawserr.ErrMessageContains(err, "BucketNotEmpty", "") {
+					return resource.RetryableError(err)
+				}
 
-			return nil
+				if err != nil {
+					return resource.NonRetryableError(err)
+				}
+
+				return nil
+			})
 		})
 
 		if err != nil {
-			return fmt.Errorf("error deleting S3 Bucket (%s): %s", name, err)
+			return multierror.Append(fmt.Errorf("error deleting S3 Bucket (%s): %s", name, err), sweep.WriteReports())
 		}
 	}
 
-	return nil
+	return sweep.WriteReports()
 }
 
 func bucketRegion(conn *s3.S3, bucket string) (string, error) {
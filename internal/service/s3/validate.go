@@ -2,6 +2,8 @@ package s3
 
 import (
 	"fmt"
+	"path"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -29,3 +31,26 @@ func validBucketLifecycleTransitionStorageClass() schema.SchemaValidateFunc {
 		s3.TransitionStorageClassDeepArchive,
 	}, false)
 }
+
+func validBucketObjectsSyncPattern(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == "" {
+		errors = append(errors, fmt.Errorf("%q cannot be empty", k))
+		return
+	}
+
+	for _, segment := range strings.Split(value, "/") {
+		if segment == "**" {
+			continue
+		}
+
+		if _, err := path.Match(segment, ""); err != nil {
+			errors = append(errors, fmt.Errorf(
+				"%q contains an invalid pattern (%s): %s", k, value, err))
+			return
+		}
+	}
+
+	return
+}
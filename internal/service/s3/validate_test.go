@@ -29,3 +29,31 @@ func TestValidBucketLifecycleTimestamp(t *testing.T) {
 		}
 	}
 }
+
+func TestValidBucketObjectsSyncPattern(t *testing.T) {
+	validPatterns := []string{
+		"**",
+		"**/*.html",
+		"assets/*.css",
+		"img/[a-z]?.png",
+	}
+
+	for _, v := range validPatterns {
+		_, errors := validBucketObjectsSyncPattern(v, "pattern")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be valid pattern: %q", v, errors)
+		}
+	}
+
+	invalidPatterns := []string{
+		"",
+		"img/[a.png",
+	}
+
+	for _, v := range invalidPatterns {
+		_, errors := validBucketObjectsSyncPattern(v, "pattern")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be invalid pattern", v)
+		}
+	}
+}
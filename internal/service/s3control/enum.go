@@ -0,0 +1,9 @@
+package s3control
+
+// AsyncOperation.RequestStatus values.
+const (
+	asyncOperationRequestStatusFailed     = "FAILED"
+	asyncOperationRequestStatusInProgress = "INPROGRESS"
+	asyncOperationRequestStatusNew        = "NEW"
+	asyncOperationRequestStatusSucceeded  = "SUCCEEDED"
+)
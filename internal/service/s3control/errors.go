@@ -4,6 +4,8 @@ package s3control
 // https://docs.aws.amazon.com/sdk-for-go/api/service/s3control/#pkg-constants
 //nolint:deadcode,varcheck // These constants are missing from the AWS SDK
 const (
-	errCodeNoSuchAccessPoint       = "NoSuchAccessPoint"
-	errCodeNoSuchAccessPointPolicy = "NoSuchAccessPointPolicy"
+	errCodeNoSuchAccessPoint            = "NoSuchAccessPoint"
+	errCodeNoSuchAccessPointPolicy      = "NoSuchAccessPointPolicy"
+	errCodeNoSuchConfiguration          = "NoSuchConfiguration"
+	errCodeNoSuchMultiRegionAccessPoint = "NoSuchMultiRegionAccessPoint"
 )
@@ -0,0 +1,224 @@
+package s3control_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+)
+
+func TestAccS3ControlMultiRegionAccessPointPolicy_basic(t *testing.T) {
+	var v s3control.MultiRegionAccessPointPolicyDocument
+	resourceName := "aws_s3control_multi_region_access_point_policy.test"
+	bucketName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	multiRegionAccessPointName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartition(endpoints.AwsPartitionID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckMultiRegionAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiRegionAccessPointPolicyConfig_basic(bucketName, multiRegionAccessPointName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiRegionAccessPointPolicyExists(resourceName, &v),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "details.0.name", multiRegionAccessPointName),
+					resource.TestCheckResourceAttrSet(resourceName, "details.0.policy"),
+					resource.TestCheckResourceAttrSet(resourceName, "established"),
+					resource.TestCheckResourceAttrSet(resourceName, "proposed"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3ControlMultiRegionAccessPointPolicy_Disappears_multiRegionAccessPoint(t *testing.T) {
+	var v s3control.MultiRegionAccessPointPolicyDocument
+	resourceName := "aws_s3control_multi_region_access_point_policy.test"
+	bucketName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	multiRegionAccessPointName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartition(endpoints.AwsPartitionID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckMultiRegionAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiRegionAccessPointPolicyConfig_basic(bucketName, multiRegionAccessPointName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiRegionAccessPointPolicyExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfs3control.ResourceMultiRegionAccessPoint(), "aws_s3control_multi_region_access_point.test"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3ControlMultiRegionAccessPointPolicy_Details_policy(t *testing.T) {
+	var v1, v2 s3control.MultiRegionAccessPointPolicyDocument
+	resourceName := "aws_s3control_multi_region_access_point_policy.test"
+	bucketName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	multiRegionAccessPointName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartition(endpoints.AwsPartitionID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckMultiRegionAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiRegionAccessPointPolicyConfig_basic(bucketName, multiRegionAccessPointName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiRegionAccessPointPolicyExists(resourceName, &v1),
+					resource.TestMatchResourceAttr(resourceName, "details.0.policy", regexp.MustCompile(`"s3:GetObject"`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMultiRegionAccessPointPolicyConfig_updatedStatement(bucketName, multiRegionAccessPointName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiRegionAccessPointPolicyExists(resourceName, &v2),
+					resource.TestMatchResourceAttr(resourceName, "details.0.policy", regexp.MustCompile(`"s3:PutObject"`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMultiRegionAccessPointPolicyExists(n string, v *s3control.MultiRegionAccessPointPolicyDocument) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Multi-Region Access Point Policy ID is set")
+		}
+
+		accountID, name, err := tfs3control.MultiRegionAccessPointParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlMultiRegionConn
+
+		output, err := tfs3control.FindMultiRegionAccessPointPolicyDocumentByAccountIDAndName(conn, accountID, name)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccMultiRegionAccessPointPolicyConfig_basic(bucketName, multiRegionAccessPointName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3control_multi_region_access_point" "test" {
+  details {
+    name = %[2]q
+
+    region {
+      bucket = aws_s3_bucket.test.id
+    }
+  }
+}
+
+resource "aws_s3control_multi_region_access_point_policy" "test" {
+  details {
+    name   = element(split(":", aws_s3control_multi_region_access_point.test.id), 1)
+    policy = jsonencode({
+      "Version" : "2012-10-17",
+      "Statement" : [
+        {
+          "Sid" : "Test",
+          "Effect" : "Allow",
+          "Principal" : {
+            "AWS" : data.aws_caller_identity.current.account_id
+          },
+          "Action" : "s3:GetObject",
+          "Resource" : "arn:${data.aws_partition.current.partition}:s3::${data.aws_caller_identity.current.account_id}:accesspoint/${aws_s3control_multi_region_access_point.test.alias}/object/*"
+        }
+      ]
+    })
+  }
+}
+`, bucketName, multiRegionAccessPointName)
+}
+
+func testAccMultiRegionAccessPointPolicyConfig_updatedStatement(bucketName, multiRegionAccessPointName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3control_multi_region_access_point" "test" {
+  details {
+    name = %[2]q
+
+    region {
+      bucket = aws_s3_bucket.test.id
+    }
+  }
+}
+
+resource "aws_s3control_multi_region_access_point_policy" "test" {
+  details {
+    name   = element(split(":", aws_s3control_multi_region_access_point.test.id), 1)
+    policy = jsonencode({
+      "Version" : "2012-10-17",
+      "Statement" : [
+        {
+          "Sid" : "Test",
+          "Effect" : "Allow",
+          "Principal" : {
+            "AWS" : data.aws_caller_identity.current.account_id
+          },
+          "Action" : "s3:PutObject",
+          "Resource" : "arn:${data.aws_partition.current.partition}:s3::${data.aws_caller_identity.current.account_id}:accesspoint/${aws_s3control_multi_region_access_point.test.alias}/object/*"
+        }
+      ]
+    })
+  }
+}
+`, bucketName, multiRegionAccessPointName)
+}
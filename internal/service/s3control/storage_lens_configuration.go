@@ -0,0 +1,519 @@
+package s3control
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceStorageLensConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStorageLensConfigurationCreate,
+		Read:   resourceStorageLensConfigurationRead,
+		Update: resourceStorageLensConfigurationUpdate,
+		Delete: resourceStorageLensConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9\-_.]+$`), "must contain only alphanumeric characters, hyphens, underscores and periods"),
+				),
+			},
+			"storage_lens_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_level": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"activity_metrics": storageLensActivityMetricsSchema(),
+									"bucket_level": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"activity_metrics": storageLensActivityMetricsSchema(),
+												"prefix_level": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"storage_metrics": {
+																Type:     schema.TypeList,
+																Required: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"enabled": {
+																			Type:     schema.TypeBool,
+																			Optional: true,
+																		},
+																		"selection_criteria": {
+																			Type:     schema.TypeList,
+																			Optional: true,
+																			MaxItems: 1,
+																			Elem: &schema.Resource{
+																				Schema: map[string]*schema.Schema{
+																					"delimiter": {
+																						Type:     schema.TypeString,
+																						Optional: true,
+																					},
+																					"max_depth": {
+																						Type:         schema.TypeInt,
+																						Optional:     true,
+																						ValidateFunc: validation.IntBetween(1, 10),
+																					},
+																					"min_storage_bytes_percentage": {
+																						Type:         schema.TypeFloat,
+																						Optional:     true,
+																						ValidateFunc: validation.FloatBetween(0.1, 100),
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"aws_org": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"data_export": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3_bucket_destination": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"account_id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidAccountID,
+												},
+												"arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"encryption": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"sse_kms": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"key_id": {
+																			Type:         schema.TypeString,
+																			Required:     true,
+																			ValidateFunc: verify.ValidARN,
+																		},
+																	},
+																},
+															},
+															"sse_s3": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{},
+																},
+															},
+														},
+													},
+												},
+												"format": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(s3control.Format_Values(), false),
+												},
+												"output_schema_version": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(s3control.OutputSchemaVersion_Values(), false),
+												},
+												"prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"exclude": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"storage_lens_configuration.0.include"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"buckets": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+									"regions": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"include": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"storage_lens_configuration.0.exclude"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"buckets": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+									"regions": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func storageLensActivityMetricsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceStorageLensConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+	configID := d.Get("config_id").(string)
+	id := StorageLensConfigurationCreateID(accountID, configID)
+
+	input := &s3control.PutStorageLensConfigurationInput{
+		AccountId: aws.String(accountID),
+		ConfigId:  aws.String(configID),
+	}
+
+	if v, ok := d.GetOk("storage_lens_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject, err := expandStorageLensConfiguration(v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return fmt.Errorf("error expanding S3 Storage Lens Configuration (%s): %w", id, err)
+		}
+
+		input.StorageLensConfiguration = apiObject
+		input.StorageLensConfiguration.Id = aws.String(configID)
+	}
+
+	if len(tags) > 0 {
+		input.Tags = storageLensTags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating S3 Storage Lens Configuration: %s", input)
+	_, err := conn.PutStorageLensConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Storage Lens Configuration (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceStorageLensConfigurationRead(d, meta)
+}
+
+func resourceStorageLensConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	accountID, configID, err := StorageLensConfigurationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindStorageLensConfigurationByAccountIDAndConfigID(conn, accountID, configID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Storage Lens Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Storage Lens Configuration (%s): %w", d.Id(), err)
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("arn", output.StorageLensArn)
+	d.Set("config_id", configID)
+
+	tfList, err := flattenStorageLensConfiguration(output)
+
+	if err != nil {
+		return fmt.Errorf("error flattening S3 Storage Lens Configuration (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("storage_lens_configuration", tfList); err != nil {
+		return fmt.Errorf("error setting storage_lens_configuration: %w", err)
+	}
+
+	tags, err := storageLensConfigurationListTags(conn, accountID, configID)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for S3 Storage Lens Configuration (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceStorageLensConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	accountID, configID, err := StorageLensConfigurationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &s3control.PutStorageLensConfigurationInput{
+			AccountId: aws.String(accountID),
+			ConfigId:  aws.String(configID),
+		}
+
+		if v, ok := d.GetOk("storage_lens_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			apiObject, err := expandStorageLensConfiguration(v.([]interface{})[0].(map[string]interface{}))
+
+			if err != nil {
+				return fmt.Errorf("error expanding S3 Storage Lens Configuration (%s): %w", d.Id(), err)
+			}
+
+			input.StorageLensConfiguration = apiObject
+			input.StorageLensConfiguration.Id = aws.String(configID)
+		}
+
+		log.Printf("[DEBUG] Updating S3 Storage Lens Configuration: %s", input)
+		_, err := conn.PutStorageLensConfiguration(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating S3 Storage Lens Configuration (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := storageLensConfigurationUpdateTags(conn, accountID, configID, o, n); err != nil {
+			return fmt.Errorf("error updating S3 Storage Lens Configuration (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceStorageLensConfigurationRead(d, meta)
+}
+
+func resourceStorageLensConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	accountID, configID, err := StorageLensConfigurationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Storage Lens Configuration: %s", d.Id())
+	_, err = conn.DeleteStorageLensConfiguration(&s3control.DeleteStorageLensConfigurationInput{
+		AccountId: aws.String(accountID),
+		ConfigId:  aws.String(configID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchConfiguration) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Storage Lens Configuration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const storageLensConfigurationIDSeparator = ":"
+
+// StorageLensConfigurationCreateID returns the resource ID for a Storage Lens configuration
+func StorageLensConfigurationCreateID(accountID, configID string) string {
+	return strings.Join([]string{accountID, configID}, storageLensConfigurationIDSeparator)
+}
+
+// StorageLensConfigurationParseID returns the Account ID and Storage Lens configuration ID
+func StorageLensConfigurationParseID(id string) (string, string, error) {
+	parts := strings.Split(id, storageLensConfigurationIDSeparator)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected ACCOUNT_ID%sCONFIG_ID", id, storageLensConfigurationIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// storageLensConfigurationAutoFlexOptions maps the storage_lens_configuration block to s3control.StorageLensConfiguration.
+func storageLensConfigurationAutoFlexOptions() []flex.AutoFlexOptionsFunc {
+	return []flex.AutoFlexOptionsFunc{
+		flex.WithFieldName("enabled", "IsEnabled"),
+		flex.WithFieldName("account_level.activity_metrics.enabled", "IsEnabled"),
+		flex.WithFieldName("account_level.bucket_level.activity_metrics.enabled", "IsEnabled"),
+		flex.WithFieldName("account_level.bucket_level.prefix_level.storage_metrics.enabled", "IsEnabled"),
+		// The SSE-S3 configuration block has no arguments.
+		flex.WithExpander("data_export.s3_bucket_destination.encryption.sse_s3", func(tfValue interface{}) (interface{}, error) {
+			if v, ok := tfValue.([]interface{}); ok && len(v) > 0 {
+				return &s3control.SSES3{}, nil
+			}
+
+			return nil, nil
+		}),
+	}
+}
+
+func expandStorageLensConfiguration(tfMap map[string]interface{}) (*s3control.StorageLensConfiguration, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	apiObject := &s3control.StorageLensConfiguration{}
+
+	if err := flex.Expand(tfMap, apiObject, storageLensConfigurationAutoFlexOptions()...); err != nil {
+		return nil, err
+	}
+
+	// BucketLevel is required.
+	if apiObject.AccountLevel != nil && apiObject.AccountLevel.BucketLevel == nil {
+		apiObject.AccountLevel.BucketLevel = &s3control.BucketLevel{}
+	}
+
+	return apiObject, nil
+}
+
+func flattenStorageLensConfiguration(apiObject *s3control.StorageLensConfiguration) ([]interface{}, error) {
+	tfSchema := ResourceStorageLensConfiguration().Schema["storage_lens_configuration"].Elem.(*schema.Resource).Schema
+
+	return flex.Flatten(apiObject, append(storageLensConfigurationAutoFlexOptions(), flex.WithSchema(tfSchema))...)
+}
@@ -0,0 +1,118 @@
+package s3control
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandFlattenStorageLensConfiguration(t *testing.T) {
+	r := ResourceStorageLensConfiguration()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"config_id": "test",
+		"storage_lens_configuration": []interface{}{map[string]interface{}{
+			"enabled": true,
+			"account_level": []interface{}{map[string]interface{}{
+				"activity_metrics": []interface{}{map[string]interface{}{
+					"enabled": true,
+				}},
+				"bucket_level": []interface{}{map[string]interface{}{
+					"prefix_level": []interface{}{map[string]interface{}{
+						"storage_metrics": []interface{}{map[string]interface{}{
+							"enabled": true,
+							"selection_criteria": []interface{}{map[string]interface{}{
+								"delimiter":                    "/",
+								"max_depth":                    5,
+								"min_storage_bytes_percentage": 1.5,
+							}},
+						}},
+					}},
+				}},
+			}},
+			"data_export": []interface{}{map[string]interface{}{
+				"s3_bucket_destination": []interface{}{map[string]interface{}{
+					"account_id":            "123456789012",
+					"arn":                   "arn:aws:s3:::test", //lintignore:AWSAT005
+					"format":                s3control.FormatCsv,
+					"output_schema_version": s3control.OutputSchemaVersionV1,
+					"encryption": []interface{}{map[string]interface{}{
+						"sse_s3": []interface{}{map[string]interface{}{}},
+					}},
+				}},
+			}},
+			"exclude": []interface{}{map[string]interface{}{
+				"regions": []interface{}{"us-east-2"}, //lintignore:AWSAT003
+			}},
+		}},
+	})
+
+	expected := &s3control.StorageLensConfiguration{
+		IsEnabled: aws.Bool(true),
+		AccountLevel: &s3control.AccountLevel{
+			ActivityMetrics: &s3control.ActivityMetrics{
+				IsEnabled: aws.Bool(true),
+			},
+			BucketLevel: &s3control.BucketLevel{
+				PrefixLevel: &s3control.PrefixLevel{
+					StorageMetrics: &s3control.PrefixLevelStorageMetrics{
+						IsEnabled: aws.Bool(true),
+						SelectionCriteria: &s3control.SelectionCriteria{
+							Delimiter:                 aws.String("/"),
+							MaxDepth:                  aws.Int64(5),
+							MinStorageBytesPercentage: aws.Float64(1.5),
+						},
+					},
+				},
+			},
+		},
+		DataExport: &s3control.StorageLensDataExport{
+			S3BucketDestination: &s3control.S3BucketDestination{
+				AccountId:           aws.String("123456789012"),
+				Arn:                 aws.String("arn:aws:s3:::test"), //lintignore:AWSAT005
+				Format:              aws.String(s3control.FormatCsv),
+				OutputSchemaVersion: aws.String(s3control.OutputSchemaVersionV1),
+				Encryption: &s3control.StorageLensDataExportEncryption{
+					SSES3: &s3control.SSES3{},
+				},
+			},
+		},
+		Exclude: &s3control.Exclude{
+			Regions: aws.StringSlice([]string{"us-east-2"}), //lintignore:AWSAT003
+		},
+	}
+
+	apiObject, err := expandStorageLensConfiguration(d.Get("storage_lens_configuration").([]interface{})[0].(map[string]interface{}))
+
+	if err != nil {
+		t.Fatalf("unexpected error expanding: %s", err)
+	}
+
+	if !reflect.DeepEqual(apiObject, expected) {
+		t.Fatalf("expanded:\n%s\nexpected:\n%s", apiObject, expected)
+	}
+
+	tfList, err := flattenStorageLensConfiguration(apiObject)
+
+	if err != nil {
+		t.Fatalf("unexpected error flattening: %s", err)
+	}
+
+	d = r.Data(nil)
+
+	if err := d.Set("storage_lens_configuration", tfList); err != nil {
+		t.Fatalf("unexpected error setting storage_lens_configuration: %s", err)
+	}
+
+	apiObject, err = expandStorageLensConfiguration(d.Get("storage_lens_configuration").([]interface{})[0].(map[string]interface{}))
+
+	if err != nil {
+		t.Fatalf("unexpected error expanding flattened: %s", err)
+	}
+
+	if !reflect.DeepEqual(apiObject, expected) {
+		t.Errorf("flattened and expanded:\n%s\nexpected:\n%s", apiObject, expected)
+	}
+}
@@ -0,0 +1,485 @@
+package s3control_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccS3ControlStorageLensConfiguration_basic(t *testing.T) {
+	resourceName := "aws_s3control_storage_lens_configuration.test"
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckStorageLensConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageLensConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageLensConfigurationExists(resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "s3", fmt.Sprintf("storage-lens/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "config_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.activity_metrics.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.bucket_level.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.bucket_level.0.activity_metrics.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.bucket_level.0.prefix_level.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.aws_org.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.data_export.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.exclude.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.include.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3ControlStorageLensConfiguration_disappears(t *testing.T) {
+	resourceName := "aws_s3control_storage_lens_configuration.test"
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckStorageLensConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageLensConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageLensConfigurationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfs3control.ResourceStorageLensConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3ControlStorageLensConfiguration_tags(t *testing.T) {
+	resourceName := "aws_s3control_storage_lens_configuration.test"
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckStorageLensConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageLensConfigurationConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageLensConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStorageLensConfigurationConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageLensConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccStorageLensConfigurationConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageLensConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlStorageLensConfiguration_update(t *testing.T) {
+	resourceName := "aws_s3control_storage_lens_configuration.test"
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckStorageLensConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageLensConfigurationConfig_allAttributes(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageLensConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.activity_metrics.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.activity_metrics.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.bucket_level.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.bucket_level.0.activity_metrics.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.bucket_level.0.activity_metrics.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.bucket_level.0.prefix_level.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.bucket_level.0.prefix_level.0.storage_metrics.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.bucket_level.0.prefix_level.0.storage_metrics.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.bucket_level.0.prefix_level.0.storage_metrics.0.selection_criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.bucket_level.0.prefix_level.0.storage_metrics.0.selection_criteria.0.delimiter", "/"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.bucket_level.0.prefix_level.0.storage_metrics.0.selection_criteria.0.max_depth", "5"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.bucket_level.0.prefix_level.0.storage_metrics.0.selection_criteria.0.min_storage_bytes_percentage", "1.25"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.data_export.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.#", "1"),
+					acctest.CheckResourceAttrAccountID(resourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.0.account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.0.arn", "aws_s3_bucket.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.0.encryption.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.0.encryption.0.sse_kms.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.0.encryption.0.sse_kms.0.key_id", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.0.encryption.0.sse_s3.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.0.format", "CSV"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.0.output_schema_version", "V_1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.0.prefix", "p1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.exclude.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.exclude.0.buckets.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.exclude.0.regions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "storage_lens_configuration.0.exclude.0.regions.*", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.include.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStorageLensConfigurationConfig_allAttributesUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageLensConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.activity_metrics.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.activity_metrics.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.bucket_level.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.bucket_level.0.activity_metrics.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.account_level.0.bucket_level.0.prefix_level.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.data_export.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.0.encryption.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.0.encryption.0.sse_kms.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.0.encryption.0.sse_s3.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.0.format", "Parquet"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.data_export.0.s3_bucket_destination.0.prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.exclude.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.include.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.include.0.buckets.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "storage_lens_configuration.0.include.0.buckets.*", "aws_s3_bucket.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.include.0.regions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlStorageLensConfiguration_awsOrg(t *testing.T) {
+	resourceName := "aws_s3control_storage_lens_configuration.test"
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckOrganizationManagementAccount(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckStorageLensConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageLensConfigurationConfig_awsOrg(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageLensConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_lens_configuration.0.aws_org.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage_lens_configuration.0.aws_org.0.arn", "data.aws_organizations_organization.current", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckStorageLensConfigurationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3control_storage_lens_configuration" {
+			continue
+		}
+
+		accountID, configID, err := tfs3control.StorageLensConfigurationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfs3control.FindStorageLensConfigurationByAccountIDAndConfigID(conn, accountID, configID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Storage Lens Configuration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckStorageLensConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Storage Lens Configuration ID is set")
+		}
+
+		accountID, configID, err := tfs3control.StorageLensConfigurationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlConn
+
+		_, err = tfs3control.FindStorageLensConfigurationByAccountIDAndConfigID(conn, accountID, configID)
+
+		return err
+	}
+}
+
+func testAccStorageLensConfigurationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3control_storage_lens_configuration" "test" {
+  config_id = %[1]q
+
+  storage_lens_configuration {
+    enabled = false
+
+    account_level {
+      bucket_level {}
+    }
+  }
+}
+`, rName)
+}
+
+func testAccStorageLensConfigurationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_s3control_storage_lens_configuration" "test" {
+  config_id = %[1]q
+
+  storage_lens_configuration {
+    enabled = false
+
+    account_level {
+      bucket_level {}
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccStorageLensConfigurationConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_s3control_storage_lens_configuration" "test" {
+  config_id = %[1]q
+
+  storage_lens_configuration {
+    enabled = false
+
+    account_level {
+      bucket_level {}
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccStorageLensConfigurationConfig_allAttributes(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_s3control_storage_lens_configuration" "test" {
+  config_id = %[1]q
+
+  storage_lens_configuration {
+    enabled = true
+
+    account_level {
+      activity_metrics {
+        enabled = true
+      }
+
+      bucket_level {
+        activity_metrics {
+          enabled = true
+        }
+
+        prefix_level {
+          storage_metrics {
+            enabled = true
+
+            selection_criteria {
+              delimiter                    = "/"
+              max_depth                    = 5
+              min_storage_bytes_percentage = 1.25
+            }
+          }
+        }
+      }
+    }
+
+    data_export {
+      s3_bucket_destination {
+        account_id            = data.aws_caller_identity.current.account_id
+        arn                   = aws_s3_bucket.test.arn
+        format                = "CSV"
+        output_schema_version = "V_1"
+        prefix                = "p1"
+
+        encryption {
+          sse_kms {
+            key_id = aws_kms_key.test.arn
+          }
+        }
+      }
+    }
+
+    exclude {
+      buckets = [
+        "arn:${data.aws_partition.current.partition}:s3:::%[1]s-1",
+        "arn:${data.aws_partition.current.partition}:s3:::%[1]s-2",
+      ]
+      regions = [%[2]q]
+    }
+  }
+}
+`, rName, acctest.AlternateRegion())
+}
+
+func testAccStorageLensConfigurationConfig_allAttributesUpdated(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_s3control_storage_lens_configuration" "test" {
+  config_id = %[1]q
+
+  storage_lens_configuration {
+    enabled = false
+
+    account_level {
+      activity_metrics {
+        enabled = false
+      }
+
+      bucket_level {}
+    }
+
+    data_export {
+      s3_bucket_destination {
+        account_id            = data.aws_caller_identity.current.account_id
+        arn                   = aws_s3_bucket.test.arn
+        format                = "Parquet"
+        output_schema_version = "V_1"
+
+        encryption {
+          sse_s3 {}
+        }
+      }
+    }
+
+    include {
+      buckets = [aws_s3_bucket.test.arn]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccStorageLensConfigurationConfig_awsOrg(rName string) string {
+	return fmt.Sprintf(`
+data "aws_organizations_organization" "current" {}
+
+resource "aws_s3control_storage_lens_configuration" "test" {
+  config_id = %[1]q
+
+  storage_lens_configuration {
+    enabled = false
+
+    account_level {
+      bucket_level {}
+    }
+
+    aws_org {
+      arn = data.aws_organizations_organization.current.arn
+    }
+  }
+}
+`, rName)
+}
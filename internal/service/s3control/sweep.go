@@ -8,6 +8,7 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/s3control"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/go-multierror"
@@ -20,6 +21,24 @@ func init() {
 	resource.AddTestSweepers("aws_s3_access_point", &resource.Sweeper{
 		Name: "aws_s3_access_point",
 		F:    sweepAccessPoints,
+		Dependencies: []string{
+			"aws_s3control_object_lambda_access_point",
+		},
+	})
+
+	resource.AddTestSweepers("aws_s3control_multi_region_access_point", &resource.Sweeper{
+		Name: "aws_s3control_multi_region_access_point",
+		F:    sweepMultiRegionAccessPoints,
+	})
+
+	resource.AddTestSweepers("aws_s3control_object_lambda_access_point", &resource.Sweeper{
+		Name: "aws_s3control_object_lambda_access_point",
+		F:    sweepObjectLambdaAccessPoints,
+	})
+
+	resource.AddTestSweepers("aws_s3control_storage_lens_configuration", &resource.Sweeper{
+		Name: "aws_s3control_storage_lens_configuration",
+		F:    sweepStorageLensConfigurations,
 	})
 }
 
@@ -78,3 +97,154 @@ func sweepAccessPoints(region string) error {
 
 	return sweeperErrs.ErrorOrNil()
 }
+
+func sweepMultiRegionAccessPoints(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	if client.(*conns.AWSClient).Partition != endpoints.AwsPartitionID {
+		log.Printf("[WARN] Skipping S3 Multi-Region Access Point sweep for region: %s", region)
+		return nil
+	}
+
+	accountID := client.(*conns.AWSClient).AccountID
+	conn := client.(*conns.AWSClient).S3ControlMultiRegionConn
+	input := &s3control.ListMultiRegionAccessPointsInput{
+		AccountId: aws.String(accountID),
+	}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListMultiRegionAccessPointsPages(input, func(page *s3control.ListMultiRegionAccessPointsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, accessPoint := range page.AccessPoints {
+			r := ResourceMultiRegionAccessPoint()
+			d := r.Data(nil)
+			d.SetId(MultiRegionAccessPointCreateID(accountID, aws.StringValue(accessPoint.Name)))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_s3control_multi_region_access_point", r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping S3 Multi-Region Access Point sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing S3 Multi-Region Access Points (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping S3 Multi-Region Access Points (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepObjectLambdaAccessPoints(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	accountID := client.(*conns.AWSClient).AccountID
+	conn := client.(*conns.AWSClient).S3ControlConn
+	input := &s3control.ListAccessPointsForObjectLambdaInput{
+		AccountId: aws.String(accountID),
+	}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListAccessPointsForObjectLambdaPages(input, func(page *s3control.ListAccessPointsForObjectLambdaOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, accessPoint := range page.ObjectLambdaAccessPointList {
+			r := ResourceObjectLambdaAccessPoint()
+			d := r.Data(nil)
+			d.SetId(ObjectLambdaAccessPointCreateID(accountID, aws.StringValue(accessPoint.Name)))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_s3control_object_lambda_access_point", r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping S3 Object Lambda Access Point sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing S3 Object Lambda Access Points (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping S3 Object Lambda Access Points (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepStorageLensConfigurations(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	accountID := client.(*conns.AWSClient).AccountID
+	conn := client.(*conns.AWSClient).S3ControlConn
+	input := &s3control.ListStorageLensConfigurationsInput{
+		AccountId: aws.String(accountID),
+	}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListStorageLensConfigurationsPages(input, func(page *s3control.ListStorageLensConfigurationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, configuration := range page.StorageLensConfigurationList {
+			// Configurations are listed for all Regions.
+			if aws.StringValue(configuration.HomeRegion) != region {
+				continue
+			}
+
+			r := ResourceStorageLensConfiguration()
+			d := r.Data(nil)
+			d.SetId(StorageLensConfigurationCreateID(accountID, aws.StringValue(configuration.Id)))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_s3control_storage_lens_configuration", r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping S3 Storage Lens Configuration sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing S3 Storage Lens Configurations (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping S3 Storage Lens Configurations (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -1,11 +1,14 @@
 package s3control
 
 import (
+	"fmt"
 	"strconv"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3control"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -17,6 +20,9 @@ const (
 
 	// Maximum amount of time to wait for S3control changes to propagate
 	propagationTimeout = 1 * time.Minute
+
+	// Minimum amount of time to wait between Multi-Region Access Point request polls
+	multiRegionAccessPointRequestMinTimeout = 5 * time.Second
 )
 
 func waitPublicAccessBlockConfigurationBlockPublicACLsUpdated(conn *s3control.S3Control, accountID string, expectedValue bool) (*s3control.PublicAccessBlockConfiguration, error) {
@@ -90,3 +96,25 @@ func waitPublicAccessBlockConfigurationRestrictPublicBucketsUpdated(conn *s3cont
 
 	return nil, err
 }
+
+func waitMultiRegionAccessPointRequestSucceeded(conn *s3control.S3Control, accountID string, requestTokenARN string, timeout time.Duration) (*s3control.AsyncOperation, error) { //nolint:unparam
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{asyncOperationRequestStatusNew, asyncOperationRequestStatusInProgress},
+		Target:     []string{asyncOperationRequestStatusSucceeded},
+		Refresh:    statusMultiRegionAccessPointRequest(conn, accountID, requestTokenARN),
+		Timeout:    timeout,
+		MinTimeout: multiRegionAccessPointRequestMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*s3control.AsyncOperation); ok {
+		if status, responseDetails := aws.StringValue(output.RequestStatus), output.ResponseDetails; status == asyncOperationRequestStatusFailed && responseDetails != nil && responseDetails.ErrorDetails != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(responseDetails.ErrorDetails.Code), aws.StringValue(responseDetails.ErrorDetails.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -17,6 +17,7 @@ import (
 func ResourceEndpoint() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceEndpointCreate,
+		//lintignore:AWSR004
 		Read:   resourceEndpointRead,
 		Delete: resourceEndpointDelete,
 
@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/s3outposts"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 
 func TestAccS3OutpostsEndpoint_basic(t *testing.T) {
 	resourceName := "aws_s3outposts_endpoint.test"
-	rInt := sdkacctest.RandIntRange(0, 255)
+	rInt := acctest.RandIntRange(0, 255)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
@@ -49,7 +48,7 @@ func TestAccS3OutpostsEndpoint_basic(t *testing.T) {
 
 func TestAccS3OutpostsEndpoint_disappears(t *testing.T) {
 	resourceName := "aws_s3outposts_endpoint.test"
-	rInt := sdkacctest.RandIntRange(0, 255)
+	rInt := acctest.RandIntRange(0, 255)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
@@ -20,6 +20,7 @@ import (
 func ResourceApp() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAppCreate,
+		//lintignore:AWSR004
 		Read:   resourceAppRead,
 		Update: resourceAppUpdate,
 		Delete: resourceAppDelete,
@@ -18,6 +18,7 @@ import (
 func ResourceAppImageConfig() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAppImageConfigCreate,
+		//lintignore:AWSR004
 		Read:   resourceAppImageConfigRead,
 		Update: resourceAppImageConfigUpdate,
 		Delete: resourceAppImageConfigDelete,
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func testAccApp_basic(t *testing.T) {
 	var app sagemaker.DescribeAppOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_app.test"
 
 	resource.Test(t, resource.TestCase{
@@ -52,7 +51,7 @@ func testAccApp_basic(t *testing.T) {
 
 func testAccApp_resourceSpec(t *testing.T) {
 	var app sagemaker.DescribeAppOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_app.test"
 
 	resource.Test(t, resource.TestCase{
@@ -82,7 +81,7 @@ func testAccApp_resourceSpec(t *testing.T) {
 
 func testAccApp_tags(t *testing.T) {
 	var app sagemaker.DescribeAppOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_app.test"
 
 	resource.Test(t, resource.TestCase{
@@ -127,7 +126,7 @@ func testAccApp_tags(t *testing.T) {
 
 func testAccApp_disappears(t *testing.T) {
 	var app sagemaker.DescribeAppOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_app.test"
 
 	resource.Test(t, resource.TestCase{
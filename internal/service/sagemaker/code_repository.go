@@ -17,6 +17,7 @@ import (
 func ResourceCodeRepository() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCodeRepositoryCreate,
+		//lintignore:AWSR004
 		Read:   resourceCodeRepositoryRead,
 		Update: resourceCodeRepositoryUpdate,
 		Delete: resourceCodeRepositoryDelete,
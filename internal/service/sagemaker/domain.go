@@ -21,6 +21,7 @@ import (
 func ResourceDomain() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDomainCreate,
+		//lintignore:AWSR004
 		Read:   resourceDomainRead,
 		Update: resourceDomainUpdate,
 		Delete: resourceDomainDelete,
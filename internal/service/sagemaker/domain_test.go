@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,7 +18,7 @@ import (
 
 func testAccDomain_basic(t *testing.T) {
 	var domain sagemaker.DescribeDomainOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_domain.test"
 
 	resource.Test(t, resource.TestCase{
@@ -57,7 +56,7 @@ func testAccDomain_basic(t *testing.T) {
 
 func testAccDomain_kms(t *testing.T) {
 	var domain sagemaker.DescribeDomainOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_domain.test"
 
 	resource.Test(t, resource.TestCase{
@@ -85,7 +84,7 @@ func testAccDomain_kms(t *testing.T) {
 
 func testAccDomain_tags(t *testing.T) {
 	var domain sagemaker.DescribeDomainOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_domain.test"
 
 	resource.Test(t, resource.TestCase{
@@ -131,7 +130,7 @@ func testAccDomain_tags(t *testing.T) {
 
 func testAccDomain_securityGroup(t *testing.T) {
 	var domain sagemaker.DescribeDomainOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_domain.test"
 
 	resource.Test(t, resource.TestCase{
@@ -168,7 +167,7 @@ func testAccDomain_securityGroup(t *testing.T) {
 
 func testAccDomain_sharingSettings(t *testing.T) {
 	var domain sagemaker.DescribeDomainOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_domain.test"
 
 	resource.Test(t, resource.TestCase{
@@ -200,7 +199,7 @@ func testAccDomain_sharingSettings(t *testing.T) {
 
 func testAccDomain_tensorboardAppSettings(t *testing.T) {
 	var domain sagemaker.DescribeDomainOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_domain.test"
 
 	resource.Test(t, resource.TestCase{
@@ -231,7 +230,7 @@ func testAccDomain_tensorboardAppSettings(t *testing.T) {
 
 func testAccDomain_tensorboardAppSettingsWithImage(t *testing.T) {
 	var domain sagemaker.DescribeDomainOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_domain.test"
 
 	resource.Test(t, resource.TestCase{
@@ -263,7 +262,7 @@ func testAccDomain_tensorboardAppSettingsWithImage(t *testing.T) {
 
 func testAccDomain_kernelGatewayAppSettings(t *testing.T) {
 	var domain sagemaker.DescribeDomainOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_domain.test"
 
 	resource.Test(t, resource.TestCase{
@@ -294,7 +293,7 @@ func testAccDomain_kernelGatewayAppSettings(t *testing.T) {
 
 func testAccDomain_kernelGatewayAppSettings_lifecycleConfig(t *testing.T) {
 	var domain sagemaker.DescribeDomainOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_domain.test"
 
 	resource.Test(t, resource.TestCase{
@@ -331,7 +330,7 @@ func testAccDomain_kernelGatewayAppSettings_customImage(t *testing.T) {
 	}
 
 	var domain sagemaker.DescribeDomainOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_domain.test"
 	baseImage := os.Getenv("SAGEMAKER_IMAGE_VERSION_BASE_IMAGE")
 
@@ -365,7 +364,7 @@ func testAccDomain_kernelGatewayAppSettings_customImage(t *testing.T) {
 
 func testAccDomain_jupyterServerAppSettings(t *testing.T) {
 	var domain sagemaker.DescribeDomainOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_domain.test"
 
 	resource.Test(t, resource.TestCase{
@@ -396,7 +395,7 @@ func testAccDomain_jupyterServerAppSettings(t *testing.T) {
 
 func testAccDomain_disappears(t *testing.T) {
 	var domain sagemaker.DescribeDomainOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_domain.test"
 
 	resource.Test(t, resource.TestCase{
@@ -19,6 +19,7 @@ import (
 func ResourceImage() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceImageCreate,
+		//lintignore:AWSR004
 		Read:   resourceImageRead,
 		Update: resourceImageUpdate,
 		Delete: resourceImageDelete,
@@ -14,6 +14,7 @@ import (
 func ResourceImageVersion() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceImageVersionCreate,
+		//lintignore:AWSR004
 		Read:   resourceImageVersionRead,
 		Delete: resourceImageVersionDelete,
 		Importer: &schema.ResourceImporter{
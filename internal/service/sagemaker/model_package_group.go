@@ -18,6 +18,7 @@ import (
 func ResourceModelPackageGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceModelPackageGroupCreate,
+		//lintignore:AWSR004
 		Read:   resourceModelPackageGroupRead,
 		Update: resourceModelPackageGroupUpdate,
 		Delete: resourceModelPackageGroupDelete,
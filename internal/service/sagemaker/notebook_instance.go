@@ -24,6 +24,7 @@ import (
 func ResourceNotebookInstance() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNotebookInstanceCreate,
+		//lintignore:AWSR004
 		Read:   resourceNotebookInstanceRead,
 		Update: resourceNotebookInstanceUpdate,
 		Delete: resourceNotebookInstanceDelete,
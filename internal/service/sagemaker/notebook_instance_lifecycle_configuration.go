@@ -16,6 +16,7 @@ import (
 func ResourceNotebookInstanceLifeCycleConfiguration() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNotebookInstanceLifeCycleConfigurationCreate,
+		//lintignore:AWSR004
 		Read:   resourceNotebookInstanceLifeCycleConfigurationRead,
 		Update: resourceNotebookInstanceLifeCycleConfigurationUpdate,
 		Delete: resourceNotebookInstanceLifeCycleConfigurationDelete,
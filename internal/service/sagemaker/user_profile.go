@@ -20,6 +20,7 @@ import (
 func ResourceUserProfile() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceUserProfileCreate,
+		//lintignore:AWSR004
 		Read:   resourceUserProfileRead,
 		Update: resourceUserProfileUpdate,
 		Delete: resourceUserProfileDelete,
@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/sagemaker"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 
 func testAccWorkteam_cognitoConfig(t *testing.T) {
 	var workteam sagemaker.Workteam
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_workteam.test"
 
 	resource.Test(t, resource.TestCase{
@@ -88,7 +87,7 @@ func testAccWorkteam_cognitoConfig(t *testing.T) {
 
 func testAccWorkteam_oidcConfig(t *testing.T) {
 	var workteam sagemaker.Workteam
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_workteam.test"
 
 	resource.Test(t, resource.TestCase{
@@ -145,7 +144,7 @@ func testAccWorkteam_oidcConfig(t *testing.T) {
 
 func testAccWorkteam_tags(t *testing.T) {
 	var workteam sagemaker.Workteam
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_workteam.test"
 
 	resource.Test(t, resource.TestCase{
@@ -191,7 +190,7 @@ func testAccWorkteam_tags(t *testing.T) {
 
 func testAccWorkteam_notificationConfig(t *testing.T) {
 	var workteam sagemaker.Workteam
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_workteam.test"
 
 	resource.Test(t, resource.TestCase{
@@ -244,7 +243,7 @@ func testAccWorkteam_notificationConfig(t *testing.T) {
 
 func testAccWorkteam_disappears(t *testing.T) {
 	var workteam sagemaker.Workteam
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_sagemaker_workteam.test"
 
 	resource.Test(t, resource.TestCase{
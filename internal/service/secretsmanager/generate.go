@@ -1,4 +1,4 @@
-//go:generate go run ../../generate/tags/main.go -ListTagsInIDElem=SecretId -ServiceTagsSlice -TagInIDElem=SecretId -UpdateTags
+//go:generate go run ../../generate/tags/main.go -ListTagsInIDElem=SecretId -ServiceTagsSlice -TagInIDElem=SecretId -UpdateTags -Tests
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package secretsmanager
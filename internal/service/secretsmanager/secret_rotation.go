@@ -16,6 +16,7 @@ import (
 )
 
 func ResourceSecretRotation() *schema.Resource {
+	//lintignore:AWSR006 // Secret rotations can't be tagged, tags is unused
 	return &schema.Resource{
 		Create: resourceSecretRotationCreate,
 		Read:   resourceSecretRotationRead,
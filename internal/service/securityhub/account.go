@@ -15,6 +15,7 @@ import (
 func ResourceAccount() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAccountCreate,
+		//lintignore:AWSR004
 		Read:   resourceAccountRead,
 		Delete: resourceAccountDelete,
 		Importer: &schema.ResourceImporter{
@@ -16,6 +16,7 @@ import (
 func ResourceActionTarget() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceActionTargetCreate,
+		//lintignore:AWSR004
 		Read:   resourceActionTargetRead,
 		Update: resourceActionTargetUpdate,
 		Delete: resourceActionTargetDelete,
@@ -14,6 +14,7 @@ import (
 func ResourceInviteAccepter() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceInviteAccepterCreate,
+		//lintignore:AWSR004
 		Read:   resourceInviteAccepterRead,
 		Delete: resourceInviteAccepterDelete,
 		Importer: &schema.ResourceImporter{
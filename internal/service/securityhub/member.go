@@ -26,6 +26,7 @@ const (
 func ResourceMember() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceMemberCreate,
+		//lintignore:AWSR004
 		Read:   resourceMemberRead,
 		Delete: resourceMemberDelete,
 		Importer: &schema.ResourceImporter{
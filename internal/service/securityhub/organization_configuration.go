@@ -12,6 +12,7 @@ import (
 func ResourceOrganizationConfiguration() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceOrganizationConfigurationUpdate,
+		//lintignore:AWSR004
 		Read:   resourceOrganizationConfigurationRead,
 		Update: resourceOrganizationConfigurationUpdate,
 		Delete: schema.Noop,
@@ -15,6 +15,7 @@ import (
 func ResourceProductSubscription() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceProductSubscriptionCreate,
+		//lintignore:AWSR004
 		Read:   resourceProductSubscriptionRead,
 		Delete: resourceProductSubscriptionDelete,
 		Importer: &schema.ResourceImporter{
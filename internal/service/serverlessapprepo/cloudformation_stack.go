@@ -31,6 +31,7 @@ const (
 func ResourceCloudFormationStack() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCloudFormationStackCreate,
+		//lintignore:AWSR004
 		Read:   resourceCloudFormationStackRead,
 		Update: resourceCloudFormationStackUpdate,
 		Delete: resourceCloudFormationStackDelete,
@@ -19,6 +19,7 @@ import (
 func ResourcePortfolio() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePortfolioCreate,
+		//lintignore:AWSR004
 		Read:   resourcePortfolioRead,
 		Update: resourcePortfolioUpdate,
 		Delete: resourcePortfolioDelete,
@@ -27,6 +28,7 @@ func ResourcePortfolio() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		//lintignore:AWSR005 // Portfolio changes are synchronous, the timeouts are kept for existing configurations
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
@@ -327,6 +327,10 @@ func resourceProvisionedProductCreate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(aws.StringValue(output.RecordDetail.ProvisionedProductId))
 
+	if _, err := WaitProvisionedProductReady(conn, d.Get("accept_language").(string), d.Id(), "", d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Service Catalog Provisioned Product (%s) to be ready: %w", d.Id(), err)
+	}
+
 	return resourceProvisionedProductRead(d, meta)
 }
 
@@ -348,7 +352,7 @@ func resourceProvisionedProductRead(d *schema.ResourceData, meta interface{}) er
 		acceptLanguage = v.(string)
 	}
 
-	output, err := WaitProvisionedProductReady(conn, acceptLanguage, d.Id(), "")
+	output, err := WaitProvisionedProductReady(conn, acceptLanguage, d.Id(), "", ProvisionedProductReadyTimeout)
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] Service Catalog Provisioned Product (%s) not found, removing from state", d.Id())
@@ -490,6 +494,10 @@ func resourceProvisionedProductUpdate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error updating Service Catalog Provisioned Product (%s): %w", d.Id(), err)
 	}
 
+	if _, err := WaitProvisionedProductReady(conn, d.Get("accept_language").(string), d.Id(), "", d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for Service Catalog Provisioned Product (%s) to be updated: %w", d.Id(), err)
+	}
+
 	return resourceProvisionedProductRead(d, meta)
 }
 
@@ -523,7 +531,7 @@ func resourceProvisionedProductDelete(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error terminating Service Catalog Provisioned Product (%s): %w", d.Id(), err)
 	}
 
-	err = WaitProvisionedProductTerminated(conn, d.Get("accept_language").(string), d.Id(), "")
+	err = WaitProvisionedProductTerminated(conn, d.Get("accept_language").(string), d.Id(), "", d.Timeout(schema.TimeoutDelete))
 
 	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
 		return nil
@@ -122,7 +122,7 @@ func sweepBudgetResourceAssociations(region string) error {
 					d := r.Data(nil)
 					d.SetId(BudgetResourceAssociationID(aws.StringValue(budget.BudgetName), aws.StringValue(port.Id)))
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_servicecatalog_budget_resource_association", r, d, client))
 				}
 
 				return !lastPage
@@ -166,7 +166,7 @@ func sweepBudgetResourceAssociations(region string) error {
 					d := r.Data(nil)
 					d.SetId(BudgetResourceAssociationID(aws.StringValue(budget.BudgetName), aws.StringValue(pvd.ProductViewSummary.ProductId)))
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_servicecatalog_budget_resource_association", r, d, client))
 				}
 
 				return !lastPage
@@ -235,7 +235,7 @@ func sweepConstraints(region string) error {
 					d := r.Data(nil)
 					d.SetId(aws.StringValue(detail.ConstraintId))
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_servicecatalog_constraint", r, d, client))
 				}
 
 				return !lastPage
@@ -302,7 +302,7 @@ func sweepPrincipalPortfolioAssociations(region string) error {
 					d := r.Data(nil)
 					d.SetId(PrincipalPortfolioAssociationID(AcceptLanguageEnglish, aws.StringValue(principal.PrincipalARN), aws.StringValue(detail.Id)))
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_servicecatalog_principal_portfolio_association", r, d, client))
 				}
 
 				return !lastPage
@@ -394,7 +394,7 @@ func sweepProductPortfolioAssociations(region string) error {
 					d := r.Data(nil)
 					d.SetId(ProductPortfolioAssociationCreateID(AcceptLanguageEnglish, aws.StringValue(detail.Id), productID))
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_servicecatalog_product_portfolio_association", r, d, client))
 				}
 
 				return !lastPage
@@ -454,7 +454,7 @@ func sweepProducts(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_servicecatalog_product", r, d, client))
 		}
 
 		return !lastPage
@@ -508,7 +508,7 @@ func sweepProvisionedProducts(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(detail.Id))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_servicecatalog_provisioned_product", r, d, client))
 		}
 
 		return !lastPage
@@ -577,7 +577,7 @@ func sweepProvisioningArtifacts(region string) error {
 					d.SetId(aws.StringValue(pad.Id))
 					d.Set("product_id", productID)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_servicecatalog_provisioning_artifact", r, d, client))
 				}
 
 				/*
@@ -640,7 +640,7 @@ func sweepServiceActions(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_servicecatalog_service_action", r, d, client))
 		}
 
 		return !lastPage
@@ -703,7 +703,7 @@ func sweepTagOptionResourceAssociations(region string) error {
 					d := r.Data(nil)
 					d.SetId(aws.StringValue(resource.Id))
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource("aws_servicecatalog_tag_option_resource_association", r, d, client))
 				}
 
 				return !lastPage
@@ -758,7 +758,7 @@ func sweepTagOptions(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_servicecatalog_tag_option", r, d, client))
 		}
 
 		return !lastPage
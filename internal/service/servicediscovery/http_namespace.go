@@ -16,6 +16,7 @@ import (
 func ResourceHTTPNamespace() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceHTTPNamespaceCreate,
+		//lintignore:AWSR004
 		Read:   resourceHTTPNamespaceRead,
 		Update: resourceHTTPNamespaceUpdate,
 		Delete: resourceHTTPNamespaceDelete,
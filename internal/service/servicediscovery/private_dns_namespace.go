@@ -17,6 +17,7 @@ import (
 func ResourcePrivateDNSNamespace() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePrivateDNSNamespaceCreate,
+		//lintignore:AWSR004
 		Read:   resourcePrivateDNSNamespaceRead,
 		Update: resourcePrivateDNSNamespaceUpdate,
 		Delete: resourcePrivateDNSNamespaceDelete,
@@ -16,6 +16,7 @@ import (
 func ResourcePublicDNSNamespace() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePublicDNSNamespaceCreate,
+		//lintignore:AWSR004
 		Read:   resourcePublicDNSNamespaceRead,
 		Update: resourcePublicDNSNamespaceUpdate,
 		Delete: resourcePublicDNSNamespaceDelete,
@@ -17,6 +17,7 @@ import (
 func ResourceServiceQuota() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceServiceQuotaCreate,
+		//lintignore:AWSR004
 		Read:   resourceServiceQuotaRead,
 		Update: resourceServiceQuotaUpdate,
 		Delete: schema.Noop,
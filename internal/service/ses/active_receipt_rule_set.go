@@ -17,6 +17,7 @@ func ResourceActiveReceiptRuleSet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceActiveReceiptRuleSetUpdate,
 		Update: resourceActiveReceiptRuleSetUpdate,
+		//lintignore:AWSR004
 		Read:   resourceActiveReceiptRuleSetRead,
 		Delete: resourceActiveReceiptRuleSetDelete,
 
@@ -17,6 +17,7 @@ import (
 func ResourceConfigurationSet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceConfigurationSetCreate,
+		//lintignore:AWSR004
 		Read:   resourceConfigurationSetRead,
 		Update: resourceConfigurationSetUpdate,
 		Delete: resourceConfigurationSetDelete,
@@ -13,6 +13,7 @@ import (
 func ResourceDomainDKIM() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDomainDKIMCreate,
+		//lintignore:AWSR004
 		Read:   resourceDomainDKIMRead,
 		Delete: resourceDomainDKIMDelete,
 		Importer: &schema.ResourceImporter{
@@ -16,6 +16,7 @@ import (
 func ResourceDomainIdentity() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDomainIdentityCreate,
+		//lintignore:AWSR004
 		Read:   resourceDomainIdentityRead,
 		Delete: resourceDomainIdentityDelete,
 		Importer: &schema.ResourceImporter{
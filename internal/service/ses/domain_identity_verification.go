@@ -19,6 +19,7 @@ import (
 func ResourceDomainIdentityVerification() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDomainIdentityVerificationCreate,
+		//lintignore:AWSR004
 		Read:   resourceDomainIdentityVerificationRead,
 		Delete: resourceDomainIdentityVerificationDelete,
 
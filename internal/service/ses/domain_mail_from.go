@@ -13,6 +13,7 @@ import (
 func ResourceDomainMailFrom() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDomainMailFromSet,
+		//lintignore:AWSR004
 		Read:   resourceDomainMailFromRead,
 		Update: resourceDomainMailFromSet,
 		Delete: resourceDomainMailFromDelete,
@@ -15,6 +15,7 @@ import (
 func ResourceEmailIdentity() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceEmailIdentityCreate,
+		//lintignore:AWSR004
 		Read:   resourceEmailIdentityRead,
 		Delete: resourceEmailIdentityDelete,
 		Importer: &schema.ResourceImporter{
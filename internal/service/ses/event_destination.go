@@ -20,6 +20,7 @@ import (
 func ResourceEventDestination() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceEventDestinationCreate,
+		//lintignore:AWSR004
 		Read:   resourceEventDestinationRead,
 		Delete: resourceEventDestinationDelete,
 		Importer: &schema.ResourceImporter{
@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ses"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -15,9 +14,9 @@ import (
 )
 
 func TestAccSESEventDestination_basic(t *testing.T) {
-	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName2 := sdkacctest.RandomWithPrefix("tf-acc-test-kinesis")
-	rName3 := sdkacctest.RandomWithPrefix("tf-acc-test-sns")
+	rName1 := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := acctest.RandomWithPrefix("tf-acc-test-kinesis")
+	rName3 := acctest.RandomWithPrefix("tf-acc-test-sns")
 	cloudwatchDestinationResourceName := "aws_ses_event_destination.cloudwatch"
 	kinesisDestinationResourceName := "aws_ses_event_destination.kinesis"
 	snsDestinationResourceName := "aws_ses_event_destination.sns"
@@ -69,9 +68,9 @@ func TestAccSESEventDestination_basic(t *testing.T) {
 }
 
 func TestAccSESEventDestination_disappears(t *testing.T) {
-	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName2 := sdkacctest.RandomWithPrefix("tf-acc-test-kinesis")
-	rName3 := sdkacctest.RandomWithPrefix("tf-acc-test-sns")
+	rName1 := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := acctest.RandomWithPrefix("tf-acc-test-kinesis")
+	rName3 := acctest.RandomWithPrefix("tf-acc-test-sns")
 	cloudwatchDestinationResourceName := "aws_ses_event_destination.cloudwatch"
 	kinesisDestinationResourceName := "aws_ses_event_destination.kinesis"
 	snsDestinationResourceName := "aws_ses_event_destination.sns"
@@ -16,6 +16,7 @@ import (
 func ResourceIdentityNotificationTopic() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNotificationTopicSet,
+		//lintignore:AWSR004
 		Read:   resourceIdentityNotificationTopicRead,
 		Update: resourceNotificationTopicSet,
 		Delete: resourceIdentityNotificationTopicDelete,
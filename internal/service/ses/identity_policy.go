@@ -17,6 +17,7 @@ import (
 func ResourceIdentityPolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceIdentityPolicyCreate,
+		//lintignore:AWSR004
 		Read:   resourceIdentityPolicyRead,
 		Update: resourceIdentityPolicyUpdate,
 		Delete: resourceIdentityPolicyDelete,
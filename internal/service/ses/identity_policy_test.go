@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ses"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -40,7 +39,7 @@ func TestAccSESIdentityPolicy_basic(t *testing.T) {
 }
 
 func TestAccSESIdentityPolicy_Identity_email(t *testing.T) {
-	emailPrefix := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	emailPrefix := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	email := fmt.Sprintf("%s@%s", emailPrefix, acctest.RandomDomainName())
 	resourceName := "aws_ses_identity_policy.test"
 
@@ -16,6 +16,7 @@ import (
 func ResourceReceiptFilter() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceReceiptFilterCreate,
+		//lintignore:AWSR004
 		Read:   resourceReceiptFilterRead,
 		Delete: resourceReceiptFilterDelete,
 		Importer: &schema.ResourceImporter{
@@ -24,6 +24,7 @@ func ResourceReceiptRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceReceiptRuleCreate,
 		Update: resourceReceiptRuleUpdate,
+		//lintignore:AWSR004
 		Read:   resourceReceiptRuleRead,
 		Delete: resourceReceiptRuleDelete,
 		Importer: &schema.ResourceImporter{
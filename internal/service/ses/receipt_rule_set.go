@@ -16,6 +16,7 @@ import (
 func ResourceReceiptRuleSet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceReceiptRuleSetCreate,
+		//lintignore:AWSR004
 		Read:   resourceReceiptRuleSetRead,
 		Delete: resourceReceiptRuleSetDelete,
 		Importer: &schema.ResourceImporter{
@@ -16,6 +16,7 @@ import (
 func ResourceTemplate() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTemplateCreate,
+		//lintignore:AWSR004
 		Read:   resourceTemplateRead,
 		Update: resourceTemplateUpdate,
 		Delete: resourceTemplateDelete,
@@ -18,6 +18,7 @@ import (
 func ResourceActivity() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceActivityCreate,
+		//lintignore:AWSR004
 		Read:   resourceActivityRead,
 		Update: resourceActivityUpdate,
 		Delete: resourceActivityDelete,
@@ -17,6 +17,7 @@ func ResourceProtection() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceProtectionCreate,
 		Update: resourceProtectionUpdate,
+		//lintignore:AWSR004
 		Read:   resourceProtectionRead,
 		Delete: resourceProtectionDelete,
 		Importer: &schema.ResourceImporter{
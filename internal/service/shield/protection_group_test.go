@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/shield"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func TestAccShieldProtectionGroup_basic(t *testing.T) {
 	resourceName := "aws_shield_protection_group.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -53,7 +52,7 @@ func TestAccShieldProtectionGroup_basic(t *testing.T) {
 
 func TestAccShieldProtectionGroup_disappears(t *testing.T) {
 	resourceName := "aws_shield_protection_group.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -79,7 +78,7 @@ func TestAccShieldProtectionGroup_disappears(t *testing.T) {
 
 func TestAccShieldProtectionGroup_aggregation(t *testing.T) {
 	resourceName := "aws_shield_protection_group.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -121,7 +120,7 @@ func TestAccShieldProtectionGroup_aggregation(t *testing.T) {
 
 func TestAccShieldProtectionGroup_members(t *testing.T) {
 	resourceName := "aws_shield_protection_group.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -153,8 +152,8 @@ func TestAccShieldProtectionGroup_members(t *testing.T) {
 
 func TestAccShieldProtectionGroup_protectionGroupID(t *testing.T) {
 	resourceName := "aws_shield_protection_group.test"
-	testID1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	testID2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	testID1 := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	testID2 := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -196,7 +195,7 @@ func TestAccShieldProtectionGroup_protectionGroupID(t *testing.T) {
 
 func TestAccShieldProtectionGroup_resourceType(t *testing.T) {
 	resourceName := "aws_shield_protection_group.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
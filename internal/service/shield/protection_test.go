@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/aws/aws-sdk-go/service/shield"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,7 +18,7 @@ import (
 
 func TestAccShieldProtection_globalAccelerator(t *testing.T) {
 	resourceName := "aws_shield_protection.test"
-	rName := sdkacctest.RandString(10)
+	rName := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -50,7 +49,7 @@ func TestAccShieldProtection_globalAccelerator(t *testing.T) {
 
 func TestAccShieldProtection_elasticIPAddress(t *testing.T) {
 	resourceName := "aws_shield_protection.test"
-	rName := sdkacctest.RandString(10)
+	rName := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -81,7 +80,7 @@ func TestAccShieldProtection_elasticIPAddress(t *testing.T) {
 
 func TestAccShieldProtection_disappears(t *testing.T) {
 	resourceName := "aws_shield_protection.test"
-	rName := sdkacctest.RandString(10)
+	rName := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -107,7 +106,7 @@ func TestAccShieldProtection_disappears(t *testing.T) {
 
 func TestAccShieldProtection_alb(t *testing.T) {
 	resourceName := "aws_shield_protection.test"
-	rName := sdkacctest.RandString(10)
+	rName := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -138,7 +137,7 @@ func TestAccShieldProtection_alb(t *testing.T) {
 
 func TestAccShieldProtection_elb(t *testing.T) {
 	resourceName := "aws_shield_protection.test"
-	rName := sdkacctest.RandString(10)
+	rName := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -169,7 +168,7 @@ func TestAccShieldProtection_elb(t *testing.T) {
 
 func TestAccShieldProtection_cloudFront(t *testing.T) {
 	resourceName := "aws_shield_protection.test"
-	rName := sdkacctest.RandString(10)
+	rName := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -201,7 +200,7 @@ func TestAccShieldProtection_cloudFront(t *testing.T) {
 
 func TestAccShieldProtection_CloudFront_tags(t *testing.T) {
 	resourceName := "aws_shield_protection.test"
-	rName := sdkacctest.RandString(10)
+	rName := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -253,7 +252,7 @@ func TestAccShieldProtection_CloudFront_tags(t *testing.T) {
 
 func TestAccShieldProtection_route53(t *testing.T) {
 	resourceName := "aws_shield_protection.test"
-	rName := sdkacctest.RandString(10)
+	rName := acctest.RandString(10)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
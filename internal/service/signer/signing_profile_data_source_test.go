@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/signer"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
@@ -13,7 +12,7 @@ import (
 func TestAccSignerSigningProfileDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_signer_signing_profile.test"
 	resourceName := "aws_signer_signing_profile.test"
-	rString := sdkacctest.RandString(48)
+	rString := acctest.RandString(48)
 	profileName := fmt.Sprintf("tf_acc_sp_basic_%s", rString)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/signer"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 func TestAccSignerSigningProfilePermission_basic(t *testing.T) {
 	resourceName := "aws_signer_signing_profile_permission.test_sp_permission"
 	profileResourceName := "aws_signer_signing_profile.test_sp"
-	rString := sdkacctest.RandString(53)
+	rString := acctest.RandString(53)
 	profileName := fmt.Sprintf("tf_acc_spp_%s", rString)
 
 	var conf signer.GetSigningProfileOutput
@@ -51,7 +50,7 @@ func TestAccSignerSigningProfilePermission_basic(t *testing.T) {
 func TestAccSignerSigningProfilePermission_getSigningProfile(t *testing.T) {
 	resourceName := "aws_signer_signing_profile_permission.test_sp_permission"
 	profileResourceName := "aws_signer_signing_profile.test_sp"
-	rString := sdkacctest.RandString(53)
+	rString := acctest.RandString(53)
 	profileName := fmt.Sprintf("tf_acc_spp_%s", rString)
 
 	var conf signer.GetSigningProfileOutput
@@ -93,7 +92,7 @@ func TestAccSignerSigningProfilePermission_StartSigningJob_getSP(t *testing.T) {
 	resourceName1 := "aws_signer_signing_profile_permission.sp1_perm"
 	resourceName2 := "aws_signer_signing_profile_permission.sp2_perm"
 	profileResourceName := "aws_signer_signing_profile.test_sp"
-	rString := sdkacctest.RandString(53)
+	rString := acctest.RandString(53)
 	profileName := fmt.Sprintf("tf_acc_spp_%s", rString)
 
 	var conf signer.GetSigningProfileOutput
@@ -126,7 +125,7 @@ func TestAccSignerSigningProfilePermission_StartSigningJob_getSP(t *testing.T) {
 func TestAccSignerSigningProfilePermission_statementPrefix(t *testing.T) {
 	resourceName := "aws_signer_signing_profile_permission.sp1_perm"
 	profileResourceName := "aws_signer_signing_profile.test_sp"
-	rString := sdkacctest.RandString(53)
+	rString := acctest.RandString(53)
 	profileName := fmt.Sprintf("tf_acc_spp_%s", rString)
 	statementNamePrefix := "tf_acc_spp_statement_"
 
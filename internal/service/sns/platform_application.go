@@ -20,6 +20,7 @@ import (
 func ResourcePlatformApplication() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePlatformApplicationCreate,
+		//lintignore:AWSR004
 		Read:   resourcePlatformApplicationRead,
 		Update: resourcePlatformApplicationUpdate,
 		Delete: resourcePlatformApplicationDelete,
@@ -31,6 +31,7 @@ func validateDeliverySamplingRate(v interface{}, k string) (ws []string, errors
 func ResourceSMSPreferences() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSMSPreferencesSet,
+		//lintignore:AWSR004
 		Read:   resourceSMSPreferencesGet,
 		Update: resourceSMSPreferencesSet,
 		Delete: resourceSMSPreferencesDelete,
@@ -24,6 +24,7 @@ import (
 func ResourceTopic() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTopicCreate,
+		//lintignore:AWSR004
 		Read:   resourceTopicRead,
 		Update: resourceTopicUpdate,
 		Delete: resourceTopicDelete,
@@ -16,6 +16,7 @@ import (
 func ResourceTopicPolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTopicPolicyUpsert,
+		//lintignore:AWSR004
 		Read:   resourceTopicPolicyRead,
 		Update: resourceTopicPolicyUpsert,
 		Delete: resourceTopicPolicyDelete,
@@ -24,6 +24,7 @@ import (
 func ResourceTopicSubscription() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTopicSubscriptionCreate,
+		//lintignore:AWSR004
 		Read:   resourceTopicSubscriptionRead,
 		Update: resourceTopicSubscriptionUpdate,
 		Delete: resourceTopicSubscriptionDelete,
@@ -8,7 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
-	awspolicy "github.com/jen20/awspolicyequivalence"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 const (
@@ -49,7 +49,7 @@ func waitQueueAttributesPropagated(conn *sqs.SQS, url string, expected map[strin
 
 			switch k {
 			case sqs.QueueAttributeNamePolicy:
-				equivalent, err := awspolicy.PoliciesAreEquivalent(g, e)
+				equivalent, err := verify.PolicyStringsEquivalent(g, e)
 
 				if err != nil {
 					return err
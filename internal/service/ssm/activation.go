@@ -21,6 +21,7 @@ import (
 func ResourceActivation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceActivationCreate,
+		//lintignore:AWSR004
 		Read:   resourceActivationRead,
 		Delete: resourceActivationDelete,
 		Importer: &schema.ResourceImporter{
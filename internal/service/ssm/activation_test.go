@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,8 +15,8 @@ import (
 
 func TestAccSSMActivation_basic(t *testing.T) {
 	var ssmActivation ssm.Activation
-	name := sdkacctest.RandomWithPrefix("tf-acc")
-	tag := sdkacctest.RandomWithPrefix("tf-acc")
+	name := acctest.RandomWithPrefix("tf-acc")
+	tag := acctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_ssm_activation.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -49,7 +48,7 @@ func TestAccSSMActivation_basic(t *testing.T) {
 
 func TestAccSSMActivation_update(t *testing.T) {
 	var ssmActivation1, ssmActivation2 ssm.Activation
-	name := sdkacctest.RandomWithPrefix("tf-acc")
+	name := acctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_ssm_activation.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -99,7 +98,7 @@ func TestAccSSMActivation_update(t *testing.T) {
 
 func TestAccSSMActivation_expirationDate(t *testing.T) {
 	var ssmActivation ssm.Activation
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix("tf-acc")
 	expirationTime := time.Now().Add(48 * time.Hour).UTC()
 	expirationDateS := expirationTime.Format(time.RFC3339)
 	resourceName := "aws_ssm_activation.test"
@@ -131,8 +130,8 @@ func TestAccSSMActivation_expirationDate(t *testing.T) {
 
 func TestAccSSMActivation_disappears(t *testing.T) {
 	var ssmActivation ssm.Activation
-	name := sdkacctest.RandomWithPrefix("tf-acc")
-	tag := sdkacctest.RandomWithPrefix("tf-acc")
+	name := acctest.RandomWithPrefix("tf-acc")
+	tag := acctest.RandomWithPrefix("tf-acc")
 	resourceName := "aws_ssm_activation.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -17,6 +17,7 @@ func ResourceAssociation() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
 		Create: resourceAssociationCreate,
+		//lintignore:AWSR004
 		Read:   resourceAssociationRead,
 		Update: resourceAssociationUpdate,
 		Delete: resourceAssociationDelete,
@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 )
 
 func TestAccSSMAssociation_basic(t *testing.T) {
-	name := fmt.Sprintf("tf-acc-ssm-association-%s", sdkacctest.RandString(10))
+	name := fmt.Sprintf("tf-acc-ssm-association-%s", acctest.RandString(10))
 	resourceName := "aws_ssm_association.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -42,7 +41,7 @@ func TestAccSSMAssociation_basic(t *testing.T) {
 }
 
 func TestAccSSMAssociation_disappears(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ssm_association.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -64,7 +63,7 @@ func TestAccSSMAssociation_disappears(t *testing.T) {
 }
 
 func TestAccSSMAssociation_applyOnlyAtCronInterval(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_ssm_association.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -97,7 +96,7 @@ func TestAccSSMAssociation_applyOnlyAtCronInterval(t *testing.T) {
 }
 
 func TestAccSSMAssociation_withTargets(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_ssm_association.test"
 	oneTarget := `
 
@@ -176,7 +175,7 @@ targets {
 }
 
 func TestAccSSMAssociation_withParameters(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_ssm_association.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -212,9 +211,9 @@ func TestAccSSMAssociation_withParameters(t *testing.T) {
 }
 
 func TestAccSSMAssociation_withAssociationName(t *testing.T) {
-	assocName1 := sdkacctest.RandString(10)
-	assocName2 := sdkacctest.RandString(10)
-	rName := sdkacctest.RandString(5)
+	assocName1 := acctest.RandString(10)
+	assocName2 := acctest.RandString(10)
+	rName := acctest.RandString(5)
 	resourceName := "aws_ssm_association.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -249,8 +248,8 @@ func TestAccSSMAssociation_withAssociationName(t *testing.T) {
 }
 
 func TestAccSSMAssociation_withAssociationNameAndScheduleExpression(t *testing.T) {
-	assocName := sdkacctest.RandString(10)
-	rName := sdkacctest.RandString(5)
+	assocName := acctest.RandString(10)
+	rName := acctest.RandString(5)
 	resourceName := "aws_ssm_association.test"
 	scheduleExpression1 := "cron(0 16 ? * TUE *)"
 	scheduleExpression2 := "cron(0 16 ? * WED *)"
@@ -287,7 +286,7 @@ func TestAccSSMAssociation_withAssociationNameAndScheduleExpression(t *testing.T
 }
 
 func TestAccSSMAssociation_withDocumentVersion(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_ssm_association.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -314,7 +313,7 @@ func TestAccSSMAssociation_withDocumentVersion(t *testing.T) {
 }
 
 func TestAccSSMAssociation_withOutputLocation(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_ssm_association.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -363,7 +362,7 @@ func TestAccSSMAssociation_withOutputLocation(t *testing.T) {
 }
 
 func TestAccSSMAssociation_withAutomationTargetParamName(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_ssm_association.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -399,7 +398,7 @@ func TestAccSSMAssociation_withAutomationTargetParamName(t *testing.T) {
 }
 
 func TestAccSSMAssociation_withScheduleExpression(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_ssm_association.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -432,8 +431,8 @@ func TestAccSSMAssociation_withScheduleExpression(t *testing.T) {
 }
 
 func TestAccSSMAssociation_withComplianceSeverity(t *testing.T) {
-	assocName := sdkacctest.RandString(10)
-	rName := sdkacctest.RandString(10)
+	assocName := acctest.RandString(10)
+	rName := acctest.RandString(10)
 	compSeverity1 := "HIGH"
 	compSeverity2 := "LOW"
 	resourceName := "aws_ssm_association.test"
@@ -474,7 +473,7 @@ func TestAccSSMAssociation_withComplianceSeverity(t *testing.T) {
 }
 
 func TestAccSSMAssociation_rateControl(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_ssm_association.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -26,6 +26,7 @@ const (
 func ResourceDocument() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDocumentCreate,
+		//lintignore:AWSR004
 		Read:   resourceDocumentRead,
 		Update: resourceDocumentUpdate,
 		Delete: resourceDocumentDelete,
@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ssm"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 )
 
 func TestAccSSMDocument_basic(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -46,8 +45,8 @@ func TestAccSSMDocument_basic(t *testing.T) {
 }
 
 func TestAccSSMDocument_name(t *testing.T) {
-	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName1 := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ssm_document.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -80,7 +79,7 @@ func TestAccSSMDocument_name(t *testing.T) {
 }
 
 func TestAccSSMDocument_Target_type(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -112,7 +111,7 @@ func TestAccSSMDocument_Target_type(t *testing.T) {
 }
 
 func TestAccSSMDocument_versionName(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -144,7 +143,7 @@ func TestAccSSMDocument_versionName(t *testing.T) {
 }
 
 func TestAccSSMDocument_update(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -179,7 +178,7 @@ func TestAccSSMDocument_update(t *testing.T) {
 }
 
 func TestAccSSMDocument_Permission_public(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -205,7 +204,7 @@ func TestAccSSMDocument_Permission_public(t *testing.T) {
 }
 
 func TestAccSSMDocument_Permission_private(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
 	ids := "123456789012"
 	resource.ParallelTest(t, resource.TestCase{
@@ -231,7 +230,7 @@ func TestAccSSMDocument_Permission_private(t *testing.T) {
 }
 
 func TestAccSSMDocument_Permission_batching(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
 	ids := "123456789012,123456789013,123456789014,123456789015,123456789016,123456789017,123456789018,123456789019,123456789020,123456789021,123456789022,123456789023,123456789024,123456789025,123456789026,123456789027,123456789028,123456789029,123456789030,123456789031,123456789032"
 	resource.ParallelTest(t, resource.TestCase{
@@ -257,7 +256,7 @@ func TestAccSSMDocument_Permission_batching(t *testing.T) {
 }
 
 func TestAccSSMDocument_Permission_change(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
 	idsInitial := "123456789012,123456789013"
 	idsRemove := "123456789012"
@@ -302,7 +301,7 @@ func TestAccSSMDocument_Permission_change(t *testing.T) {
 }
 
 func TestAccSSMDocument_params(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -332,7 +331,7 @@ func TestAccSSMDocument_params(t *testing.T) {
 }
 
 func TestAccSSMDocument_automation(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -357,9 +356,9 @@ func TestAccSSMDocument_automation(t *testing.T) {
 }
 
 func TestAccSSMDocument_package(t *testing.T) {
-	name := sdkacctest.RandString(10)
-	rInt := sdkacctest.RandInt()
-	rInt2 := sdkacctest.RandInt()
+	name := acctest.RandString(10)
+	rInt := acctest.RandInt()
+	rInt2 := acctest.RandInt()
 	resourceName := "aws_ssm_document.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -393,7 +392,7 @@ func TestAccSSMDocument_package(t *testing.T) {
 }
 
 func TestAccSSMDocument_SchemaVersion_1(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ssm_document.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -426,7 +425,7 @@ func TestAccSSMDocument_SchemaVersion_1(t *testing.T) {
 }
 
 func TestAccSSMDocument_session(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -451,7 +450,7 @@ func TestAccSSMDocument_session(t *testing.T) {
 }
 
 func TestAccSSMDocument_DocumentFormat_yaml(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
 	content1 := `
 ---
@@ -507,7 +506,7 @@ mainSteps:
 }
 
 func TestAccSSMDocument_tags(t *testing.T) {
-	rName := sdkacctest.RandString(10)
+	rName := acctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -551,7 +550,7 @@ func TestAccSSMDocument_tags(t *testing.T) {
 }
 
 func TestAccSSMDocument_disappears(t *testing.T) {
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
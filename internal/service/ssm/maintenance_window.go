@@ -17,6 +17,7 @@ import (
 func ResourceMaintenanceWindow() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceMaintenanceWindowCreate,
+		//lintignore:AWSR004
 		Read:   resourceMaintenanceWindowRead,
 		Update: resourceMaintenanceWindowUpdate,
 		Delete: resourceMaintenanceWindowDelete,
@@ -17,6 +17,7 @@ import (
 func ResourceMaintenanceWindowTarget() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceMaintenanceWindowTargetCreate,
+		//lintignore:AWSR004
 		Read:   resourceMaintenanceWindowTargetRead,
 		Update: resourceMaintenanceWindowTargetUpdate,
 		Delete: resourceMaintenanceWindowTargetDelete,
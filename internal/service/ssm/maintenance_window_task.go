@@ -20,6 +20,7 @@ import (
 func ResourceMaintenanceWindowTask() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceMaintenanceWindowTaskCreate,
+		//lintignore:AWSR004
 		Read:   resourceMaintenanceWindowTaskRead,
 		Update: resourceMaintenanceWindowTaskUpdate,
 		Delete: resourceMaintenanceWindowTaskDelete,
@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 
 func TestAccSSMParameter_basic(t *testing.T) {
 	var param ssm.Parameter
-	name := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	name := fmt.Sprintf("%s_%s", t.Name(), acctest.RandString(10))
 	resourceName := "aws_ssm_parameter.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -51,7 +50,7 @@ func TestAccSSMParameter_basic(t *testing.T) {
 
 func TestAccSSMParameter_tier(t *testing.T) {
 	var parameter1, parameter2, parameter3 ssm.Parameter
-	rName := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	rName := fmt.Sprintf("%s_%s", t.Name(), acctest.RandString(10))
 	resourceName := "aws_ssm_parameter.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -93,7 +92,7 @@ func TestAccSSMParameter_tier(t *testing.T) {
 
 func TestAccSSMParameter_Tier_intelligentTieringToStandard(t *testing.T) {
 	var parameter ssm.Parameter
-	rName := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	rName := fmt.Sprintf("%s_%s", t.Name(), acctest.RandString(10))
 	resourceName := "aws_ssm_parameter.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -141,7 +140,7 @@ func TestAccSSMParameter_Tier_intelligentTieringToStandard(t *testing.T) {
 
 func TestAccSSMParameter_Tier_intelligentTieringToAdvanced(t *testing.T) {
 	var parameter1, parameter2 ssm.Parameter
-	rName := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	rName := fmt.Sprintf("%s_%s", t.Name(), acctest.RandString(10))
 	resourceName := "aws_ssm_parameter.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -189,7 +188,7 @@ func TestAccSSMParameter_Tier_intelligentTieringToAdvanced(t *testing.T) {
 
 func TestAccSSMParameter_disappears(t *testing.T) {
 	var param ssm.Parameter
-	name := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	name := fmt.Sprintf("%s_%s", t.Name(), acctest.RandString(10))
 	resourceName := "aws_ssm_parameter.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -212,7 +211,7 @@ func TestAccSSMParameter_disappears(t *testing.T) {
 
 func TestAccSSMParameter_overwrite(t *testing.T) {
 	var param ssm.Parameter
-	name := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	name := fmt.Sprintf("%s_%s", t.Name(), acctest.RandString(10))
 	resourceName := "aws_ssm_parameter.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -245,7 +244,7 @@ func TestAccSSMParameter_overwrite(t *testing.T) {
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/18550
 func TestAccSSMParameter_overwriteWithTags(t *testing.T) {
 	var param ssm.Parameter
-	rName := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	rName := fmt.Sprintf("%s_%s", t.Name(), acctest.RandString(10))
 	resourceName := "aws_ssm_parameter.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -275,7 +274,7 @@ func TestAccSSMParameter_overwriteWithTags(t *testing.T) {
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/18550
 func TestAccSSMParameter_noOverwriteWithTags(t *testing.T) {
 	var param ssm.Parameter
-	rName := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	rName := fmt.Sprintf("%s_%s", t.Name(), acctest.RandString(10))
 	resourceName := "aws_ssm_parameter.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -305,7 +304,7 @@ func TestAccSSMParameter_noOverwriteWithTags(t *testing.T) {
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/18550
 func TestAccSSMParameter_updateToOverwriteWithTags(t *testing.T) {
 	var param ssm.Parameter
-	rName := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	rName := fmt.Sprintf("%s_%s", t.Name(), acctest.RandString(10))
 	resourceName := "aws_ssm_parameter.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -341,7 +340,7 @@ func TestAccSSMParameter_updateToOverwriteWithTags(t *testing.T) {
 
 func TestAccSSMParameter_tags(t *testing.T) {
 	var param ssm.Parameter
-	rName := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	rName := fmt.Sprintf("%s_%s", t.Name(), acctest.RandString(10))
 	resourceName := "aws_ssm_parameter.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -387,7 +386,7 @@ func TestAccSSMParameter_tags(t *testing.T) {
 
 func TestAccSSMParameter_updateType(t *testing.T) {
 	var param ssm.Parameter
-	name := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	name := fmt.Sprintf("%s_%s", t.Name(), acctest.RandString(10))
 	resourceName := "aws_ssm_parameter.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -418,7 +417,7 @@ func TestAccSSMParameter_updateType(t *testing.T) {
 
 func TestAccSSMParameter_updateDescription(t *testing.T) {
 	var param ssm.Parameter
-	name := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	name := fmt.Sprintf("%s_%s", t.Name(), acctest.RandString(10))
 	resourceName := "aws_ssm_parameter.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -449,8 +448,8 @@ func TestAccSSMParameter_updateDescription(t *testing.T) {
 
 func TestAccSSMParameter_changeNameForcesNew(t *testing.T) {
 	var beforeParam, afterParam ssm.Parameter
-	before := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
-	after := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	before := fmt.Sprintf("%s_%s", t.Name(), acctest.RandString(10))
+	after := fmt.Sprintf("%s_%s", t.Name(), acctest.RandString(10))
 	resourceName := "aws_ssm_parameter.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -484,7 +483,7 @@ func TestAccSSMParameter_changeNameForcesNew(t *testing.T) {
 
 func TestAccSSMParameter_fullPath(t *testing.T) {
 	var param ssm.Parameter
-	name := fmt.Sprintf("/path/%s_%s", t.Name(), sdkacctest.RandString(10))
+	name := fmt.Sprintf("/path/%s_%s", t.Name(), acctest.RandString(10))
 	resourceName := "aws_ssm_parameter.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -514,7 +513,7 @@ func TestAccSSMParameter_fullPath(t *testing.T) {
 
 func TestAccSSMParameter_secure(t *testing.T) {
 	var param ssm.Parameter
-	name := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	name := fmt.Sprintf("%s_%s", t.Name(), acctest.RandString(10))
 	resourceName := "aws_ssm_parameter.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -544,7 +543,7 @@ func TestAccSSMParameter_secure(t *testing.T) {
 
 func TestAccSSMParameter_DataType_awsEC2Image(t *testing.T) {
 	var param ssm.Parameter
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ssm_parameter.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -572,7 +571,7 @@ func TestAccSSMParameter_DataType_awsEC2Image(t *testing.T) {
 
 func TestAccSSMParameter_secureWithKey(t *testing.T) {
 	var param ssm.Parameter
-	randString := sdkacctest.RandString(10)
+	randString := acctest.RandString(10)
 	name := fmt.Sprintf("%s_%s", t.Name(), randString)
 	resourceName := "aws_ssm_parameter.secret_test"
 
@@ -603,7 +602,7 @@ func TestAccSSMParameter_secureWithKey(t *testing.T) {
 
 func TestAccSSMParameter_Secure_keyUpdate(t *testing.T) {
 	var param ssm.Parameter
-	randString := sdkacctest.RandString(10)
+	randString := acctest.RandString(10)
 	name := fmt.Sprintf("%s_%s", t.Name(), randString)
 	resourceName := "aws_ssm_parameter.secret_test"
 
@@ -21,6 +21,7 @@ import (
 func ResourcePatchBaseline() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePatchBaselineCreate,
+		//lintignore:AWSR004
 		Read:   resourcePatchBaselineRead,
 		Update: resourcePatchBaselineUpdate,
 		Delete: resourcePatchBaselineDelete,
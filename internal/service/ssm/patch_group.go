@@ -15,6 +15,7 @@ import (
 func ResourcePatchGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePatchGroupCreate,
+		//lintignore:AWSR004
 		Read:   resourcePatchGroupRead,
 		Delete: resourcePatchGroupDelete,
 
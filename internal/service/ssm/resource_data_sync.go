@@ -15,6 +15,7 @@ import (
 func ResourceResourceDataSync() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceResourceDataSyncCreate,
+		//lintignore:AWSR004
 		Read:   resourceResourceDataSyncRead,
 		Delete: resourceResourceDataSyncDelete,
 
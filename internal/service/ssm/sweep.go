@@ -108,7 +108,7 @@ func sweepResourceDataSyncs(region string) error {
 			d.SetId(aws.StringValue(resourceDataSync.SyncName))
 			d.Set("name", resourceDataSync.SyncName)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_ssm_resource_data_sync", r, d, client))
 		}
 
 		return !lastPage
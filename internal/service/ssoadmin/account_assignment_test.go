@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func TestAccSSOAdminAccountAssignment_Basic_group(t *testing.T) {
 	resourceName := "aws_ssoadmin_account_assignment.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	groupName := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -51,7 +50,7 @@ func TestAccSSOAdminAccountAssignment_Basic_group(t *testing.T) {
 
 func TestAccSSOAdminAccountAssignment_Basic_user(t *testing.T) {
 	resourceName := "aws_ssoadmin_account_assignment.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	userName := os.Getenv("AWS_IDENTITY_STORE_USER_NAME")
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -84,7 +83,7 @@ func TestAccSSOAdminAccountAssignment_Basic_user(t *testing.T) {
 
 func TestAccSSOAdminAccountAssignment_disappears(t *testing.T) {
 	resourceName := "aws_ssoadmin_account_assignment.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	groupName := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
 
 	resource.ParallelTest(t, resource.TestCase{
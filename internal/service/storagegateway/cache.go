@@ -16,6 +16,7 @@ import (
 func ResourceCache() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCacheCreate,
+		//lintignore:AWSR004
 		Read:   resourceCacheRead,
 		Delete: schema.Noop,
 		Importer: &schema.ResourceImporter{
@@ -21,6 +21,7 @@ import (
 func ResourceCachediSCSIVolume() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCachediSCSIVolumeCreate,
+		//lintignore:AWSR004
 		Read:   resourceCachediSCSIVolumeRead,
 		Update: resourceCachediSCSIVolumeUpdate,
 		Delete: resourceCachediSCSIVolumeDelete,
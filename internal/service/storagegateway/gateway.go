@@ -26,6 +26,7 @@ import (
 func ResourceGateway() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceGatewayCreate,
+		//lintignore:AWSR004
 		Read:   resourceGatewayRead,
 		Update: resourceGatewayUpdate,
 		Delete: resourceGatewayDelete,
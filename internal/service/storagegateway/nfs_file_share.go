@@ -23,6 +23,7 @@ import (
 func ResourceNFSFileShare() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNFSFileShareCreate,
+		//lintignore:AWSR004
 		Read:   resourceNFSFileShareRead,
 		Update: resourceNFSFileShareUpdate,
 		Delete: resourceNFSFileShareDelete,
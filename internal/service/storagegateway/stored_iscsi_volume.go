@@ -19,6 +19,7 @@ import (
 func ResourceStorediSCSIVolume() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceStorediSCSIVolumeCreate,
+		//lintignore:AWSR004
 		Read:   resourceStorediSCSIVolumeRead,
 		Update: resourceStorediSCSIVolumeUpdate,
 		Delete: resourceStorediSCSIVolumeDelete,
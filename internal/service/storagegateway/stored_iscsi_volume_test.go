@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func TestAccStorageGatewayStorediSCSIVolume_basic(t *testing.T) {
 	var storedIscsiVolume storagegateway.StorediSCSIVolume
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_storagegateway_stored_iscsi_volume.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -59,7 +58,7 @@ func TestAccStorageGatewayStorediSCSIVolume_basic(t *testing.T) {
 
 func TestAccStorageGatewayStorediSCSIVolume_kms(t *testing.T) {
 	var storedIscsiVolume storagegateway.StorediSCSIVolume
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_storagegateway_stored_iscsi_volume.test"
 	keyResourceName := "aws_kms_key.test"
 
@@ -88,7 +87,7 @@ func TestAccStorageGatewayStorediSCSIVolume_kms(t *testing.T) {
 
 func TestAccStorageGatewayStorediSCSIVolume_tags(t *testing.T) {
 	var storedIscsiVolume storagegateway.StorediSCSIVolume
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_storagegateway_stored_iscsi_volume.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -136,7 +135,7 @@ func TestAccStorageGatewayStorediSCSIVolume_tags(t *testing.T) {
 
 func TestAccStorageGatewayStorediSCSIVolume_snapshotID(t *testing.T) {
 	var storedIscsiVolume storagegateway.StorediSCSIVolume
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_storagegateway_stored_iscsi_volume.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -173,7 +172,7 @@ func TestAccStorageGatewayStorediSCSIVolume_snapshotID(t *testing.T) {
 
 func TestAccStorageGatewayStorediSCSIVolume_disappears(t *testing.T) {
 	var storedIscsiVolume storagegateway.StorediSCSIVolume
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_storagegateway_stored_iscsi_volume.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -16,6 +16,7 @@ import (
 func ResourceTapePool() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTapePoolCreate,
+		//lintignore:AWSR004
 		Read:   resourceTapePoolRead,
 		Update: resourceTapePoolUpdate,
 		Delete: resourceTapePoolDelete,
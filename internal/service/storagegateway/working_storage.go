@@ -16,6 +16,7 @@ import (
 func ResourceWorkingStorage() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceWorkingStorageCreate,
+		//lintignore:AWSR004
 		Read:   resourceWorkingStorageRead,
 		Delete: schema.Noop,
 		Importer: &schema.ResourceImporter{
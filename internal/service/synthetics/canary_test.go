@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/synthetics"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -21,7 +20,7 @@ import (
 
 func TestAccSyntheticsCanary_basic(t *testing.T) {
 	var conf1, conf2 synthetics.Canary
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
 	resourceName := "aws_synthetics_canary.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -94,7 +93,7 @@ func TestAccSyntheticsCanary_basic(t *testing.T) {
 
 func TestAccSyntheticsCanary_runtimeVersion(t *testing.T) {
 	var conf1 synthetics.Canary
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
 	resourceName := "aws_synthetics_canary.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -129,7 +128,7 @@ func TestAccSyntheticsCanary_runtimeVersion(t *testing.T) {
 
 func TestAccSyntheticsCanary_startCanary(t *testing.T) {
 	var conf1, conf2, conf3 synthetics.Canary
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
 	resourceName := "aws_synthetics_canary.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -177,7 +176,7 @@ func TestAccSyntheticsCanary_startCanary(t *testing.T) {
 
 func TestAccSyntheticsCanary_StartCanary_codeChanges(t *testing.T) {
 	var conf1, conf2 synthetics.Canary
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
 	resourceName := "aws_synthetics_canary.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -218,7 +217,7 @@ func TestAccSyntheticsCanary_StartCanary_codeChanges(t *testing.T) {
 
 func TestAccSyntheticsCanary_s3(t *testing.T) {
 	var conf synthetics.Canary
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
 	resourceName := "aws_synthetics_canary.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -262,7 +261,7 @@ func TestAccSyntheticsCanary_s3(t *testing.T) {
 
 func TestAccSyntheticsCanary_run(t *testing.T) {
 	var conf synthetics.Canary
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
 	resourceName := "aws_synthetics_canary.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -308,7 +307,7 @@ func TestAccSyntheticsCanary_run(t *testing.T) {
 
 func TestAccSyntheticsCanary_runTracing(t *testing.T) {
 	var conf synthetics.Canary
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
 	resourceName := "aws_synthetics_canary.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -350,7 +349,7 @@ func TestAccSyntheticsCanary_runTracing(t *testing.T) {
 
 func TestAccSyntheticsCanary_vpc(t *testing.T) {
 	var conf synthetics.Canary
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
 	resourceName := "aws_synthetics_canary.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -399,7 +398,7 @@ func TestAccSyntheticsCanary_vpc(t *testing.T) {
 
 func TestAccSyntheticsCanary_tags(t *testing.T) {
 	var conf synthetics.Canary
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
 	resourceName := "aws_synthetics_canary.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -445,7 +444,7 @@ func TestAccSyntheticsCanary_tags(t *testing.T) {
 
 func TestAccSyntheticsCanary_disappears(t *testing.T) {
 	var conf synthetics.Canary
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
 	resourceName := "aws_synthetics_canary.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -16,6 +16,7 @@ func ResourceSSHKey() *schema.Resource {
 
 	return &schema.Resource{
 		Create: resourceSSHKeyCreate,
+		//lintignore:AWSR004
 		Read:   resourceSSHKeyRead,
 		Delete: resourceSSHKeyDelete,
 		Importer: &schema.ResourceImporter{
@@ -15,6 +15,7 @@ import (
 func ResourceByteMatchSet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceByteMatchSetCreate,
+		//lintignore:AWSR004
 		Read:   resourceByteMatchSetRead,
 		Update: resourceByteMatchSetUpdate,
 		Delete: resourceByteMatchSetDelete,
@@ -15,6 +15,7 @@ import (
 func ResourceGeoMatchSet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceGeoMatchSetCreate,
+		//lintignore:AWSR004
 		Read:   resourceGeoMatchSetRead,
 		Update: resourceGeoMatchSetUpdate,
 		Delete: resourceGeoMatchSetDelete,
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func TestAccWAFGeoMatchSet_basic(t *testing.T) {
 	var v waf.GeoMatchSet
-	geoMatchSet := fmt.Sprintf("geoMatchSet-%s", sdkacctest.RandString(5))
+	geoMatchSet := fmt.Sprintf("geoMatchSet-%s", acctest.RandString(5))
 	resourceName := "aws_waf_geo_match_set.geo_match_set"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -55,8 +54,8 @@ func TestAccWAFGeoMatchSet_basic(t *testing.T) {
 
 func TestAccWAFGeoMatchSet_changeNameForceNew(t *testing.T) {
 	var before, after waf.GeoMatchSet
-	geoMatchSet := fmt.Sprintf("geoMatchSet-%s", sdkacctest.RandString(5))
-	geoMatchSetNewName := fmt.Sprintf("geoMatchSetNewName-%s", sdkacctest.RandString(5))
+	geoMatchSet := fmt.Sprintf("geoMatchSet-%s", acctest.RandString(5))
+	geoMatchSetNewName := fmt.Sprintf("geoMatchSetNewName-%s", acctest.RandString(5))
 	resourceName := "aws_waf_geo_match_set.geo_match_set"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -92,7 +91,7 @@ func TestAccWAFGeoMatchSet_changeNameForceNew(t *testing.T) {
 
 func TestAccWAFGeoMatchSet_disappears(t *testing.T) {
 	var v waf.GeoMatchSet
-	geoMatchSet := fmt.Sprintf("geoMatchSet-%s", sdkacctest.RandString(5))
+	geoMatchSet := fmt.Sprintf("geoMatchSet-%s", acctest.RandString(5))
 	resourceName := "aws_waf_geo_match_set.geo_match_set"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -115,7 +114,7 @@ func TestAccWAFGeoMatchSet_disappears(t *testing.T) {
 
 func TestAccWAFGeoMatchSet_changeConstraints(t *testing.T) {
 	var before, after waf.GeoMatchSet
-	setName := fmt.Sprintf("geoMatchSet-%s", sdkacctest.RandString(5))
+	setName := fmt.Sprintf("geoMatchSet-%s", acctest.RandString(5))
 	resourceName := "aws_waf_geo_match_set.geo_match_set"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -167,7 +166,7 @@ func TestAccWAFGeoMatchSet_changeConstraints(t *testing.T) {
 
 func TestAccWAFGeoMatchSet_noConstraints(t *testing.T) {
 	var ipset waf.GeoMatchSet
-	setName := fmt.Sprintf("geoMatchSet-%s", sdkacctest.RandString(5))
+	setName := fmt.Sprintf("geoMatchSet-%s", acctest.RandString(5))
 	resourceName := "aws_waf_geo_match_set.geo_match_set"
 
 	resource.ParallelTest(t, resource.TestCase{
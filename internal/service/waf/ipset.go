@@ -19,6 +19,7 @@ const ipSetUpdatesLimit = 1000
 func ResourceIPSet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceIPSetCreate,
+		//lintignore:AWSR004
 		Read:   resourceIPSetRead,
 		Update: resourceIPSetUpdate,
 		Delete: resourceIPSetDelete,
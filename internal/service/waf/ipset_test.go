@@ -11,7 +11,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -21,7 +20,7 @@ import (
 
 func TestAccWAFIPSet_basic(t *testing.T) {
 	var v waf.IPSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_waf_ipset.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -53,7 +52,7 @@ func TestAccWAFIPSet_basic(t *testing.T) {
 
 func TestAccWAFIPSet_disappears(t *testing.T) {
 	var v waf.IPSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_waf_ipset.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -76,8 +75,8 @@ func TestAccWAFIPSet_disappears(t *testing.T) {
 
 func TestAccWAFIPSet_changeNameForceNew(t *testing.T) {
 	var before, after waf.IPSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	uName := sdkacctest.RandomWithPrefix("tf-acc-test-updated")
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	uName := acctest.RandomWithPrefix("tf-acc-test-updated")
 	resourceName := "aws_waf_ipset.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -119,7 +118,7 @@ func TestAccWAFIPSet_changeNameForceNew(t *testing.T) {
 
 func TestAccWAFIPSet_changeDescriptors(t *testing.T) {
 	var before, after waf.IPSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_waf_ipset.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -163,7 +162,7 @@ func TestAccWAFIPSet_changeDescriptors(t *testing.T) {
 
 func TestAccWAFIPSet_noDescriptors(t *testing.T) {
 	var ipset waf.IPSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_waf_ipset.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -191,7 +190,7 @@ func TestAccWAFIPSet_noDescriptors(t *testing.T) {
 
 func TestAccWAFIPSet_IPSetDescriptors_1000UpdateLimit(t *testing.T) {
 	var ipset waf.IPSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_waf_ipset.test"
 
 	incrementIP := func(ip net.IP) {
@@ -342,7 +341,7 @@ func TestDiffWafIpSetDescriptors(t *testing.T) {
 
 func TestAccWAFIPSet_ipv6(t *testing.T) {
 	var v waf.IPSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_waf_ipset.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -18,6 +18,7 @@ import (
 func ResourceRateBasedRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRateBasedRuleCreate,
+		//lintignore:AWSR004
 		Read:   resourceRateBasedRuleRead,
 		Update: resourceRateBasedRuleUpdate,
 		Delete: resourceRateBasedRuleDelete,
@@ -16,6 +16,7 @@ import (
 func ResourceRegexMatchSet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRegexMatchSetCreate,
+		//lintignore:AWSR004
 		Read:   resourceRegexMatchSetRead,
 		Update: resourceRegexMatchSetUpdate,
 		Delete: resourceRegexMatchSetDelete,
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -39,8 +38,8 @@ func testAccRegexMatchSet_basic(t *testing.T) {
 	var patternSet waf.RegexPatternSet
 	var idx int
 
-	matchSetName := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
-	patternSetName := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
+	matchSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
+	patternSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
 	resourceName := "aws_waf_regex_match_set.test"
 
 	fieldToMatch := waf.FieldToMatch{
@@ -85,8 +84,8 @@ func testAccRegexMatchSet_changePatterns(t *testing.T) {
 	var patternSet waf.RegexPatternSet
 	var idx1, idx2 int
 
-	matchSetName := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
-	patternSetName := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
+	matchSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
+	patternSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
 	resourceName := "aws_waf_regex_match_set.test"
 
 	resource.Test(t, resource.TestCase{
@@ -138,7 +137,7 @@ func testAccRegexMatchSet_changePatterns(t *testing.T) {
 
 func testAccRegexMatchSet_noPatterns(t *testing.T) {
 	var matchSet waf.RegexMatchSet
-	matchSetName := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
+	matchSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
 	resourceName := "aws_waf_regex_match_set.test"
 
 	resource.Test(t, resource.TestCase{
@@ -166,8 +165,8 @@ func testAccRegexMatchSet_noPatterns(t *testing.T) {
 
 func testAccRegexMatchSet_disappears(t *testing.T) {
 	var matchSet waf.RegexMatchSet
-	matchSetName := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
-	patternSetName := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
+	matchSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
+	patternSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
 	resourceName := "aws_waf_regex_match_set.test"
 
 	resource.Test(t, resource.TestCase{
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -36,7 +35,7 @@ func TestAccWAFRegexPatternSet_serial(t *testing.T) {
 
 func testAccRegexPatternSet_basic(t *testing.T) {
 	var v waf.RegexPatternSet
-	patternSetName := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
+	patternSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
 	resourceName := "aws_waf_regex_pattern_set.test"
 
 	resource.Test(t, resource.TestCase{
@@ -67,7 +66,7 @@ func testAccRegexPatternSet_basic(t *testing.T) {
 
 func testAccRegexPatternSet_changePatterns(t *testing.T) {
 	var before, after waf.RegexPatternSet
-	patternSetName := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
+	patternSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
 	resourceName := "aws_waf_regex_pattern_set.test"
 
 	resource.Test(t, resource.TestCase{
@@ -108,7 +107,7 @@ func testAccRegexPatternSet_changePatterns(t *testing.T) {
 
 func testAccRegexPatternSet_noPatterns(t *testing.T) {
 	var patternSet waf.RegexPatternSet
-	patternSetName := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
+	patternSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
 	resourceName := "aws_waf_regex_pattern_set.test"
 
 	resource.Test(t, resource.TestCase{
@@ -136,7 +135,7 @@ func testAccRegexPatternSet_noPatterns(t *testing.T) {
 
 func testAccRegexPatternSet_disappears(t *testing.T) {
 	var v waf.RegexPatternSet
-	patternSetName := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
+	patternSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
 	resourceName := "aws_waf_regex_pattern_set.test"
 
 	resource.Test(t, resource.TestCase{
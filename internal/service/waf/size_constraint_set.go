@@ -15,6 +15,7 @@ import (
 func ResourceSizeConstraintSet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSizeConstraintSetCreate,
+		//lintignore:AWSR004
 		Read:   resourceSizeConstraintSetRead,
 		Update: resourceSizeConstraintSetUpdate,
 		Delete: resourceSizeConstraintSetDelete,
@@ -14,6 +14,7 @@ import (
 func ResourceSQLInjectionMatchSet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSQLInjectionMatchSetCreate,
+		//lintignore:AWSR004
 		Read:   resourceSQLInjectionMatchSetRead,
 		Update: resourceSQLInjectionMatchSetUpdate,
 		Delete: resourceSQLInjectionMatchSetDelete,
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func TestAccWAFWebACL_basic(t *testing.T) {
 	var webACL waf.WebACL
-	rName := fmt.Sprintf("wafacl%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("wafacl%s", acctest.RandString(5))
 	resourceName := "aws_waf_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -51,8 +50,8 @@ func TestAccWAFWebACL_basic(t *testing.T) {
 
 func TestAccWAFWebACL_changeNameForceNew(t *testing.T) {
 	var webACL waf.WebACL
-	rName1 := fmt.Sprintf("wafacl%s", sdkacctest.RandString(5))
-	rName2 := fmt.Sprintf("wafacl%s", sdkacctest.RandString(5))
+	rName1 := fmt.Sprintf("wafacl%s", acctest.RandString(5))
+	rName2 := fmt.Sprintf("wafacl%s", acctest.RandString(5))
 	resourceName := "aws_waf_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -96,7 +95,7 @@ func TestAccWAFWebACL_changeNameForceNew(t *testing.T) {
 
 func TestAccWAFWebACL_defaultAction(t *testing.T) {
 	var webACL waf.WebACL
-	rName := fmt.Sprintf("wafacl%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("wafacl%s", acctest.RandString(5))
 	resourceName := "aws_waf_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -132,7 +131,7 @@ func TestAccWAFWebACL_defaultAction(t *testing.T) {
 
 func TestAccWAFWebACL_rules(t *testing.T) {
 	var webACL waf.WebACL
-	rName := fmt.Sprintf("wafacl%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("wafacl%s", acctest.RandString(5))
 	resourceName := "aws_waf_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -177,7 +176,7 @@ func TestAccWAFWebACL_rules(t *testing.T) {
 
 func TestAccWAFWebACL_logging(t *testing.T) {
 	var webACL waf.WebACL
-	rName := fmt.Sprintf("wafacl%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("wafacl%s", acctest.RandString(5))
 	resourceName := "aws_waf_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -229,7 +228,7 @@ func TestAccWAFWebACL_logging(t *testing.T) {
 
 func TestAccWAFWebACL_disappears(t *testing.T) {
 	var webACL waf.WebACL
-	rName := fmt.Sprintf("wafacl%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("wafacl%s", acctest.RandString(5))
 	resourceName := "aws_waf_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -252,7 +251,7 @@ func TestAccWAFWebACL_disappears(t *testing.T) {
 
 func TestAccWAFWebACL_tags(t *testing.T) {
 	var webACL waf.WebACL
-	rName := fmt.Sprintf("wafacl%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("wafacl%s", acctest.RandString(5))
 	resourceName := "aws_waf_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func TestAccWAFXSSMatchSet_basic(t *testing.T) {
 	var v waf.XssMatchSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_waf_xss_match_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -59,8 +58,8 @@ func TestAccWAFXSSMatchSet_basic(t *testing.T) {
 
 func TestAccWAFXSSMatchSet_changeNameForceNew(t *testing.T) {
 	var before, after waf.XssMatchSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	xssMatchSetNewName := fmt.Sprintf("xssMatchSetNewName-%s", sdkacctest.RandString(5))
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	xssMatchSetNewName := fmt.Sprintf("xssMatchSetNewName-%s", acctest.RandString(5))
 	resourceName := "aws_waf_xss_match_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -96,7 +95,7 @@ func TestAccWAFXSSMatchSet_changeNameForceNew(t *testing.T) {
 
 func TestAccWAFXSSMatchSet_disappears(t *testing.T) {
 	var v waf.XssMatchSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_waf_xss_match_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -119,7 +118,7 @@ func TestAccWAFXSSMatchSet_disappears(t *testing.T) {
 
 func TestAccWAFXSSMatchSet_changeTuples(t *testing.T) {
 	var before, after waf.XssMatchSet
-	setName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	setName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_waf_xss_match_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -179,7 +178,7 @@ func TestAccWAFXSSMatchSet_changeTuples(t *testing.T) {
 
 func TestAccWAFXSSMatchSet_noTuples(t *testing.T) {
 	var ipset waf.XssMatchSet
-	setName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	setName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_waf_xss_match_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
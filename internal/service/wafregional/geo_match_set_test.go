@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,7 +18,7 @@ import (
 func TestAccWAFRegionalGeoMatchSet_basic(t *testing.T) {
 	var v waf.GeoMatchSet
 	resourceName := "aws_wafregional_geo_match_set.test"
-	geoMatchSet := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
+	geoMatchSet := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(wafregional.EndpointsID, t) },
@@ -57,8 +56,8 @@ func TestAccWAFRegionalGeoMatchSet_basic(t *testing.T) {
 func TestAccWAFRegionalGeoMatchSet_changeNameForceNew(t *testing.T) {
 	var before, after waf.GeoMatchSet
 	resourceName := "aws_wafregional_geo_match_set.test"
-	geoMatchSet := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
-	geoMatchSetNewName := fmt.Sprintf("geoMatchSetNewName-%s", sdkacctest.RandString(5))
+	geoMatchSet := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
+	geoMatchSetNewName := fmt.Sprintf("geoMatchSetNewName-%s", acctest.RandString(5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(wafregional.EndpointsID, t) },
@@ -99,7 +98,7 @@ func TestAccWAFRegionalGeoMatchSet_changeNameForceNew(t *testing.T) {
 func TestAccWAFRegionalGeoMatchSet_disappears(t *testing.T) {
 	var v waf.GeoMatchSet
 	resourceName := "aws_wafregional_geo_match_set.test"
-	geoMatchSet := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
+	geoMatchSet := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(wafregional.EndpointsID, t) },
@@ -122,7 +121,7 @@ func TestAccWAFRegionalGeoMatchSet_disappears(t *testing.T) {
 func TestAccWAFRegionalGeoMatchSet_changeConstraints(t *testing.T) {
 	var before, after waf.GeoMatchSet
 	resourceName := "aws_wafregional_geo_match_set.test"
-	setName := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
+	setName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(wafregional.EndpointsID, t) },
@@ -178,7 +177,7 @@ func TestAccWAFRegionalGeoMatchSet_changeConstraints(t *testing.T) {
 func TestAccWAFRegionalGeoMatchSet_noConstraints(t *testing.T) {
 	var ipset waf.GeoMatchSet
 	resourceName := "aws_wafregional_geo_match_set.test"
-	setName := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
+	setName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(wafregional.EndpointsID, t) },
@@ -12,7 +12,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -23,7 +22,7 @@ import (
 func TestAccWAFRegionalIPSet_basic(t *testing.T) {
 	resourceName := "aws_wafregional_ipset.ipset"
 	var v waf.IPSet
-	ipsetName := fmt.Sprintf("ip-set-%s", sdkacctest.RandString(5))
+	ipsetName := fmt.Sprintf("ip-set-%s", acctest.RandString(5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(wafregional.EndpointsID, t) },
@@ -55,7 +54,7 @@ func TestAccWAFRegionalIPSet_basic(t *testing.T) {
 func TestAccWAFRegionalIPSet_disappears(t *testing.T) {
 	resourceName := "aws_wafregional_ipset.ipset"
 	var v waf.IPSet
-	ipsetName := fmt.Sprintf("ip-set-%s", sdkacctest.RandString(5))
+	ipsetName := fmt.Sprintf("ip-set-%s", acctest.RandString(5))
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(wafregional.EndpointsID, t) },
 		ErrorCheck:   acctest.ErrorCheck(t, wafregional.EndpointsID),
@@ -77,8 +76,8 @@ func TestAccWAFRegionalIPSet_disappears(t *testing.T) {
 func TestAccWAFRegionalIPSet_changeNameForceNew(t *testing.T) {
 	resourceName := "aws_wafregional_ipset.ipset"
 	var before, after waf.IPSet
-	ipsetName := fmt.Sprintf("ip-set-%s", sdkacctest.RandString(5))
-	ipsetNewName := fmt.Sprintf("ip-set-new-%s", sdkacctest.RandString(5))
+	ipsetName := fmt.Sprintf("ip-set-%s", acctest.RandString(5))
+	ipsetNewName := fmt.Sprintf("ip-set-new-%s", acctest.RandString(5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(wafregional.EndpointsID, t) },
@@ -120,7 +119,7 @@ func TestAccWAFRegionalIPSet_changeNameForceNew(t *testing.T) {
 func TestAccWAFRegionalIPSet_changeDescriptors(t *testing.T) {
 	resourceName := "aws_wafregional_ipset.ipset"
 	var before, after waf.IPSet
-	ipsetName := fmt.Sprintf("ip-set-%s", sdkacctest.RandString(5))
+	ipsetName := fmt.Sprintf("ip-set-%s", acctest.RandString(5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(wafregional.EndpointsID, t) },
@@ -163,7 +162,7 @@ func TestAccWAFRegionalIPSet_changeDescriptors(t *testing.T) {
 
 func TestAccWAFRegionalIPSet_IPSetDescriptors_1000UpdateLimit(t *testing.T) {
 	var ipset waf.IPSet
-	ipsetName := fmt.Sprintf("ip-set-%s", sdkacctest.RandString(5))
+	ipsetName := fmt.Sprintf("ip-set-%s", acctest.RandString(5))
 	resourceName := "aws_wafregional_ipset.ipset"
 
 	incrementIP := func(ip net.IP) {
@@ -210,7 +209,7 @@ func TestAccWAFRegionalIPSet_IPSetDescriptors_1000UpdateLimit(t *testing.T) {
 func TestAccWAFRegionalIPSet_noDescriptors(t *testing.T) {
 	resourceName := "aws_wafregional_ipset.ipset"
 	var ipset waf.IPSet
-	ipsetName := fmt.Sprintf("ip-set-%s", sdkacctest.RandString(5))
+	ipsetName := fmt.Sprintf("ip-set-%s", acctest.RandString(5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(wafregional.EndpointsID, t) },
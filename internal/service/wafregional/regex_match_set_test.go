@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -40,8 +39,8 @@ func testAccRegexMatchSet_basic(t *testing.T) {
 	var idx int
 
 	resourceName := "aws_wafregional_regex_match_set.test"
-	matchSetName := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
-	patternSetName := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
+	matchSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
+	patternSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
 
 	fieldToMatch := waf.FieldToMatch{
 		Data: aws.String("User-Agent"),
@@ -85,8 +84,8 @@ func testAccRegexMatchSet_changePatterns(t *testing.T) {
 	var idx1, idx2 int
 
 	resourceName := "aws_wafregional_regex_match_set.test"
-	matchSetName := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
-	patternSetName := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
+	matchSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
+	patternSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(wafregional.EndpointsID, t) },
@@ -138,7 +137,7 @@ func testAccRegexMatchSet_changePatterns(t *testing.T) {
 func testAccRegexMatchSet_noPatterns(t *testing.T) {
 	var matchSet waf.RegexMatchSet
 	resourceName := "aws_wafregional_regex_match_set.test"
-	matchSetName := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
+	matchSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(wafregional.EndpointsID, t) },
@@ -166,8 +165,8 @@ func testAccRegexMatchSet_noPatterns(t *testing.T) {
 func testAccRegexMatchSet_disappears(t *testing.T) {
 	var matchSet waf.RegexMatchSet
 	resourceName := "aws_wafregional_regex_match_set.test"
-	matchSetName := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
-	patternSetName := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
+	matchSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
+	patternSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(wafregional.EndpointsID, t) },
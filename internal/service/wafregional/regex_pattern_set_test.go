@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -36,7 +35,7 @@ func TestAccWAFRegionalRegexPatternSet_serial(t *testing.T) {
 
 func testAccRegexPatternSet_basic(t *testing.T) {
 	var patternSet waf.RegexPatternSet
-	patternSetName := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
+	patternSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
 	resourceName := "aws_wafregional_regex_pattern_set.test"
 
 	resource.Test(t, resource.TestCase{
@@ -66,7 +65,7 @@ func testAccRegexPatternSet_basic(t *testing.T) {
 
 func testAccRegexPatternSet_changePatterns(t *testing.T) {
 	var before, after waf.RegexPatternSet
-	patternSetName := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
+	patternSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
 	resourceName := "aws_wafregional_regex_pattern_set.test"
 
 	resource.Test(t, resource.TestCase{
@@ -107,7 +106,7 @@ func testAccRegexPatternSet_changePatterns(t *testing.T) {
 
 func testAccRegexPatternSet_noPatterns(t *testing.T) {
 	var patternSet waf.RegexPatternSet
-	patternSetName := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
+	patternSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
 	resourceName := "aws_wafregional_regex_pattern_set.test"
 
 	resource.Test(t, resource.TestCase{
@@ -135,7 +134,7 @@ func testAccRegexPatternSet_noPatterns(t *testing.T) {
 
 func testAccRegexPatternSet_disappears(t *testing.T) {
 	var patternSet waf.RegexPatternSet
-	patternSetName := fmt.Sprintf("tfacc-%s", sdkacctest.RandString(5))
+	patternSetName := fmt.Sprintf("tfacc-%s", acctest.RandString(5))
 	resourceName := "aws_wafregional_regex_pattern_set.test"
 
 	resource.Test(t, resource.TestCase{
@@ -19,6 +19,7 @@ import (
 func ResourceRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRuleCreate,
+		//lintignore:AWSR004
 		Read:   resourceRuleRead,
 		Update: resourceRuleUpdate,
 		Delete: resourceRuleDelete,
@@ -18,6 +18,7 @@ import (
 func ResourceRuleGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRuleGroupCreate,
+		//lintignore:AWSR004
 		Read:   resourceRuleGroupRead,
 		Update: resourceRuleGroupUpdate,
 		Delete: resourceRuleGroupDelete,
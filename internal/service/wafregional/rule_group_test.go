@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -23,8 +22,8 @@ func TestAccWAFRegionalRuleGroup_basic(t *testing.T) {
 	var group waf.RuleGroup
 	var idx int
 
-	ruleName := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
-	groupName := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
+	ruleName := fmt.Sprintf("tfacc%s", acctest.RandString(5))
+	groupName := fmt.Sprintf("tfacc%s", acctest.RandString(5))
 	resourceName := "aws_wafregional_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -63,8 +62,8 @@ func TestAccWAFRegionalRuleGroup_tags(t *testing.T) {
 	var rule waf.Rule
 	var group waf.RuleGroup
 
-	ruleName := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
-	groupName := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
+	ruleName := fmt.Sprintf("tfacc%s", acctest.RandString(5))
+	groupName := fmt.Sprintf("tfacc%s", acctest.RandString(5))
 	resourceName := "aws_wafregional_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -113,9 +112,9 @@ func TestAccWAFRegionalRuleGroup_tags(t *testing.T) {
 func TestAccWAFRegionalRuleGroup_changeNameForceNew(t *testing.T) {
 	var before, after waf.RuleGroup
 
-	ruleName := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
-	groupName := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
-	newGroupName := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
+	ruleName := fmt.Sprintf("tfacc%s", acctest.RandString(5))
+	groupName := fmt.Sprintf("tfacc%s", acctest.RandString(5))
+	newGroupName := fmt.Sprintf("tfacc%s", acctest.RandString(5))
 	resourceName := "aws_wafregional_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -153,8 +152,8 @@ func TestAccWAFRegionalRuleGroup_changeNameForceNew(t *testing.T) {
 
 func TestAccWAFRegionalRuleGroup_disappears(t *testing.T) {
 	var group waf.RuleGroup
-	ruleName := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
-	groupName := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
+	ruleName := fmt.Sprintf("tfacc%s", acctest.RandString(5))
+	groupName := fmt.Sprintf("tfacc%s", acctest.RandString(5))
 	resourceName := "aws_wafregional_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -180,10 +179,10 @@ func TestAccWAFRegionalRuleGroup_changeActivatedRules(t *testing.T) {
 	var groupBefore, groupAfter waf.RuleGroup
 	var idx0, idx1, idx2, idx3 int
 
-	groupName := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
-	ruleName1 := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
-	ruleName2 := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
-	ruleName3 := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
+	groupName := fmt.Sprintf("tfacc%s", acctest.RandString(5))
+	ruleName1 := fmt.Sprintf("tfacc%s", acctest.RandString(5))
+	ruleName2 := fmt.Sprintf("tfacc%s", acctest.RandString(5))
+	ruleName3 := fmt.Sprintf("tfacc%s", acctest.RandString(5))
 	resourceName := "aws_wafregional_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -250,7 +249,7 @@ func TestAccWAFRegionalRuleGroup_changeActivatedRules(t *testing.T) {
 
 func TestAccWAFRegionalRuleGroup_noActivatedRules(t *testing.T) {
 	var group waf.RuleGroup
-	groupName := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
+	groupName := fmt.Sprintf("tfacc%s", acctest.RandString(5))
 	resourceName := "aws_wafregional_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
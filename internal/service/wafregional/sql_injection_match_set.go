@@ -18,6 +18,7 @@ import (
 func ResourceSQLInjectionMatchSet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSQLInjectionMatchSetCreate,
+		//lintignore:AWSR004
 		Read:   resourceSQLInjectionMatchSetRead,
 		Update: resourceSQLInjectionMatchSetUpdate,
 		Delete: resourceSQLInjectionMatchSetDelete,
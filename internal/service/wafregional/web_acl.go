@@ -19,6 +19,7 @@ import (
 func ResourceWebACL() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceWebACLCreate,
+		//lintignore:AWSR004
 		Read:   resourceWebACLRead,
 		Update: resourceWebACLUpdate,
 		Delete: resourceWebACLDelete,
@@ -18,6 +18,7 @@ import (
 func ResourceWebACLAssociation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceWebACLAssociationCreate,
+		//lintignore:AWSR004
 		Read:   resourceWebACLAssociationRead,
 		Delete: resourceWebACLAssociationDelete,
 		Importer: &schema.ResourceImporter{
@@ -16,6 +16,7 @@ import (
 func ResourceXSSMatchSet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceXSSMatchSetCreate,
+		//lintignore:AWSR004
 		Read:   resourceXSSMatchSetRead,
 		Update: resourceXSSMatchSetUpdate,
 		Delete: resourceXSSMatchSetDelete,
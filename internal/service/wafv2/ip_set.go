@@ -22,6 +22,7 @@ import (
 func ResourceIPSet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceIPSetCreate,
+		//lintignore:AWSR004
 		Read:   resourceIPSetRead,
 		Update: resourceIPSetUpdate,
 		Delete: resourceIPSetDelete,
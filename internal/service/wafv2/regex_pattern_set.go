@@ -21,6 +21,7 @@ import (
 func ResourceRegexPatternSet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRegexPatternSetCreate,
+		//lintignore:AWSR004
 		Read:   resourceRegexPatternSetRead,
 		Update: resourceRegexPatternSetUpdate,
 		Delete: resourceRegexPatternSetDelete,
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func TestAccWAFV2RegexPatternSet_basic(t *testing.T) {
 	var v wafv2.RegexPatternSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_regex_pattern_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -78,7 +77,7 @@ func TestAccWAFV2RegexPatternSet_basic(t *testing.T) {
 
 func TestAccWAFV2RegexPatternSet_disappears(t *testing.T) {
 	var v wafv2.RegexPatternSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_regex_pattern_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -101,7 +100,7 @@ func TestAccWAFV2RegexPatternSet_disappears(t *testing.T) {
 
 func TestAccWAFV2RegexPatternSet_minimal(t *testing.T) {
 	var v wafv2.RegexPatternSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_regex_pattern_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -127,8 +126,8 @@ func TestAccWAFV2RegexPatternSet_minimal(t *testing.T) {
 
 func TestAccWAFV2RegexPatternSet_changeNameForceNew(t *testing.T) {
 	var before, after wafv2.RegexPatternSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rNewName := fmt.Sprintf("regex-pattern-set-%s", sdkacctest.RandString(5))
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNewName := fmt.Sprintf("regex-pattern-set-%s", acctest.RandString(5))
 	resourceName := "aws_wafv2_regex_pattern_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -165,7 +164,7 @@ func TestAccWAFV2RegexPatternSet_changeNameForceNew(t *testing.T) {
 
 func TestAccWAFV2RegexPatternSet_tags(t *testing.T) {
 	var v wafv2.RegexPatternSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_regex_pattern_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
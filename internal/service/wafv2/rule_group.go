@@ -22,6 +22,7 @@ import (
 func ResourceRuleGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceRuleGroupCreate,
+		//lintignore:AWSR004
 		Read:   resourceRuleGroupRead,
 		Update: resourceRuleGroupUpdate,
 		Delete: resourceRuleGroupDelete,
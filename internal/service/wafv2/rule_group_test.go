@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func TestAccWAFV2RuleGroup_basic(t *testing.T) {
 	var v wafv2.RuleGroup
-	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -56,7 +55,7 @@ func TestAccWAFV2RuleGroup_basic(t *testing.T) {
 
 func TestAccWAFV2RuleGroup_updateRule(t *testing.T) {
 	var v wafv2.RuleGroup
-	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -122,7 +121,7 @@ func TestAccWAFV2RuleGroup_updateRule(t *testing.T) {
 
 func TestAccWAFV2RuleGroup_updateRuleProperties(t *testing.T) {
 	var v wafv2.RuleGroup
-	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_rule_group.test"
 	ruleName2 := fmt.Sprintf("%s-2", ruleGroupName)
 
@@ -295,7 +294,7 @@ func TestAccWAFV2RuleGroup_updateRuleProperties(t *testing.T) {
 
 func TestAccWAFV2RuleGroup_byteMatchStatement(t *testing.T) {
 	var v wafv2.RuleGroup
-	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -358,7 +357,7 @@ func TestAccWAFV2RuleGroup_byteMatchStatement(t *testing.T) {
 
 func TestAccWAFV2RuleGroup_ByteMatchStatement_fieldToMatch(t *testing.T) {
 	var v wafv2.RuleGroup
-	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -521,8 +520,8 @@ func TestAccWAFV2RuleGroup_ByteMatchStatement_fieldToMatch(t *testing.T) {
 
 func TestAccWAFV2RuleGroup_changeNameForceNew(t *testing.T) {
 	var before, after wafv2.RuleGroup
-	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	ruleGroupNewName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleGroupNewName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -569,7 +568,7 @@ func TestAccWAFV2RuleGroup_changeNameForceNew(t *testing.T) {
 
 func TestAccWAFV2RuleGroup_changeCapacityForceNew(t *testing.T) {
 	var before, after wafv2.RuleGroup
-	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -616,7 +615,7 @@ func TestAccWAFV2RuleGroup_changeCapacityForceNew(t *testing.T) {
 
 func TestAccWAFV2RuleGroup_changeMetricNameForceNew(t *testing.T) {
 	var before, after wafv2.RuleGroup
-	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -663,7 +662,7 @@ func TestAccWAFV2RuleGroup_changeMetricNameForceNew(t *testing.T) {
 
 func TestAccWAFV2RuleGroup_disappears(t *testing.T) {
 	var v wafv2.RuleGroup
-	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -686,7 +685,7 @@ func TestAccWAFV2RuleGroup_disappears(t *testing.T) {
 
 func TestAccWAFV2RuleGroup_geoMatchStatement(t *testing.T) {
 	var v wafv2.RuleGroup
-	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -740,7 +739,7 @@ func TestAccWAFV2RuleGroup_geoMatchStatement(t *testing.T) {
 
 func TestAccWAFV2RuleGroup_GeoMatchStatement_forwardedIP(t *testing.T) {
 	var v wafv2.RuleGroup
-	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -797,7 +796,7 @@ func TestAccWAFV2RuleGroup_GeoMatchStatement_forwardedIP(t *testing.T) {
 
 func TestAccWAFV2RuleGroup_ipSetReferenceStatement(t *testing.T) {
 	var v wafv2.RuleGroup
-	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -834,7 +833,7 @@ func TestAccWAFV2RuleGroup_ipSetReferenceStatement(t *testing.T) {
 
 func TestAccWAFV2RuleGroup_IPSetReferenceStatement_ipsetForwardedIP(t *testing.T) {
 	var v wafv2.RuleGroup
-	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -934,7 +933,7 @@ func TestAccWAFV2RuleGroup_IPSetReferenceStatement_ipsetForwardedIP(t *testing.T
 
 func TestAccWAFV2RuleGroup_logicalRuleStatements(t *testing.T) {
 	var v wafv2.RuleGroup
-	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1007,7 +1006,7 @@ func TestAccWAFV2RuleGroup_logicalRuleStatements(t *testing.T) {
 
 func TestAccWAFV2RuleGroup_minimal(t *testing.T) {
 	var v wafv2.RuleGroup
-	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1038,7 +1037,7 @@ func TestAccWAFV2RuleGroup_minimal(t *testing.T) {
 
 func TestAccWAFV2RuleGroup_regexPatternSetReferenceStatement(t *testing.T) {
 	var v wafv2.RuleGroup
-	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1076,7 +1075,7 @@ func TestAccWAFV2RuleGroup_regexPatternSetReferenceStatement(t *testing.T) {
 
 func TestAccWAFV2RuleGroup_ruleAction(t *testing.T) {
 	var v wafv2.RuleGroup
-	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1157,7 +1156,7 @@ func TestAccWAFV2RuleGroup_ruleAction(t *testing.T) {
 
 func TestAccWAFV2RuleGroup_RuleAction_customRequestHandling(t *testing.T) {
 	var v wafv2.RuleGroup
-	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1228,7 +1227,7 @@ func TestAccWAFV2RuleGroup_RuleAction_customRequestHandling(t *testing.T) {
 
 func TestAccWAFV2RuleGroup_RuleAction_customResponse(t *testing.T) {
 	var v wafv2.RuleGroup
-	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1275,7 +1274,7 @@ func TestAccWAFV2RuleGroup_RuleAction_customResponse(t *testing.T) {
 
 func TestAccWAFV2RuleGroup_sizeConstraintStatement(t *testing.T) {
 	var v wafv2.RuleGroup
-	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1330,7 +1329,7 @@ func TestAccWAFV2RuleGroup_sizeConstraintStatement(t *testing.T) {
 
 func TestAccWAFV2RuleGroup_sqliMatchStatement(t *testing.T) {
 	var v wafv2.RuleGroup
-	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1401,7 +1400,7 @@ func TestAccWAFV2RuleGroup_sqliMatchStatement(t *testing.T) {
 
 func TestAccWAFV2RuleGroup_tags(t *testing.T) {
 	var v wafv2.RuleGroup
-	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1450,7 +1449,7 @@ func TestAccWAFV2RuleGroup_tags(t *testing.T) {
 
 func TestAccWAFV2RuleGroup_xssMatchStatement(t *testing.T) {
 	var v wafv2.RuleGroup
-	ruleGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
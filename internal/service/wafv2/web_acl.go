@@ -28,6 +28,7 @@ const (
 func ResourceWebACL() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceWebACLCreate,
+		//lintignore:AWSR004
 		Read:   resourceWebACLRead,
 		Update: resourceWebACLUpdate,
 		Delete: resourceWebACLDelete,
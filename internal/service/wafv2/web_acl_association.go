@@ -23,6 +23,7 @@ const (
 func ResourceWebACLAssociation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceWebACLAssociationCreate,
+		//lintignore:AWSR004
 		Read:   resourceWebACLAssociationRead,
 		Delete: resourceWebACLAssociationDelete,
 		Importer: &schema.ResourceImporter{
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 )
 
 func TestAccWAFV2WebACLAssociation_basic(t *testing.T) {
-	testName := fmt.Sprintf("web-acl-association-%s", sdkacctest.RandString(5))
+	testName := fmt.Sprintf("web-acl-association-%s", acctest.RandString(5))
 	resourceName := "aws_wafv2_web_acl_association.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -50,7 +49,7 @@ func TestAccWAFV2WebACLAssociation_basic(t *testing.T) {
 }
 
 func TestAccWAFV2WebACLAssociation_disappears(t *testing.T) {
-	testName := fmt.Sprintf("web-acl-association-%s", sdkacctest.RandString(5))
+	testName := fmt.Sprintf("web-acl-association-%s", acctest.RandString(5))
 	resourceName := "aws_wafv2_web_acl_association.test"
 
 	resource.ParallelTest(t, resource.TestCase{
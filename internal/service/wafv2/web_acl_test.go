@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func TestAccWAFV2WebACL_basic(t *testing.T) {
 	var v wafv2.WebACL
-	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	webACLName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -58,7 +57,7 @@ func TestAccWAFV2WebACL_basic(t *testing.T) {
 
 func TestAccWAFV2WebACL_Update_rule(t *testing.T) {
 	var v wafv2.WebACL
-	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	webACLName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_web_acl.test"
 	ruleName1 := fmt.Sprintf("%s-1", webACLName)
 	ruleName2 := fmt.Sprintf("%s-2", webACLName)
@@ -189,7 +188,7 @@ func TestAccWAFV2WebACL_Update_rule(t *testing.T) {
 
 func TestAccWAFV2WebACL_Update_ruleProperties(t *testing.T) {
 	var v wafv2.WebACL
-	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	webACLName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_web_acl.test"
 	ruleName1 := fmt.Sprintf("%s-1", webACLName)
 	ruleName2 := fmt.Sprintf("%s-2", webACLName)
@@ -395,8 +394,8 @@ func TestAccWAFV2WebACL_Update_ruleProperties(t *testing.T) {
 
 func TestAccWAFV2WebACL_Update_nameForceNew(t *testing.T) {
 	var before, after wafv2.WebACL
-	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	ruleGroupNewName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	webACLName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ruleGroupNewName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -447,7 +446,7 @@ func TestAccWAFV2WebACL_Update_nameForceNew(t *testing.T) {
 
 func TestAccWAFV2WebACL_disappears(t *testing.T) {
 	var v wafv2.WebACL
-	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	webACLName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -470,7 +469,7 @@ func TestAccWAFV2WebACL_disappears(t *testing.T) {
 
 func TestAccWAFV2WebACL_ManagedRuleGroup_basic(t *testing.T) {
 	var v wafv2.WebACL
-	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	webACLName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -541,7 +540,7 @@ func TestAccWAFV2WebACL_ManagedRuleGroup_basic(t *testing.T) {
 
 func TestAccWAFV2WebACL_minimal(t *testing.T) {
 	var v wafv2.WebACL
-	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	webACLName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -574,7 +573,7 @@ func TestAccWAFV2WebACL_minimal(t *testing.T) {
 
 func TestAccWAFV2WebACL_RateBased_basic(t *testing.T) {
 	var v wafv2.WebACL
-	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	webACLName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -643,7 +642,7 @@ func TestAccWAFV2WebACL_RateBased_basic(t *testing.T) {
 
 func TestAccWAFV2WebACL_GeoMatch_basic(t *testing.T) {
 	var v wafv2.WebACL
-	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	webACLName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_web_acl.test"
 	countryCode := fmt.Sprintf("%q", "US")
 	countryCodes := fmt.Sprintf("%s, %q", countryCode, "CA")
@@ -735,7 +734,7 @@ func TestAccWAFV2WebACL_GeoMatch_basic(t *testing.T) {
 
 func TestAccWAFV2WebACL_GeoMatch_forwardedIP(t *testing.T) {
 	var v wafv2.WebACL
-	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	webACLName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -800,7 +799,7 @@ func TestAccWAFV2WebACL_GeoMatch_forwardedIP(t *testing.T) {
 
 func TestAccWAFV2WebACL_IPSetReference_basic(t *testing.T) {
 	var v wafv2.WebACL
-	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	webACLName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -846,7 +845,7 @@ func TestAccWAFV2WebACL_IPSetReference_basic(t *testing.T) {
 
 func TestAccWAFV2WebACL_IPSetReference_forwardedIP(t *testing.T) {
 	var v wafv2.WebACL
-	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	webACLName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -950,7 +949,7 @@ func TestAccWAFV2WebACL_IPSetReference_forwardedIP(t *testing.T) {
 
 func TestAccWAFV2WebACL_RateBased_forwardedIP(t *testing.T) {
 	var v wafv2.WebACL
-	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	webACLName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1019,7 +1018,7 @@ func TestAccWAFV2WebACL_RateBased_forwardedIP(t *testing.T) {
 
 func TestAccWAFV2WebACL_RuleGroupReference_basic(t *testing.T) {
 	var v wafv2.WebACL
-	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	webACLName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1084,7 +1083,7 @@ func TestAccWAFV2WebACL_RuleGroupReference_basic(t *testing.T) {
 
 func TestAccWAFV2WebACL_Custom_requestHandling(t *testing.T) {
 	var v wafv2.WebACL
-	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	webACLName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1167,7 +1166,7 @@ func TestAccWAFV2WebACL_Custom_requestHandling(t *testing.T) {
 
 func TestAccWAFV2WebACL_Custom_response(t *testing.T) {
 	var v wafv2.WebACL
-	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	webACLName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1252,7 +1251,7 @@ func TestAccWAFV2WebACL_Custom_response(t *testing.T) {
 
 func TestAccWAFV2WebACL_tags(t *testing.T) {
 	var v wafv2.WebACL
-	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	webACLName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1302,7 +1301,7 @@ func TestAccWAFV2WebACL_tags(t *testing.T) {
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/13862
 func TestAccWAFV2WebACL_RateBased_maxNested(t *testing.T) {
 	var v wafv2.WebACL
-	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	webACLName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1345,7 +1344,7 @@ func TestAccWAFV2WebACL_RateBased_maxNested(t *testing.T) {
 
 func TestAccWAFV2WebACL_Operators_maxNested(t *testing.T) {
 	var v wafv2.WebACL
-	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	webACLName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/worklink"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 )
 
 func TestAccWorkLinkFleet_basic(t *testing.T) {
-	suffix := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	suffix := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
 	resourceName := "aws_worklink_fleet.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -46,7 +45,7 @@ func TestAccWorkLinkFleet_basic(t *testing.T) {
 }
 
 func TestAccWorkLinkFleet_displayName(t *testing.T) {
-	suffix := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	suffix := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
 	resourceName := "aws_worklink_fleet.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -79,7 +78,7 @@ func TestAccWorkLinkFleet_displayName(t *testing.T) {
 }
 
 func TestAccWorkLinkFleet_optimizeForEndUserLocation(t *testing.T) {
-	suffix := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	suffix := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
 	resourceName := "aws_worklink_fleet.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -112,7 +111,7 @@ func TestAccWorkLinkFleet_optimizeForEndUserLocation(t *testing.T) {
 }
 
 func TestAccWorkLinkFleet_auditStreamARN(t *testing.T) {
-	rName := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	rName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
 	resourceName := "aws_worklink_fleet.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -138,7 +137,7 @@ func TestAccWorkLinkFleet_auditStreamARN(t *testing.T) {
 }
 
 func TestAccWorkLinkFleet_network(t *testing.T) {
-	rName := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	rName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
 	resourceName := "aws_worklink_fleet.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -181,7 +180,7 @@ func TestAccWorkLinkFleet_network(t *testing.T) {
 }
 
 func TestAccWorkLinkFleet_deviceCaCertificate(t *testing.T) {
-	rName := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	rName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
 	resourceName := "aws_worklink_fleet.test"
 	fName := "test-fixtures/worklink-device-ca-certificate.pem"
 
@@ -215,7 +214,7 @@ func TestAccWorkLinkFleet_deviceCaCertificate(t *testing.T) {
 }
 
 func TestAccWorkLinkFleet_identityProvider(t *testing.T) {
-	rName := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	rName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
 	resourceName := "aws_worklink_fleet.test"
 	fName := "test-fixtures/saml-metadata.xml"
 
@@ -247,7 +246,7 @@ func TestAccWorkLinkFleet_identityProvider(t *testing.T) {
 }
 
 func TestAccWorkLinkFleet_disappears(t *testing.T) {
-	rName := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	rName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
 	resourceName := "aws_worklink_fleet.test"
 
 	resource.ParallelTest(t, resource.TestCase{
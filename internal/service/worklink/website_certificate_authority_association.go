@@ -18,6 +18,7 @@ import (
 func ResourceWebsiteCertificateAuthorityAssociation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceWebsiteCertificateAuthorityAssociationCreate,
+		//lintignore:AWSR004
 		Read:   resourceWebsiteCertificateAuthorityAssociationRead,
 		Delete: resourceWebsiteCertificateAuthorityAssociationDelete,
 		Importer: &schema.ResourceImporter{
@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/worklink"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 )
 
 func TestAccWorkLinkWebsiteCertificateAuthorityAssociation_WorkLink_basic(t *testing.T) {
-	suffix := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	suffix := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
 	resourceName := "aws_worklink_website_certificate_authority_association.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -47,10 +46,10 @@ func TestAccWorkLinkWebsiteCertificateAuthorityAssociation_WorkLink_basic(t *tes
 }
 
 func TestAccWorkLinkWebsiteCertificateAuthorityAssociation_WorkLink_displayName(t *testing.T) {
-	suffix := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	suffix := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
 	resourceName := "aws_worklink_website_certificate_authority_association.test"
-	displayName1 := fmt.Sprintf("tf-website-certificate-%s", sdkacctest.RandStringFromCharSet(5, sdkacctest.CharSetAlpha))
-	displayName2 := fmt.Sprintf("tf-website-certificate-%s", sdkacctest.RandStringFromCharSet(5, sdkacctest.CharSetAlpha))
+	displayName1 := fmt.Sprintf("tf-website-certificate-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
+	displayName2 := fmt.Sprintf("tf-website-certificate-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlpha))
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:   acctest.ErrorCheck(t, worklink.EndpointsID),
@@ -81,7 +80,7 @@ func TestAccWorkLinkWebsiteCertificateAuthorityAssociation_WorkLink_displayName(
 }
 
 func TestAccWorkLinkWebsiteCertificateAuthorityAssociation_WorkLink_disappears(t *testing.T) {
-	suffix := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	suffix := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
 	resourceName := "aws_worklink_website_certificate_authority_association.test"
 
 	resource.ParallelTest(t, resource.TestCase{
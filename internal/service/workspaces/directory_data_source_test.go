@@ -5,13 +5,12 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/workspaces"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
 func testAccDirectoryDataSource_basic(t *testing.T) {
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(8)
 	domain := acctest.RandomDomainName()
 
 	resourceName := "aws_workspaces_directory.test"
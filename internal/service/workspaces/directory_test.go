@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/workspaces"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func testAccDirectory_basic(t *testing.T) {
 	var v workspaces.WorkspaceDirectory
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(8)
 
 	resourceName := "aws_workspaces_directory.main"
 	directoryResourceName := "aws_directory_service_directory.main"
@@ -87,7 +86,7 @@ func testAccDirectory_basic(t *testing.T) {
 
 func testAccDirectory_disappears(t *testing.T) {
 	var v workspaces.WorkspaceDirectory
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(8)
 
 	resourceName := "aws_workspaces_directory.main"
 
@@ -118,7 +117,7 @@ func testAccDirectory_disappears(t *testing.T) {
 
 func testAccDirectory_subnetIDs(t *testing.T) {
 	var v workspaces.WorkspaceDirectory
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(8)
 
 	resourceName := "aws_workspaces_directory.main"
 
@@ -153,7 +152,7 @@ func testAccDirectory_subnetIDs(t *testing.T) {
 
 func testAccDirectory_tags(t *testing.T) {
 	var v workspaces.WorkspaceDirectory
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(8)
 
 	resourceName := "aws_workspaces_directory.main"
 
@@ -206,7 +205,7 @@ func testAccDirectory_tags(t *testing.T) {
 
 func testAccDirectory_selfServicePermissions(t *testing.T) {
 	var v workspaces.WorkspaceDirectory
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(8)
 
 	resourceName := "aws_workspaces_directory.main"
 
@@ -241,7 +240,7 @@ func testAccDirectory_selfServicePermissions(t *testing.T) {
 
 func testAccDirectory_workspaceAccessProperties(t *testing.T) {
 	var v workspaces.WorkspaceDirectory
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(8)
 
 	resourceName := "aws_workspaces_directory.main"
 
@@ -279,7 +278,7 @@ func testAccDirectory_workspaceAccessProperties(t *testing.T) {
 
 func testAccDirectory_workspaceCreationProperties(t *testing.T) {
 	var v workspaces.WorkspaceDirectory
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(8)
 
 	resourceName := "aws_workspaces_directory.main"
 	resourceSecurityGroup := "aws_security_group.test"
@@ -315,7 +314,7 @@ func testAccDirectory_workspaceCreationProperties(t *testing.T) {
 
 func testAccDirectory_workspaceCreationProperties_customSecurityGroupId_defaultOu(t *testing.T) {
 	var v workspaces.WorkspaceDirectory
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(8)
 
 	resourceName := "aws_workspaces_directory.main"
 	resourceSecurityGroup := "aws_security_group.test"
@@ -367,7 +366,7 @@ func testAccDirectory_workspaceCreationProperties_customSecurityGroupId_defaultO
 
 func testAccDirectory_ipGroupIDs(t *testing.T) {
 	var v workspaces.WorkspaceDirectory
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(8)
 
 	resourceName := "aws_workspaces_directory.test"
 
@@ -17,6 +17,7 @@ import (
 func ResourceIPGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceIPGroupCreate,
+		//lintignore:AWSR004
 		Read:   resourceIPGroupRead,
 		Update: resourceIPGroupUpdate,
 		Delete: resourceIPGroupDelete,
@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/workspaces"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,9 +16,9 @@ import (
 
 func testAccIPGroup_basic(t *testing.T) {
 	var v workspaces.IpGroup
-	ipGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	ipGroupNewName := sdkacctest.RandomWithPrefix("tf-acc-test-upd")
-	ipGroupDescription := fmt.Sprintf("Terraform Acceptance Test %s", strings.Title(sdkacctest.RandString(20)))
+	ipGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ipGroupNewName := acctest.RandomWithPrefix("tf-acc-test-upd")
+	ipGroupDescription := fmt.Sprintf("Terraform Acceptance Test %s", strings.Title(acctest.RandString(20)))
 	resourceName := "aws_workspaces_ip_group.test"
 
 	resource.Test(t, resource.TestCase{
@@ -64,7 +63,7 @@ func testAccIPGroup_basic(t *testing.T) {
 func testAccIPGroup_tags(t *testing.T) {
 	var v workspaces.IpGroup
 	resourceName := "aws_workspaces_ip_group.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -108,8 +107,8 @@ func testAccIPGroup_tags(t *testing.T) {
 
 func testAccIPGroup_disappears(t *testing.T) {
 	var v workspaces.IpGroup
-	ipGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	ipGroupDescription := fmt.Sprintf("Terraform Acceptance Test %s", strings.Title(sdkacctest.RandString(20)))
+	ipGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ipGroupDescription := fmt.Sprintf("Terraform Acceptance Test %s", strings.Title(acctest.RandString(20)))
 	resourceName := "aws_workspaces_ip_group.test"
 
 	resource.Test(t, resource.TestCase{
@@ -134,7 +133,7 @@ func testAccIPGroup_MultipleDirectories(t *testing.T) {
 	var v workspaces.IpGroup
 	var d1, d2 workspaces.WorkspaceDirectory
 
-	ipGroupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ipGroupName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	domain := acctest.RandomDomainName()
 
 	resourceName := "aws_workspaces_ip_group.test"
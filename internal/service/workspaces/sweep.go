@@ -52,7 +52,7 @@ func sweepDirectories(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(directory.DirectoryId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_workspaces_directory", r, d, client))
 		}
 
 		return !lastPage
@@ -95,7 +95,7 @@ func sweepIPGroups(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(ipGroup.GroupId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource("aws_workspaces_ip_group", r, d, client))
 		}
 
 		return !lastPage
@@ -17,6 +17,7 @@ import (
 func ResourceWorkspace() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceWorkspaceCreate,
+		//lintignore:AWSR004
 		Read:   resourceWorkspaceRead,
 		Update: resourceWorkspaceUpdate,
 		Delete: resourceWorkspaceDelete,
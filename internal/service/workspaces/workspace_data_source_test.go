@@ -5,13 +5,12 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/workspaces"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
 func testAccWorkspaceDataSource_byWorkspaceID(t *testing.T) {
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(8)
 	domain := acctest.RandomDomainName()
 
 	dataSourceName := "data.aws_workspaces_workspace.test"
@@ -46,7 +45,7 @@ func testAccWorkspaceDataSource_byWorkspaceID(t *testing.T) {
 }
 
 func testAccWorkspaceDataSource_byDirectoryID_userName(t *testing.T) {
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(8)
 	domain := acctest.RandomDomainName()
 
 	dataSourceName := "data.aws_workspaces_workspace.test"
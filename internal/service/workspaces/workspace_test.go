@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/workspaces"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func testAccWorkspace_basic(t *testing.T) {
 	var v workspaces.Workspace
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(8)
 	domain := acctest.RandomDomainName()
 
 	resourceName := "aws_workspaces_workspace.test"
@@ -69,7 +68,7 @@ func testAccWorkspace_basic(t *testing.T) {
 
 func testAccWorkspace_tags(t *testing.T) {
 	var v1, v2, v3 workspaces.Workspace
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(8)
 	domain := acctest.RandomDomainName()
 
 	resourceName := "aws_workspaces_workspace.test"
@@ -122,7 +121,7 @@ func testAccWorkspace_tags(t *testing.T) {
 
 func testAccWorkspace_workspaceProperties(t *testing.T) {
 	var v1, v2, v3 workspaces.Workspace
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(8)
 	domain := acctest.RandomDomainName()
 
 	resourceName := "aws_workspaces_workspace.test"
@@ -189,7 +188,7 @@ func testAccWorkspace_workspaceProperties(t *testing.T) {
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/13558
 func testAccWorkspace_workspaceProperties_runningModeAlwaysOn(t *testing.T) {
 	var v1 workspaces.Workspace
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_workspaces_workspace.test"
 	domain := acctest.RandomDomainName()
 
@@ -226,7 +225,7 @@ func testAccWorkspace_workspaceProperties_runningModeAlwaysOn(t *testing.T) {
 }
 
 func testAccWorkspace_validateRootVolumeSize(t *testing.T) {
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(8)
 	domain := acctest.RandomDomainName()
 
 	resource.Test(t, resource.TestCase{
@@ -244,7 +243,7 @@ func testAccWorkspace_validateRootVolumeSize(t *testing.T) {
 }
 
 func testAccWorkspace_validateUserVolumeSize(t *testing.T) {
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(8)
 	domain := acctest.RandomDomainName()
 
 	resource.Test(t, resource.TestCase{
@@ -263,7 +262,7 @@ func testAccWorkspace_validateUserVolumeSize(t *testing.T) {
 
 func testAccWorkspace_recreate(t *testing.T) {
 	var v workspaces.Workspace
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(8)
 	domain := acctest.RandomDomainName()
 
 	resourceName := "aws_workspaces_workspace.test"
@@ -298,7 +297,7 @@ func testAccWorkspace_recreate(t *testing.T) {
 
 func testAccWorkspace_timeout(t *testing.T) {
 	var v workspaces.Workspace
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(8)
 	domain := acctest.RandomDomainName()
 
 	resourceName := "aws_workspaces_workspace.test"
@@ -14,6 +14,7 @@ import (
 func ResourceEncryptionConfig() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceEncryptionPutConfig,
+		//lintignore:AWSR004
 		Read:   resourceEncryptionConfigRead,
 		Update: resourceEncryptionPutConfig,
 		Delete: schema.Noop,
@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/xray"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -115,5 +114,5 @@ resource "aws_xray_encryption_config" "test" {
   type   = "KMS"
   key_id = aws_kms_key.test.arn
 }
-`, sdkacctest.RandString(8))
+`, acctest.RandString(8))
 }
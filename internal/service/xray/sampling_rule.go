@@ -17,6 +17,7 @@ import (
 func ResourceSamplingRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSamplingRuleCreate,
+		//lintignore:AWSR004
 		Read:   resourceSamplingRuleRead,
 		Update: resourceSamplingRuleUpdate,
 		Delete: resourceSamplingRuleDelete,
@@ -0,0 +1,313 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+const (
+	// EnvVarIncludeTags is a comma-separated list of key=value (or key) tags all of which resources must have to be swept.
+	EnvVarIncludeTags = "TF_AWS_SWEEP_INCLUDE_TAGS"
+	// EnvVarExcludeTags is a comma-separated list of key=value (or key) tags any of which prevents resources being swept.
+	EnvVarExcludeTags = "TF_AWS_SWEEP_EXCLUDE_TAGS"
+	// EnvVarIncludeNamePrefixes is a comma-separated list of name prefixes one of which resources must have to be swept.
+	EnvVarIncludeNamePrefixes = "TF_AWS_SWEEP_INCLUDE_NAME_PREFIXES"
+	// EnvVarExcludeNamePrefixes is a comma-separated list of name prefixes any of which prevents resources being swept.
+	EnvVarExcludeNamePrefixes = "TF_AWS_SWEEP_EXCLUDE_NAME_PREFIXES"
+	// EnvVarMinAge is the minimum age, e.g. "2h", of resources to be swept.
+	EnvVarMinAge = "TF_AWS_SWEEP_MIN_AGE"
+)
+
+// Candidate describes a resource found by a sweeper for filtering.
+// Zero values indicate that the attribute is not known for the resource type.
+type Candidate struct {
+	ID           string
+	Name         string
+	Tags         tftags.KeyValueTags
+	CreationTime time.Time
+}
+
+// Filter selects the resources to sweep.
+// Resources whose attributes are unknown don't satisfy the include filters and aren't excluded by the exclude filters.
+type Filter struct {
+	IncludeTags         map[string]*string
+	ExcludeTags         map[string]*string
+	IncludeNamePrefixes []string
+	ExcludeNamePrefixes []string
+	MinAge              time.Duration
+}
+
+var defaultFilter struct {
+	once   sync.Once
+	filter *Filter
+	err    error
+}
+
+// DefaultFilter returns the filter configured by environment variables.
+func DefaultFilter() (*Filter, error) {
+	defaultFilter.once.Do(func() {
+		defaultFilter.filter, defaultFilter.err = filterFromEnv()
+	})
+
+	return defaultFilter.filter, defaultFilter.err
+}
+
+// ShouldSweep returns whether a resource found by a sweeper satisfies the filter configured by environment variables.
+// Sweepers call it for each resource before adding it to the resources to sweep.
+func ShouldSweep(c Candidate) bool {
+	filter, err := DefaultFilter()
+
+	if err != nil {
+		log.Printf("[ERROR] Skipping resource (%s): %s", c.ID, err)
+		return false
+	}
+
+	if reason := filter.Skip(c); reason != "" {
+		log.Printf("[INFO] Skipping resource (%s): %s", c.ID, reason)
+		return false
+	}
+
+	return true
+}
+
+// IsEmpty returns whether the filter selects all resources.
+func (f *Filter) IsEmpty() bool {
+	return len(f.IncludeTags) == 0 && len(f.ExcludeTags) == 0 && len(f.IncludeNamePrefixes) == 0 && len(f.ExcludeNamePrefixes) == 0 && f.MinAge == 0
+}
+
+// Skip returns the reason a resource doesn't satisfy the filter, or "" if it does.
+func (f *Filter) Skip(c Candidate) string {
+	for key, value := range f.IncludeTags {
+		if !hasTag(c.Tags, key, value) {
+			return fmt.Sprintf("missing included tag %s", tagString(key, value))
+		}
+	}
+
+	for key, value := range f.ExcludeTags {
+		if hasTag(c.Tags, key, value) {
+			return fmt.Sprintf("has excluded tag %s", tagString(key, value))
+		}
+	}
+
+	if len(f.IncludeNamePrefixes) > 0 && !hasPrefix(c.Name, f.IncludeNamePrefixes) {
+		return fmt.Sprintf("name (%s) has no included prefix", c.Name)
+	}
+
+	if c.Name != "" && hasPrefix(c.Name, f.ExcludeNamePrefixes) {
+		return fmt.Sprintf("name (%s) has excluded prefix", c.Name)
+	}
+
+	if f.MinAge > 0 {
+		if c.CreationTime.IsZero() {
+			return "creation time unknown"
+		}
+
+		if age := time.Since(c.CreationTime); age < f.MinAge {
+			return fmt.Sprintf("age (%s) less than %s", age.Round(time.Second), f.MinAge)
+		}
+	}
+
+	return ""
+}
+
+func filterFromEnv() (*Filter, error) {
+	filter := &Filter{
+		IncludeTags:         parseTags(splitEnvVar(EnvVarIncludeTags)),
+		ExcludeTags:         parseTags(splitEnvVar(EnvVarExcludeTags)),
+		IncludeNamePrefixes: splitEnvVar(EnvVarIncludeNamePrefixes),
+		ExcludeNamePrefixes: splitEnvVar(EnvVarExcludeNamePrefixes),
+	}
+
+	if v := os.Getenv(EnvVarMinAge); v != "" {
+		d, err := time.ParseDuration(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", EnvVarMinAge, err)
+		}
+
+		filter.MinAge = d
+	}
+
+	return filter, nil
+}
+
+// parseTags parses key=value or key (any value) tag filters.
+func parseTags(values []string) map[string]*string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	tags := make(map[string]*string, len(values))
+
+	for _, v := range values {
+		if i := strings.Index(v, "="); i >= 0 {
+			value := v[i+1:]
+			tags[v[:i]] = &value
+		} else {
+			tags[v] = nil
+		}
+	}
+
+	return tags
+}
+
+func hasTag(tags tftags.KeyValueTags, key string, value *string) bool {
+	if !tags.KeyExists(key) {
+		return false
+	}
+
+	if value == nil {
+		return true
+	}
+
+	v := tags.KeyValue(key)
+
+	return v != nil && *v == *value
+}
+
+func hasPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func tagString(key string, value *string) string {
+	if value == nil {
+		return key
+	}
+
+	return key + "=" + *value
+}
+
+// creationTimeAttributes are the names of the RFC 3339 timestamp attributes from which resources' creation times are read.
+var creationTimeAttributes = []string{
+	"create_date",
+	"create_time",
+	"created_at",
+	"created_date",
+	"created_time",
+	"creation_date",
+	"creation_time",
+}
+
+// filterSweepResources returns the resources which satisfy the filter configured by environment
+// variables, and those which don't. Unless the filter is empty, each resource is read to find
+// its name, tags and creation time. Resources declared part of another inherit its result.
+func filterSweepResources(sweepResources []*SweepResource) ([]*SweepResource, []*SweepResource) {
+	if filterIsEmpty() {
+		return sweepResources, nil
+	}
+
+	var included, skipped []*SweepResource
+	results := make(map[*SweepResource]bool, len(sweepResources))
+
+	for _, sweepResource := range sweepResources {
+		if sweepResource.shouldSweep(results) {
+			included = append(included, sweepResource)
+		} else {
+			skipped = append(skipped, sweepResource)
+		}
+	}
+
+	return included, skipped
+}
+
+// shouldSweep returns whether the resource, or the resource it's part of, satisfies the filter.
+// Results are recorded so that each resource is read at most once.
+func (sr *SweepResource) shouldSweep(results map[*SweepResource]bool) bool {
+	if v, ok := results[sr]; ok {
+		return v
+	}
+
+	var v bool
+
+	if sr.partOf != nil {
+		if v = sr.partOf.shouldSweep(results); !v {
+			log.Printf("[INFO] Skipping resource (%s): part of skipped resource (%s)", sr.d.Id(), sr.partOf.d.Id())
+		}
+	} else {
+		c, err := sr.candidate()
+
+		switch {
+		case err != nil:
+			log.Printf("[ERROR] Skipping resource (%s): %s", sr.d.Id(), err)
+		case c.ID == "":
+			log.Printf("[INFO] Skipping resource (%s): not found", sr.d.Id())
+		default:
+			v = ShouldSweep(c)
+		}
+	}
+
+	results[sr] = v
+
+	return v
+}
+
+// candidate reads the resource for filtering.
+func (sr *SweepResource) candidate() (Candidate, error) {
+	id := sr.d.Id()
+
+	if err := ReadResource(sr.resource, sr.d, sr.meta); err != nil {
+		return Candidate{}, fmt.Errorf("error reading resource (%s): %w", id, err)
+	}
+
+	c := Candidate{
+		ID: sr.d.Id(),
+	}
+
+	if v, ok := sr.stringAttribute("name"); ok {
+		c.Name = v
+	}
+
+	for _, k := range []string{"tags_all", "tags"} {
+		if _, ok := sr.resource.Schema[k]; !ok {
+			continue
+		}
+
+		if v, ok := sr.d.Get(k).(map[string]interface{}); ok && len(v) > 0 {
+			c.Tags = tftags.New(v)
+			break
+		}
+	}
+
+	// Resources without a name attribute are commonly named by their Name tag.
+	if c.Name == "" {
+		if v := c.Tags.KeyValue("Name"); v != nil {
+			c.Name = *v
+		}
+	}
+
+	for _, k := range creationTimeAttributes {
+		if v, ok := sr.stringAttribute(k); ok {
+			if t, err := time.Parse(time.RFC3339, v); err == nil {
+				c.CreationTime = t
+				break
+			}
+		}
+	}
+
+	return c, nil
+}
+
+func (sr *SweepResource) stringAttribute(k string) (string, bool) {
+	if _, ok := sr.resource.Schema[k]; !ok {
+		return "", false
+	}
+
+	v, ok := sr.d.Get(k).(string)
+
+	return v, ok && v != ""
+}
@@ -0,0 +1,185 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestFilterSkip(t *testing.T) {
+	value := "acctest"
+	now := time.Now()
+
+	testCases := []struct {
+		Name         string
+		Filter       *Filter
+		Candidate    Candidate
+		ExpectedSkip bool
+	}{
+		{
+			Name:      "empty filter",
+			Filter:    &Filter{},
+			Candidate: Candidate{ID: "id"},
+		},
+		{
+			Name:      "include tag value match",
+			Filter:    &Filter{IncludeTags: map[string]*string{"Purpose": &value}},
+			Candidate: Candidate{ID: "id", Tags: tftags.New(map[string]string{"Purpose": "acctest"})},
+		},
+		{
+			Name:         "include tag value mismatch",
+			Filter:       &Filter{IncludeTags: map[string]*string{"Purpose": &value}},
+			Candidate:    Candidate{ID: "id", Tags: tftags.New(map[string]string{"Purpose": "production"})},
+			ExpectedSkip: true,
+		},
+		{
+			Name:         "include tag unknown tags",
+			Filter:       &Filter{IncludeTags: map[string]*string{"Purpose": nil}},
+			Candidate:    Candidate{ID: "id"},
+			ExpectedSkip: true,
+		},
+		{
+			Name:         "exclude tag key",
+			Filter:       &Filter{ExcludeTags: map[string]*string{"DoNotSweep": nil}},
+			Candidate:    Candidate{ID: "id", Tags: tftags.New(map[string]string{"DoNotSweep": ""})},
+			ExpectedSkip: true,
+		},
+		{
+			Name:      "exclude tag unknown tags",
+			Filter:    &Filter{ExcludeTags: map[string]*string{"DoNotSweep": nil}},
+			Candidate: Candidate{ID: "id"},
+		},
+		{
+			Name:      "include name prefix match",
+			Filter:    &Filter{IncludeNamePrefixes: []string{"tf-acc-test-", "tf-test-"}},
+			Candidate: Candidate{ID: "id", Name: "tf-test-123"},
+		},
+		{
+			Name:         "include name prefix unknown name",
+			Filter:       &Filter{IncludeNamePrefixes: []string{"tf-acc-test-"}},
+			Candidate:    Candidate{ID: "id"},
+			ExpectedSkip: true,
+		},
+		{
+			Name:         "exclude name prefix match",
+			Filter:       &Filter{ExcludeNamePrefixes: []string{"shared-"}},
+			Candidate:    Candidate{ID: "id", Name: "shared-vpc"},
+			ExpectedSkip: true,
+		},
+		{
+			Name:      "min age older",
+			Filter:    &Filter{MinAge: time.Hour},
+			Candidate: Candidate{ID: "id", CreationTime: now.Add(-2 * time.Hour)},
+		},
+		{
+			Name:         "min age younger",
+			Filter:       &Filter{MinAge: time.Hour},
+			Candidate:    Candidate{ID: "id", CreationTime: now.Add(-30 * time.Minute)},
+			ExpectedSkip: true,
+		},
+		{
+			Name:         "min age unknown creation time",
+			Filter:       &Filter{MinAge: time.Hour},
+			Candidate:    Candidate{ID: "id"},
+			ExpectedSkip: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			reason := testCase.Filter.Skip(testCase.Candidate)
+
+			if got := reason != ""; got != testCase.ExpectedSkip {
+				t.Errorf("got skip %t (%q), expected %t", got, reason, testCase.ExpectedSkip)
+			}
+		})
+	}
+}
+
+func TestFilterSweepResourcesPartOf(t *testing.T) {
+	testSetFilter(t, &Filter{ExcludeTags: map[string]*string{"DoNotSweep": nil}})
+
+	// Each resource reads the given tags.
+	newTagged := func(id string, tags map[string]interface{}) *SweepResource {
+		r := &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:     schema.TypeMap,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+			Read: func(d *schema.ResourceData, meta interface{}) error {
+				return d.Set("tags", tags)
+			},
+		}
+		d := r.Data(nil)
+		d.SetId(id)
+
+		return NewSweepResource("aws_test", r, d, nil)
+	}
+
+	sharedVPC := newTagged("vpc-shared", map[string]interface{}{"DoNotSweep": ""})
+	sharedSubnet := newTagged("subnet-shared", nil).PartOf(sharedVPC)
+	sharedSG := newTagged("sg-shared", nil).PartOf(sharedVPC)
+	sharedENI := newTagged("eni-shared", nil).PartOf(sharedVPC)
+	sharedVPC.DependsOn(sharedSubnet, sharedSG)
+
+	testVPC := newTagged("vpc-test", nil)
+	testSubnet := newTagged("subnet-test", nil).PartOf(testVPC)
+
+	included, skipped := filterSweepResources([]*SweepResource{sharedSubnet, sharedSG, sharedENI, sharedVPC, testSubnet, testVPC})
+
+	if got, expected := sweepResourceIDs(included), []string{"subnet-test", "vpc-test"}; !stringSlicesEqual(got, expected) {
+		t.Errorf("got included %v, expected %v", got, expected)
+	}
+
+	if got, expected := sweepResourceIDs(skipped), []string{"subnet-shared", "sg-shared", "eni-shared", "vpc-shared"}; !stringSlicesEqual(got, expected) {
+		t.Errorf("got skipped %v, expected %v", got, expected)
+	}
+}
+
+func sweepResourceIDs(sweepResources []*SweepResource) []string {
+	var ids []string
+
+	for _, sweepResource := range sweepResources {
+		ids = append(ids, sweepResource.d.Id())
+	}
+
+	return ids
+}
+
+func stringSlicesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestParseTags(t *testing.T) {
+	tags := parseTags([]string{"Purpose=acctest", "DoNotSweep", "Empty="})
+
+	if v, ok := tags["Purpose"]; !ok || v == nil || *v != "acctest" {
+		t.Errorf("unexpected Purpose tag filter: %v", v)
+	}
+
+	if v, ok := tags["DoNotSweep"]; !ok || v != nil {
+		t.Errorf("unexpected DoNotSweep tag filter: %v", v)
+	}
+
+	if v, ok := tags["Empty"]; !ok || v == nil || *v != "" {
+		t.Errorf("unexpected Empty tag filter: %v", v)
+	}
+}
@@ -0,0 +1,134 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync/atomic"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// Error codes of the requests rejected by sweeper clients.
+const (
+	ErrCodeDryRun     = "SweepDryRun"
+	ErrCodeUnfiltered = "SweepUnfiltered"
+)
+
+// readOnlyOperationPrefixes are the prefixes of the names of AWS API operations which only read.
+// Any other operation, e.g. RunInstances or AuthorizeSecurityGroupIngress, is treated as changing
+// resources, so that the guard fails closed for operations not listed.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Filter",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+}
+
+// guardedDeletes is the number of deletions in progress which the filters have been applied to.
+var guardedDeletes int32
+
+// allowDeletes runs f, during which sweeper clients send requests which change resources.
+// Sweepers run one at a time, so requests made meanwhile come from f.
+func allowDeletes(f func() error) error {
+	atomic.AddInt32(&guardedDeletes, 1)
+	defer atomic.AddInt32(&guardedDeletes, -1)
+
+	return f()
+}
+
+// Delete deletes a resource found by a sweeper which doesn't use SweepOrchestrator.
+// The resource is skipped unless it satisfies the filters, and only listed in a dry run.
+// The outcome is added to the report of the region.
+func Delete(region, resourceType string, c Candidate, f func() error) error {
+	if !ShouldSweep(c) {
+		return nil
+	}
+
+	if DryRun() {
+		log.Printf("[INFO] Dry run, would delete resource (%s): %s", resourceType, c.ID)
+		RecordOutcome(region, resourceType, c.ID, OutcomeWouldDelete, nil)
+
+		return nil
+	}
+
+	err := allowDeletes(f)
+
+	if err != nil {
+		RecordOutcome(region, resourceType, c.ID, OutcomeFailed, err)
+	} else {
+		RecordOutcome(region, resourceType, c.ID, OutcomeDeleted, nil)
+	}
+
+	return err
+}
+
+// addGuardHandlers makes sweeper clients reject requests which change resources in a dry run,
+// and, when filters are configured, outside SweepOrchestrator and Delete. Most sweepers call
+// AWS APIs directly, so this stops them deleting resources the filters haven't been applied to.
+func addGuardHandlers(handlers *request.Handlers) {
+	handlers.Validate.PushFrontNamed(request.NamedHandler{
+		Name: "tf-sweep.Guard",
+		Fn:   guardRequest,
+	})
+}
+
+func guardRequest(r *request.Request) {
+	if r.Operation == nil || isReadOnlyOperation(r.Operation.Name) {
+		return
+	}
+
+	operation := fmt.Sprintf("%s:%s", r.ClientInfo.ServiceName, r.Operation.Name)
+	params := strings.Join(strings.Fields(awsutil.Prettify(r.Params)), " ")
+
+	switch {
+	case DryRun():
+		log.Printf("[INFO] Dry run, rejecting request (%s): %s", operation, params)
+
+		r.Error = awserr.New(ErrCodeDryRun, fmt.Sprintf("dry run, request (%s) not sent", operation), nil)
+		RecordOutcome(aws.StringValue(r.Config.Region), operation, params, OutcomeWouldDelete, nil)
+	case atomic.LoadInt32(&guardedDeletes) == 0 && !filterIsEmpty():
+		r.Error = awserr.New(ErrCodeUnfiltered, fmt.Sprintf("sweep filters are configured, but request (%s) is not made through SweepOrchestrator or Delete", operation), nil)
+		RecordOutcome(aws.StringValue(r.Config.Region), operation, params, OutcomeFailed, r.Error)
+	default:
+		return
+	}
+
+	if err := WriteReports(); err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+}
+
+func isReadOnlyOperation(name string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func filterIsEmpty() bool {
+	filter, err := DefaultFilter()
+
+	// An invalid filter fails SharedRegionalSweepClient, so this is not reached.
+	if err != nil {
+		return false
+	}
+
+	return filter.IsEmpty()
+}
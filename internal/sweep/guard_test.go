@@ -0,0 +1,193 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// testSetFilter replaces the filter configured by environment variables for the duration of the test.
+func testSetFilter(t *testing.T, filter *Filter) {
+	t.Helper()
+
+	if _, err := DefaultFilter(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	old := defaultFilter.filter
+	defaultFilter.filter = filter
+
+	t.Cleanup(func() {
+		defaultFilter.filter = old
+	})
+}
+
+func testGuardRequest(operation string) error {
+	r := &request.Request{
+		ClientInfo: metadata.ClientInfo{ServiceName: "example"},
+		Config:     aws.Config{Region: aws.String("us-west-2")},
+		Operation:  &request.Operation{Name: operation},
+		Params:     &struct{ Id *string }{Id: aws.String("thing-1")},
+	}
+
+	guardRequest(r)
+
+	return r.Error
+}
+
+func testErrCode(err error) string {
+	var awsErr awserr.Error
+
+	if errors.As(err, &awsErr) {
+		return awsErr.Code()
+	}
+
+	return ""
+}
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	testCases := map[string]bool{
+		"DescribeVpcs":                  true,
+		"GetBucketLocation":             true,
+		"ListThings":                    true,
+		"HeadBucket":                    true,
+		"FilterLogEvents":               true,
+		"SearchResources":               true,
+		"Scan":                          true,
+		"Query":                         true,
+		"LookupEvents":                  true,
+		"BatchGetItem":                  true,
+		"SelectObjectContent":           true,
+		"DeleteSecurityGroup":           false,
+		"TerminateInstances":            false,
+		"PutBucketVersioning":           false,
+		"BatchDeleteImage":              false,
+		"RunInstances":                  false,
+		"AuthorizeSecurityGroupIngress": false,
+		"AllocateAddress":               false,
+		"RegisterTaskDefinition":        false,
+		"Invoke":                        false,
+		"Publish":                       false,
+		"SendMessage":                   false,
+		"RebootInstances":               false,
+		"RestoreDBClusterFromSnapshot":  false,
+		"RotateSecret":                  false,
+		"CopyImage":                     false,
+		"ImportKeyPair":                 false,
+		"AcceptVpcPeeringConnection":    false,
+		"ExecuteStatement":              false,
+		"UploadPart":                    false,
+	}
+
+	for operation, expected := range testCases {
+		if got := isReadOnlyOperation(operation); got != expected {
+			t.Errorf("%s: got %t, expected %t", operation, got, expected)
+		}
+	}
+}
+
+func TestGuardRequestDryRun(t *testing.T) {
+	t.Setenv(EnvVarDryRun, "true")
+	testSetFilter(t, &Filter{})
+
+	for _, operation := range []string{"ListThings", "FilterLogEvents", "SearchThings"} {
+		if err := testGuardRequest(operation); err != nil {
+			t.Errorf("read-only request (%s) rejected: %s", operation, err)
+		}
+	}
+
+	if got, expected := testErrCode(testGuardRequest("DeleteThing")), ErrCodeDryRun; got != expected {
+		t.Errorf("got error code %q, expected %q", got, expected)
+	}
+
+	// Deletions through the orchestrator or Delete are also rejected in a dry run.
+	err := allowDeletes(func() error {
+		return testGuardRequest("DeleteThing")
+	})
+
+	if got, expected := testErrCode(err), ErrCodeDryRun; got != expected {
+		t.Errorf("got error code %q, expected %q", got, expected)
+	}
+}
+
+func TestGuardRequestFiltered(t *testing.T) {
+	t.Setenv(EnvVarDryRun, "")
+	testSetFilter(t, &Filter{IncludeNamePrefixes: []string{"tf-acc-test-"}})
+
+	if got, expected := testErrCode(testGuardRequest("DeleteThing")), ErrCodeUnfiltered; got != expected {
+		t.Errorf("got error code %q, expected %q", got, expected)
+	}
+
+	err := allowDeletes(func() error {
+		return testGuardRequest("DeleteThing")
+	})
+
+	if err != nil {
+		t.Errorf("filtered request rejected: %s", err)
+	}
+}
+
+func TestGuardRequestUnfiltered(t *testing.T) {
+	t.Setenv(EnvVarDryRun, "")
+	testSetFilter(t, &Filter{})
+
+	if err := testGuardRequest("DeleteThing"); err != nil {
+		t.Errorf("request rejected: %s", err)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	testSetFilter(t, &Filter{IncludeNamePrefixes: []string{"tf-acc-test-"}})
+
+	testCases := []struct {
+		Name            string
+		DryRun          string
+		Candidate       Candidate
+		ExpectedDeleted bool
+	}{
+		{
+			Name:            "included",
+			Candidate:       Candidate{ID: "id", Name: "tf-acc-test-1"},
+			ExpectedDeleted: true,
+		},
+		{
+			Name:      "skipped",
+			Candidate: Candidate{ID: "id", Name: "production"},
+		},
+		{
+			Name:      "dry run",
+			DryRun:    "true",
+			Candidate: Candidate{ID: "id", Name: "tf-acc-test-1"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Setenv(EnvVarDryRun, testCase.DryRun)
+
+			var deleted bool
+
+			err := Delete("us-west-2", "example.Thing", testCase.Candidate, func() error {
+				deleted = true
+
+				// Requests changing resources are allowed while deleting.
+				return testGuardRequest("DeleteThing")
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if deleted != testCase.ExpectedDeleted {
+				t.Errorf("got deleted %t, expected %t", deleted, testCase.ExpectedDeleted)
+			}
+		})
+	}
+}
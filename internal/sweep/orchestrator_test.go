@@ -0,0 +1,233 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testSweepResources records the order in which test resources are deleted.
+type testSweepResources struct {
+	mu      sync.Mutex
+	deleted []string
+}
+
+func (t *testSweepResources) new(id string, deleteFunc func(id string) error) *SweepResource {
+	r := &schema.Resource{
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			if err := deleteFunc(d.Id()); err != nil {
+				return err
+			}
+
+			t.mu.Lock()
+			defer t.mu.Unlock()
+
+			t.deleted = append(t.deleted, d.Id())
+
+			return nil
+		},
+	}
+	d := r.Data(nil)
+	d.SetId(id)
+
+	return NewSweepResource("aws_test", r, d, nil)
+}
+
+func (t *testSweepResources) index(id string) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for i, v := range t.deleted {
+		if v == id {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func testSweepOrchestrator(sweepResources []*SweepResource) error {
+	return SweepOrchestratorContext(context.Background(), sweepResources, 0, 0, 0, 0, 10*time.Second)
+}
+
+func TestSweepOrchestratorDependencies(t *testing.T) {
+	var resources testSweepResources
+
+	deleteFunc := func(string) error {
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	}
+
+	eni := resources.new("eni", deleteFunc)
+	subnet := resources.new("subnet", deleteFunc).DependsOn(eni)
+	sg := resources.new("sg", deleteFunc).DependsOn(eni)
+	vpc := resources.new("vpc", deleteFunc).DependsOn(subnet, sg)
+
+	// Hand the resources over in reverse order.
+	if err := testSweepOrchestrator([]*SweepResource{vpc, sg, subnet, eni}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if resources.index("eni") > resources.index("subnet") || resources.index("eni") > resources.index("sg") {
+		t.Errorf("network interface deleted after dependents: %v", resources.deleted)
+	}
+
+	if resources.index("vpc") != 3 {
+		t.Errorf("VPC not deleted last: %v", resources.deleted)
+	}
+}
+
+func TestSweepOrchestratorDependencyFailed(t *testing.T) {
+	var resources testSweepResources
+
+	subnet := resources.new("subnet", func(string) error { return errors.New("AccessDenied") })
+	vpc := resources.new("vpc", func(string) error { return nil }).DependsOn(subnet)
+
+	err := testSweepOrchestrator([]*SweepResource{vpc, subnet})
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if !strings.Contains(err.Error(), "dependency (subnet) not deleted") {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if resources.index("vpc") != -1 {
+		t.Error("VPC deleted after its dependency failed")
+	}
+}
+
+func TestSweepOrchestratorDependencyCycle(t *testing.T) {
+	var resources testSweepResources
+
+	a := resources.new("a", func(string) error { return nil })
+	b := resources.new("b", func(string) error { return nil }).DependsOn(a)
+	a.DependsOn(b)
+
+	err := testSweepOrchestrator([]*SweepResource{a, b})
+
+	if err == nil || !strings.Contains(err.Error(), "dependency cycle") {
+		t.Fatalf("expected dependency cycle error, got: %v", err)
+	}
+
+	if len(resources.deleted) != 0 {
+		t.Errorf("resources deleted despite dependency cycle: %v", resources.deleted)
+	}
+}
+
+func TestSweepOrchestratorDependencyViolationRetry(t *testing.T) {
+	var resources testSweepResources
+	var attempts int32
+
+	sr := resources.new("sg", func(string) error {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			return errors.New("DependencyViolation: resource sg has a dependent object")
+		}
+
+		return nil
+	})
+
+	if err := testSweepOrchestrator([]*SweepResource{sr}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := atomic.LoadInt32(&attempts); got != 3 {
+		t.Errorf("got %d attempts, expected 3", got)
+	}
+}
+
+func TestSweepOrchestratorConcurrency(t *testing.T) {
+	var resources testSweepResources
+	var current, max int32
+
+	deleteFunc := func(string) error {
+		n := atomic.AddInt32(&current, 1)
+		defer atomic.AddInt32(&current, -1)
+
+		for {
+			m := atomic.LoadInt32(&max)
+			if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+				break
+			}
+		}
+
+		time.Sleep(5 * time.Millisecond)
+
+		return nil
+	}
+
+	var sweepResources []*SweepResource
+
+	for i := 0; i < 5*DefaultConcurrency; i++ {
+		sweepResources = append(sweepResources, resources.new(fmt.Sprintf("r%d", i), deleteFunc))
+	}
+
+	if err := testSweepOrchestrator(sweepResources); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := atomic.LoadInt32(&max); got > DefaultConcurrency {
+		t.Errorf("got %d concurrent deletions, expected at most %d", got, DefaultConcurrency)
+	}
+
+	if len(resources.deleted) != len(sweepResources) {
+		t.Errorf("got %d resources deleted, expected %d", len(resources.deleted), len(sweepResources))
+	}
+}
+
+func TestSweepOrchestratorFilter(t *testing.T) {
+	testSetFilter(t, &Filter{IncludeNamePrefixes: []string{"tf-acc-test-"}})
+
+	var resources testSweepResources
+
+	// The filter is applied to the name read by each resource's read function.
+	newNamed := func(id, name string) *SweepResource {
+		sr := resources.new(id, func(string) error { return nil })
+		sr.resource.Schema = map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		}
+		sr.resource.Read = func(d *schema.ResourceData, meta interface{}) error {
+			return d.Set("name", name)
+		}
+		sr.d = sr.resource.Data(nil)
+		sr.d.SetId(id)
+
+		return sr
+	}
+
+	subnet := newNamed("subnet", "shared-subnet")
+	vpc := newNamed("vpc", "tf-acc-test-vpc").DependsOn(subnet)
+	sg := newNamed("sg", "tf-acc-test-sg")
+
+	err := testSweepOrchestrator([]*SweepResource{vpc, subnet, sg})
+
+	if err == nil || !strings.Contains(err.Error(), "dependency (subnet) not deleted") {
+		t.Fatalf("expected dependency error, got: %v", err)
+	}
+
+	if resources.index("subnet") != -1 {
+		t.Error("subnet deleted despite the filter")
+	}
+
+	if resources.index("vpc") != -1 {
+		t.Error("VPC deleted although its dependency was skipped")
+	}
+
+	if resources.index("sg") == -1 {
+		t.Error("security group not deleted")
+	}
+}
@@ -0,0 +1,123 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	// EnvVarDryRun, when true, lists the resources that would be deleted without deleting them.
+	EnvVarDryRun = "TF_AWS_SWEEP_DRY_RUN"
+	// EnvVarReportDir is the directory to which the JSON report of each region is written.
+	EnvVarReportDir = "TF_AWS_SWEEP_REPORT_DIR"
+)
+
+// Sweep outcomes.
+const (
+	OutcomeDeleted     = "deleted"
+	OutcomeFailed      = "failed"
+	OutcomeWouldDelete = "would_delete"
+)
+
+// Report is the machine-readable record of a sweep of a region.
+type Report struct {
+	Region    string         `json:"region"`
+	DryRun    bool           `json:"dry_run"`
+	Resources []ReportRecord `json:"resources"`
+}
+
+// ReportRecord is the outcome of sweeping a single resource.
+type ReportRecord struct {
+	ResourceType string `json:"resource_type"`
+	ID           string `json:"id"`
+	Outcome      string `json:"outcome"`
+	Error        string `json:"error,omitempty"`
+}
+
+var reports = struct {
+	sync.Mutex
+	values map[string]*Report
+}{values: make(map[string]*Report)}
+
+// recordOutcome adds the outcome of sweeping an orchestrated resource to the report of its region.
+func recordOutcome(sweepResource *SweepResource, outcome string, err error) {
+	RecordOutcome(sweepResource.region(), sweepResource.resourceType, sweepResource.d.Id(), outcome, err)
+}
+
+// RecordOutcome adds the outcome of sweeping a resource to the report of its region.
+// Sweepers which delete resources without SweepOrchestrator call it, followed by WriteReports.
+func RecordOutcome(region, resourceType, id, outcome string, err error) {
+	record := ReportRecord{
+		ResourceType: resourceType,
+		ID:           id,
+		Outcome:      outcome,
+	}
+
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	reports.Lock()
+	defer reports.Unlock()
+
+	report, ok := reports.values[region]
+
+	if !ok {
+		report = &Report{
+			Region: region,
+			DryRun: DryRun(),
+		}
+		reports.values[region] = report
+	}
+
+	report.Resources = append(report.Resources, record)
+}
+
+// WriteReports writes the report of each region swept so far to the configured directory.
+// Reports are rewritten after each orchestrated sweep as sweepers have no hook at the end of the run.
+func WriteReports() error {
+	dir := os.Getenv(EnvVarReportDir)
+
+	if dir == "" {
+		return nil
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("error creating sweep report directory (%s): %w", dir, err)
+	}
+
+	reports.Lock()
+	defer reports.Unlock()
+
+	for region, report := range reports.values {
+		b, err := json.MarshalIndent(report, "", "  ")
+
+		if err != nil {
+			return fmt.Errorf("error encoding sweep report (%s): %w", region, err)
+		}
+
+		filename := filepath.Join(dir, fmt.Sprintf("sweep-report-%s.json", region))
+
+		if err := os.WriteFile(filename, b, 0644); err != nil {
+			return fmt.Errorf("error writing sweep report (%s): %w", filename, err)
+		}
+	}
+
+	return nil
+}
+
+func (sr *SweepResource) region() string {
+	if client, ok := sr.meta.(*conns.AWSClient); ok {
+		return client.Region
+	}
+
+	return "unknown"
+}
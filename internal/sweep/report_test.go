@@ -0,0 +1,68 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteReports(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(EnvVarReportDir, dir)
+
+	region := "test-report-region"
+
+	RecordOutcome(region, "aws_vpc", "vpc-12345678", OutcomeDeleted, nil)
+	RecordOutcome(region, "aws_subnet", "subnet-12345678", OutcomeFailed, errors.New("DependencyViolation"))
+
+	if err := WriteReports(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	b, err := os.ReadFile(filepath.Join(dir, "sweep-report-test-report-region.json"))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var report struct {
+		Region    string                   `json:"region"`
+		Resources []map[string]interface{} `json:"resources"`
+	}
+
+	if err := json.Unmarshal(b, &report); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if report.Region != region {
+		t.Errorf("got region %q, expected %q", report.Region, region)
+	}
+
+	expected := []map[string]interface{}{
+		{"resource_type": "aws_vpc", "id": "vpc-12345678", "outcome": "deleted"},
+		{"resource_type": "aws_subnet", "id": "subnet-12345678", "outcome": "failed", "error": "DependencyViolation"},
+	}
+
+	if len(report.Resources) != len(expected) {
+		t.Fatalf("got %d resources, expected %d: %s", len(report.Resources), len(expected), b)
+	}
+
+	for i, e := range expected {
+		got := report.Resources[i]
+
+		if len(got) != len(e) {
+			t.Errorf("resource %d: got fields %v, expected %v", i, got, e)
+		}
+
+		for k, v := range e {
+			if got[k] != v {
+				t.Errorf("resource %d: got %s %v, expected %v", i, k, got[k], v)
+			}
+		}
+	}
+}
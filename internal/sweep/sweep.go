@@ -10,6 +10,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -30,6 +31,14 @@ const (
 
 const defaultSweeperAssumeRoleDurationSeconds = 3600
 
+const (
+	// DefaultConcurrency is the default maximum number of resources deleted concurrently by an orchestrator call.
+	DefaultConcurrency = 10
+
+	// EnvVarConcurrency overrides DefaultConcurrency.
+	EnvVarConcurrency = "TF_AWS_SWEEP_CONCURRENCY"
+)
+
 // SweeperClients is a shared cache of regional conns.AWSClient
 // This prevents client re-initialization for every resource with no benefit.
 var SweeperClients map[string]interface{}
@@ -54,8 +63,19 @@ func SharedRegionalSweepClient(region string) (interface{}, error) {
 	}
 
 	conf := &conns.Config{
-		MaxRetries: 5,
-		Region:     region,
+		AllowedRegions:   splitEnvVar(conns.EnvVarAllowedRegions),
+		ForbiddenRegions: splitEnvVar(conns.EnvVarForbiddenRegions),
+		MaxRetries:       5,
+		Region:           region,
+		RequestHandlers:  addGuardHandlers,
+	}
+
+	if err := conns.ValidateRegionAllowed(region, conf.AllowedRegions, conf.ForbiddenRegions); err != nil {
+		return nil, err
+	}
+
+	if _, err := DefaultFilter(); err != nil {
+		return nil, err
 	}
 
 	if role := os.Getenv(conns.EnvVarAssumeRoleARN); role != "" {
@@ -90,55 +110,264 @@ func SharedRegionalSweepClient(region string) (interface{}, error) {
 	return client, nil
 }
 
+// splitEnvVar returns the non-empty values of a comma-separated environment variable.
+func splitEnvVar(name string) []string {
+	var values []string
+
+	for _, v := range strings.Split(os.Getenv(name), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
+
 type SweepResource struct {
-	d        *schema.ResourceData
-	meta     interface{}
-	resource *schema.Resource
+	d            *schema.ResourceData
+	meta         interface{}
+	resource     *schema.Resource
+	resourceType string
+	dependsOn    []*SweepResource
+	partOf       *SweepResource
 }
 
-func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) *SweepResource {
+// NewSweepResource returns a resource to be swept.
+// resourceType is the Terraform resource type, e.g. "aws_vpc", used in dry-run output and sweep reports.
+func NewSweepResource(resourceType string, resource *schema.Resource, d *schema.ResourceData, meta interface{}) *SweepResource {
 	return &SweepResource{
-		d:        d,
-		meta:     meta,
-		resource: resource,
+		d:            d,
+		meta:         meta,
+		resource:     resource,
+		resourceType: resourceType,
 	}
 }
 
+// DependsOn declares that the resource is only deleted after the given resources,
+// handed to the same orchestrator call, have been deleted. For example, an EC2 VPC
+// depends on its subnets, which depend on their network interfaces.
+func (sr *SweepResource) DependsOn(sweepResources ...*SweepResource) *SweepResource {
+	sr.dependsOn = append(sr.dependsOn, sweepResources...)
+
+	return sr
+}
+
+// PartOf declares that the resource is part of another, for example an EC2 subnet of its VPC.
+// The resource is only swept if the other resource satisfies the sweep filters, whatever
+// its own name, tags and creation time.
+func (sr *SweepResource) PartOf(sweepResource *SweepResource) *SweepResource {
+	sr.partOf = sweepResource
+
+	return sr
+}
+
 func SweepOrchestrator(sweepResources []*SweepResource) error {
 	return SweepOrchestratorContext(context.Background(), sweepResources, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, SweepThrottlingRetryTimeout)
 }
 
+// DryRun returns whether sweepers list the resources that would be deleted without deleting them.
+func DryRun() bool {
+	v, _ := strconv.ParseBool(os.Getenv(EnvVarDryRun))
+
+	return v
+}
+
+// Concurrency returns the maximum number of resources deleted concurrently by an orchestrator call.
+func Concurrency() int {
+	if v, err := strconv.Atoi(os.Getenv(EnvVarConcurrency)); err == nil && v > 0 {
+		return v
+	}
+
+	return DefaultConcurrency
+}
+
+// SweepOrchestratorContext deletes the resources, at most Concurrency() at a time, each only after
+// the resources it depends on. Deletions failing with throttling or dependency violation errors
+// are retried with backoff until the timeout.
 func SweepOrchestratorContext(ctx context.Context, sweepResources []*SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
+	// Resources skipped by the filters are not deleted, nor are the resources depending on them.
+	sweepResources, skipped := filterSweepResources(sweepResources)
+
+	if DryRun() {
+		for _, sweepResource := range sweepResources {
+			log.Printf("[INFO] Dry run, would delete resource (%s): %s", sweepResource.resourceType, sweepResource.d.Id())
+			recordOutcome(sweepResource, OutcomeWouldDelete, nil)
+		}
+
+		return WriteReports()
+	}
+
+	if err := checkDependencyCycles(sweepResources); err != nil {
+		return err
+	}
+
+	// Closed when the resource has been swept, successfully or not.
+	done := make(map[*SweepResource]chan struct{}, len(sweepResources))
+	// Set before the resource's done channel is closed.
+	failed := make(map[*SweepResource]bool, len(sweepResources))
+	var failedLock sync.Mutex
+
+	for _, sweepResource := range sweepResources {
+		done[sweepResource] = make(chan struct{})
+	}
+
+	for _, sweepResource := range skipped {
+		done[sweepResource] = make(chan struct{})
+		close(done[sweepResource])
+		failed[sweepResource] = true
+	}
+
+	sem := make(chan struct{}, Concurrency())
 	var g multierror.Group
 
 	for _, sweepResource := range sweepResources {
 		sweepResource := sweepResource
 
 		g.Go(func() error {
-			err := tfresource.RetryConfigContext(ctx, delay, delayRand, minTimeout, pollInterval, timeout, func() *resource.RetryError {
-				err := DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
+			defer close(done[sweepResource])
+
+			// Dependencies not handed to this orchestrator call are assumed to have been swept.
+			for _, dependency := range sweepResource.dependsOn {
+				ch, ok := done[dependency]
+
+				if !ok {
+					continue
+				}
+
+				<-ch
+
+				failedLock.Lock()
+				dependencyFailed := failed[dependency]
+				failedLock.Unlock()
+
+				if dependencyFailed {
+					err := fmt.Errorf("error sweeping resource (%s): dependency (%s) not deleted", sweepResource.d.Id(), dependency.d.Id())
+
+					failedLock.Lock()
+					failed[sweepResource] = true
+					failedLock.Unlock()
+
+					recordOutcome(sweepResource, OutcomeFailed, err)
+
+					return err
+				}
+			}
 
-				if err != nil {
-					if strings.Contains(err.Error(), "Throttling") {
-						log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sweepResource.d.Id(), err)
-						return resource.RetryableError(err)
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			err := allowDeletes(func() error {
+				err := tfresource.RetryConfigContext(ctx, delay, delayRand, minTimeout, pollInterval, timeout, func() *resource.RetryError {
+					err := DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
+
+					if err != nil {
+						if strings.Contains(err.Error(), "Throttling") {
+							log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sweepResource.d.Id(), err)
+							return resource.RetryableError(err)
+						}
+
+						if isDependencyViolation(err) {
+							log.Printf("[INFO] While sweeping resource (%s), encountered dependency violation error (%s). Retrying...", sweepResource.d.Id(), err)
+							return resource.RetryableError(err)
+						}
+
+						return resource.NonRetryableError(err)
 					}
 
-					return resource.NonRetryableError(err)
+					return nil
+				})
+
+				if tfresource.TimedOut(err) {
+					err = DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
 				}
 
-				return nil
+				return err
 			})
 
-			if tfresource.TimedOut(err) {
-				err = DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
+			if err != nil {
+				failedLock.Lock()
+				failed[sweepResource] = true
+				failedLock.Unlock()
+
+				recordOutcome(sweepResource, OutcomeFailed, err)
+			} else {
+				recordOutcome(sweepResource, OutcomeDeleted, nil)
 			}
 
 			return err
 		})
 	}
 
-	return g.Wait().ErrorOrNil()
+	err := g.Wait().ErrorOrNil()
+
+	if reportErr := WriteReports(); reportErr != nil {
+		err = multierror.Append(err, reportErr)
+	}
+
+	return err
+}
+
+// dependencyViolationErrors are the error codes indicating that a resource can't be deleted
+// until other resources are, which the orchestrator retries.
+var dependencyViolationErrors = []string{
+	"DependencyViolation",
+	"InvalidGroup.InUse",
+	"InvalidNetworkInterface.InUse",
+	"ResourceInUse",
+	"ResourceInUseException",
+}
+
+func isDependencyViolation(err error) bool {
+	// Errors from resources' delete functions are not always AWS errors, so match their text.
+	for _, code := range dependencyViolationErrors {
+		if strings.Contains(err.Error(), code) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkDependencyCycles returns an error if the resources' dependencies contain a cycle,
+// which would otherwise block the orchestrator forever.
+func checkDependencyCycles(sweepResources []*SweepResource) error {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[*SweepResource]int, len(sweepResources))
+
+	var visit func(*SweepResource) error
+	visit = func(sr *SweepResource) error {
+		switch state[sr] {
+		case visiting:
+			return fmt.Errorf("dependency cycle at sweep resource (%s)", sr.d.Id())
+		case visited:
+			return nil
+		}
+
+		state[sr] = visiting
+
+		for _, dependency := range sr.dependsOn {
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+
+		state[sr] = visited
+
+		return nil
+	}
+
+	for _, sweepResource := range sweepResources {
+		if err := visit(sweepResource); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // Check sweeper API call error for reasons to skip sweeping
@@ -217,6 +446,32 @@ func DeleteResource(resource *schema.Resource, d *schema.ResourceData, meta inte
 	return resource.Delete(d, meta)
 }
 
+func ReadResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) error {
+	if resource.ReadContext != nil || resource.ReadWithoutTimeout != nil {
+		var diags diag.Diagnostics
+
+		if resource.ReadContext != nil {
+			diags = resource.ReadContext(context.Background(), d, meta)
+		} else {
+			diags = resource.ReadWithoutTimeout(context.Background(), d, meta)
+		}
+
+		for i := range diags {
+			if diags[i].Severity == diag.Error {
+				return fmt.Errorf("error reading resource: %s", diags[i].Summary)
+			}
+		}
+
+		return nil
+	}
+
+	if resource.Read == nil {
+		return nil
+	}
+
+	return resource.Read(d, meta)
+}
+
 func Partition(region string) string {
 	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
 		return partition.ID()
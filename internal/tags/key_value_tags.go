@@ -28,6 +28,8 @@ type DefaultConfig struct {
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+	KeySuffixes KeyValueTags
+	KeyPatterns []*regexp.Regexp
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -95,6 +97,8 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 	}
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
+	result = result.IgnoreSuffixes(config.KeySuffixes)
+	result = result.IgnorePatterns(config.KeyPatterns)
 	result = result.Ignore(config.Keys)
 
 	return result
@@ -147,6 +151,54 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnoreSuffixes returns non-matching tag key suffixes.
+func (tags KeyValueTags) IgnoreSuffixes(ignoreTagSuffixes KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for ignoreTagSuffix := range ignoreTagSuffixes {
+			if strings.HasSuffix(k, ignoreTagSuffix) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnorePatterns returns tag keys not matching any of the regular expressions.
+func (tags KeyValueTags) IgnorePatterns(ignoreTagPatterns []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for _, ignoreTagPattern := range ignoreTagPatterns {
+			if ignoreTagPattern.MatchString(k) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreRDS returns non-AWS and non-RDS tag keys.
 func (tags KeyValueTags) IgnoreRds() KeyValueTags {
 	result := make(KeyValueTags)
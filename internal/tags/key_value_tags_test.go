@@ -1,6 +1,7 @@
 package tags
 
 import (
+	"regexp"
 	"testing"
 )
 
@@ -466,6 +467,27 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key suffixes and key patterns",
+			tags: New(map[string]string{
+				"cost:team:owner":     "value1",
+				"aws-backup:schedule": "value2",
+				"app-owner":           "value3",
+				"key4":                "value4",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeySuffixes: New([]string{
+					"-owner",
+				}),
+				KeyPatterns: []*regexp.Regexp{
+					regexp.MustCompile(`^cost:[^:]+:owner$`),
+					regexp.MustCompile(`^aws-backup:`),
+				},
+			},
+			want: map[string]string{
+				"key4": "value4",
+			},
+		},
 		{
 			name: "key prefixes all exact",
 			tags: New(map[string]string{
@@ -689,6 +711,114 @@ func TestKeyValueTagsIgnorePrefixes(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsIgnoreSuffixes(t *testing.T) {
+	testCases := []struct {
+		name              string
+		tags              KeyValueTags
+		ignoreTagSuffixes KeyValueTags
+		want              map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			ignoreTagSuffixes: New([]string{
+				"key1",
+			}),
+			want: map[string]string{},
+		},
+		{
+			name: "all_suffix",
+			tags: New(map[string]string{
+				"key1:owner": "value1",
+				"key2:owner": "value2",
+			}),
+			ignoreTagSuffixes: New([]string{
+				":owner",
+			}),
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"key1:owner": "value1",
+				"key2":       "value2",
+				"owner:key3": "value3",
+			}),
+			ignoreTagSuffixes: New([]string{
+				":owner",
+			}),
+			want: map[string]string{
+				"key2":       "value2",
+				"owner:key3": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreSuffixes(testCase.ignoreTagSuffixes)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnorePatterns(t *testing.T) {
+	testCases := []struct {
+		name              string
+		tags              KeyValueTags
+		ignoreTagPatterns []*regexp.Regexp
+		want              map[string]string
+	}{
+		{
+			name: "no patterns",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "anchored",
+			tags: New(map[string]string{
+				"cost:team:owner":        "value1",
+				"cost:team:owner:backup": "value2",
+				"cost:owner":             "value3",
+			}),
+			ignoreTagPatterns: []*regexp.Regexp{
+				regexp.MustCompile(`^cost:[^:]+:owner$`),
+			},
+			want: map[string]string{
+				"cost:team:owner:backup": "value2",
+				"cost:owner":             "value3",
+			},
+		},
+		{
+			name: "unanchored",
+			tags: New(map[string]string{
+				"aws-backup:plan": "value1",
+				"my-aws-backup":   "value2",
+				"key3":            "value3",
+			}),
+			ignoreTagPatterns: []*regexp.Regexp{
+				regexp.MustCompile(`aws-backup`),
+			},
+			want: map[string]string{
+				"key3": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnorePatterns(testCase.ignoreTagPatterns)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreRds(t *testing.T) {
 	testCases := []struct {
 		name string
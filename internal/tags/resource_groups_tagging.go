@@ -0,0 +1,114 @@
+package tags
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
+)
+
+const (
+	// Maximum number of tags, or tag keys, in a single TagResources or UntagResources request
+	resourceGroupsTaggingOpBatchSize = 50
+)
+
+// ListResourceTags lists the tags of the resource with the specified ARN using the
+// Resource Groups Tagging API.
+// This works for any resource that supports tagging, without service-specific code.
+// A resource that has never been tagged has no tags.
+func ListResourceTags(conn resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI, arn string) (KeyValueTags, error) {
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceARNList: aws.StringSlice([]string{arn}),
+	}
+	m := make(map[string]*string)
+
+	err := conn.GetResourcesPages(input, func(page *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, mapping := range page.ResourceTagMappingList {
+			if mapping == nil || aws.StringValue(mapping.ResourceARN) != arn {
+				continue
+			}
+
+			for _, tag := range mapping.Tags {
+				if tag == nil {
+					continue
+				}
+
+				m[aws.StringValue(tag.Key)] = tag.Value
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return New(m), nil
+}
+
+// UpdateResourceTags updates the tags of the resource with the specified ARN using the
+// Resource Groups Tagging API.
+// This works for any resource that supports tagging, without service-specific code.
+func UpdateResourceTags(conn resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI, arn string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAWS(); len(removedTags) > 0 {
+		for _, removedTags := range removedTags.Chunks(resourceGroupsTaggingOpBatchSize) {
+			input := &resourcegroupstaggingapi.UntagResourcesInput{
+				ResourceARNList: aws.StringSlice([]string{arn}),
+				TagKeys:         aws.StringSlice(removedTags.Keys()),
+			}
+
+			output, err := conn.UntagResources(input)
+
+			if err == nil && output != nil {
+				err = resourceGroupsTaggingFailureError(output.FailedResourcesMap, arn)
+			}
+
+			if err != nil {
+				return fmt.Errorf("error untagging resource (%s): %w", arn, err)
+			}
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAWS(); len(updatedTags) > 0 {
+		for _, updatedTags := range updatedTags.Chunks(resourceGroupsTaggingOpBatchSize) {
+			input := &resourcegroupstaggingapi.TagResourcesInput{
+				ResourceARNList: aws.StringSlice([]string{arn}),
+				Tags:            aws.StringMap(updatedTags.Map()),
+			}
+
+			output, err := conn.TagResources(input)
+
+			if err == nil && output != nil {
+				err = resourceGroupsTaggingFailureError(output.FailedResourcesMap, arn)
+			}
+
+			if err != nil {
+				return fmt.Errorf("error tagging resource (%s): %w", arn, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// resourceGroupsTaggingFailureError returns an error for the resource's entry in a
+// TagResources or UntagResources failure map, which is how those operations report
+// per-resource failures.
+func resourceGroupsTaggingFailureError(failures map[string]*resourcegroupstaggingapi.FailureInfo, arn string) error {
+	failure, ok := failures[arn]
+
+	if !ok || failure == nil {
+		return nil
+	}
+
+	return fmt.Errorf("%s: %s", aws.StringValue(failure.ErrorCode), aws.StringValue(failure.ErrorMessage))
+}
@@ -0,0 +1,212 @@
+package tags
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
+)
+
+const testResourceGroupsTaggingARN = "arn:aws:macie2:us-west-2:123456789012:findings-filter/abcd" //lintignore:AWSAT003,AWSAT005
+
+type testResourceGroupsTaggingClient struct {
+	resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI
+
+	tags     map[string]string
+	failures map[string]*resourcegroupstaggingapi.FailureInfo
+
+	tagInputs   []*resourcegroupstaggingapi.TagResourcesInput
+	untagInputs []*resourcegroupstaggingapi.UntagResourcesInput
+}
+
+func (c *testResourceGroupsTaggingClient) GetResourcesPages(input *resourcegroupstaggingapi.GetResourcesInput, fn func(*resourcegroupstaggingapi.GetResourcesOutput, bool) bool) error {
+	var tags []*resourcegroupstaggingapi.Tag
+
+	for k, v := range c.tags {
+		tags = append(tags, &resourcegroupstaggingapi.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+
+	pages := []*resourcegroupstaggingapi.GetResourcesOutput{
+		{
+			ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{
+				{ResourceARN: aws.String("arn:aws:macie2:us-west-2:123456789012:findings-filter/other"), Tags: []*resourcegroupstaggingapi.Tag{{Key: aws.String("other"), Value: aws.String("other")}}}, //lintignore:AWSAT003,AWSAT005
+			},
+		},
+	}
+
+	for _, arn := range input.ResourceARNList {
+		if len(tags) > 0 {
+			pages = append(pages, &resourcegroupstaggingapi.GetResourcesOutput{
+				ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{{ResourceARN: arn, Tags: tags}},
+			})
+		}
+	}
+
+	for i, page := range pages {
+		if !fn(page, i == len(pages)-1) {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (c *testResourceGroupsTaggingClient) TagResources(input *resourcegroupstaggingapi.TagResourcesInput) (*resourcegroupstaggingapi.TagResourcesOutput, error) {
+	c.tagInputs = append(c.tagInputs, input)
+
+	return &resourcegroupstaggingapi.TagResourcesOutput{FailedResourcesMap: c.failures}, nil
+}
+
+func (c *testResourceGroupsTaggingClient) UntagResources(input *resourcegroupstaggingapi.UntagResourcesInput) (*resourcegroupstaggingapi.UntagResourcesOutput, error) {
+	c.untagInputs = append(c.untagInputs, input)
+
+	return &resourcegroupstaggingapi.UntagResourcesOutput{FailedResourcesMap: c.failures}, nil
+}
+
+func TestListResourceTags(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags map[string]string
+	}{
+		{
+			name: "no tags",
+		},
+		{
+			name: "tags",
+			tags: map[string]string{
+				"key1": "value1",
+				"key2": "",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			conn := &testResourceGroupsTaggingClient{tags: testCase.tags}
+
+			got, err := ListResourceTags(conn, testResourceGroupsTaggingARN)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if expected := New(testCase.tags); !got.Equal(expected) {
+				t.Errorf("expected %s, got %s", expected, got)
+			}
+		})
+	}
+}
+
+func TestUpdateResourceTags(t *testing.T) {
+	testCases := []struct {
+		name                string
+		oldTags             map[string]interface{}
+		newTags             map[string]interface{}
+		expectedTagCalls    int
+		expectedUntagCalls  int
+		expectedRemovedKeys []string
+	}{
+		{
+			name:    "no changes",
+			oldTags: map[string]interface{}{"key1": "value1"},
+			newTags: map[string]interface{}{"key1": "value1"},
+		},
+		{
+			name:                "add update and remove",
+			oldTags:             map[string]interface{}{"key1": "value1", "key2": "value2"},
+			newTags:             map[string]interface{}{"key1": "value1updated", "key3": "value3"},
+			expectedTagCalls:    1,
+			expectedUntagCalls:  1,
+			expectedRemovedKeys: []string{"key2"},
+		},
+		{
+			name:    "ignores aws tags",
+			oldTags: map[string]interface{}{"aws:cloudformation:stack-name": "stack"},
+			newTags: map[string]interface{}{"aws:cloudformation:logical-id": "resource"},
+		},
+		{
+			name:             "chunks",
+			newTags:          testResourceGroupsTaggingTags(resourceGroupsTaggingOpBatchSize + 1),
+			expectedTagCalls: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			conn := &testResourceGroupsTaggingClient{}
+
+			if err := UpdateResourceTags(conn, testResourceGroupsTaggingARN, testCase.oldTags, testCase.newTags); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := len(conn.tagInputs); got != testCase.expectedTagCalls {
+				t.Errorf("expected %d TagResources calls, got %d", testCase.expectedTagCalls, got)
+			}
+
+			if got := len(conn.untagInputs); got != testCase.expectedUntagCalls {
+				t.Errorf("expected %d UntagResources calls, got %d", testCase.expectedUntagCalls, got)
+			}
+
+			tagged := New(nil)
+
+			for _, input := range conn.tagInputs {
+				if len(input.Tags) > resourceGroupsTaggingOpBatchSize {
+					t.Errorf("expected at most %d tags per TagResources call, got %d", resourceGroupsTaggingOpBatchSize, len(input.Tags))
+				}
+
+				if arns := aws.StringValueSlice(input.ResourceARNList); len(arns) != 1 || arns[0] != testResourceGroupsTaggingARN {
+					t.Errorf("unexpected TagResources ARNs: %v", arns)
+				}
+
+				tagged = tagged.Merge(New(input.Tags))
+			}
+
+			if expected := New(testCase.oldTags).Updated(New(testCase.newTags)).IgnoreAWS(); !tagged.Equal(expected) {
+				t.Errorf("expected tagged %s, got %s", expected, tagged)
+			}
+
+			var removed []string
+
+			for _, input := range conn.untagInputs {
+				removed = append(removed, aws.StringValueSlice(input.TagKeys)...)
+			}
+
+			if expected := New(testCase.expectedRemovedKeys); !New(removed).Equal(expected) {
+				t.Errorf("expected removed %v, got %v", testCase.expectedRemovedKeys, removed)
+			}
+		})
+	}
+}
+
+func TestUpdateResourceTags_failure(t *testing.T) {
+	conn := &testResourceGroupsTaggingClient{
+		failures: map[string]*resourcegroupstaggingapi.FailureInfo{
+			testResourceGroupsTaggingARN: {
+				ErrorCode:    aws.String(resourcegroupstaggingapi.ErrorCodeInvalidParameterException),
+				ErrorMessage: aws.String("resource does not support tagging"),
+			},
+		},
+	}
+
+	err := UpdateResourceTags(conn, testResourceGroupsTaggingARN, nil, map[string]interface{}{"key1": "value1"})
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if !strings.Contains(err.Error(), "resource does not support tagging") {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func testResourceGroupsTaggingTags(n int) map[string]interface{} {
+	m := make(map[string]interface{}, n)
+
+	for i := 0; i < n; i++ {
+		m[strings.Repeat("k", i+1)] = "value"
+	}
+
+	return m
+}
@@ -0,0 +1,49 @@
+package tfresource
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+const (
+	defaultBackoffInitialInterval = 1 * time.Second
+	defaultBackoffMaxInterval     = 30 * time.Second
+	defaultBackoffMultiplier      = 2
+)
+
+// Backoff configures exponentially increasing intervals between attempts.
+// Zero values are replaced by defaults.
+type Backoff struct {
+	InitialInterval time.Duration // Interval after the first attempt. Defaults to 1 second.
+	MaxInterval     time.Duration // Largest interval. Defaults to 30 seconds.
+	Multiplier      float64       // Factor by which the interval grows after each attempt. Defaults to 2.
+	Jitter          float64       // Fraction, between 0 and 1, of each interval that is randomly subtracted. Defaults to none.
+}
+
+// interval returns the interval to wait after the attempt with the specified (zero-based) index.
+func (b Backoff) interval(attempt int) time.Duration {
+	initialInterval := b.InitialInterval
+	if initialInterval <= 0 {
+		initialInterval = defaultBackoffInitialInterval
+	}
+
+	maxInterval := b.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = defaultBackoffMaxInterval
+	}
+
+	multiplier := b.Multiplier
+	if multiplier < 1 {
+		multiplier = defaultBackoffMultiplier
+	}
+
+	d := math.Min(float64(initialInterval)*math.Pow(multiplier, float64(attempt)), float64(maxInterval))
+
+	if jitter := math.Min(b.Jitter, 1); jitter > 0 {
+		// Subtracting the jitter keeps the interval within MaxInterval.
+		d -= d * jitter * rand.Float64()
+	}
+
+	return time.Duration(d)
+}
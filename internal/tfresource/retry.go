@@ -174,3 +174,50 @@ func RetryConfigContext(ctx context.Context, delay time.Duration, delayRand time
 	// more likely to be useful
 	return resultErr
 }
+
+const (
+	retryStateRetryableError = "retryableerror"
+	retryStateSuccess        = "success"
+)
+
+// RetryOpts configures RetryContext.
+type RetryOpts struct {
+	Delay      time.Duration      // Wait this time before the first attempt.
+	Backoff    Backoff            // Intervals between attempts.
+	OnProgress func(WaitProgress) // Called after each retryable error.
+}
+
+// RetryContext retries the function `f` until it succeeds, returns a non-retryable error or `timeout` expires.
+// Waits between calls to `f` using exponential backoff with optional jitter.
+// If `timeout` expires, the returned *resource.TimeoutError wraps the last retryable error.
+func RetryContext(ctx context.Context, timeout time.Duration, f resource.RetryFunc, opts RetryOpts) error {
+	var lastErr error
+
+	_, err := WaitForStateContext(ctx, WaitForStateOpts{
+		Pending: []string{retryStateRetryableError},
+		Target:  []string{retryStateSuccess},
+		Refresh: func() (interface{}, string, error) {
+			rerr := f()
+
+			if rerr == nil {
+				return 42, retryStateSuccess, nil
+			}
+
+			if rerr.Retryable {
+				lastErr = rerr.Err
+
+				return 42, retryStateRetryableError, nil
+			}
+
+			return nil, "", rerr.Err
+		},
+		Timeout:    timeout,
+		Delay:      opts.Delay,
+		Backoff:    opts.Backoff,
+		OnProgress: opts.OnProgress,
+	})
+
+	SetLastError(err, lastErr)
+
+	return err
+}
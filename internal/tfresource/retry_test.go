@@ -246,3 +246,79 @@ func TestRetryConfigContext_error(t *testing.T) {
 		t.Fatal("timeout")
 	}
 }
+
+func TestRetryContext(t *testing.T) {
+	var retryCount int32
+
+	errRetryable := errors.New("retryable")
+	errNonRetryable := errors.New("non-retryable")
+
+	testCases := []struct {
+		Name          string
+		F             func() *resource.RetryError
+		ExpectedErr   error
+		ExpectTimeout bool
+	}{
+		{
+			Name: "no error",
+			F: func() *resource.RetryError {
+				return nil
+			},
+		},
+		{
+			Name: "non-retryable error",
+			F: func() *resource.RetryError {
+				return resource.NonRetryableError(errNonRetryable)
+			},
+			ExpectedErr: errNonRetryable,
+		},
+		{
+			Name: "retry then success",
+			F: func() *resource.RetryError {
+				if atomic.AddInt32(&retryCount, 1) < 3 {
+					return resource.RetryableError(errRetryable)
+				}
+
+				return nil
+			},
+		},
+		{
+			Name: "timeout wraps last retryable error",
+			F: func() *resource.RetryError {
+				return resource.RetryableError(errRetryable)
+			},
+			ExpectedErr:   errRetryable,
+			ExpectTimeout: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			retryCount = 0
+
+			opts := tfresource.RetryOpts{
+				Backoff: tfresource.Backoff{
+					InitialInterval: 10 * time.Millisecond,
+					MaxInterval:     50 * time.Millisecond,
+					Jitter:          0.5,
+				},
+			}
+
+			err := tfresource.RetryContext(context.Background(), 500*time.Millisecond, testCase.F, opts)
+
+			if testCase.ExpectedErr == nil && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectedErr != nil && !errors.Is(err, testCase.ExpectedErr) {
+				t.Fatalf("expected error %q, got %v", testCase.ExpectedErr, err)
+			}
+
+			var timeoutErr *resource.TimeoutError
+			if got := errors.As(err, &timeoutErr); got != testCase.ExpectTimeout {
+				t.Fatalf("expected timeout error %t, got %v", testCase.ExpectTimeout, err)
+			}
+		})
+	}
+}
@@ -2,16 +2,20 @@ package tfresource
 
 import (
 	"context"
+	"errors"
+	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 type WaitOpts struct {
-	ContinuousTargetOccurence int           // Number of times the target state has to occur continuously.
-	Delay                     time.Duration // Wait this time before starting checks.
-	MinTimeout                time.Duration // Smallest time to wait before refreshes.
-	PollInterval              time.Duration // Override MinTimeout/backoff and only poll this often.
+	ContinuousTargetOccurence int                // Number of times the target state has to occur continuously.
+	Delay                     time.Duration      // Wait this time before starting checks.
+	MinTimeout                time.Duration      // Smallest time to wait before refreshes.
+	PollInterval              time.Duration      // Override MinTimeout/backoff and only poll this often.
+	Backoff                   *Backoff           // Use exponential backoff with jitter, ignoring MinTimeout and PollInterval.
+	OnProgress                func(WaitProgress) // Called after each unsuccessful call to `f`. Implies Backoff.
 }
 
 const (
@@ -39,6 +43,26 @@ func WaitUntilContext(ctx context.Context, timeout time.Duration, f func() (bool
 		return "", targetStateFalse, nil
 	}
 
+	if opts.Backoff != nil || opts.OnProgress != nil {
+		var backoff Backoff
+		if opts.Backoff != nil {
+			backoff = *opts.Backoff
+		}
+
+		_, err := WaitForStateContext(ctx, WaitForStateOpts{
+			Pending:                   []string{targetStateFalse},
+			Target:                    []string{targetStateTrue},
+			Refresh:                   refresh,
+			Timeout:                   timeout,
+			ContinuousTargetOccurence: opts.ContinuousTargetOccurence,
+			Delay:                     opts.Delay,
+			Backoff:                   backoff,
+			OnProgress:                opts.OnProgress,
+		})
+
+		return err
+	}
+
 	stateConf := &resource.StateChangeConf{
 		Pending:                   []string{targetStateFalse},
 		Target:                    []string{targetStateTrue},
@@ -62,3 +86,155 @@ func WaitUntilContext(ctx context.Context, timeout time.Duration, f func() (bool
 func WaitUntil(timeout time.Duration, f func() (bool, error), opts WaitOpts) error {
 	return WaitUntilContext(context.Background(), timeout, f, opts)
 }
+
+// WaitProgress describes a wait in progress.
+type WaitProgress struct {
+	Attempt  int           // Number of refreshes so far.
+	State    string        // State returned by the latest refresh.
+	Elapsed  time.Duration // Time spent waiting so far.
+	NextWait time.Duration // Time until the next refresh.
+}
+
+// WaitForStateOpts configures WaitForStateContext.
+type WaitForStateOpts struct {
+	Pending                   []string                  // States to keep waiting in.
+	Target                    []string                  // States to wait for. Empty waits for Refresh to return a nil result.
+	Refresh                   resource.StateRefreshFunc // Returns the current result and state.
+	Timeout                   time.Duration             // Maximum time to wait.
+	Delay                     time.Duration             // Wait this time before the first refresh.
+	ContinuousTargetOccurence int                       // Number of times the target state has to occur continuously.
+	NotFoundChecks            int                       // Number of consecutive nil results allowed while waiting for a target state. Defaults to 20.
+	Backoff                   Backoff                   // Intervals between refreshes.
+	OnProgress                func(WaitProgress)        // Called after each refresh which doesn't end the wait.
+}
+
+// WaitForStateContext waits for the state returned by `opts.Refresh` to be one of `opts.Target`,
+// refreshing at exponentially increasing intervals with optional jitter.
+// It returns the latest result along with any error.
+// If `opts.Timeout` expires, or the context's deadline is exceeded, the error is a *resource.TimeoutError
+// recording the target states, the last state observed and the time spent waiting.
+func WaitForStateContext(ctx context.Context, opts WaitForStateOpts) (interface{}, error) {
+	log.Printf("[DEBUG] Waiting for state to become: %s", opts.Target)
+
+	notFoundChecks := opts.NotFoundChecks
+	if notFoundChecks <= 0 {
+		notFoundChecks = 20
+	}
+
+	continuousTargetOccurence := opts.ContinuousTargetOccurence
+	if continuousTargetOccurence <= 0 {
+		continuousTargetOccurence = 1
+	}
+
+	start := time.Now()
+	wait := opts.Delay
+
+	var lastResult interface{}
+	var lastState string
+	var notFoundCount, targetOccurence int
+
+	for attempt := 1; ; attempt++ {
+		if remaining := opts.Timeout - time.Since(start); wait > remaining {
+			// Refresh once more as the timeout expires.
+			wait = remaining
+		}
+
+		if wait > 0 {
+			timer := time.NewTimer(wait)
+
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+
+				if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+					return lastResult, waitTimeoutError(opts.Target, lastState, time.Since(start))
+				}
+
+				return lastResult, ctx.Err()
+			case <-timer.C:
+			}
+		}
+
+		result, state, err := opts.Refresh()
+
+		if err != nil {
+			return result, err
+		}
+
+		lastResult, lastState = result, state
+
+		if result == nil {
+			// A nil result means the resource is gone.
+			if len(opts.Target) == 0 {
+				return nil, nil
+			}
+
+			targetOccurence = 0
+			notFoundCount++
+
+			if notFoundCount > notFoundChecks {
+				return nil, &resource.NotFoundError{
+					LastError: waitTimeoutError(opts.Target, lastState, time.Since(start)),
+					Retries:   notFoundCount,
+				}
+			}
+		} else {
+			notFoundCount = 0
+
+			switch {
+			case stringInSlice(state, opts.Target):
+				targetOccurence++
+
+				if targetOccurence >= continuousTargetOccurence {
+					return result, nil
+				}
+			case stringInSlice(state, opts.Pending):
+				targetOccurence = 0
+			default:
+				return result, &resource.UnexpectedStateError{
+					State:         state,
+					ExpectedState: opts.Target,
+				}
+			}
+		}
+
+		elapsed := time.Since(start)
+
+		if elapsed >= opts.Timeout {
+			return result, waitTimeoutError(opts.Target, lastState, elapsed)
+		}
+
+		wait = opts.Backoff.interval(attempt - 1)
+
+		log.Printf("[TRACE] Waiting %s before next try", wait)
+
+		if opts.OnProgress != nil {
+			opts.OnProgress(WaitProgress{
+				Attempt:  attempt,
+				State:    state,
+				Elapsed:  elapsed,
+				NextWait: wait,
+			})
+		}
+	}
+}
+
+// waitTimeoutError returns the error for a wait which timed out.
+// The error's Timeout is the time spent waiting.
+func waitTimeoutError(target []string, lastState string, elapsed time.Duration) error {
+	return &resource.TimeoutError{
+		ExpectedState: target,
+		LastState:     lastState,
+		Timeout:       elapsed.Round(time.Second),
+	}
+}
+
+func stringInSlice(s string, values []string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -1,11 +1,13 @@
 package tfresource_test
 
 import (
+	"context"
 	"errors"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -63,3 +65,196 @@ func TestWaitUntil(t *testing.T) {
 		})
 	}
 }
+
+func TestWaitUntil_backoff(t *testing.T) {
+	var progress []tfresource.WaitProgress
+	var retryCount int32
+
+	f := func() (bool, error) {
+		return atomic.AddInt32(&retryCount, 1) > 3, nil
+	}
+	opts := tfresource.WaitOpts{
+		Backoff: &tfresource.Backoff{
+			InitialInterval: 10 * time.Millisecond,
+			MaxInterval:     30 * time.Millisecond,
+		},
+		OnProgress: func(p tfresource.WaitProgress) {
+			progress = append(progress, p)
+		},
+	}
+
+	if err := tfresource.WaitUntil(5*time.Second, f, opts); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 30 * time.Millisecond}
+
+	if len(progress) != len(expected) {
+		t.Fatalf("expected %d progress callbacks, got %d", len(expected), len(progress))
+	}
+
+	for i, p := range progress {
+		if p.Attempt != i+1 {
+			t.Errorf("expected progress %d attempt to be %d, got %d", i, i+1, p.Attempt)
+		}
+
+		if p.NextWait != expected[i] {
+			t.Errorf("expected progress %d next wait to be %s, got %s", i, expected[i], p.NextWait)
+		}
+	}
+}
+
+func TestWaitForStateContext(t *testing.T) {
+	backoff := tfresource.Backoff{
+		InitialInterval: 10 * time.Millisecond,
+		MaxInterval:     20 * time.Millisecond,
+		Jitter:          0.5,
+	}
+
+	states := func(states ...string) resource.StateRefreshFunc {
+		var i int32
+
+		return func() (interface{}, string, error) {
+			n := int(atomic.AddInt32(&i, 1)) - 1
+
+			if n >= len(states) {
+				n = len(states) - 1
+			}
+
+			if states[n] == "" {
+				return nil, "", nil
+			}
+
+			return states[n], states[n], nil
+		}
+	}
+
+	testCases := []struct {
+		Name                string
+		Target              []string
+		Refresh             resource.StateRefreshFunc
+		ExpectedResult      interface{}
+		ExpectError         bool
+		ExpectedErrorString string
+	}{
+		{
+			Name:           "target reached",
+			Target:         []string{"available"},
+			Refresh:        states("creating", "creating", "available"),
+			ExpectedResult: "available",
+		},
+		{
+			Name:           "gone",
+			Refresh:        states("deleting", ""),
+			ExpectedResult: nil,
+		},
+		{
+			Name:                "unexpected state",
+			Target:              []string{"available"},
+			Refresh:             states("creating", "failed"),
+			ExpectedResult:      "failed",
+			ExpectError:         true,
+			ExpectedErrorString: "unexpected state 'failed', wanted target 'available'. last error: %!s(<nil>)",
+		},
+		{
+			Name:           "timeout",
+			Target:         []string{"available"},
+			Refresh:        states("creating"),
+			ExpectedResult: "creating",
+			ExpectError:    true,
+		},
+		{
+			Name:    "refresh error",
+			Target:  []string{"available"},
+			Refresh: func() (interface{}, string, error) { return nil, "", errors.New("TestCode") },
+			// ExpectedResult is nil.
+			ExpectError:         true,
+			ExpectedErrorString: "TestCode",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := tfresource.WaitForStateContext(context.Background(), tfresource.WaitForStateOpts{
+				Pending: []string{"creating", "deleting"},
+				Target:  testCase.Target,
+				Refresh: testCase.Refresh,
+				Timeout: 100 * time.Millisecond,
+				Backoff: backoff,
+			})
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectedErrorString != "" && err.Error() != testCase.ExpectedErrorString {
+				t.Errorf("expected error %q, got %q", testCase.ExpectedErrorString, err)
+			}
+
+			if result != testCase.ExpectedResult {
+				t.Errorf("expected result %v, got %v", testCase.ExpectedResult, result)
+			}
+		})
+	}
+}
+
+func TestWaitForStateContext_timeoutError(t *testing.T) {
+	refresh := func() (interface{}, string, error) {
+		return "creating", "creating", nil
+	}
+
+	_, err := tfresource.WaitForStateContext(context.Background(), tfresource.WaitForStateOpts{
+		Pending: []string{"creating"},
+		Target:  []string{"available"},
+		Refresh: refresh,
+		Timeout: 1 * time.Second,
+		Backoff: tfresource.Backoff{
+			InitialInterval: 100 * time.Millisecond,
+		},
+	})
+
+	if !tfresource.TimedOut(err) {
+		t.Fatalf("expected timeout error, got %v", err)
+	}
+
+	if expected := "timeout while waiting for state to become 'available' (last state: 'creating', timeout: 1s)"; err.Error() != expected {
+		t.Errorf("expected error %q, got %q", expected, err)
+	}
+}
+
+func TestWaitForStateContext_contextDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	refresh := func() (interface{}, string, error) {
+		return "creating", "creating", nil
+	}
+
+	result, err := tfresource.WaitForStateContext(ctx, tfresource.WaitForStateOpts{
+		Pending: []string{"creating"},
+		Target:  []string{"available"},
+		Refresh: refresh,
+		Timeout: 5 * time.Second,
+		Backoff: tfresource.Backoff{
+			InitialInterval: 10 * time.Millisecond,
+		},
+	})
+
+	var timeoutErr *resource.TimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("expected timeout error, got %v", err)
+	}
+
+	if timeoutErr.LastState != "creating" {
+		t.Errorf("expected last state %q, got %q", "creating", timeoutErr.LastState)
+	}
+
+	if result != "creating" {
+		t.Errorf("expected result %v, got %v", "creating", result)
+	}
+}
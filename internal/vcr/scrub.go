@@ -0,0 +1,107 @@
+package vcr
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const (
+	// ScrubbedAccountID replaces AWS account IDs in cassettes.
+	ScrubbedAccountID = "123456789012"
+	// ScrubbedValue replaces credentials in cassettes.
+	ScrubbedValue = "REDACTED"
+)
+
+// scrubbedHeaders are removed from recorded requests and responses.
+// They carry credentials or vary between runs without affecting the response.
+var scrubbedHeaders = []string{
+	"Authorization",
+	"X-Amz-Content-Sha256",
+	"X-Amz-Date",
+	"X-Amz-Security-Token",
+	"User-Agent",
+}
+
+var (
+	accessKeyIDRegexp     = regexp.MustCompile(`\b(AKIA|ASIA)[A-Z0-9]{16}\b`)
+	accountIDARNRegexp    = regexp.MustCompile(`arn:aws[a-z-]*:[a-z0-9-]*:[a-z0-9-]*:([0-9]{12}):`)
+	accountIDXMLRegexp    = regexp.MustCompile(`<(?:Account|AccountId|OwnerId)>([0-9]{12})</`)
+	accountIDJSONRegexp   = regexp.MustCompile(`"(?:Account|AccountId|OwnerId)"\s*:\s*"([0-9]{12})"`)
+	credentialXMLRegexp   = regexp.MustCompile(`<(AccessKeyId|SecretAccessKey|SessionToken)>[^<]*</`)
+	credentialJSONRegexp  = regexp.MustCompile(`"(AccessKeyId|SecretAccessKey|SessionToken)"\s*:\s*"[^"]*"`)
+	credentialQueryRegexp = regexp.MustCompile(`(X-Amz-Credential|X-Amz-Security-Token|X-Amz-Signature)=[^&]*`)
+)
+
+// accountIDs are the AWS account IDs seen in recorded interactions.
+var accountIDs = struct {
+	sync.Mutex
+	values map[string]struct{}
+}{values: make(map[string]struct{})}
+
+func scrubHeaders(header http.Header) http.Header {
+	if header == nil {
+		return nil
+	}
+
+	header = header.Clone()
+
+	for _, name := range scrubbedHeaders {
+		header.Del(name)
+	}
+
+	for name, values := range header {
+		if strings.HasPrefix(strings.ToLower(name), "amz-sdk-") {
+			header.Del(name)
+
+			continue
+		}
+
+		for i, value := range values {
+			values[i] = scrubString(value)
+		}
+	}
+
+	return header
+}
+
+func scrubBody(b []byte) []byte {
+	if len(b) == 0 {
+		return b
+	}
+
+	return []byte(scrubString(string(b)))
+}
+
+// scrubString redacts credentials and replaces AWS account IDs.
+func scrubString(s string) string {
+	learnAccountIDs(s)
+
+	s = credentialXMLRegexp.ReplaceAllString(s, "<$1>"+ScrubbedValue+"</")
+	s = credentialJSONRegexp.ReplaceAllString(s, `"$1":"`+ScrubbedValue+`"`)
+	s = credentialQueryRegexp.ReplaceAllString(s, "$1="+ScrubbedValue)
+	s = accessKeyIDRegexp.ReplaceAllString(s, ScrubbedValue)
+
+	accountIDs.Lock()
+	defer accountIDs.Unlock()
+
+	for accountID := range accountIDs.values {
+		s = strings.ReplaceAll(s, accountID, ScrubbedAccountID)
+	}
+
+	return s
+}
+
+func learnAccountIDs(s string) {
+	accountIDs.Lock()
+	defer accountIDs.Unlock()
+
+	for _, re := range []*regexp.Regexp{accountIDARNRegexp, accountIDXMLRegexp, accountIDJSONRegexp} {
+		for _, match := range re.FindAllStringSubmatch(s, -1) {
+			if match[1] != ScrubbedAccountID {
+				accountIDs.values[match[1]] = struct{}{}
+			}
+		}
+	}
+}
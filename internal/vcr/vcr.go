@@ -0,0 +1,614 @@
+// Package vcr records AWS API interactions made during acceptance testing into
+// cassette files and replays them, allowing acceptance tests to run offline.
+//
+// The mode is selected with the VCR_MODE environment variable:
+//
+//	RECORDING  requests are sent to AWS and the scrubbed interactions are saved
+//	REPLAYING  requests are served from previously recorded cassettes
+//
+// Cassettes are stored in the directory given by the VCR_PATH environment variable,
+// one file per test.
+package vcr
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	EnvVarMode = "VCR_MODE"
+	EnvVarPath = "VCR_PATH"
+
+	ModeRecording = "RECORDING"
+	ModeReplaying = "REPLAYING"
+
+	cassetteVersion = 2
+
+	// DefaultStartTimeout is the default maximum time Start waits for another test's cassette to be stopped.
+	DefaultStartTimeout = 30 * time.Minute
+)
+
+// Mode returns the configured VCR mode, or "" if disabled.
+func Mode() string {
+	switch v := strings.ToUpper(os.Getenv(EnvVarMode)); v {
+	case ModeRecording, ModeReplaying:
+		return v
+	default:
+		return ""
+	}
+}
+
+// Enabled returns whether AWS API interactions are recorded or replayed.
+func Enabled() bool {
+	return Mode() != ""
+}
+
+// Replaying returns whether AWS API interactions are replayed from cassettes.
+func Replaying() bool {
+	return Mode() == ModeReplaying
+}
+
+type Cassette struct {
+	Version      int            `json:"version"`
+	Interactions []*Interaction `json:"interactions"`
+
+	// Setup holds the interactions made while configuring the provider shared by the tests
+	// of the process. They are saved into every test's cassette, as any test may be the first
+	// to run, and so configure the provider, when replaying.
+	Setup []*Interaction `json:"setup,omitempty"`
+
+	name        string
+	configuring bool
+	used        []bool
+	mu          sync.Mutex
+}
+
+type Interaction struct {
+	Request  *Request  `json:"request"`
+	Response *Response `json:"response"`
+}
+
+type Request struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    *Body       `json:"body,omitempty"`
+}
+
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       *Body       `json:"body,omitempty"`
+}
+
+// Body is an HTTP message body. Bodies that are not valid UTF-8 are base64 encoded.
+type Body struct {
+	Encoding string `json:"encoding,omitempty"`
+	Data     string `json:"data"`
+}
+
+func newBody(b []byte) *Body {
+	if len(b) == 0 {
+		return nil
+	}
+
+	if utf8.Valid(b) {
+		return &Body{Data: string(b)}
+	}
+
+	return &Body{Encoding: "base64", Data: base64.StdEncoding.EncodeToString(b)}
+}
+
+func (b *Body) Bytes() ([]byte, error) {
+	if b == nil {
+		return nil, nil
+	}
+
+	if b.Encoding == "base64" {
+		return base64.StdEncoding.DecodeString(b.Data)
+	}
+
+	return []byte(b.Data), nil
+}
+
+var (
+	current   *Cassette
+	currentMu sync.Mutex
+
+	// setup holds the interactions recorded between StartSetup and StopSetup.
+	setup []*Interaction
+
+	// inUse is held from Start to Stop. The requests made by tests' check functions share
+	// the provider instance configured by acctest.PreCheck, so they can't be told apart
+	// by test; instead, tests recording or replaying interactions run one at a time.
+	inUse = make(chan struct{}, 1)
+)
+
+// CassettePath returns the path of the cassette file for the specified test.
+func CassettePath(name string) string {
+	return filepath.Join(os.Getenv(EnvVarPath), strings.NewReplacer("/", "_", " ", "_").Replace(name)+".json")
+}
+
+// Start makes the cassette for the specified test the current cassette, waiting up to the timeout
+// until any other test's cassette is stopped. When replaying, the cassette is loaded from disk.
+// If the test's cassette is already current, e.g. when acctest.PreCheck is called again by the
+// same test, Start does nothing.
+func Start(name string, timeout time.Duration) error {
+	if os.Getenv(EnvVarPath) == "" {
+		return fmt.Errorf("%s must be set when %s is %q", EnvVarPath, EnvVarMode, Mode())
+	}
+
+	if holder := currentName(); holder == name {
+		return nil
+	} else if holder != "" && strings.HasPrefix(name, holder+"/") {
+		// Waiting for the parent test's cassette to be stopped would never end.
+		return fmt.Errorf("cassette (%s) can't be started while that of parent test (%s) is in use", name, holder)
+	}
+
+	cassette, err := newCassette(name)
+
+	if err != nil {
+		return err
+	}
+
+	return setCurrent(cassette, timeout)
+}
+
+// StartSetup starts recording or replaying the interactions made while configuring the provider
+// shared by the tests of the process, until StopSetup is called. When recording, the interactions
+// are saved into the cassette of every test stopped afterwards, so that each test can be replayed
+// on its own or in any order. When replaying, they are loaded from the specified test's cassette.
+func StartSetup(name string, timeout time.Duration) error {
+	if os.Getenv(EnvVarPath) == "" {
+		return fmt.Errorf("%s must be set when %s is %q", EnvVarPath, EnvVarMode, Mode())
+	}
+
+	cassette, err := newCassette(name)
+
+	if err != nil {
+		return err
+	}
+
+	cassette.configuring = true
+	cassette.Interactions, cassette.Setup = cassette.Setup, nil
+	cassette.used = make([]bool, len(cassette.Interactions))
+
+	return setCurrent(cassette, timeout)
+}
+
+// StopSetup stops recording or replaying the provider configuration interactions.
+func StopSetup() {
+	currentMu.Lock()
+	defer currentMu.Unlock()
+
+	if current == nil || !current.configuring {
+		return
+	}
+
+	if !Replaying() {
+		current.mu.Lock()
+		setup = current.Interactions
+		current.mu.Unlock()
+	}
+
+	current = nil
+	<-inUse
+}
+
+// newCassette returns an empty cassette for the specified test or, when replaying, loads it from disk.
+func newCassette(name string) (*Cassette, error) {
+	cassette := &Cassette{
+		Version: cassetteVersion,
+		name:    name,
+	}
+
+	if !Replaying() {
+		return cassette, nil
+	}
+
+	path := CassettePath(name)
+	b, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading cassette (%s): %w", path, err)
+	}
+
+	if err := json.Unmarshal(b, cassette); err != nil {
+		return nil, fmt.Errorf("error decoding cassette (%s): %w", path, err)
+	}
+
+	if cassette.Version != cassetteVersion {
+		return nil, fmt.Errorf("cassette (%s) has version %d, expected %d: record it again", path, cassette.Version, cassetteVersion)
+	}
+
+	cassette.used = make([]bool, len(cassette.Interactions))
+
+	return cassette, nil
+}
+
+// setCurrent makes the cassette current, waiting up to the timeout until the current cassette is stopped.
+func setCurrent(cassette *Cassette, timeout time.Duration) error {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case inUse <- struct{}{}:
+	case <-timer.C:
+		return fmt.Errorf("timeout after %s waiting to start cassette (%s), cassette (%s) in use", timeout, cassette.name, currentName())
+	}
+
+	currentMu.Lock()
+	defer currentMu.Unlock()
+
+	current = cassette
+
+	return nil
+}
+
+// Stop saves the specified test's cassette when recording and clears it.
+// Stop does nothing if the test's cassette is not current.
+func Stop(name string) error {
+	currentMu.Lock()
+	cassette := current
+
+	if cassette == nil || cassette.configuring || cassette.name != name {
+		currentMu.Unlock()
+		return nil
+	}
+
+	current = nil
+	cassette.Setup = setup
+	currentMu.Unlock()
+
+	defer func() { <-inUse }()
+
+	if Replaying() {
+		return nil
+	}
+
+	cassette.mu.Lock()
+	defer cassette.mu.Unlock()
+
+	b, err := json.MarshalIndent(cassette, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("error encoding cassette (%s): %w", cassette.name, err)
+	}
+
+	path := CassettePath(cassette.name)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("error creating cassette directory: %w", err)
+	}
+
+	if err := ioutil.WriteFile(path, b, 0644); err != nil {
+		return fmt.Errorf("error writing cassette (%s): %w", path, err)
+	}
+
+	log.Printf("[DEBUG] Saved %d interactions to cassette (%s)", len(cassette.Interactions), path)
+
+	return nil
+}
+
+// currentName returns the name of the current cassette, or "" if none.
+func currentName() string {
+	currentMu.Lock()
+	defer currentMu.Unlock()
+
+	if current == nil {
+		return ""
+	}
+
+	return current.name
+}
+
+func currentCassette() (*Cassette, error) {
+	currentMu.Lock()
+	defer currentMu.Unlock()
+
+	if current == nil {
+		return nil, fmt.Errorf("no cassette started, ensure acctest.PreCheck is called")
+	}
+
+	return current, nil
+}
+
+// Transport returns an http.RoundTripper that records the interactions sent via next
+// into, or replays them from, the current cassette.
+func Transport(next http.RoundTripper) http.RoundTripper {
+	return &transport{next: next}
+}
+
+type transport struct {
+	next http.RoundTripper
+}
+
+func (t *transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	cassette, err := currentCassette()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var body []byte
+
+	if r.Body != nil {
+		body, err = ioutil.ReadAll(r.Body)
+		r.Body.Close()
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading request body: %w", err)
+		}
+
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	request := newRequest(r, body)
+
+	if Replaying() {
+		interaction, err := cassette.match(request)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return interaction.Response.httpResponse(r)
+	}
+
+	response, err := t.next.RoundTrip(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	response.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
+
+	cassette.record(request, &Response{
+		StatusCode: response.StatusCode,
+		Headers:    scrubHeaders(response.Header),
+		Body:       newBody(scrubBody(responseBody)),
+	})
+
+	return response, nil
+}
+
+func newRequest(r *http.Request, body []byte) *Request {
+	return &Request{
+		Method:  r.Method,
+		URL:     scrubString(r.URL.String()),
+		Headers: scrubHeaders(r.Header),
+		Body:    newBody(scrubBody(body)),
+	}
+}
+
+func (c *Cassette) record(request *Request, response *Response) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.Interactions = append(c.Interactions, &Interaction{
+		Request:  request,
+		Response: response,
+	})
+}
+
+// volatileParameters are the names of request parameters whose values differ between runs,
+// e.g. idempotency tokens generated by the AWS SDK, and which are ignored when matching requests.
+var volatileParameters = map[string]bool{
+	"ClientRequestToken": true,
+	"ClientToken":        true,
+	"IdempotencyToken":   true,
+}
+
+// match returns the first unused interaction matching the request.
+func (c *Cassette) match(request *Request) (*Interaction, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, interaction := range c.Interactions {
+		if c.used[i] {
+			continue
+		}
+
+		if interaction.Request.matches(request) {
+			c.used[i] = true
+
+			return interaction, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no match for %s %s in cassette (%s)", request.Method, request.URL, c.name)
+}
+
+// matches returns whether the requests have the same method, URL and body, ignoring the values of volatile parameters.
+func (r *Request) matches(other *Request) bool {
+	if r.Method != other.Method || r.URL != other.URL {
+		return false
+	}
+
+	b1, _ := r.Body.Bytes()
+	b2, _ := other.Body.Bytes()
+
+	if bytes.Equal(b1, b2) {
+		return true
+	}
+
+	return bytes.Equal(withoutVolatileParameters(r.Headers, b1), withoutVolatileParameters(other.Headers, b2))
+}
+
+// withoutVolatileParameters returns the request body with the volatile parameters removed from
+// JSON (JSON protocols) or form-encoded (query protocols) bodies. Other bodies are returned unchanged.
+func withoutVolatileParameters(headers http.Header, body []byte) []byte {
+	if strings.HasPrefix(headers.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		values, err := url.ParseQuery(string(body))
+
+		if err != nil {
+			return body
+		}
+
+		for k := range values {
+			// Nested parameters are named e.g. "LaunchTemplateData.ClientToken".
+			if volatileParameters[k[strings.LastIndex(k, ".")+1:]] {
+				delete(values, k)
+			}
+		}
+
+		return []byte(values.Encode())
+	}
+
+	var v interface{}
+
+	if err := json.Unmarshal(body, &v); err != nil {
+		return body
+	}
+
+	b, err := json.Marshal(removeVolatileParameters(v))
+
+	if err != nil {
+		return body
+	}
+
+	return b
+}
+
+func removeVolatileParameters(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if volatileParameters[k] {
+				delete(v, k)
+			} else {
+				v[k] = removeVolatileParameters(e)
+			}
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = removeVolatileParameters(e)
+		}
+	}
+
+	return v
+}
+
+func (r *Response) httpResponse(req *http.Request) (*http.Response, error) {
+	body, err := r.Body.Bytes()
+
+	if err != nil {
+		return nil, fmt.Errorf("error decoding recorded response body: %w", err)
+	}
+
+	header := r.Headers.Clone()
+
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+var (
+	random      = rand.New(rand.NewSource(time.Now().UnixNano()))
+	testRandoms = make(map[string]*rand.Rand)
+	randomMu    sync.Mutex
+)
+
+// RandInt returns a random non-negative integer for use in resource names.
+func RandInt() int {
+	var v int
+
+	withRandom(func(r *rand.Rand) {
+		v = r.Int()
+	})
+
+	return v
+}
+
+// RandIntn returns a random integer in [0, n) for use in resource names.
+func RandIntn(n int) int {
+	var v int
+
+	withRandom(func(r *rand.Rand) {
+		v = r.Intn(n)
+	})
+
+	return v
+}
+
+// withRandom calls f with the source of random values. When recording or replaying,
+// each test function draws from its own sequence, seeded from the function's name,
+// so that a test generates the same names as when its cassette was recorded,
+// whichever tests are run and in whichever order.
+func withRandom(f func(*rand.Rand)) {
+	randomMu.Lock()
+	defer randomMu.Unlock()
+
+	r := random
+
+	if Enabled() {
+		if name := testFunctionName(); name != "" {
+			if r = testRandoms[name]; r == nil {
+				h := fnv.New64a()
+				h.Write([]byte(name))
+				r = rand.New(rand.NewSource(int64(h.Sum64())))
+				testRandoms[name] = r
+			}
+		}
+	}
+
+	f(r)
+}
+
+// testFunctionName returns the name of the test function, e.g. "ec2.TestAccEC2VPC_basic",
+// or one of its closures, on the call stack.
+func testFunctionName() string {
+	pcs := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+
+	for {
+		frame, more := frames.Next()
+		name := frame.Function
+
+		if i := strings.LastIndex(name, "/"); i >= 0 {
+			name = name[i+1:]
+		}
+
+		if i := strings.Index(name, "."); i >= 0 && strings.HasPrefix(name[i+1:], "Test") {
+			return name
+		}
+
+		if !more {
+			return ""
+		}
+	}
+}
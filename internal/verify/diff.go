@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
-	awspolicy "github.com/jen20/awspolicyequivalence"
 )
 
 // SetTagsDiff sets the new plan difference with the result of
@@ -55,8 +54,62 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 	return nil
 }
 
+// RegionsDiff returns a CustomizeDiffFunc that returns an error if any of the
+// regions returned by the specified function are not permitted by the provider-level
+// allowed_regions or forbidden_regions configuration. Unknown (empty) regions are ignored.
+func RegionsDiff(regions func(*schema.ResourceDiff) []string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		client := meta.(*conns.AWSClient)
+
+		for _, region := range regions(diff) {
+			if region == "" {
+				continue
+			}
+
+			if err := client.ValidateRegion(region); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// BlockRegions returns a function returning the values of the region attribute
+// of every configuration block of the list or set attribute at the specified key.
+func BlockRegions(key, regionKey string) func(*schema.ResourceDiff) []string {
+	return func(diff *schema.ResourceDiff) []string {
+		var blocks []interface{}
+
+		switch v := diff.Get(key).(type) {
+		case *schema.Set:
+			blocks = v.List()
+		case []interface{}:
+			blocks = v
+		}
+
+		var regions []string
+
+		for _, block := range blocks {
+			tfMap, ok := block.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			if v, ok := tfMap[regionKey].(string); ok {
+				regions = append(regions, v)
+			}
+		}
+
+		return regions
+	}
+}
+
+// SuppressEquivalentPolicyDiffs suppresses differences between IAM policy documents with the same canonical form.
+// See NormalizeIAMPolicy.
 func SuppressEquivalentPolicyDiffs(k, old, new string, d *schema.ResourceData) bool {
-	equivalent, err := awspolicy.PoliciesAreEquivalent(old, new)
+	equivalent, err := PolicyStringsEquivalent(old, new)
 	if err != nil {
 		return false
 	}
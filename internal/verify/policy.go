@@ -0,0 +1,290 @@
+package verify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// iamPolicyRootPrincipalRegexp matches the root user ARN of an account, which AWS may return for an account ID principal.
+var iamPolicyRootPrincipalRegexp = regexp.MustCompile(`^arn:[\w-]+:iam::(\d{12}):root$`)
+
+// NormalizeIAMPolicy returns the canonical JSON form of an IAM policy document.
+// Policies which differ only in ways that AWS doesn't preserve or that don't change their meaning
+// have the same canonical form. Statements are ordered, and a single statement may be given as an object.
+// Actions, resources, principal identifiers and condition values are ordered and deduplicated,
+// and a single value may be given as a string. Condition values may also be given as JSON booleans or numbers.
+// An AWS account ID principal is equivalent to the account's root user ARN.
+// Effect is not case-sensitive and empty elements are omitted.
+func NormalizeIAMPolicy(policy string) (string, error) {
+	doc, err := parseIAMPolicyDoc(policy)
+
+	if err != nil {
+		return "", err
+	}
+
+	if err := doc.canonicalize(); err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(doc)
+
+	if err != nil {
+		return "", fmt.Errorf("error encoding IAM policy: %w", err)
+	}
+
+	return string(b), nil
+}
+
+// PolicyStringsEquivalent returns whether two IAM policy documents have the same canonical form.
+func PolicyStringsEquivalent(policy1, policy2 string) (bool, error) {
+	normalized1, err := NormalizeIAMPolicy(policy1)
+
+	if err != nil {
+		return false, err
+	}
+
+	normalized2, err := NormalizeIAMPolicy(policy2)
+
+	if err != nil {
+		return false, err
+	}
+
+	return normalized1 == normalized2, nil
+}
+
+func parseIAMPolicyDoc(policy string) (*IAMPolicyDoc, error) {
+	var raw struct {
+		Version    string
+		Id         string
+		Statements json.RawMessage `json:"Statement"`
+	}
+
+	if err := json.Unmarshal([]byte(policy), &raw); err != nil {
+		return nil, fmt.Errorf("error parsing IAM policy: %w", err)
+	}
+
+	doc := &IAMPolicyDoc{
+		Version: raw.Version,
+		Id:      raw.Id,
+	}
+
+	switch statements := bytes.TrimSpace(raw.Statements); {
+	case len(statements) == 0, bytes.Equal(statements, []byte("null")):
+	case statements[0] == '{':
+		statement := &IAMPolicyStatement{}
+
+		if err := json.Unmarshal(statements, statement); err != nil {
+			return nil, fmt.Errorf("error parsing IAM policy statement: %w", err)
+		}
+
+		doc.Statements = []*IAMPolicyStatement{statement}
+	default:
+		if err := json.Unmarshal(statements, &doc.Statements); err != nil {
+			return nil, fmt.Errorf("error parsing IAM policy statements: %w", err)
+		}
+	}
+
+	return doc, nil
+}
+
+func (s *IAMPolicyDoc) canonicalize() error {
+	keys := make(map[*IAMPolicyStatement]string, len(s.Statements))
+
+	for i, statement := range s.Statements {
+		if statement == nil {
+			return fmt.Errorf("IAM policy statement %d is null", i)
+		}
+
+		if err := statement.canonicalize(); err != nil {
+			return fmt.Errorf("IAM policy statement %d: %w", i, err)
+		}
+
+		b, err := json.Marshal(statement)
+
+		if err != nil {
+			return fmt.Errorf("IAM policy statement %d: %w", i, err)
+		}
+
+		keys[statement] = string(b)
+	}
+
+	sort.SliceStable(s.Statements, func(i, j int) bool {
+		return keys[s.Statements[i]] < keys[s.Statements[j]]
+	})
+
+	return nil
+}
+
+func (s *IAMPolicyStatement) canonicalize() error {
+	switch strings.ToLower(s.Effect) {
+	case "allow":
+		s.Effect = "Allow"
+	case "deny":
+		s.Effect = "Deny"
+	}
+
+	for _, v := range []*interface{}{&s.Actions, &s.NotActions, &s.Resources, &s.NotResources} {
+		values, err := iamPolicyStringSet(*v)
+
+		if err != nil {
+			return err
+		}
+
+		if len(values) == 0 {
+			*v = nil
+		} else {
+			*v = values
+		}
+	}
+
+	var err error
+
+	if s.Principals, err = s.Principals.canonicalize(); err != nil {
+		return err
+	}
+
+	if s.NotPrincipals, err = s.NotPrincipals.canonicalize(); err != nil {
+		return err
+	}
+
+	s.Conditions = s.Conditions.canonicalize()
+
+	return nil
+}
+
+func (ps IAMPolicyStatementPrincipalSet) canonicalize() (IAMPolicyStatementPrincipalSet, error) {
+	identifiers := make(map[string][]string)
+
+	for _, p := range ps {
+		values, err := iamPolicyStringSet(p.Identifiers)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range values {
+			if m := iamPolicyRootPrincipalRegexp.FindStringSubmatch(v); p.Type == "AWS" && m != nil {
+				v = m[1]
+			}
+
+			identifiers[p.Type] = append(identifiers[p.Type], v)
+		}
+	}
+
+	var out IAMPolicyStatementPrincipalSet
+
+	for _, principalType := range sortedKeys(identifiers) {
+		out = append(out, IAMPolicyStatementPrincipal{
+			Type:        principalType,
+			Identifiers: uniqueSortedStrings(identifiers[principalType]),
+		})
+	}
+
+	return out, nil
+}
+
+func (cs IAMPolicyStatementConditionSet) canonicalize() IAMPolicyStatementConditionSet {
+	type conditionKey struct {
+		test, variable string
+	}
+
+	values := make(map[conditionKey][]string)
+	var keys []conditionKey
+
+	for _, c := range cs {
+		key := conditionKey{c.Test, c.Variable}
+
+		if _, ok := values[key]; !ok {
+			keys = append(keys, key)
+		}
+
+		switch v := c.Values.(type) {
+		case string:
+			values[key] = append(values[key], v)
+		case []string:
+			values[key] = append(values[key], v...)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].test != keys[j].test {
+			return keys[i].test < keys[j].test
+		}
+
+		return keys[i].variable < keys[j].variable
+	})
+
+	var out IAMPolicyStatementConditionSet
+
+	for _, key := range keys {
+		out = append(out, IAMPolicyStatementCondition{
+			Test:     key.test,
+			Variable: key.variable,
+			Values:   uniqueSortedStrings(values[key]),
+		})
+	}
+
+	return out
+}
+
+// iamPolicyStringSet returns the strings in a policy element, which may be a single string or a list of strings.
+func iamPolicyStringSet(v interface{}) ([]string, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{v}, nil
+	case []string:
+		return uniqueSortedStrings(v), nil
+	case []interface{}:
+		values := make([]string, 0, len(v))
+
+		for _, e := range v {
+			s, ok := e.(string)
+
+			if !ok {
+				return nil, fmt.Errorf("unsupported data type %T in IAM policy element", e)
+			}
+
+			values = append(values, s)
+		}
+
+		return uniqueSortedStrings(values), nil
+	default:
+		return nil, fmt.Errorf("unsupported data type %T for IAM policy element", v)
+	}
+}
+
+func uniqueSortedStrings(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	out := make([]string, 0, len(values))
+
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+
+		seen[v] = struct{}{}
+		out = append(out, v)
+	}
+
+	sort.Strings(out)
+
+	return out
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
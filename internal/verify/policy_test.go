@@ -0,0 +1,431 @@
+package verify
+
+import (
+	"testing"
+)
+
+func TestPolicyStringsEquivalent(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Policy1     string
+		Policy2     string
+		Equivalent  bool
+		ExpectError bool
+	}{
+		{
+			Name:       "identical",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:    "whitespace",
+			Policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "*"
+    }
+  ]
+}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "single statement object",
+			Policy1:    `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "different version",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2008-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "different id",
+			Policy1:    `{"Version":"2012-10-17","Id":"a","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Id":"b","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "statements reordered by sid",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Sid":"B","Effect":"Deny","Action":"s3:PutObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Sid":"B","Effect":"Deny","Action":"s3:PutObject","Resource":"*"},{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "statements reordered without sid",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Deny","Action":"s3:PutObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:PutObject","Resource":"*"},{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "different sid",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Sid":"B","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "empty sid and no sid",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "extra statement",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "effect case",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "different effect",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "single element action array",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "actions reordered",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "duplicate actions",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:GetObject"],"Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "different actions",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":"*"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "action and not action",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","NotAction":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "not actions reordered",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotAction":["iam:*","sts:*"],"Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotAction":["sts:*","iam:*"],"Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "single element resource array",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":["arn:aws:s3:::bucket/*"]}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "resources reordered",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":["arn:aws:s3:::bucket","arn:aws:s3:::bucket/*"]}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":["arn:aws:s3:::bucket/*","arn:aws:s3:::bucket"]}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "resource case",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::Bucket"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::bucket"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "empty resource array",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Resource":[]}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "not resource",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","NotResource":["arn:aws:s3:::b","arn:aws:s3:::a"]}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","NotResource":["arn:aws:s3:::a","arn:aws:s3:::b"]}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "star principal",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"*":"*"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "star principal and AWS star principal",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "account ID principal and root ARN",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "account ID principal and root ARN in other partition",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["123456789012"]},"Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws-us-gov:iam::123456789012:root"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "account ID principals and root ARNs reordered",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["111111111111","arn:aws:iam::222222222222:root"]},"Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::111111111111:root","222222222222"]},"Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "different account ID principal and root ARN",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::210987654321:root"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "account ID principal and user ARN",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:user/root"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "service principals reordered",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":["ec2.amazonaws.com","lambda.amazonaws.com"]},"Action":"sts:AssumeRole"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":["lambda.amazonaws.com","ec2.amazonaws.com"]},"Action":"sts:AssumeRole"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "principal types",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com","AWS":"123456789012"},"Action":"sts:AssumeRole"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root"],"Service":["ec2.amazonaws.com"]},"Action":"sts:AssumeRole"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "different principal type",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Federated":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "empty principal identifiers",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012","Service":[]},"Action":"sts:AssumeRole"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"sts:AssumeRole"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "not principal account ID and root ARN",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotPrincipal":{"AWS":["123456789012","arn:aws:iam::123456789012:role/admin"]},"Action":"s3:*","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotPrincipal":{"AWS":["arn:aws:iam::123456789012:role/admin","arn:aws:iam::123456789012:root"]},"Action":"s3:*","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "principal and not principal",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":{"AWS":"123456789012"},"Action":"s3:*","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotPrincipal":{"AWS":"123456789012"},"Action":"s3:*","Resource":"*"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "single element condition value array",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEquals":{"aws:SourceVpce":["vpce-1a2b3c4d"]}}}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEquals":{"aws:SourceVpce":"vpce-1a2b3c4d"}}}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "condition values reordered",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"IpAddress":{"aws:SourceIp":["10.0.0.0/8","192.168.0.0/16"]}}}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"IpAddress":{"aws:SourceIp":["192.168.0.0/16","10.0.0.0/8"]}}}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "boolean condition value",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":false}}}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"false"}}}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "different boolean condition value",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":false}}}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"true"}}}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "numeric condition value",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"NumericLessThan":{"s3:TlsVersion":[1.2]}}}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"NumericLessThan":{"s3:TlsVersion":"1.2"}}}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "conditions reordered",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEquals":{"aws:PrincipalOrgID":"o-1234567890","aws:SourceAccount":"123456789012"},"Bool":{"aws:SecureTransport":"true"}}}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":true},"StringEquals":{"aws:SourceAccount":["123456789012"],"aws:PrincipalOrgID":["o-1234567890"]}}}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "different condition operator",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEquals":{"aws:SourceAccount":"123456789012"}}}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringLike":{"aws:SourceAccount":"123456789012"}}}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "different condition values",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEquals":{"aws:SourceAccount":["123456789012","210987654321"]}}}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEquals":{"aws:SourceAccount":"123456789012"}}}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "missing condition",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEquals":{"aws:SourceAccount":"123456789012"}}}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "empty condition",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{}}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name: "bucket policy",
+			Policy1: `{
+  "Version": "2012-10-17",
+  "Id": "BucketPolicy",
+  "Statement": [
+    {
+      "Sid": "AllowAccount",
+      "Effect": "Allow",
+      "Principal": {"AWS": "123456789012"},
+      "Action": ["s3:GetObject", "s3:ListBucket"],
+      "Resource": ["arn:aws:s3:::bucket", "arn:aws:s3:::bucket/*"]
+    },
+    {
+      "Sid": "DenyInsecureTransport",
+      "Effect": "Deny",
+      "Principal": "*",
+      "Action": "s3:*",
+      "Resource": ["arn:aws:s3:::bucket/*", "arn:aws:s3:::bucket"],
+      "Condition": {"Bool": {"aws:SecureTransport": false}}
+    }
+  ]
+}`,
+			Policy2:    `{"Version":"2012-10-17","Id":"BucketPolicy","Statement":[{"Sid":"DenyInsecureTransport","Effect":"Deny","Principal":"*","Action":"s3:*","Resource":["arn:aws:s3:::bucket","arn:aws:s3:::bucket/*"],"Condition":{"Bool":{"aws:SecureTransport":"false"}}},{"Sid":"AllowAccount","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":["s3:ListBucket","s3:GetObject"],"Resource":["arn:aws:s3:::bucket/*","arn:aws:s3:::bucket"]}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "queue policy",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},"Action":"sqs:SendMessage","Resource":"arn:aws:sqs:us-west-2:123456789012:queue","Condition":{"ArnEquals":{"aws:SourceArn":"arn:aws:sns:us-west-2:123456789012:topic"}}}]}`,         //lintignore:AWSAT003,AWSAT005
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":["sns.amazonaws.com"]},"Action":["sqs:SendMessage"],"Resource":["arn:aws:sqs:us-west-2:123456789012:queue"],"Condition":{"ArnEquals":{"aws:SourceArn":["arn:aws:sns:us-west-2:123456789012:topic"]}}}]}`, //lintignore:AWSAT003,AWSAT005
+			Equivalent: true,
+		},
+		{
+			Name:        "invalid JSON",
+			Policy1:     `{"Version":"2012-10-17","Statement":[`,
+			Policy2:     `{"Version":"2012-10-17","Statement":[]}`,
+			ExpectError: true,
+		},
+		{
+			Name:        "empty policy",
+			Policy1:     ``,
+			Policy2:     `{"Version":"2012-10-17","Statement":[]}`,
+			ExpectError: true,
+		},
+		{
+			Name:        "string principal",
+			Policy1:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"123456789012","Action":"s3:*","Resource":"*"}]}`,
+			Policy2:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:*","Resource":"*"}]}`,
+			ExpectError: true,
+		},
+		{
+			Name:        "non-string action",
+			Policy1:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":[1],"Resource":"*"}]}`,
+			Policy2:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"1","Resource":"*"}]}`,
+			ExpectError: true,
+		},
+		{
+			Name:        "object condition value",
+			Policy1:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEquals":{"aws:SourceAccount":{"a":"b"}}}}]}`,
+			Policy2:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, policies := range [][2]string{{testCase.Policy1, testCase.Policy2}, {testCase.Policy2, testCase.Policy1}} {
+				equivalent, err := PolicyStringsEquivalent(policies[0], policies[1])
+
+				if testCase.ExpectError {
+					if err == nil {
+						t.Fatalf("expected error comparing %s and %s", policies[0], policies[1])
+					}
+
+					continue
+				}
+
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if equivalent != testCase.Equivalent {
+					t.Errorf("expected equivalent %t, got %t comparing %s and %s", testCase.Equivalent, equivalent, policies[0], policies[1])
+				}
+			}
+		})
+	}
+}
+
+func TestNormalizeIAMPolicy(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Policy   string
+		Expected string
+	}{
+		{
+			Name:     "single statement",
+			Policy:   `{"Version":"2012-10-17","Statement":{"Effect":"allow","Action":"s3:GetObject","Resource":"*"}}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":["s3:GetObject"],"Resource":["*"]}]}`,
+		},
+		{
+			Name:     "principals and conditions",
+			Policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root","arn:aws:iam::123456789012:role/a"]},"Action":"sts:AssumeRole","Condition":{"Bool":{"aws:MultiFactorAuthPresent":true}}}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":["sts:AssumeRole"],"Principal":{"AWS":["arn:aws:iam::123456789012:role/a","123456789012"]},"Condition":{"Bool":{"aws:MultiFactorAuthPresent":["true"]}}}]}`,
+		},
+		{
+			Name:     "star principal",
+			Policy:   `{"Statement":[{"Effect":"Deny","Principal":{"*":["*"]},"NotAction":["s3:*","s3:*"],"Resource":"*"}]}`,
+			Expected: `{"Statement":[{"Sid":"","Effect":"Deny","NotAction":["s3:*"],"Resource":["*"],"Principal":"*"}]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NormalizeIAMPolicy(testCase.Policy)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("expected %s, got %s", testCase.Expected, got)
+			}
+		})
+	}
+}
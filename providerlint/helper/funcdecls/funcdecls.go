@@ -0,0 +1,51 @@
+package funcdecls
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Map returns the function declarations of the analyzed package keyed by their object
+func Map(pass *analysis.Pass) map[types.Object]*ast.FuncDecl {
+	result := make(map[types.Object]*ast.FuncDecl)
+
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+
+			if !ok {
+				continue
+			}
+
+			if obj := pass.TypesInfo.Defs[funcDecl.Name]; obj != nil {
+				result[obj] = funcDecl
+			}
+		}
+	}
+
+	return result
+}
+
+// Lookup returns the function declaration referenced by the expression, if declared in the analyzed package
+func Lookup(info *types.Info, funcDecls map[types.Object]*ast.FuncDecl, e ast.Expr) *ast.FuncDecl {
+	var ident *ast.Ident
+
+	switch e := e.(type) {
+	case *ast.Ident:
+		ident = e
+	case *ast.SelectorExpr:
+		ident = e.Sel
+	default:
+		return nil
+	}
+
+	obj := info.Uses[ident]
+
+	if obj == nil {
+		return nil
+	}
+
+	return funcDecls[obj]
+}
@@ -0,0 +1,110 @@
+package AWSR003
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourcedatasetcallexpr"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for d.Set() of non-primitive attributes that ignores the error
+
+The AWSR003 analyzer reports when the error returned by a
+(schema.ResourceData).Set() call is ignored and the value is not a primitive
+type or a pointer to one, e.g. a slice, map, struct or *schema.Set.
+
+Setting complex values can fail when the value does not match the attribute
+schema, so the error should be checked and returned.
+`
+
+const analyzerName = "AWSR003"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+		resourcedatasetcallexpr.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	callExprs := pass.ResultOf[resourcedatasetcallexpr.Analyzer].([]*ast.CallExpr)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+
+	setCallExprs := make(map[*ast.CallExpr]bool, len(callExprs))
+
+	for _, callExpr := range callExprs {
+		setCallExprs[callExpr] = true
+	}
+
+	nodeFilter := []ast.Node{
+		(*ast.AssignStmt)(nil),
+		(*ast.ExprStmt)(nil),
+	}
+
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		var callExpr *ast.CallExpr
+
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if len(n.Lhs) != 1 || len(n.Rhs) != 1 {
+				return
+			}
+
+			if ident, ok := n.Lhs[0].(*ast.Ident); !ok || ident.Name != "_" {
+				return
+			}
+
+			callExpr, _ = n.Rhs[0].(*ast.CallExpr)
+		case *ast.ExprStmt:
+			callExpr, _ = n.X.(*ast.CallExpr)
+		}
+
+		if callExpr == nil || !setCallExprs[callExpr] {
+			return
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, n) {
+			return
+		}
+
+		if len(callExpr.Args) < 2 {
+			return
+		}
+
+		if isPrimitive(pass.TypesInfo.TypeOf(callExpr.Args[1])) {
+			return
+		}
+
+		pass.Reportf(callExpr.Pos(), "%s: check error from d.Set() of non-primitive attribute", analyzerName)
+	})
+
+	return nil, nil
+}
+
+// isPrimitive returns true for basic types, pointers to basic types and types
+// that cannot be determined, such as interface{} values.
+func isPrimitive(t types.Type) bool {
+	if t == nil {
+		return true
+	}
+
+	if ptr, ok := t.Underlying().(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	switch t.Underlying().(type) {
+	case *types.Array, *types.Map, *types.Slice, *types.Struct:
+		return false
+	default:
+		return true
+	}
+}
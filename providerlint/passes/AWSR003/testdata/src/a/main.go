@@ -0,0 +1,58 @@
+package a
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type testStruct struct {
+	Name string
+}
+
+func f(d *schema.ResourceData, name *string, value interface{}) error {
+	/* Passing cases */
+
+	d.Set("name", "test")
+
+	d.Set("name", name)
+
+	d.Set("count", 1)
+
+	d.Set("value", value)
+
+	if err := d.Set("list", []interface{}{"test"}); err != nil {
+		return fmt.Errorf("error setting list: %w", err)
+	}
+
+	err := d.Set("map", map[string]interface{}{"key": "value"})
+
+	if err != nil {
+		return err
+	}
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR003
+	d.Set("list", []interface{}{"test"})
+
+	d.Set("list", []interface{}{"test"}) //lintignore:AWSR003
+
+	/* Failing cases */
+
+	d.Set("list", []interface{}{"test"}) // want "check error from d.Set\\(\\) of non-primitive attribute"
+
+	d.Set("map", map[string]interface{}{"key": "value"}) // want "check error from d.Set\\(\\) of non-primitive attribute"
+
+	d.Set("set", schema.NewSet(schema.HashString, nil)) // want "check error from d.Set\\(\\) of non-primitive attribute"
+
+	d.Set("struct", &testStruct{}) // want "check error from d.Set\\(\\) of non-primitive attribute"
+
+	_ = d.Set("list", flattenList()) // want "check error from d.Set\\(\\) of non-primitive attribute"
+
+	return nil
+}
+
+func flattenList() []interface{} {
+	return nil
+}
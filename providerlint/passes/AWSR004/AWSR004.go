@@ -0,0 +1,142 @@
+package AWSR004
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourceinforesourceonly"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/helper/funcdecls"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for Read functions that do not remove resources not found from state
+
+The AWSR004 analyzer reports when a resource Read function does not contain a
+conditional on !d.IsNewResource() that calls d.SetId(""). Resources that are
+deleted outside Terraform should be removed from state during refresh, while
+eventual consistency errors directly after creation should still be returned.
+`
+
+const analyzerName = "AWSR004"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		resourceinforesourceonly.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	resourceInfos := pass.ResultOf[resourceinforesourceonly.Analyzer].([]*schema.ResourceInfo)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	funcDecls := funcdecls.Map(pass)
+
+	for _, resourceInfo := range resourceInfos {
+		for _, fieldName := range []string{schema.ResourceFieldRead, schema.ResourceFieldReadContext, schema.ResourceFieldReadWithoutTimeout} {
+			kvExpr := resourceInfo.Fields[fieldName]
+
+			if kvExpr == nil {
+				continue
+			}
+
+			if commentIgnorer.ShouldIgnore(analyzerName, kvExpr) {
+				continue
+			}
+
+			funcDecl := funcdecls.Lookup(pass.TypesInfo, funcDecls, kvExpr.Value)
+
+			if funcDecl == nil || funcDecl.Body == nil {
+				continue
+			}
+
+			if handlesNotFound(pass, funcDecl.Body) {
+				continue
+			}
+
+			pass.Reportf(kvExpr.Value.Pos(), "%s: Read function should call d.SetId(\"\") when the resource is not found and !d.IsNewResource()", analyzerName)
+		}
+	}
+
+	return nil, nil
+}
+
+func handlesNotFound(pass *analysis.Pass, body *ast.BlockStmt) bool {
+	var found bool
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+
+		ifStmt, ok := n.(*ast.IfStmt)
+
+		if !ok {
+			return true
+		}
+
+		if containsNotIsNewResource(pass, ifStmt.Cond) && containsSetIdEmpty(pass, ifStmt.Body) {
+			found = true
+			return false
+		}
+
+		return true
+	})
+
+	return found
+}
+
+func containsNotIsNewResource(pass *analysis.Pass, cond ast.Expr) bool {
+	var found bool
+
+	ast.Inspect(cond, func(n ast.Node) bool {
+		unaryExpr, ok := n.(*ast.UnaryExpr)
+
+		if !ok || unaryExpr.Op != token.NOT {
+			return !found
+		}
+
+		x := unaryExpr.X
+
+		for parenExpr, ok := x.(*ast.ParenExpr); ok; parenExpr, ok = x.(*ast.ParenExpr) {
+			x = parenExpr.X
+		}
+
+		if callExpr, ok := x.(*ast.CallExpr); ok && schema.IsReceiverMethod(callExpr.Fun, pass.TypesInfo, schema.TypeNameResourceData, "IsNewResource") {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+func containsSetIdEmpty(pass *analysis.Pass, body *ast.BlockStmt) bool {
+	var found bool
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		callExpr, ok := n.(*ast.CallExpr)
+
+		if !ok {
+			return !found
+		}
+
+		if !schema.IsReceiverMethod(callExpr.Fun, pass.TypesInfo, schema.TypeNameResourceData, "SetId") || len(callExpr.Args) != 1 {
+			return !found
+		}
+
+		if id := astutils.ExprStringValue(callExpr.Args[0]); id != nil && *id == "" {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
@@ -0,0 +1,13 @@
+package AWSR004
+
+import (
+	"testing"
+
+	_ "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAWSR004(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, Analyzer, "a")
+}
@@ -0,0 +1,118 @@
+package a
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var errNotFound = errors.New("not found")
+
+func find(id string) error {
+	return nil
+}
+
+func f() {
+	/* Passing cases */
+
+	_ = &schema.Resource{
+		Create: resourceExampleCreate,
+		Read:   resourceExampleReadPassing,
+		Delete: resourceExampleDelete,
+	}
+
+	_ = &schema.Resource{
+		CreateContext: resourceExampleCreateContext,
+		ReadContext:   resourceExampleReadContextPassing,
+		DeleteContext: resourceExampleDeleteContext,
+	}
+
+	// Data Sources are not checked
+	_ = &schema.Resource{
+		Read: resourceExampleReadFailing,
+	}
+
+	/* Comment ignored cases */
+
+	_ = &schema.Resource{
+		Create: resourceExampleCreate,
+		//lintignore:AWSR004
+		Read:   resourceExampleReadFailing,
+		Delete: resourceExampleDelete,
+	}
+
+	/* Failing cases */
+
+	_ = &schema.Resource{
+		Create: resourceExampleCreate,
+		Read:   resourceExampleReadFailing, // want "Read function should call d.SetId"
+		Delete: resourceExampleDelete,
+	}
+
+	_ = &schema.Resource{
+		Create: resourceExampleCreate,
+		Read:   resourceExampleReadNoIsNewResource, // want "Read function should call d.SetId"
+		Delete: resourceExampleDelete,
+	}
+}
+
+func resourceExampleCreate(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceExampleDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceExampleCreateContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceExampleDeleteContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceExampleReadPassing(d *schema.ResourceData, meta interface{}) error {
+	err := find(d.Id())
+
+	if !d.IsNewResource() && errors.Is(err, errNotFound) {
+		log.Printf("[WARN] Example (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return err
+}
+
+func resourceExampleReadContextPassing(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := find(d.Id())
+
+	if errors.Is(err, errNotFound) && !d.IsNewResource() {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceExampleReadFailing(d *schema.ResourceData, meta interface{}) error {
+	return find(d.Id())
+}
+
+func resourceExampleReadNoIsNewResource(d *schema.ResourceData, meta interface{}) error {
+	err := find(d.Id())
+
+	if errors.Is(err, errNotFound) {
+		d.SetId("")
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,120 @@
+package AWSR005
+
+import (
+	"go/ast"
+
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourceinforesourceonly"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/helper/funcdecls"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for resources with Timeouts that never call d.Timeout()
+
+The AWSR005 analyzer reports when a resource declares Timeouts, but none of
+its Create, Read, Update or Delete functions call (schema.ResourceData).Timeout().
+Configured timeouts then have no effect.
+`
+
+const analyzerName = "AWSR005"
+
+var crudFieldNames = []string{
+	schema.ResourceFieldCreate,
+	schema.ResourceFieldCreateContext,
+	schema.ResourceFieldCreateWithoutTimeout,
+	schema.ResourceFieldRead,
+	schema.ResourceFieldReadContext,
+	schema.ResourceFieldReadWithoutTimeout,
+	schema.ResourceFieldUpdate,
+	schema.ResourceFieldUpdateContext,
+	schema.ResourceFieldUpdateWithoutTimeout,
+	schema.ResourceFieldDelete,
+	schema.ResourceFieldDeleteContext,
+	schema.ResourceFieldDeleteWithoutTimeout,
+}
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		resourceinforesourceonly.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	resourceInfos := pass.ResultOf[resourceinforesourceonly.Analyzer].([]*schema.ResourceInfo)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	funcDecls := funcdecls.Map(pass)
+
+	for _, resourceInfo := range resourceInfos {
+		kvExpr := resourceInfo.Fields[schema.ResourceFieldTimeouts]
+
+		if kvExpr == nil {
+			continue
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, kvExpr) {
+			continue
+		}
+
+		var crudFuncDecls []*ast.FuncDecl
+		var unresolved bool
+
+		for _, fieldName := range crudFieldNames {
+			crudKvExpr := resourceInfo.Fields[fieldName]
+
+			if crudKvExpr == nil {
+				continue
+			}
+
+			funcDecl := funcdecls.Lookup(pass.TypesInfo, funcDecls, crudKvExpr.Value)
+
+			if funcDecl == nil || funcDecl.Body == nil {
+				unresolved = true
+				continue
+			}
+
+			crudFuncDecls = append(crudFuncDecls, funcDecl)
+		}
+
+		// Functions declared elsewhere may call d.Timeout().
+		if unresolved {
+			continue
+		}
+
+		if callsTimeout(pass, crudFuncDecls) {
+			continue
+		}
+
+		pass.Reportf(kvExpr.Pos(), "%s: Timeouts declared but d.Timeout() is never called", analyzerName)
+	}
+
+	return nil, nil
+}
+
+func callsTimeout(pass *analysis.Pass, funcDecls []*ast.FuncDecl) bool {
+	var found bool
+
+	for _, funcDecl := range funcDecls {
+		ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+			if found {
+				return false
+			}
+
+			if callExpr, ok := n.(*ast.CallExpr); ok && schema.IsReceiverMethod(callExpr.Fun, pass.TypesInfo, schema.TypeNameResourceData, "Timeout") {
+				found = true
+			}
+
+			return !found
+		})
+
+		if found {
+			break
+		}
+	}
+
+	return found
+}
@@ -0,0 +1,70 @@
+package a
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func f() {
+	/* Passing cases */
+
+	_ = &schema.Resource{
+		Create: resourceExampleCreateWithTimeout,
+		Read:   resourceExampleRead,
+		Delete: resourceExampleDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+
+	_ = &schema.Resource{
+		Create: resourceExampleCreate,
+		Read:   resourceExampleRead,
+		Delete: resourceExampleDelete,
+	}
+
+	/* Comment ignored cases */
+
+	_ = &schema.Resource{
+		Create: resourceExampleCreate,
+		Read:   resourceExampleRead,
+		Delete: resourceExampleDelete,
+
+		//lintignore:AWSR005
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+
+	/* Failing cases */
+
+	_ = &schema.Resource{
+		Create: resourceExampleCreate,
+		Read:   resourceExampleRead,
+		Delete: resourceExampleDelete,
+
+		Timeouts: &schema.ResourceTimeout{ // want "Timeouts declared but d.Timeout\\(\\) is never called"
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceExampleCreate(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceExampleCreateWithTimeout(d *schema.ResourceData, meta interface{}) error {
+	_ = d.Timeout(schema.TimeoutCreate)
+
+	return nil
+}
+
+func resourceExampleRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceExampleDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
@@ -0,0 +1,110 @@
+package AWSR006
+
+import (
+	"go/ast"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourceinforesourceonly"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for tags attribute without tags_all and verify.SetTagsDiff
+
+The AWSR006 analyzer reports when a resource schema includes the tags
+attribute, but is missing the tags_all attribute or a CustomizeDiff that
+includes verify.SetTagsDiff. Both are required for provider level
+default_tags to be applied and shown in plans.
+`
+
+const (
+	analyzerName = "AWSR006"
+
+	funcNameSetTagsDiff = "SetTagsDiff"
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		resourceinforesourceonly.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	resourceInfos := pass.ResultOf[resourceinforesourceonly.Analyzer].([]*schema.ResourceInfo)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+
+	for _, resourceInfo := range resourceInfos {
+		attributeNames := schemaAttributeNames(resourceInfo)
+
+		if !attributeNames["tags"] {
+			continue
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, resourceInfo.AstCompositeLit) {
+			continue
+		}
+
+		if !attributeNames["tags_all"] {
+			pass.Reportf(resourceInfo.Fields[schema.ResourceFieldSchema].Pos(), "%s: missing tags_all attribute", analyzerName)
+		}
+
+		if kvExpr := resourceInfo.Fields[schema.ResourceFieldCustomizeDiff]; kvExpr == nil || !referencesSetTagsDiff(kvExpr.Value) {
+			pass.Reportf(resourceInfo.AstCompositeLit.Pos(), "%s: missing CustomizeDiff with verify.SetTagsDiff", analyzerName)
+		}
+	}
+
+	return nil, nil
+}
+
+// schemaAttributeNames returns the attribute names of a Schema map literal.
+// Unlike the Schema parsed by ResourceInfo, this includes attributes whose
+// value is not a composite literal, e.g. "tags": tftags.TagsSchema().
+func schemaAttributeNames(resourceInfo *schema.ResourceInfo) map[string]bool {
+	result := make(map[string]bool)
+
+	kvExpr := resourceInfo.Fields[schema.ResourceFieldSchema]
+
+	if kvExpr == nil {
+		return result
+	}
+
+	compositeLit, ok := kvExpr.Value.(*ast.CompositeLit)
+
+	if !ok {
+		return result
+	}
+
+	for _, elt := range compositeLit.Elts {
+		if kvExpr, ok := elt.(*ast.KeyValueExpr); ok {
+			if name := astutils.ExprStringValue(kvExpr.Key); name != nil {
+				result[*name] = true
+			}
+		}
+	}
+
+	return result
+}
+
+// referencesSetTagsDiff returns true if the expression refers to SetTagsDiff,
+// either directly or as an argument, e.g. customdiff.Sequence(verify.SetTagsDiff, ...).
+func referencesSetTagsDiff(e ast.Expr) bool {
+	var found bool
+
+	ast.Inspect(e, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.Ident:
+			found = found || n.Name == funcNameSetTagsDiff
+		case *ast.SelectorExpr:
+			found = found || n.Sel.Name == funcNameSetTagsDiff
+		}
+
+		return !found
+	})
+
+	return found
+}
@@ -0,0 +1,174 @@
+package a
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return nil
+}
+
+func otherDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return nil
+}
+
+func tagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+	}
+}
+
+func tagsSchemaComputed() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+		Computed: true,
+	}
+}
+
+func sequence(funcs ...schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return funcs[0]
+}
+
+func f() {
+	/* Passing cases */
+
+	_ = &schema.Resource{
+		Create: resourceExampleCreate,
+		Read:   resourceExampleRead,
+		Delete: resourceExampleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"tags_all": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: SetTagsDiff,
+	}
+
+	_ = &schema.Resource{
+		Create: resourceExampleCreate,
+		Read:   resourceExampleRead,
+		Delete: resourceExampleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+		},
+
+		CustomizeDiff: sequence(
+			otherDiff,
+			SetTagsDiff,
+		),
+	}
+
+	_ = &schema.Resource{
+		Create: resourceExampleCreate,
+		Read:   resourceExampleRead,
+		Delete: resourceExampleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+
+	// Data Sources are not checked
+	_ = &schema.Resource{
+		Read: resourceExampleRead,
+
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR006
+	_ = &schema.Resource{
+		Create: resourceExampleCreate,
+		Read:   resourceExampleRead,
+		Delete: resourceExampleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+
+	/* Failing cases */
+
+	_ = &schema.Resource{ // want "missing CustomizeDiff with verify.SetTagsDiff"
+		Create: resourceExampleCreate,
+		Read:   resourceExampleRead,
+		Delete: resourceExampleDelete,
+
+		Schema: map[string]*schema.Schema{ // want "missing tags_all attribute"
+			"tags": tagsSchema(),
+		},
+	}
+
+	_ = &schema.Resource{
+		Create: resourceExampleCreate,
+		Read:   resourceExampleRead,
+		Delete: resourceExampleDelete,
+
+		Schema: map[string]*schema.Schema{ // want "missing tags_all attribute"
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+
+		CustomizeDiff: SetTagsDiff,
+	}
+
+	_ = &schema.Resource{ // want "missing CustomizeDiff with verify.SetTagsDiff"
+		Create: resourceExampleCreate,
+		Read:   resourceExampleRead,
+		Delete: resourceExampleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"tags_all": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: otherDiff,
+	}
+}
+
+func resourceExampleCreate(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceExampleRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceExampleDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
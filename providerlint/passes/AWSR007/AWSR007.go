@@ -0,0 +1,338 @@
+package AWSR007
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for resources registered in the provider without a sweeper or import test
+
+The AWSR007 analyzer reports when a resource registered in the provider
+ResourcesMap has no sweeper registered with resource.AddTestSweepers() or no
+acceptance test with an ImportState step, in the package that implements it.
+
+Sweepers clean up resources left behind by failed acceptance tests and import
+tests verify that the resource can be imported.
+`
+
+const (
+	analyzerName = "AWSR007"
+
+	commentIgnorePrefix     = "//lintignore:"
+	fieldNameImportState    = "ImportState"
+	fieldNameResourcesMap   = "ResourcesMap"
+	funcNameAddTestSweepers = "AddTestSweepers"
+	goFileNameSuffix        = ".go"
+	testFileNameSuffix      = "_test.go"
+)
+
+// resourceAddressRegexp matches resource addresses, e.g. aws_vpc.test
+var resourceAddressRegexp = regexp.MustCompile(`^([a-z][a-z0-9]*_[a-z0-9_]+)\.[a-zA-Z0-9_-]+$`)
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		inspect.Analyzer,
+	},
+	Run: run,
+}
+
+// packageInfo holds the resource names with sweepers and import tests in a package directory
+type packageInfo struct {
+	importTests map[string]bool
+	sweepers    map[string]bool
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	endOfLineIgnores, ownLineIgnores := ignoredLines(pass)
+	packageInfos := make(map[string]*packageInfo)
+
+	check := func(key ast.Expr, value ast.Expr) {
+		if position := pass.Fset.Position(key.Pos()); endOfLineIgnores[position.Filename][position.Line] || ownLineIgnores[position.Filename][position.Line-1] {
+			return
+		}
+
+		name := astutils.ExprStringValue(key)
+
+		if name == nil {
+			return
+		}
+
+		dir := resourceFuncDir(pass, value)
+
+		if dir == "" {
+			return
+		}
+
+		info, ok := packageInfos[dir]
+
+		if !ok {
+			info = newPackageInfo(dir)
+			packageInfos[dir] = info
+		}
+
+		if !info.sweepers[*name] {
+			pass.Reportf(key.Pos(), "%s: resource %s has no sweeper", analyzerName, *name)
+		}
+
+		if !info.importTests[*name] {
+			pass.Reportf(key.Pos(), "%s: resource %s has no import test", analyzerName, *name)
+		}
+	}
+
+	nodeFilter := []ast.Node{
+		(*ast.AssignStmt)(nil),
+		(*ast.KeyValueExpr)(nil),
+	}
+
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			// provider.ResourcesMap["aws_example"] = example.ResourceExample()
+			if len(n.Lhs) != 1 || len(n.Rhs) != 1 {
+				return
+			}
+
+			indexExpr, ok := n.Lhs[0].(*ast.IndexExpr)
+
+			if !ok {
+				return
+			}
+
+			if selectorExpr, ok := indexExpr.X.(*ast.SelectorExpr); !ok || selectorExpr.Sel.Name != fieldNameResourcesMap {
+				return
+			}
+
+			check(indexExpr.Index, n.Rhs[0])
+		case *ast.KeyValueExpr:
+			// ResourcesMap: map[string]*schema.Resource{"aws_example": example.ResourceExample()}
+			if ident, ok := n.Key.(*ast.Ident); !ok || ident.Name != fieldNameResourcesMap {
+				return
+			}
+
+			compositeLit, ok := n.Value.(*ast.CompositeLit)
+
+			if !ok {
+				return
+			}
+
+			for _, elt := range compositeLit.Elts {
+				if kvExpr, ok := elt.(*ast.KeyValueExpr); ok {
+					check(kvExpr.Key, kvExpr.Value)
+				}
+			}
+		}
+	})
+
+	return nil, nil
+}
+
+// ignoredLines returns the lines of each file with a //lintignore:AWSR007 comment
+// at the end of the line and the lines with only such a comment.
+// The commentignore analyzer can't be used, as ast.CommentMap associates a
+// comment at the end of a map entry line with the following entry.
+func ignoredLines(pass *analysis.Pass) (map[string]map[int]bool, map[string]map[int]bool) {
+	endOfLine := make(map[string]map[int]bool)
+	ownLine := make(map[string]map[int]bool)
+
+	for _, file := range pass.Files {
+		var src []byte
+
+		for _, commentGroup := range file.Comments {
+			for _, comment := range commentGroup.List {
+				if !isIgnoreComment(comment.Text) {
+					continue
+				}
+
+				position := pass.Fset.Position(comment.Pos())
+
+				if src == nil {
+					var err error
+
+					if src, err = os.ReadFile(position.Filename); err != nil {
+						break
+					}
+				}
+
+				lines := endOfLine
+
+				if lineStart := position.Offset - (position.Column - 1); lineStart >= 0 && strings.TrimSpace(string(src[lineStart:position.Offset])) == "" {
+					lines = ownLine
+				}
+
+				if lines[position.Filename] == nil {
+					lines[position.Filename] = make(map[int]bool)
+				}
+
+				lines[position.Filename][position.Line] = true
+			}
+		}
+	}
+
+	return endOfLine, ownLine
+}
+
+// isIgnoreComment returns true if the comment is //lintignore: for this analyzer,
+// optionally with other analyzers, e.g. //lintignore:AWSR006,AWSR007
+func isIgnoreComment(text string) bool {
+	if !strings.HasPrefix(text, commentIgnorePrefix) {
+		return false
+	}
+
+	keys := strings.Split(strings.TrimPrefix(text, commentIgnorePrefix), "//")[0]
+
+	for _, key := range strings.Split(keys, ",") {
+		if strings.TrimSpace(key) == analyzerName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resourceFuncDir returns the directory of the function called to create the resource
+func resourceFuncDir(pass *analysis.Pass, e ast.Expr) string {
+	callExpr, ok := e.(*ast.CallExpr)
+
+	if !ok {
+		return ""
+	}
+
+	var ident *ast.Ident
+
+	switch fun := callExpr.Fun.(type) {
+	case *ast.Ident:
+		ident = fun
+	case *ast.SelectorExpr:
+		ident = fun.Sel
+	default:
+		return ""
+	}
+
+	obj := pass.TypesInfo.Uses[ident]
+
+	if obj == nil || !obj.Pos().IsValid() {
+		return ""
+	}
+
+	filename := pass.Fset.Position(obj.Pos()).Filename
+
+	if filename == "" {
+		return ""
+	}
+
+	return filepath.Dir(filename)
+}
+
+// newPackageInfo parses the Go source files in the directory, including
+// files with build constraints and tests which are not part of the analyzed
+// package, for sweepers and import tests.
+func newPackageInfo(dir string) *packageInfo {
+	info := &packageInfo{
+		importTests: make(map[string]bool),
+		sweepers:    make(map[string]bool),
+	}
+
+	entries, err := os.ReadDir(dir)
+
+	if err != nil {
+		return info
+	}
+
+	fset := token.NewFileSet()
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), goFileNameSuffix) {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dir, entry.Name()), nil, 0)
+
+		if err != nil {
+			continue
+		}
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			callExpr, ok := n.(*ast.CallExpr)
+
+			if !ok || len(callExpr.Args) == 0 {
+				return true
+			}
+
+			if selectorExpr, ok := callExpr.Fun.(*ast.SelectorExpr); !ok || selectorExpr.Sel.Name != funcNameAddTestSweepers {
+				return true
+			}
+
+			if name := astutils.ExprStringValue(callExpr.Args[0]); name != nil {
+				info.sweepers[*name] = true
+			}
+
+			return true
+		})
+
+		if !strings.HasSuffix(entry.Name(), testFileNameSuffix) {
+			continue
+		}
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+
+			if !ok || funcDecl.Body == nil || !hasImportStateStep(funcDecl.Body) {
+				continue
+			}
+
+			ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+				basicLit, ok := n.(*ast.BasicLit)
+
+				if !ok {
+					return true
+				}
+
+				if value := astutils.ExprStringValue(basicLit); value != nil {
+					if matches := resourceAddressRegexp.FindStringSubmatch(*value); matches != nil {
+						info.importTests[matches[1]] = true
+					}
+				}
+
+				return true
+			})
+		}
+	}
+
+	return info
+}
+
+// hasImportStateStep returns true if the function body includes ImportState: true
+func hasImportStateStep(body *ast.BlockStmt) bool {
+	var found bool
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		kvExpr, ok := n.(*ast.KeyValueExpr)
+
+		if !ok {
+			return !found
+		}
+
+		if key, ok := kvExpr.Key.(*ast.Ident); ok && key.Name == fieldNameImportState {
+			if value, ok := kvExpr.Value.(*ast.Ident); ok && value.Name == "true" {
+				found = true
+			}
+		}
+
+		return !found
+	})
+
+	return found
+}
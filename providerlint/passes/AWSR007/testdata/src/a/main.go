@@ -0,0 +1,36 @@
+package a
+
+import (
+	"b"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func Provider() *schema.Provider {
+	provider := &schema.Provider{
+		DataSourcesMap: map[string]*schema.Resource{
+			"example_thing": b.DataSourceThing(),
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			/* Passing cases */
+
+			"example_thing": b.ResourceThing(),
+
+			/* Comment ignored cases */
+
+			//lintignore:AWSR007
+			"example_widget":      b.ResourceWidget(),
+			"example_widget_part": b.ResourceWidgetPart(), //lintignore:AWSR007
+
+			/* Failing cases */
+
+			"example_widget_attachment": b.ResourceWidgetAttachment(), // want "resource example_widget_attachment has no sweeper"
+			"example_gadget":            b.ResourceGadget(),           // want "resource example_gadget has no sweeper" "resource example_gadget has no import test"
+		},
+	}
+
+	provider.ResourcesMap["example_gizmo"] = b.ResourceGizmo() // want "resource example_gizmo has no import test"
+
+	return provider
+}
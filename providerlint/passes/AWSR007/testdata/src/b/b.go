@@ -0,0 +1,33 @@
+package b
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceThing() *schema.Resource {
+	return &schema.Resource{}
+}
+
+func ResourceGadget() *schema.Resource {
+	return &schema.Resource{}
+}
+
+func ResourceGizmo() *schema.Resource {
+	return &schema.Resource{}
+}
+
+func ResourceThing() *schema.Resource {
+	return &schema.Resource{}
+}
+
+func ResourceWidget() *schema.Resource {
+	return &schema.Resource{}
+}
+
+func ResourceWidgetAttachment() *schema.Resource {
+	return &schema.Resource{}
+}
+
+func ResourceWidgetPart() *schema.Resource {
+	return &schema.Resource{}
+}
@@ -0,0 +1,51 @@
+package b
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccThing_basic(t *testing.T) {
+	resourceName := "example_thing.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "example_thing" "test" {}`,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWidgetAttachment_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "example_widget_attachment" "test" {}`,
+			},
+			{
+				ResourceName: "example_widget_attachment.test",
+				ImportState:  true,
+			},
+		},
+	})
+}
+
+func TestAccGadget_basic(t *testing.T) {
+	resourceName := "example_gadget.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "example_gadget" "test" {}`,
+				Check:  resource.TestCheckResourceAttrSet(resourceName, "id"),
+			},
+		},
+	})
+}
@@ -0,0 +1,28 @@
+//go:build sweep
+// +build sweep
+
+package b
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("example_gizmo", &resource.Sweeper{
+		Name: "example_gizmo",
+		F:    sweepGizmos,
+	})
+
+	resource.AddTestSweepers("example_thing", &resource.Sweeper{
+		Name: "example_thing",
+		F:    sweepThings,
+	})
+}
+
+func sweepGizmos(region string) error {
+	return nil
+}
+
+func sweepThings(region string) error {
+	return nil
+}